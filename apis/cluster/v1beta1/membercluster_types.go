@@ -78,6 +78,11 @@ type MemberClusterSpec struct {
 	// DeleteOptions for deleting the MemberCluster.
 	// +optional
 	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
+
+	// MaintenanceWindow, if specified, restricts when Fleet rolls out changes to the MemberCluster;
+	// bindings to the MemberCluster are only updated inside the window.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 // DeleteValidationMode identifies the type of validation when deleting a MemberCluster.
@@ -100,6 +105,39 @@ type DeleteOptions struct {
 	ValidationMode DeleteValidationMode `json:"validationMode,omitempty"`
 }
 
+// MaintenanceWindow is a recurring window of time during which Fleet is allowed to roll out changes
+// to a MemberCluster.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression in the standard five-field format (minute, hour, day of month,
+	// month, day of week) that specifies when each window starts, e.g., "0 2 * * *" for 02:00
+	// every day.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long each window lasts once it starts, e.g., "3h".
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the name of the IANA time zone in which the schedule is interpreted, e.g.,
+	// "America/Los_Angeles". Default is UTC.
+	// +kubebuilder:default=UTC
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindowStatus describes the maintenance window of a MemberCluster as observed by the hub.
+type MaintenanceWindowStatus struct {
+	// StartTime is when the current maintenance window started, if the MemberCluster is inside a
+	// window; otherwise it is when the next window starts.
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+
+	// EndTime is when the window specified by StartTime ends.
+	// +required
+	EndTime metav1.Time `json:"endTime"`
+}
+
 // PropertyName is the name of a cluster property; it should be a Kubernetes label name.
 type PropertyName string
 
@@ -152,6 +190,11 @@ type MemberClusterStatus struct {
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
+
+	// MaintenanceWindow is the current or next maintenance window of the member cluster; it is only
+	// populated when a maintenance window is specified.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
 }
 
 // Taint attached to MemberCluster has the "effect" on
@@ -166,8 +209,17 @@ type Taint struct {
 	Value string `json:"value,omitempty"`
 
 	// The effect of the taint on ClusterResourcePlacements that do not tolerate the taint.
-	// Only NoSchedule is supported.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// Supported effects are NoSchedule and NoExecute.
+	//
+	// With the NoSchedule effect, ClusterResourcePlacements that do not tolerate the taint will not
+	// be scheduled to the MemberCluster, but their existing placements on the MemberCluster are kept.
+	//
+	// With the NoExecute effect, ClusterResourcePlacements that do not tolerate the taint will not be
+	// scheduled to the MemberCluster either; in addition, their existing placements on the MemberCluster
+	// are evicted (subject to their disruption budgets), unless they tolerate the taint for a limited
+	// period of time (see the TolerationSeconds field of tolerations), in which case the placements
+	// are evicted when the period ends.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +required
 	Effect corev1.TaintEffect `json:"effect"`
 }
@@ -22,6 +22,18 @@ import (
 
 const (
 	ClusterRequestCondTypeCompleted = "Completed"
+	ClusterRequestCondTypeStale     = "Stale"
+)
+
+// The reasons for each condition type of the ClusterRequest API.
+const (
+	ClusterRequestStaleCondReasonNewerClusterObserved = "NewerClusterObserved"
+	ClusterRequestStaleCondReasonReevaluated          = "Reevaluated"
+)
+
+const (
+	// ClusterRequestKind is the kind of the ClusterRequest API.
+	ClusterRequestKind = "ClusterRequest"
 )
 
 // ClusterRequest is a KubeFleet API that represents a request for a member cluster to be provisioned.
@@ -40,3 +40,59 @@ type ObjectReference struct {
 	// +kubebuilder:validation:Required
 	Kind string `json:"kind,omitempty"`
 }
+
+const (
+	// KubeFleetPrefix is the prefix used for official KubeFleet labels, annotations, and finalizers.
+	KubeFleetPrefix = "kubefleet.dev/"
+
+	// PlacementPolicyCleanupFinalizer is a finalizer added by the placement policy controller to all
+	// (cluster) placement policies, so that bindings and resource snapshots can be cleaned up before
+	// a placement policy is deleted.
+	PlacementPolicyCleanupFinalizer = KubeFleetPrefix + "placement-policy-cleanup"
+
+	// PlacementBindingCleanupFinalizer is a finalizer added by the placement binding controller to all
+	// (cluster) placement bindings, so that the generated works can be cleaned up before a binding is deleted.
+	PlacementBindingCleanupFinalizer = KubeFleetPrefix + "placement-binding-cleanup"
+
+	// ParentPlacementPolicyLabel is the label added to resource snapshots, bindings, cluster requests,
+	// and works that tracks the name of the placement policy they are created for.
+	ParentPlacementPolicyLabel = KubeFleetPrefix + "parent-placement-policy"
+
+	// ParentPlacementPolicyNamespaceLabel is the label added to cluster requests and works that tracks the
+	// namespace of the placement policy they are created for; it is absent if the parent is a cluster
+	// placement policy.
+	ParentPlacementPolicyNamespaceLabel = KubeFleetPrefix + "parent-placement-policy-namespace"
+
+	// ParentPlacementBindingLabel is the label added to works that tracks the name of the binding
+	// they are generated from.
+	ParentPlacementBindingLabel = KubeFleetPrefix + "parent-placement-binding"
+
+	// ClusterSelectorHashLabel is the label added to cluster requests that tracks the hash of the
+	// cluster selector that the cluster request is submitted for.
+	ClusterSelectorHashLabel = KubeFleetPrefix + "cluster-selector-hash"
+
+	// ResourceIndexLabel is the label added to resource snapshots that tracks the index of the snapshot.
+	ResourceIndexLabel = KubeFleetPrefix + "resource-index"
+
+	// IsLatestResourceSnapshotLabel is the label added to resource snapshots that marks whether the snapshot
+	// is the latest one for its placement policy.
+	IsLatestResourceSnapshotLabel = KubeFleetPrefix + "is-latest-resource-snapshot"
+
+	// ResourceHashAnnotation is the annotation added to resource snapshots that tracks the hash of the
+	// snapshotted resources.
+	ResourceHashAnnotation = KubeFleetPrefix + "resource-hash"
+
+	// ClusterSelectorHashesAnnotation is the annotation added to bindings that tracks the hashes of the
+	// cluster selectors fulfilled by the binding, as a comma-separated list.
+	ClusterSelectorHashesAnnotation = KubeFleetPrefix + "cluster-selector-hashes"
+
+	// ParentResourceSnapshotNameAnnotation is the annotation added to works that tracks the name of the
+	// resource snapshot the work is generated from.
+	ParentResourceSnapshotNameAnnotation = KubeFleetPrefix + "parent-resource-snapshot-name"
+
+	// WhenNamespaceDoesNotExistAnnotation is the annotation added to works that tracks the action the work
+	// applier should take when the namespace of a namespaced resource does not exist on the member cluster;
+	// the value is a WhenNamespaceDoesNotExistOption. The option is conveyed as an annotation as the apply
+	// strategy of works has no equivalent field.
+	WhenNamespaceDoesNotExistAnnotation = KubeFleetPrefix + "when-namespace-does-not-exist"
+)
@@ -17,7 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
 )
 
 // The condition types for the PlacementBinding and ClusterPlacementBinding APIs.
@@ -35,6 +39,36 @@ const (
 	PlacementBindingAvailableCondReasonSomeResourcesUnavailable = "SomeResourcesUnavailable"
 )
 
+const (
+	// PlacementBindingKind is the kind of the PlacementBinding API.
+	PlacementBindingKind = "PlacementBinding"
+	// ClusterPlacementBindingKind is the kind of the ClusterPlacementBinding API.
+	ClusterPlacementBindingKind = "ClusterPlacementBinding"
+)
+
+// make sure the PlacementBindingObj and PlacementBindingObjList interfaces are implemented by the
+// PlacementBinding and ClusterPlacementBinding types.
+var _ PlacementBindingObj = &PlacementBinding{}
+var _ PlacementBindingObj = &ClusterPlacementBinding{}
+var _ PlacementBindingObjList = &PlacementBindingList{}
+var _ PlacementBindingObjList = &ClusterPlacementBindingList{}
+
+// PlacementBindingObj offers an abstract way to work with the PlacementBinding and ClusterPlacementBinding APIs.
+// +kubebuilder:object:generate=false
+type PlacementBindingObj interface {
+	apis.ConditionedObj
+	GetPlacementBindingSpec() *PlacementBindingSpec
+	GetPlacementBindingStatus() *PlacementBindingStatus
+	SetPlacementBindingStatus(PlacementBindingStatus)
+}
+
+// PlacementBindingObjList offers an abstract way to work with a list of placement binding objects.
+// +kubebuilder:object:generate=false
+type PlacementBindingObjList interface {
+	client.ObjectList
+	GetPlacementBindingObjs() []PlacementBindingObj
+}
+
 // PlacementBinding is the KubeFleet API that binds the resources selected by a placement
 // policy to a specific member cluster.
 //
@@ -214,6 +248,78 @@ type ClusterPlacementBindingList struct {
 	Items []ClusterPlacementBinding `json:"items"`
 }
 
+// SetConditions sets the conditions of the PlacementBinding.
+func (b *PlacementBinding) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&b.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given type from the PlacementBinding.
+func (b *PlacementBinding) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(b.Status.Conditions, conditionType)
+}
+
+// GetPlacementBindingSpec returns the spec of the PlacementBinding.
+func (b *PlacementBinding) GetPlacementBindingSpec() *PlacementBindingSpec {
+	return &b.Spec
+}
+
+// GetPlacementBindingStatus returns the status of the PlacementBinding.
+func (b *PlacementBinding) GetPlacementBindingStatus() *PlacementBindingStatus {
+	return &b.Status
+}
+
+// SetPlacementBindingStatus sets the status of the PlacementBinding.
+func (b *PlacementBinding) SetPlacementBindingStatus(status PlacementBindingStatus) {
+	status.DeepCopyInto(&b.Status)
+}
+
+// SetConditions sets the conditions of the ClusterPlacementBinding.
+func (b *ClusterPlacementBinding) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&b.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given type from the ClusterPlacementBinding.
+func (b *ClusterPlacementBinding) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(b.Status.Conditions, conditionType)
+}
+
+// GetPlacementBindingSpec returns the spec of the ClusterPlacementBinding.
+func (b *ClusterPlacementBinding) GetPlacementBindingSpec() *PlacementBindingSpec {
+	return &b.Spec
+}
+
+// GetPlacementBindingStatus returns the status of the ClusterPlacementBinding.
+func (b *ClusterPlacementBinding) GetPlacementBindingStatus() *PlacementBindingStatus {
+	return &b.Status
+}
+
+// SetPlacementBindingStatus sets the status of the ClusterPlacementBinding.
+func (b *ClusterPlacementBinding) SetPlacementBindingStatus(status PlacementBindingStatus) {
+	status.DeepCopyInto(&b.Status)
+}
+
+// GetPlacementBindingObjs returns the placement binding objects in the list.
+func (l *PlacementBindingList) GetPlacementBindingObjs() []PlacementBindingObj {
+	objs := make([]PlacementBindingObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
+// GetPlacementBindingObjs returns the placement binding objects in the list.
+func (l *ClusterPlacementBindingList) GetPlacementBindingObjs() []PlacementBindingObj {
+	objs := make([]PlacementBindingObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
 // Set up the API types with the scheme builder.
 func init() {
 	SchemeBuilder.Register(&PlacementBinding{}, &PlacementBindingList{})
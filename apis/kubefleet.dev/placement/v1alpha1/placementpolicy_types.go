@@ -18,8 +18,12 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
 )
 
 // The condition types for PlacementPolicy and ClusterPlacementPolicy API objects.
@@ -45,6 +49,36 @@ const (
 	PlacementPolicyAvailableCondReasonSomeClustersUnavailable = "ResourcesUnavailableOnSomeClusters"
 )
 
+const (
+	// PlacementPolicyKind is the kind of the PlacementPolicy API.
+	PlacementPolicyKind = "PlacementPolicy"
+	// ClusterPlacementPolicyKind is the kind of the ClusterPlacementPolicy API.
+	ClusterPlacementPolicyKind = "ClusterPlacementPolicy"
+)
+
+// make sure the PlacementPolicyObj and PlacementPolicyObjList interfaces are implemented by the
+// PlacementPolicy and ClusterPlacementPolicy types.
+var _ PlacementPolicyObj = &PlacementPolicy{}
+var _ PlacementPolicyObj = &ClusterPlacementPolicy{}
+var _ PlacementPolicyObjList = &PlacementPolicyList{}
+var _ PlacementPolicyObjList = &ClusterPlacementPolicyList{}
+
+// PlacementPolicyObj offers an abstract way to work with the PlacementPolicy and ClusterPlacementPolicy APIs.
+// +kubebuilder:object:generate=false
+type PlacementPolicyObj interface {
+	apis.ConditionedObj
+	GetPlacementPolicySpec() *PlacementPolicySpec
+	GetPlacementPolicyStatus() *PlacementPolicyStatus
+	SetPlacementPolicyStatus(PlacementPolicyStatus)
+}
+
+// PlacementPolicyObjList offers an abstract way to work with a list of placement policy objects.
+// +kubebuilder:object:generate=false
+type PlacementPolicyObjList interface {
+	client.ObjectList
+	GetPlacementPolicyObjs() []PlacementPolicyObj
+}
+
 // PlacementPolicy is the KubeFleet API that enables users to place resources within a namespace across
 // member clusters.
 //
@@ -594,6 +628,78 @@ type ClusterPlacementPolicyList struct {
 	Items []ClusterPlacementPolicy `json:"items"`
 }
 
+// SetConditions sets the conditions of the PlacementPolicy.
+func (p *PlacementPolicy) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&p.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given type from the PlacementPolicy.
+func (p *PlacementPolicy) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(p.Status.Conditions, conditionType)
+}
+
+// GetPlacementPolicySpec returns the spec of the PlacementPolicy.
+func (p *PlacementPolicy) GetPlacementPolicySpec() *PlacementPolicySpec {
+	return &p.Spec
+}
+
+// GetPlacementPolicyStatus returns the status of the PlacementPolicy.
+func (p *PlacementPolicy) GetPlacementPolicyStatus() *PlacementPolicyStatus {
+	return &p.Status
+}
+
+// SetPlacementPolicyStatus sets the status of the PlacementPolicy.
+func (p *PlacementPolicy) SetPlacementPolicyStatus(status PlacementPolicyStatus) {
+	status.DeepCopyInto(&p.Status)
+}
+
+// SetConditions sets the conditions of the ClusterPlacementPolicy.
+func (p *ClusterPlacementPolicy) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&p.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given type from the ClusterPlacementPolicy.
+func (p *ClusterPlacementPolicy) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(p.Status.Conditions, conditionType)
+}
+
+// GetPlacementPolicySpec returns the spec of the ClusterPlacementPolicy.
+func (p *ClusterPlacementPolicy) GetPlacementPolicySpec() *PlacementPolicySpec {
+	return &p.Spec
+}
+
+// GetPlacementPolicyStatus returns the status of the ClusterPlacementPolicy.
+func (p *ClusterPlacementPolicy) GetPlacementPolicyStatus() *PlacementPolicyStatus {
+	return &p.Status
+}
+
+// SetPlacementPolicyStatus sets the status of the ClusterPlacementPolicy.
+func (p *ClusterPlacementPolicy) SetPlacementPolicyStatus(status PlacementPolicyStatus) {
+	status.DeepCopyInto(&p.Status)
+}
+
+// GetPlacementPolicyObjs returns the placement policy objects in the list.
+func (l *PlacementPolicyList) GetPlacementPolicyObjs() []PlacementPolicyObj {
+	objs := make([]PlacementPolicyObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
+// GetPlacementPolicyObjs returns the placement policy objects in the list.
+func (l *ClusterPlacementPolicyList) GetPlacementPolicyObjs() []PlacementPolicyObj {
+	objs := make([]PlacementPolicyObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
 // Set up the API types with the scheme builder.
 func init() {
 	SchemeBuilder.Register(&PlacementPolicy{}, &PlacementPolicyList{})
@@ -19,8 +19,44 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// PlacementResourceSnapshotNameFmt is the format of the name of a (cluster) placement resource snapshot:
+	// {PlacementPolicyName}-{ResourceIndex}-snapshot.
+	PlacementResourceSnapshotNameFmt = "%s-%d-snapshot"
+)
+
+const (
+	// PlacementResourceSnapshotKind is the kind of the PlacementResourceSnapshot API.
+	PlacementResourceSnapshotKind = "PlacementResourceSnapshot"
+	// ClusterPlacementResourceSnapshotKind is the kind of the ClusterPlacementResourceSnapshot API.
+	ClusterPlacementResourceSnapshotKind = "ClusterPlacementResourceSnapshot"
+)
+
+// make sure the PlacementResourceSnapshotObj and PlacementResourceSnapshotObjList interfaces are implemented by the
+// PlacementResourceSnapshot and ClusterPlacementResourceSnapshot types.
+var _ PlacementResourceSnapshotObj = &PlacementResourceSnapshot{}
+var _ PlacementResourceSnapshotObj = &ClusterPlacementResourceSnapshot{}
+var _ PlacementResourceSnapshotObjList = &PlacementResourceSnapshotList{}
+var _ PlacementResourceSnapshotObjList = &ClusterPlacementResourceSnapshotList{}
+
+// PlacementResourceSnapshotObj offers an abstract way to work with the PlacementResourceSnapshot and
+// ClusterPlacementResourceSnapshot APIs.
+// +kubebuilder:object:generate=false
+type PlacementResourceSnapshotObj interface {
+	client.Object
+	GetPlacementResourceSnapshotSpec() *PlacementResourceSnapshotSpec
+}
+
+// PlacementResourceSnapshotObjList offers an abstract way to work with a list of placement resource snapshot objects.
+// +kubebuilder:object:generate=false
+type PlacementResourceSnapshotObjList interface {
+	client.ObjectList
+	GetPlacementResourceSnapshotObjs() []PlacementResourceSnapshotObj
+}
+
 // PlacementResourceSnapshot is the KubeFleet API that captures the resources selected by a placement policy
 // as seen on the hub cluster at a specific point in time. It is referenced by other KubeFleet APIs
 // to enable consistent rollouts of resources across multiple member clusters in the fleet.
@@ -111,6 +147,34 @@ type ClusterPlacementResourceSnapshotList struct {
 	Items []ClusterPlacementResourceSnapshot `json:"items"`
 }
 
+// GetPlacementResourceSnapshotSpec returns the spec of the PlacementResourceSnapshot.
+func (s *PlacementResourceSnapshot) GetPlacementResourceSnapshotSpec() *PlacementResourceSnapshotSpec {
+	return &s.Spec
+}
+
+// GetPlacementResourceSnapshotSpec returns the spec of the ClusterPlacementResourceSnapshot.
+func (s *ClusterPlacementResourceSnapshot) GetPlacementResourceSnapshotSpec() *PlacementResourceSnapshotSpec {
+	return &s.Spec
+}
+
+// GetPlacementResourceSnapshotObjs returns the placement resource snapshot objects in the list.
+func (l *PlacementResourceSnapshotList) GetPlacementResourceSnapshotObjs() []PlacementResourceSnapshotObj {
+	objs := make([]PlacementResourceSnapshotObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
+// GetPlacementResourceSnapshotObjs returns the placement resource snapshot objects in the list.
+func (l *ClusterPlacementResourceSnapshotList) GetPlacementResourceSnapshotObjs() []PlacementResourceSnapshotObj {
+	objs := make([]PlacementResourceSnapshotObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
 // Set up the API types with the scheme builder.
 func init() {
 	SchemeBuilder.Register(&PlacementResourceSnapshot{}, &PlacementResourceSnapshotList{})
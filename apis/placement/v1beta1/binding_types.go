@@ -131,6 +131,35 @@ type ResourceBindingSpec struct {
 	// and is owned by other appliers.
 	// +optional
 	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+
+	// ReplicaDivision describes the share of the replicas of the selected workloads that the target
+	// cluster runs, when the placement divides the replicas across the selected clusters.
+	// If not set, the target cluster runs the workloads with the full number of replicas.
+	// +optional
+	ReplicaDivision *ReplicaDivision `json:"replicaDivision,omitempty"`
+}
+
+// ReplicaDivision describes the share of the replicas of the selected workloads that a cluster runs.
+//
+// The clusters a placement divides the replicas across are laid out one after another on a line of
+// length TotalWeight, each taking up a segment as long as its weight; a workload with N replicas runs
+// floor(N * (WeightOffset + Weight) / TotalWeight) - floor(N * WeightOffset / TotalWeight) replicas on
+// the cluster. This way the replicas received by all the clusters always add up to N.
+type ReplicaDivision struct {
+	// WeightOffset is the total weight of the clusters that precede the cluster on the line.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	WeightOffset int64 `json:"weightOffset"`
+
+	// Weight is the weight of the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	Weight int64 `json:"weight"`
+
+	// TotalWeight is the total weight of all the clusters.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	TotalWeight int64 `json:"totalWeight"`
 }
 
 // BindingState is the state of the binding.
@@ -182,6 +211,11 @@ type ResourceBindingStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	DiffedPlacements []DiffedResourcePlacement `json:"diffedPlacements,omitempty"`
 
+	// BatchJobSummary summarizes the status of the batch workloads (Jobs and CronJobs) placed
+	// to the target cluster. It is only set when some of the placed resources are batch workloads.
+	// +kubebuilder:validation:Optional
+	BatchJobSummary *BatchJobSummary `json:"batchJobSummary,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
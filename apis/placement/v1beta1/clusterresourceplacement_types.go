@@ -127,6 +127,8 @@ type ClusterResourcePlacement struct {
 // +kubebuilder:validation:XValidation:rule="size(self.resourceSelectors.filter(x, x.kind == 'Namespace' && x.group == \"\" && x.version == 'v1' && has(x.selectionScope) && x.selectionScope == 'NamespaceWithResourceSelectors')) <= 1",message="only one namespace selector with NamespaceWithResourceSelectors mode is allowed"
 // +kubebuilder:validation:XValidation:rule="size(self.resourceSelectors.filter(x, x.kind == 'Namespace' && x.group == \"\" && x.version == 'v1' && has(x.selectionScope) && x.selectionScope == 'NamespaceWithResourceSelectors')) == 0 || (size(self.resourceSelectors.filter(x, x.kind == 'Namespace' && x.group == \"\" && x.version == 'v1' && has(x.selectionScope) && x.selectionScope == 'NamespaceWithResourceSelectors' && has(x.name) && size(x.name) > 0 && !has(x.labelSelector))) == 1)",message="namespace selector with NamespaceWithResourceSelectors mode must select by name (not by label)"
 // +kubebuilder:validation:XValidation:rule="size(self.resourceSelectors.filter(x, x.kind == 'Namespace' && x.group == \"\" && x.version == 'v1' && has(x.selectionScope) && x.selectionScope == 'NamespaceWithResourceSelectors')) == 0 || size(self.resourceSelectors.filter(x, x.kind == 'Namespace' && x.group == \"\" && x.version == 'v1')) == 1",message="when using NamespaceWithResourceSelectors mode, only one namespace selector is allowed (cannot mix with other namespace selectors)"
+// +kubebuilder:validation:XValidation:rule="!has(self.pinnedResourceSnapshotIndex) || !has(self.strategy) || !has(self.strategy.type) || self.strategy.type == 'RollingUpdate'",message="pinnedResourceSnapshotIndex can only be set when the rollout strategy type is RollingUpdate"
+// +kubebuilder:validation:XValidation:rule="!has(self.policy) || !has(self.policy.replicaScheduling) || !has(self.policy.replicaScheduling.type) || self.policy.replicaScheduling.type != 'Divided' || !has(self.strategy) || !has(self.strategy.type) || self.strategy.type == 'RollingUpdate'",message="replicas can only be divided when the rollout strategy type is RollingUpdate"
 type PlacementSpec struct {
 	// ResourceSelectors is an array of selectors used to select cluster scoped resources. The selectors are `ORed`.
 	// You can have 1-100 selectors.
@@ -159,6 +161,19 @@ type PlacementSpec struct {
 	// +kubebuilder:validation:Optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 
+	// PinnedResourceSnapshotIndex, if set, pins the placement to the resource snapshot with the given index,
+	// i.e., all the bindings of the placement are rolled out to the pinned resource snapshot instead of the
+	// latest one, which allows rolling back to known good content instantly while the hub resources are
+	// being fixed. Unset the field to resume following the latest resource snapshot.
+	// The index must refer to a resource snapshot that is still retained per the RevisionHistoryLimit;
+	// Fleet keeps the pinned resource snapshot around for as long as it is pinned. If the pinned resource
+	// snapshot is not found, Fleet holds back all the updates to the bindings and reports it in the
+	// Pinned condition of the placement.
+	// Only applicable to the RollingUpdate rollout strategy; pinning takes precedence over automatic rollback.
+	// +kubebuilder:validation:Pattern=`^(0|[1-9][0-9]*)$`
+	// +kubebuilder:validation:Optional
+	PinnedResourceSnapshotIndex *string `json:"pinnedResourceSnapshotIndex,omitempty"`
+
 	// StatusReportingScope controls where ClusterResourcePlacement status information is made available.
 	// When set to "ClusterScopeOnly", status is accessible only through the cluster-scoped ClusterResourcePlacement object.
 	// When set to "NamespaceAccessible", a ClusterResourcePlacementStatus object is created in the target namespace,
@@ -169,6 +184,24 @@ type PlacementSpec struct {
 	// +kubebuilder:validation:Enum=ClusterScopeOnly;NamespaceAccessible
 	// +kubebuilder:validation:Optional
 	StatusReportingScope StatusReportingScope `json:"statusReportingScope,omitempty"`
+
+	// Batch, if set, runs the placement in the batch mode, where the placement dispatches batch
+	// workloads (Jobs) across the fleet. In this mode, Fleet reports the progress of the Jobs in the
+	// placement status, and considers the placement to be completed once all the Jobs on all the
+	// selected clusters have finished.
+	// +kubebuilder:validation:Optional
+	Batch *BatchOptions `json:"batch,omitempty"`
+}
+
+// BatchOptions configures the batch mode of a placement.
+type BatchOptions struct {
+	// TTLSecondsAfterFinished limits the lifetime of a placement that has completed, i.e., all the Jobs
+	// on all the selected clusters have finished. Once the TTL expires, Fleet deletes the placement,
+	// which in turn removes the placed resources from the member clusters.
+	// If unset, the placement will not be deleted automatically.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 }
 
 // Tolerations returns tolerations for PlacementSpec to handle nil policy case.
@@ -355,7 +388,184 @@ type PlacementPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="self.all(x, x.operator != 'Exists' || !has(x.value) || size(x.value) == 0)",message="value must be empty when operator is Exists"
 	// +kubebuilder:validation:XValidation:rule="self.all(x, (has(x.key) && size(x.key) > 0) || x.operator == 'Exists')",message="operator must be Exists when key is empty"
+	// +kubebuilder:validation:XValidation:rule="self.all(x, !has(x.tolerationSeconds) || (has(x.effect) && x.effect == 'NoExecute'))",message="effect must be NoExecute when tolerationSeconds is set"
 	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// FailoverPolicy describes how Fleet handles the resources placed on a member cluster that has
+	// become unhealthy or disconnected from the fleet.
+	// If not set, Fleet keeps the placement on the cluster until the cluster leaves the fleet.
+	// Only valid if the placement type is "PickN".
+	// +kubebuilder:validation:Optional
+	FailoverPolicy *FailoverPolicy `json:"failoverPolicy,omitempty"`
+
+	// SchedulerName is the name of the scheduler profile that schedules the placement; scheduler
+	// profiles are declared in the scheduler configuration file of the hub agent.
+	// If not set, the default scheduler profile is used.
+	// Only valid if the placement type is "PickAll" or "PickN".
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// ReplicaScheduling describes how Fleet distributes the replicas of the workloads selected by the
+	// placement, i.e., the Deployments, StatefulSets, and ReplicaSets, across the selected clusters.
+	// If not set, every selected cluster runs the workloads with the full number of replicas.
+	// The replicas can only be divided when the rollout strategy type is RollingUpdate.
+	// +kubebuilder:validation:Optional
+	ReplicaScheduling *ReplicaSchedulingPolicy `json:"replicaScheduling,omitempty"`
+}
+
+// FailoverPolicy describes how Fleet fails over the resources placed on a member cluster that has
+// become unhealthy or disconnected from the fleet.
+//
+// A member cluster is considered lost once its member agent has stopped sending heartbeats, or has
+// been reporting the cluster as unhealthy, for longer than the cluster unhealthy threshold of the
+// hub agent. After a lost cluster has stayed lost for the grace period, the scheduler picks a
+// replacement cluster and marks the placement on the lost cluster for removal; the removal completes
+// when the cluster comes back.
+type FailoverPolicy struct {
+	// GracePeriodSeconds is the period of time, in seconds, Fleet waits after a cluster is considered
+	// lost before failing over the placement on the cluster. Defaults to 300 seconds.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:validation:Optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+
+	// ResourceRetentionPolicy controls what Fleet does to the resources placed on a lost cluster
+	// once the cluster comes back.
+	//
+	// Available options:
+	//
+	// * Delete: the resources are deleted from the cluster. This is the default behavior.
+	//
+	// * Keep: the resources are left intact on the cluster, but Fleet no longer manages them.
+	//
+	// +kubebuilder:validation:Enum=Delete;Keep
+	// +kubebuilder:default=Delete
+	// +kubebuilder:validation:Optional
+	ResourceRetentionPolicy FailoverResourceRetentionPolicy `json:"resourceRetentionPolicy,omitempty"`
+}
+
+// FailoverResourceRetentionPolicy identifies what Fleet does to the resources placed on a lost
+// cluster once the cluster comes back.
+// +enum
+type FailoverResourceRetentionPolicy string
+
+const (
+	// FailoverResourceRetentionPolicyDelete instructs Fleet to delete the resources placed on a lost
+	// cluster once the cluster comes back. This is the default behavior.
+	FailoverResourceRetentionPolicyDelete FailoverResourceRetentionPolicy = "Delete"
+
+	// FailoverResourceRetentionPolicyKeep instructs Fleet to leave the resources placed on a lost
+	// cluster intact once the cluster comes back.
+	FailoverResourceRetentionPolicyKeep FailoverResourceRetentionPolicy = "Keep"
+)
+
+// ReplicaSchedulingPolicy describes how Fleet distributes the replicas of the workloads selected by a
+// placement across the selected clusters.
+//
+// When the replicas are divided, each selected cluster receives a share of the replicas proportional to
+// its weight; the shares are rounded so that they always add up to the replica count of the workload.
+// The shares are re-computed whenever clusters join or leave the placement, or the values of the property
+// that weighs the clusters change; the new shares are rolled out to the clusters as instructed by the
+// rollout strategy. Overrides still apply on top of the divided replica counts, and workloads wrapped in
+// envelopes are not divided.
+// +kubebuilder:validation:XValidation:rule="!has(self.staticWeights) || size(self.staticWeights) == 0 || (has(self.weightType) && self.weightType == 'Static')",message="staticWeights can only be set when weightType is Static"
+// +kubebuilder:validation:XValidation:rule="!has(self.weightType) || self.weightType != 'ClusterProperty' || (has(self.weightPropertyName) && size(self.weightPropertyName) > 0)",message="weightPropertyName must be set when weightType is ClusterProperty"
+type ReplicaSchedulingPolicy struct {
+	// Type is the type of replica scheduling.
+	//
+	// Available options:
+	//
+	// * Duplicated: every selected cluster runs the workloads with the full number of replicas. This is
+	//   the default behavior.
+	//
+	// * Divided: the replicas of the workloads are divided across the selected clusters.
+	//
+	// +kubebuilder:validation:Enum=Duplicated;Divided
+	// +kubebuilder:default=Duplicated
+	// +kubebuilder:validation:Optional
+	Type ReplicaSchedulingType `json:"type,omitempty"`
+
+	// WeightType is how Fleet weighs the selected clusters when dividing the replicas.
+	// Only valid if the type is "Divided".
+	//
+	// Available options:
+	//
+	// * Even: all selected clusters have the same weight. This is the default behavior.
+	//
+	// * Static: the selected clusters are weighed as specified in the StaticWeights field.
+	//
+	// * ClusterProperty: the selected clusters are weighed by the value of the cluster property specified
+	//   in the WeightPropertyName field, e.g., the available CPU of each cluster.
+	//
+	// +kubebuilder:validation:Enum=Even;Static;ClusterProperty
+	// +kubebuilder:default=Even
+	// +kubebuilder:validation:Optional
+	WeightType ReplicaDivisionWeightType `json:"weightType,omitempty"`
+
+	// StaticWeights are the weights of the selected clusters when the weight type is "Static".
+	// A selected cluster that is not listed here has a weight of 0, i.e., it receives no replicas.
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:Optional
+	StaticWeights []StaticClusterWeight `json:"staticWeights,omitempty"`
+
+	// WeightPropertyName is the name of the cluster property that weighs the selected clusters when the
+	// weight type is "ClusterProperty", e.g., `resources.kubernetes-fleet.io/available-cpu`; the property
+	// value must be a number. A selected cluster that does not report the property has a weight of 0.
+	//
+	// Fleet normalizes the property values into percentages of their sum, so that small fluctuations of
+	// the values do not cause the replicas to be re-divided.
+	// +kubebuilder:validation:Optional
+	WeightPropertyName string `json:"weightPropertyName,omitempty"`
+}
+
+// ReplicaSchedulingType identifies how Fleet distributes the replicas of the workloads selected by a
+// placement across the selected clusters.
+// +enum
+type ReplicaSchedulingType string
+
+const (
+	// ReplicaSchedulingTypeDuplicated instructs Fleet to run the workloads with the full number of
+	// replicas on every selected cluster. This is the default behavior.
+	ReplicaSchedulingTypeDuplicated ReplicaSchedulingType = "Duplicated"
+
+	// ReplicaSchedulingTypeDivided instructs Fleet to divide the replicas of the workloads across the
+	// selected clusters.
+	ReplicaSchedulingTypeDivided ReplicaSchedulingType = "Divided"
+)
+
+// ReplicaDivisionWeightType identifies how Fleet weighs the selected clusters when dividing the
+// replicas of the workloads selected by a placement.
+// +enum
+type ReplicaDivisionWeightType string
+
+const (
+	// ReplicaDivisionWeightTypeEven instructs Fleet to give all selected clusters the same weight.
+	ReplicaDivisionWeightTypeEven ReplicaDivisionWeightType = "Even"
+
+	// ReplicaDivisionWeightTypeStatic instructs Fleet to weigh the selected clusters as specified by
+	// the user.
+	ReplicaDivisionWeightTypeStatic ReplicaDivisionWeightType = "Static"
+
+	// ReplicaDivisionWeightTypeClusterProperty instructs Fleet to weigh the selected clusters by the
+	// value of a cluster property.
+	ReplicaDivisionWeightTypeClusterProperty ReplicaDivisionWeightType = "ClusterProperty"
+)
+
+// StaticClusterWeight is the weight of a cluster when Fleet divides the replicas of the workloads
+// selected by a placement.
+type StaticClusterWeight struct {
+	// ClusterName is the name of the member cluster.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// Weight is the weight of the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10000
+	// +kubebuilder:validation:Required
+	Weight int32 `json:"weight"`
 }
 
 // Affinity is a group of cluster affinity scheduling rules. More to be added.
@@ -363,6 +573,16 @@ type Affinity struct {
 	// ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
 	// +kubebuilder:validation:Optional
 	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// PlacementAffinity contains inter-placement affinity scheduling rules, which instruct Fleet to
+	// place the selected resources on the clusters where some other placements have been scheduled.
+	// +kubebuilder:validation:Optional
+	PlacementAffinity *PlacementAffinity `json:"placementAffinity,omitempty"`
+
+	// PlacementAntiAffinity contains inter-placement anti-affinity scheduling rules, which instruct Fleet
+	// to avoid placing the selected resources on the clusters where some other placements have been scheduled.
+	// +kubebuilder:validation:Optional
+	PlacementAntiAffinity *PlacementAntiAffinity `json:"placementAntiAffinity,omitempty"`
 }
 
 // ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
@@ -375,6 +595,16 @@ type ClusterAffinity struct {
 	// +kubebuilder:validation:Optional
 	RequiredDuringSchedulingIgnoredDuringExecution *ClusterSelector `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
 
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the resource will not be scheduled onto the cluster.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point after the placement (e.g. due to a label or property change
+	// on the cluster), the system will remove the resource from the cluster;
+	// for placements of the PickN placement type, the system will also try to
+	// pick another cluster as a replacement.
+	// +kubebuilder:validation:Optional
+	RequiredDuringSchedulingRequiredDuringExecution *ClusterSelector `json:"requiredDuringSchedulingRequiredDuringExecution,omitempty"`
+
 	// The scheduler computes a score for each cluster at schedule time by iterating
 	// through the elements of this field and adding "weight" to the sum if the cluster
 	// matches the corresponding matchExpression. The scheduler then chooses the first
@@ -406,6 +636,73 @@ type PreferredClusterSelector struct {
 	Preference ClusterSelectorTerm `json:"preference"`
 }
 
+// PlacementAffinity contains inter-placement affinity scheduling rules for the selected resources.
+type PlacementAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the resource will not be scheduled onto the cluster.
+	// All the terms are ANDed, i.e., a cluster must satisfy every term to be selected.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point after the placement (e.g. due to an update), the system
+	// may or may not try to eventually remove the resource from the cluster.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	RequiredDuringSchedulingIgnoredDuringExecution []PlacementAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// The scheduler computes a score for each cluster at schedule time by iterating
+	// through the elements of this field and adding "weight" to the sum if the cluster
+	// satisfies the corresponding term.
+	// This field is ignored if the placement type is "PickAll".
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPlacementAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PlacementAntiAffinity contains inter-placement anti-affinity scheduling rules for the selected resources.
+type PlacementAntiAffinity struct {
+	// If the anti-affinity requirements specified by this field are not met at
+	// scheduling time, the resource will not be scheduled onto the cluster.
+	// All the terms are ANDed, i.e., a cluster must not satisfy any term to be selected.
+	// If the anti-affinity requirements specified by this field cease to be met
+	// at some point after the placement (e.g. due to an update), the system
+	// may or may not try to eventually remove the resource from the cluster.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	RequiredDuringSchedulingIgnoredDuringExecution []PlacementAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// The scheduler computes a score for each cluster at schedule time by iterating
+	// through the elements of this field and subtracting "weight" from the sum if the cluster
+	// satisfies the corresponding term.
+	// This field is ignored if the placement type is "PickAll".
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPlacementAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PlacementAffinityTerm selects a group of placements; a cluster satisfies the term if any of the
+// selected placements has been scheduled on the cluster.
+type PlacementAffinityTerm struct {
+	// PlacementSelector is a label query over placements.
+	//
+	// A ClusterResourcePlacement can only select other ClusterResourcePlacements, and a
+	// ResourcePlacement can only select other ResourcePlacements in the same namespace. A placement
+	// never selects itself.
+	// +kubebuilder:validation:Required
+	PlacementSelector metav1.LabelSelector `json:"placementSelector"`
+}
+
+// WeightedPlacementAffinityTerm is a placement affinity term associated with a weight.
+type WeightedPlacementAffinityTerm struct {
+	// Weight associated with satisfying the corresponding placement affinity term, in the range [1, 100].
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// A placement affinity term, associated with the corresponding weight.
+	// +kubebuilder:validation:Required
+	PlacementAffinityTerm PlacementAffinityTerm `json:"placementAffinityTerm"`
+}
+
 // +enum
 type PropertySortOrder string
 
@@ -466,6 +763,15 @@ const (
 	// PropertySelectorLessThanOrEqualTo dictates Fleet to select cluster if its observed value of a
 	// given property is less than or equal to the value specified in the requirement.
 	PropertySelectorLessThanOrEqualTo PropertySelectorOperator = "Le"
+	// PropertySelectorIn dictates Fleet to select cluster if its observed value of a given
+	// property is equal to any of the values specified in the requirement.
+	PropertySelectorIn PropertySelectorOperator = "In"
+	// PropertySelectorNotIn dictates Fleet to select cluster if its observed value of a given
+	// property is not equal to any of the values specified in the requirement.
+	PropertySelectorNotIn PropertySelectorOperator = "NotIn"
+	// PropertySelectorExists dictates Fleet to select cluster if a given property is available
+	// for the cluster, regardless of its observed value.
+	PropertySelectorExists PropertySelectorOperator = "Exists"
 )
 
 // PropertySelectorRequirement is a specific property requirement when picking clusters for
@@ -484,16 +790,22 @@ type PropertySelectorRequirement struct {
 	// the observed values of individual member clusters in accordance with the given
 	// operator.
 	//
-	// At this moment, each value should be a Kubernetes quantity. For more information, see
-	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
-	//
 	// If the operator is Gt (greater than), Ge (greater than or equal to), Lt (less than),
 	// or `Le` (less than or equal to), Eq (equal to), or Ne (ne), exactly one value must be
-	// specified in the list.
+	// specified in the list. The value should be a Kubernetes quantity (for more information, see
+	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity) or a version (e.g.,
+	// `v1.30.2`); versions are compared component by component, so that properties such as
+	// the Kubernetes version of a cluster can be compared as well.
 	//
-	// +kubebuilder:validation:MaxItems=1
-	// +kubebuilder:validation:Required
-	Values []string `json:"values"`
+	// If the operator is In or NotIn, one or more values must be specified in the list; each
+	// value is compared with the observed value as a string, or as a version if both of them are
+	// versions.
+	//
+	// If the operator is Exists, the list must be empty.
+	//
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:Optional
+	Values []string `json:"values,omitempty"`
 }
 
 // PropertySelector helps user specify property requirements when picking clusters for resource
@@ -629,6 +941,15 @@ type RolloutStrategy struct {
 	// +kubebuilder:validation:Optional
 	RollingUpdate *RollingUpdateConfig `json:"rollingUpdate,omitempty"`
 
+	// Paused, if set to true, pauses the rollout of the placement: Fleet holds back all the updates to the
+	// bindings, i.e., it stops rolling out changes to the selected resources, the overrides, and the apply
+	// strategy, as well as placing resources on newly selected clusters and removing them from unselected
+	// ones, while the scheduling and the status reporting of the placement continue.
+	// Set it to false (or unset it) to resume the rollout.
+	// Only applicable to the RollingUpdate rollout strategy.
+	// +kubebuilder:validation:Optional
+	Paused bool `json:"paused,omitempty"`
+
 	// ApplyStrategy describes when and how to apply the selected resources to the target cluster.
 	// +kubebuilder:validation:Optional
 	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
@@ -1015,6 +1336,34 @@ type RollingUpdateConfig struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
 	UnavailablePeriodSeconds *int `json:"unavailablePeriodSeconds,omitempty"`
+
+	// ProgressDeadlineSeconds is the maximum time in seconds for the rollout of a new resource snapshot
+	// to complete, counting from the start of the rollout and excluding the time the rollout is paused
+	// or waiting for the maintenance windows of the selected clusters to open; the rollout completes
+	// when all the selected clusters run the resources in the snapshot and report them as available.
+	// Once the deadline passes, the rollout is considered failed and Fleet rolls the placement back.
+	// It can only be set when AutoRollback is specified; by default there is no deadline.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int `json:"progressDeadlineSeconds,omitempty"`
+
+	// AutoRollback, if specified, makes Fleet roll the placement back automatically when the rollout of a
+	// new resource snapshot fails, i.e., re-point all the bindings to the last resource snapshot that was
+	// fully available on all the selected clusters.
+	// Fleet does not roll forward again until a newer resource snapshot is created.
+	// +kubebuilder:validation:Optional
+	AutoRollback *AutoRollbackConfig `json:"autoRollback,omitempty"`
+}
+
+// AutoRollbackConfig configures the automatic rollback of failed rollouts.
+type AutoRollbackConfig struct {
+	// FailureThreshold is the number of clusters on which the resources in a new resource snapshot
+	// fail to apply or to become available that marks the rollout as failed.
+	// Default is 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
 }
 
 // PlacementStatus defines the observed status of the ClusterResourcePlacement and ResourcePlacement object.
@@ -1030,7 +1379,8 @@ type PlacementStatus struct {
 	// One resource snapshot can contain multiple clusterResourceSnapshots CRs in order to store large amount of resources.
 	// To get clusterResourceSnapshot of a given resource index, use the following command:
 	// `kubectl get ClusterResourceSnapshot --selector=kubernetes-fleet.io/resource-index=$ObservedResourceIndex`
-	// If the rollout strategy type is `RollingUpdate`, `ObservedResourceIndex` is the default-latest resource snapshot index.
+	// If the rollout strategy type is `RollingUpdate`, `ObservedResourceIndex` is the default-latest resource snapshot index,
+	// or the index of the resource snapshot that the placement is pinned or has been rolled back to.
 	// If the rollout strategy type is `External`, rollout and version control are managed by an external controller,
 	// and this field is not empty only if all targeted clusters observe the same resource index in `PlacementStatuses`.
 	// +kubebuilder:validation:Optional
@@ -1058,6 +1408,43 @@ type PlacementStatus struct {
 	// conditions except `ClusterResourcePlacementScheduled` will be empty or set to Unknown.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// BatchJobSummary summarizes the status of the batch workloads (Jobs and CronJobs) placed
+	// across all the selected clusters. It is only set when the placement runs in the batch mode.
+	// +kubebuilder:validation:Optional
+	BatchJobSummary *BatchJobSummary `json:"batchJobSummary,omitempty"`
+}
+
+// BatchJobSummary summarizes the status of a set of batch workloads (Jobs and CronJobs).
+type BatchJobSummary struct {
+	// Total is the total number of batch workloads.
+	// +kubebuilder:validation:Optional
+	Total int32 `json:"total,omitempty"`
+
+	// Active is the number of batch workloads which have not finished yet. Note that CronJobs are
+	// always counted as active.
+	// +kubebuilder:validation:Optional
+	Active int32 `json:"active,omitempty"`
+
+	// Succeeded is the number of Jobs which have finished successfully.
+	// +kubebuilder:validation:Optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed is the number of Jobs which have finished with a failure.
+	// +kubebuilder:validation:Optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// ActivePods is the number of pending and running pods of all the Jobs.
+	// +kubebuilder:validation:Optional
+	ActivePods int32 `json:"activePods,omitempty"`
+
+	// SucceededPods is the number of succeeded pods of all the Jobs.
+	// +kubebuilder:validation:Optional
+	SucceededPods int32 `json:"succeededPods,omitempty"`
+
+	// FailedPods is the number of failed pods of all the Jobs.
+	// +kubebuilder:validation:Optional
+	FailedPods int32 `json:"failedPods,omitempty"`
 }
 
 // ResourceIdentifier identifies one Kubernetes resource.
@@ -1181,6 +1568,12 @@ type PerClusterPlacementStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	DiffedPlacements []DiffedResourcePlacement `json:"diffedPlacements,omitempty"`
 
+	// BatchJobSummary summarizes the status of the batch workloads (Jobs and CronJobs) placed to
+	// the given cluster. It is only set when the placement runs in the batch mode.
+	// This field is only meaningful if the `ClusterName` is not empty.
+	// +kubebuilder:validation:Optional
+	BatchJobSummary *BatchJobSummary `json:"batchJobSummary,omitempty"`
+
 	// Conditions is an array of current observed conditions on the cluster.
 	// Each condition corresponds to the resource snapshot at the index specified by `ObservedResourceIndex`.
 	// For example, the condition of type `RolloutStarted` is observing the rollout status of the resource snapshot with index `ObservedResourceIndex`.
@@ -1316,10 +1709,22 @@ type Toleration struct {
 	Value string `json:"value,omitempty"`
 
 	// Effect indicates the taint effect to match. Empty means match all taint effects.
-	// When specified, only allowed value is NoSchedule.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// When specified, allowed values are NoSchedule and NoExecute.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +kubebuilder:validation:Optional
 	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// TolerationSeconds represents the period of time the toleration (which must be of effect
+	// NoExecute) tolerates the taint. When the period ends, the placement on the tainted cluster
+	// is evicted (subject to the disruption budget of the placement). The period is measured from
+	// when Fleet first observes the taint on the cluster.
+	//
+	// By default, it is not set, which means the taint is tolerated forever. Note that the scheduler
+	// does not pick a cluster with a NoExecute taint that is only tolerated for a limited period
+	// of time.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
 }
 
 // ClusterResourcePlacementConditionType defines a specific condition of a cluster resource placement object.
@@ -1401,6 +1806,45 @@ const (
 	// * False: Fleet has failed to create or update the ClusterResourcePlacementStatus object
 	//   in the target namespace.
 	ClusterResourcePlacementStatusSyncedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementStatusSynced"
+
+	// ClusterResourcePlacementCompletedConditionType indicates whether all the batch workloads (Jobs)
+	// placed by a ClusterResourcePlacement running in the batch mode have finished on all the
+	// selected member clusters.
+	//
+	// It can have the following condition statuses:
+	// * True: all the Jobs have finished, successfully or not, on all the selected member clusters.
+	// * False: some of the Jobs have not finished yet.
+	// * Unknown: Fleet has not collected the status of the Jobs from all the selected member clusters yet.
+	ClusterResourcePlacementCompletedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementCompleted"
+
+	// ClusterResourcePlacementRolledBackConditionType indicates whether Fleet has automatically rolled
+	// the ClusterResourcePlacement back to an earlier resource snapshot, as the rollout of the latest
+	// resource snapshot has failed.
+	// It is only set when AutoRollback is specified in the rolling update config.
+	//
+	// It can have the following condition statuses:
+	// * True: the rollout of the latest resource snapshot has failed and Fleet has rolled back.
+	// * False: Fleet follows the latest resource snapshot.
+	ClusterResourcePlacementRolledBackConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementRolledBack"
+
+	// ClusterResourcePlacementPausedConditionType indicates whether the rollout of the ClusterResourcePlacement
+	// has been paused, i.e., Fleet holds back all the updates to the bindings while the scheduling and the
+	// status reporting continue.
+	// It is only set when the rollout is paused in the rollout strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: the rollout is paused.
+	ClusterResourcePlacementPausedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementPaused"
+
+	// ClusterResourcePlacementPinnedConditionType indicates whether the ClusterResourcePlacement has been
+	// rolled out to the resource snapshot it is pinned to.
+	// It is only set when PinnedResourceSnapshotIndex is specified.
+	//
+	// It can have the following condition statuses:
+	// * True: the bindings are rolled out to the pinned resource snapshot.
+	// * False: the pinned resource snapshot is not found; Fleet holds back all the updates to the bindings
+	//   until the placement is unpinned or pinned to an existing resource snapshot.
+	ClusterResourcePlacementPinnedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementPinned"
 )
 
 // ResourcePlacementConditionType defines a specific condition of a resource placement object.
@@ -1469,6 +1913,44 @@ const (
 	//   clusters, or an error has occurred.
 	// * Unknown: Fleet has not finished processing the diff reporting yet.
 	ResourcePlacementDiffReportedConditionType ResourcePlacementConditionType = "ResourcePlacementDiffReported"
+
+	// ResourcePlacementCompletedConditionType indicates whether all the batch workloads (Jobs)
+	// placed by a placement running in the batch mode have finished on all the selected member clusters.
+	//
+	// It can have the following condition statuses:
+	// * True: all the Jobs have finished, successfully or not, on all the selected member clusters.
+	// * False: some of the Jobs have not finished yet.
+	// * Unknown: Fleet has not collected the status of the Jobs from all the selected member clusters yet.
+	ResourcePlacementCompletedConditionType ResourcePlacementConditionType = "ResourcePlacementCompleted"
+
+	// ResourcePlacementRolledBackConditionType indicates whether Fleet has automatically rolled the
+	// placement back to an earlier resource snapshot, as the rollout of the latest resource snapshot
+	// has failed.
+	// It is only set when AutoRollback is specified in the rolling update config.
+	//
+	// It can have the following condition statuses:
+	// * True: the rollout of the latest resource snapshot has failed and Fleet has rolled back.
+	// * False: Fleet follows the latest resource snapshot.
+	ResourcePlacementRolledBackConditionType ResourcePlacementConditionType = "ResourcePlacementRolledBack"
+
+	// ResourcePlacementPausedConditionType indicates whether the rollout of the placement has been paused,
+	// i.e., Fleet holds back all the updates to the bindings while the scheduling and the status reporting
+	// continue.
+	// It is only set when the rollout is paused in the rollout strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: the rollout is paused.
+	ResourcePlacementPausedConditionType ResourcePlacementConditionType = "ResourcePlacementPaused"
+
+	// ResourcePlacementPinnedConditionType indicates whether the placement has been rolled out to the
+	// resource snapshot it is pinned to.
+	// It is only set when PinnedResourceSnapshotIndex is specified.
+	//
+	// It can have the following condition statuses:
+	// * True: the bindings are rolled out to the pinned resource snapshot.
+	// * False: the pinned resource snapshot is not found; Fleet holds back all the updates to the bindings
+	//   until the placement is unpinned or pinned to an existing resource snapshot.
+	ResourcePlacementPinnedConditionType ResourcePlacementConditionType = "ResourcePlacementPinned"
 )
 
 // PerClusterPlacementConditionType defines a specific condition of a per cluster placement.
@@ -57,6 +57,12 @@ const (
 	ClusterResourcePlacementEvictionKind = "ClusterResourcePlacementEviction"
 	// ClusterResourcePlacementDisruptionBudgetKind is the kind of the ClusterResourcePlacementDisruptionBudget.
 	ClusterResourcePlacementDisruptionBudgetKind = "ClusterResourcePlacementDisruptionBudget"
+	// PlacementSimulationKind is the kind of the PlacementSimulation.
+	PlacementSimulationKind = "PlacementSimulation"
+	// ClusterSchedulingTraceKind is the kind of the ClusterSchedulingTrace.
+	ClusterSchedulingTraceKind = "ClusterSchedulingTrace"
+	// SchedulingTraceKind is the kind of the SchedulingTrace.
+	SchedulingTraceKind = "SchedulingTrace"
 	// ResourceEnvelopeKind is the kind of the ResourceEnvelope.
 	ResourceEnvelopeKind = "ResourceEnvelope"
 	// ClusterResourceEnvelopeKind is the kind of the ClusterResourceEnvelope.
@@ -156,6 +162,50 @@ const (
 	// This is used to remember if an "unscheduled" binding was moved from a "bound" state or a "scheduled" state.
 	PreviousBindingStateAnnotation = FleetPrefix + "previous-binding-state"
 
+	// DeschedulerStrategyLabel is the label applied to the eviction objects created by the Fleet descheduler;
+	// its value is the name of the descheduling strategy that has requested the eviction.
+	DeschedulerStrategyLabel = FleetPrefix + "descheduler-strategy"
+
+	// KeepResourcesOnDeletionAnnotation is the annotation applied to a binding or a work object to instruct Fleet
+	// to leave the resources placed on the member cluster intact when the object is deleted; its value is always "true".
+	// The scheduler applies it to the bindings on a lost cluster that are failed over with the Keep resource retention policy.
+	KeepResourcesOnDeletionAnnotation = FleetPrefix + "keep-resources-on-deletion"
+
+	// TaintEvictionLabel is the label applied to the eviction objects created by the Fleet taint eviction controller;
+	// its value is the name of the member cluster whose NoExecute taints have triggered the eviction.
+	TaintEvictionLabel = FleetPrefix + "taint-eviction"
+
+	// NoExecuteTaintsAddedTimeAnnotation is the annotation that the Fleet taint eviction controller applies to
+	// a member cluster to keep track of the time when each NoExecute taint on the member cluster was first
+	// observed, so that the toleration periods survive controller restarts.
+	NoExecuteTaintsAddedTimeAnnotation = FleetPrefix + "no-execute-taints-added-time"
+
+	// FullyAvailableAnnotation is the annotation applied to a master resource snapshot once the
+	// resources in it have become available on all the clusters selected by the placement; its value is
+	// always "true". It is only applied when the placement has automatic rollback enabled, and marks the
+	// resource snapshot as a target for future rollbacks.
+	FullyAvailableAnnotation = FleetPrefix + "fully-available"
+
+	// RolledBackToResourceIndexAnnotation is the annotation applied to a master resource snapshot whose
+	// rollout has failed; its value is the index of the resource snapshot that the placement has been
+	// rolled back to.
+	RolledBackToResourceIndexAnnotation = FleetPrefix + "rolled-back-to-resource-index"
+
+	// RollbackReasonAnnotation is the annotation applied to a master resource snapshot whose rollout has
+	// failed; its value describes why the rollout is considered failed.
+	RollbackReasonAnnotation = FleetPrefix + "rollback-reason"
+
+	// RolloutStartTimeAnnotation is the annotation applied to a master resource snapshot once its rollout
+	// starts; its value, in RFC 3339 format, is the time from which the progress deadline of the rollout is
+	// measured. Fleet moves the time forward by how long the rollout has been held back, i.e., paused or
+	// waiting for the maintenance windows of the selected clusters to open. It is only applied when the
+	// placement has automatic rollback and a progress deadline enabled.
+	RolloutStartTimeAnnotation = FleetPrefix + "rollout-start-time"
+
+	// RolloutHeldSinceAnnotation is the annotation applied to a master resource snapshot while its rollout
+	// is held back; its value, in RFC 3339 format, is the time since when the rollout has been held back.
+	RolloutHeldSinceAnnotation = FleetPrefix + "rollout-held-since"
+
 	// UpdateRunFinalizer is used by the UpdateRun controller to make sure that the UpdateRun
 	// object is not deleted until all its dependent resources are deleted.
 	UpdateRunFinalizer = FleetPrefix + "stagedupdaterun-finalizer"
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=psim
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.policy.placementType`,name="Type",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Simulated")].status`,name="Simulated",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PlacementSimulation is a what-if run of the Fleet scheduler; the scheduler runs its Filter and
+// Score plugins for a hypothetical placement policy over the current set of member clusters, and
+// reports the scheduling decisions it would make, along with the results of each plugin, in the
+// status of the object.
+//
+// A simulation does not create any scheduling policy snapshot or binding, and thus has no effect
+// on the placements in the fleet. The scheduler runs a simulation once per generation of the
+// object; to run the simulation again, e.g., after the member clusters have changed, update the
+// spec of the object, or re-create the object.
+//
+// Note that a simulation does not consider any existing placement; the decisions it reports are
+// the ones the scheduler would make for a new placement with the hypothetical policy.
+type PlacementSimulation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the PlacementSimulation.
+	// +required
+	Spec PlacementSimulationSpec `json:"spec"`
+
+	// Status is the observed state of the PlacementSimulation.
+	// +optional
+	Status PlacementSimulationStatus `json:"status,omitempty"`
+}
+
+// PlacementSimulationSpec is the desired state of the PlacementSimulation.
+type PlacementSimulationSpec struct {
+	// Policy is the hypothetical placement policy to simulate.
+	// +kubebuilder:validation:Required
+	// +required
+	Policy *PlacementPolicy `json:"policy"`
+}
+
+// PlacementSimulationStatus is the observed state of the PlacementSimulation.
+type PlacementSimulationStatus struct {
+	// ClusterDecisions is the list of scheduling decisions the scheduler would make for the
+	// hypothetical placement policy, in the same format as the decisions reported in the status
+	// of a scheduling policy snapshot.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	ClusterDecisions []ClusterDecision `json:"clusterDecisions,omitempty"`
+
+	// ClusterResults is the list of results of the scheduler plugins for each member cluster
+	// evaluated in the simulation.
+	//
+	// Note that no plugin runs for the PickFixed placement type, and thus this list is always
+	// empty for such policies.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	ClusterResults []ClusterSimulationResult `json:"clusterResults,omitempty"`
+
+	// Conditions is the list of currently observed conditions for the PlacementSimulation object.
+	//
+	// Available condition types include:
+	// * Simulated: whether the simulation has been run for the current generation of the object.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ClusterSimulationResult describes how the scheduler plugins have evaluated a member cluster in
+// a simulation.
+type ClusterSimulationResult struct {
+	// ClusterName is the name of the member cluster.
+	// +required
+	ClusterName string `json:"clusterName"`
+
+	// Feasible is true if the member cluster has passed all the Filter plugins.
+	// +required
+	Feasible bool `json:"feasible"`
+
+	// FilterResults is the list of Filter plugins that have filtered out the member cluster, along
+	// with their reasons.
+	//
+	// Note that unlike a regular scheduling cycle, a simulation runs all the Filter plugins for a
+	// member cluster, even if it has already been filtered out by an earlier plugin.
+	// +optional
+	FilterResults []PluginFilterResult `json:"filterResults,omitempty"`
+
+	// PluginScores is the list of scores the Score plugins have assigned to the member cluster.
+	//
+	// Score plugins only run for feasible clusters with the PickN placement type.
+	// +optional
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+}
+
+// PluginFilterResult describes why a Filter plugin has filtered out a member cluster.
+type PluginFilterResult struct {
+	// PluginName is the name of the Filter plugin.
+	// +required
+	PluginName string `json:"pluginName"`
+
+	// Reason is the reason why the Filter plugin has filtered out the member cluster.
+	// +required
+	Reason string `json:"reason"`
+}
+
+// PluginScore describes the score a Score plugin has assigned to a member cluster.
+type PluginScore struct {
+	// PluginName is the name of the Score plugin.
+	// +required
+	PluginName string `json:"pluginName"`
+
+	// Score is the score the Score plugin has assigned to the member cluster.
+	// +required
+	Score ClusterScore `json:"score"`
+}
+
+// PlacementSimulationConditionType identifies a specific condition of the PlacementSimulation.
+type PlacementSimulationConditionType string
+
+const (
+	// PlacementSimulationConditionTypeSimulated indicates whether the simulation has been run.
+	//
+	// The following values are possible:
+	// * True: the simulation has been run for the observed generation of the object; the results
+	//   are reported in the status.
+	// * False: the simulation cannot be run for the observed generation of the object, e.g., the
+	//   hypothetical placement policy is invalid.
+	PlacementSimulationConditionTypeSimulated PlacementSimulationConditionType = "Simulated"
+)
+
+// PlacementSimulationList contains a list of PlacementSimulation objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlacementSimulationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PlacementSimulation objects.
+	Items []PlacementSimulation `json:"items"`
+}
+
+// SetConditions set the given conditions on the PlacementSimulation.
+func (s *PlacementSimulation) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&s.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given PlacementSimulation.
+func (s *PlacementSimulation) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(s.Status.Conditions, conditionType)
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlacementSimulation{},
+		&PlacementSimulationList{})
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// make sure the SchedulingTraceObj interface is implemented by the ClusterSchedulingTrace and
+// SchedulingTrace types.
+var _ SchedulingTraceObj = &ClusterSchedulingTrace{}
+var _ SchedulingTraceObj = &SchedulingTrace{}
+
+// A SchedulingTraceObj offers an abstract way to work with a fleet scheduling trace object.
+// +kubebuilder:object:generate=false
+type SchedulingTraceObj interface {
+	client.Object
+	GetSchedulingCycleTrace() *SchedulingCycleTrace
+	SetSchedulingCycleTrace(SchedulingCycleTrace)
+	SetLastUpdatedTime(metav1.Time)
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=cstrace,categories={fleet,fleet-placement}
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.trace.policySnapshotName`,name="Policy-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdatedTime`,name="Last-Updated",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSchedulingTrace records how the scheduler has evaluated every member cluster in the latest
+// scheduling cycle of a ClusterResourcePlacement, i.e., which Filter plugin has filtered out a cluster
+// and why, and the score each Score plugin has assigned to a cluster.
+//
+// Unlike the scheduling decisions in the status of a scheduling policy snapshot, which only include
+// a limited number of clusters that are not selected, the trace lists all the evaluated clusters; it
+// is kept in a separate object so that it does not count towards the size limit of the snapshot.
+//
+// The scheduler creates and updates this object only when the scheduling trace support is enabled;
+// the name of this object is the same as the name of the corresponding ClusterResourcePlacement, and
+// it is deleted along with the ClusterResourcePlacement.
+type ClusterSchedulingTrace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Trace is the trace of the latest scheduling cycle of the ClusterResourcePlacement.
+	// +kubebuilder:validation:Required
+	Trace SchedulingCycleTrace `json:"trace"`
+
+	// LastUpdatedTime is the timestamp when the trace was last updated.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastUpdatedTime metav1.Time `json:"lastUpdatedTime,omitempty"`
+}
+
+// SchedulingCycleTrace describes how the scheduler has evaluated each member cluster in a scheduling cycle.
+type SchedulingCycleTrace struct {
+	// PolicySnapshotName is the name of the scheduling policy snapshot the scheduling cycle has run for.
+	// +kubebuilder:validation:Required
+	PolicySnapshotName string `json:"policySnapshotName"`
+
+	// Clusters is the list of member clusters evaluated in the scheduling cycle, sorted by name.
+	//
+	// Note that the scheduler only runs its plugins for placements of the PickAll and the PickN
+	// placement types, and only when there are more clusters to pick; the trace is left as it is
+	// in other cases.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Clusters []ClusterSchedulingTraceEntry `json:"clusters,omitempty"`
+}
+
+// ClusterSchedulingTraceEntry describes how the scheduler has evaluated a member cluster in a
+// scheduling cycle.
+type ClusterSchedulingTraceEntry struct {
+	// ClusterName is the name of the member cluster.
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// Selected is true if the member cluster is picked in the scheduling cycle, or has been picked
+	// in an earlier scheduling cycle for the same placement.
+	// +kubebuilder:validation:Required
+	Selected bool `json:"selected"`
+
+	// Reason is a human-readable summary of why the member cluster is selected or not.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// FilterResult is the result of the Filter plugin that has filtered out the member cluster; it is
+	// not set if the member cluster has passed all the Filter plugins.
+	// +optional
+	FilterResult *PluginFilterResult `json:"filterResult,omitempty"`
+
+	// PluginScores is the list of scores the Score plugins have assigned to the member cluster.
+	//
+	// Score plugins only run for clusters that have passed all the Filter plugins with the PickN
+	// placement type.
+	// +optional
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+}
+
+// ClusterSchedulingTraceList contains a list of ClusterSchedulingTrace.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterSchedulingTraceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSchedulingTrace `json:"items"`
+}
+
+// GetSchedulingCycleTrace returns the scheduling cycle trace.
+func (m *ClusterSchedulingTrace) GetSchedulingCycleTrace() *SchedulingCycleTrace {
+	return &m.Trace
+}
+
+// SetSchedulingCycleTrace sets the scheduling cycle trace.
+func (m *ClusterSchedulingTrace) SetSchedulingCycleTrace(trace SchedulingCycleTrace) {
+	trace.DeepCopyInto(&m.Trace)
+}
+
+// SetLastUpdatedTime sets the timestamp when the trace was last updated.
+func (m *ClusterSchedulingTrace) SetLastUpdatedTime(t metav1.Time) {
+	m.LastUpdatedTime = t
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=strace,categories={fleet,fleet-placement}
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.trace.policySnapshotName`,name="Policy-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdatedTime`,name="Last-Updated",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulingTrace records how the scheduler has evaluated every member cluster in the latest
+// scheduling cycle of a ResourcePlacement; it is the namespaced counterpart of ClusterSchedulingTrace.
+//
+// The name of this object is the same as the name of the corresponding ResourcePlacement, and it is
+// deleted along with the ResourcePlacement.
+type SchedulingTrace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Trace is the trace of the latest scheduling cycle of the ResourcePlacement.
+	// +kubebuilder:validation:Required
+	Trace SchedulingCycleTrace `json:"trace"`
+
+	// LastUpdatedTime is the timestamp when the trace was last updated.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastUpdatedTime metav1.Time `json:"lastUpdatedTime,omitempty"`
+}
+
+// SchedulingTraceList contains a list of SchedulingTrace.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SchedulingTraceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SchedulingTrace `json:"items"`
+}
+
+// GetSchedulingCycleTrace returns the scheduling cycle trace.
+func (m *SchedulingTrace) GetSchedulingCycleTrace() *SchedulingCycleTrace {
+	return &m.Trace
+}
+
+// SetSchedulingCycleTrace sets the scheduling cycle trace.
+func (m *SchedulingTrace) SetSchedulingCycleTrace(trace SchedulingCycleTrace) {
+	trace.DeepCopyInto(&m.Trace)
+}
+
+// SetLastUpdatedTime sets the timestamp when the trace was last updated.
+func (m *SchedulingTrace) SetLastUpdatedTime(t metav1.Time) {
+	m.LastUpdatedTime = t
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterSchedulingTrace{}, &ClusterSchedulingTraceList{}, &SchedulingTrace{}, &SchedulingTraceList{})
+}
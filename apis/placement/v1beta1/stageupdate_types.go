@@ -324,25 +324,35 @@ type StageConfig struct {
 
 	// The collection of tasks that each stage needs to complete successfully before moving to the next stage.
 	// Each task is executed in parallel and there cannot be more than one task of the same type.
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=4
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Approval' && has(e.waitTime))",message="AfterStageTaskType is Approval, waitTime is not allowed"
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'TimedWait' && !has(e.waitTime))",message="AfterStageTaskType is TimedWait, waitTime is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'HealthCheck' && has(e.waitTime))",message="AfterStageTaskType is HealthCheck, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'HealthCheck' && !has(e.healthCheck))",message="AfterStageTaskType is HealthCheck, healthCheck is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'HealthCheck' && has(e.healthCheck))",message="healthCheck is only allowed for AfterStageTaskType HealthCheck"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && has(e.waitTime))",message="AfterStageTaskType is Webhook, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && !has(e.webhook))",message="AfterStageTaskType is Webhook, webhook is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'Webhook' && has(e.webhook))",message="webhook is only allowed for AfterStageTaskType Webhook"
 	AfterStageTasks []StageTask `json:"afterStageTasks,omitempty"`
 
 	// The collection of tasks that needs to completed successfully by each stage before starting the stage.
 	// Each task is executed in parallel and there cannot be more than one task of the same type.
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=2
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Approval' && has(e.waitTime))",message="AfterStageTaskType is Approval, waitTime is not allowed"
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'TimedWait')",message="BeforeStageTaskType cannot be TimedWait"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'HealthCheck')",message="BeforeStageTaskType cannot be HealthCheck"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && has(e.waitTime))",message="BeforeStageTaskType is Webhook, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && !has(e.webhook))",message="BeforeStageTaskType is Webhook, webhook is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'Webhook' && has(e.webhook))",message="webhook is only allowed for BeforeStageTaskType Webhook"
 	BeforeStageTasks []StageTask `json:"beforeStageTasks,omitempty"`
 }
 
 // StageTask is the pre or post stage task that needs to be completed before starting or moving to the next stage.
 type StageTask struct {
 	// The type of the before or after stage task.
-	// +kubebuilder:validation:Enum=TimedWait;Approval
+	// +kubebuilder:validation:Enum=TimedWait;Approval;HealthCheck;Webhook
 	// +kubebuilder:validation:Required
 	Type StageTaskType `json:"type"`
 
@@ -352,6 +362,99 @@ type StageTask struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Optional
 	WaitTime *metav1.Duration `json:"waitTime,omitempty"`
+
+	// HealthCheck specifies how to check the health of the clusters in the current stage after all of them
+	// complete the update and before moving to the next stage.
+	// Only valid if the AfterStageTaskType is HealthCheck.
+	// +kubebuilder:validation:Optional
+	HealthCheck *StageHealthCheck `json:"healthCheck,omitempty"`
+
+	// Webhook specifies the external webhook to call before starting or moving to the next stage.
+	// Only valid if the task type is Webhook.
+	// +kubebuilder:validation:Optional
+	Webhook *StageWebhook `json:"webhook,omitempty"`
+}
+
+// StageWebhook describes an external webhook that signs off a stage.
+//
+// Fleet sends a POST request to the webhook with a JSON body that has the following fields:
+//   - `updateRun`: the name of the update run.
+//   - `namespace`: the namespace of the update run; empty for a cluster-scoped update run.
+//   - `placementName`: the name of the placement that the update run rolls out.
+//   - `stageName`: the name of the stage.
+//   - `taskType`: `beforeStage` or `afterStage`, i.e., whether the task runs before or after the stage.
+//   - `clusters`: the names of the clusters in the stage.
+//   - `resourceSnapshotIndex`: the index of the resource snapshot that the update run rolls out.
+//
+// The webhook is expected to respond with status code 200 and a JSON body that has the `approved` field
+// set to true to let the update run proceed, or false to fail the update run; an optional `message` field
+// explains the decision. Fleet makes one call per reconciliation, and retries a failed call with an
+// exponential backoff.
+//
+// Note that the hub agent calls the webhook from within the hub cluster network. As a StagedUpdateStrategy
+// can be authored by any user with access to its namespace, a webhook in a namespaced strategy could make
+// the hub agent send requests to the internal endpoints of the hub cluster network (server-side request
+// forgery); hence the webhooks of a StagedUpdateRun may only target the hosts that the hub agent allows
+// with its `--allowed-stage-webhook-hosts` flag, and are rejected if no host is allowed.
+// The webhooks of a ClusterStagedUpdateRun are not restricted.
+type StageWebhook struct {
+	// URL is the address of the webhook; it must use the http or https scheme.
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the webhook.
+	// If not specified, the system trust roots are used.
+	// +kubebuilder:validation:Optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// The time to wait for the webhook to respond to a single call.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// Defaults to 10s; at most 60s.
+	// +kubebuilder:default="10s"
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:XValidation:rule="duration(self) <= duration('60s')",message="timeout must not exceed 60s"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// MaxRetries is the number of times to retry a call that fails, e.g., one that times out or receives
+	// a response with an unexpected status code; the update run fails if all the attempts fail.
+	// Defaults to 3.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:validation:Optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// StageHealthCheck describes a health check that runs repeatedly on the clusters of a stage for a soak period.
+type StageHealthCheck struct {
+	// Expressions is a list of CEL expressions, each of which evaluates to true when a cluster is healthy;
+	// a cluster is healthy only if all the expressions evaluate to true. Each expression can access:
+	//   - `cluster`: the status of the placement on the cluster, i.e., the entry of the cluster in the
+	//     perClusterPlacementStatuses field of the placement status, e.g.,
+	//     `cluster.conditions.exists(c, c.type == "Available" && c.status == "True")`.
+	//   - `resources`: the list of the resources placed on the cluster whose statuses are reported back
+	//     to the hub cluster (see the reportBackStrategy field of the placement), each with the group,
+	//     version, kind, namespace, name, and status fields, e.g.,
+	//     `resources.all(r, r.kind != "Deployment" || r.status.availableReplicas == r.status.replicas)`.
+	// An expression that fails to evaluate, e.g., one that accesses a field that does not exist, marks
+	// the cluster as unhealthy; use the `has()` macro to guard optional fields.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Required
+	Expressions []string `json:"expressions"`
+
+	// The time during which the health of the clusters is checked, starting from the time all the clusters
+	// in the current stage complete the update. The task completes once the time has elapsed and all the
+	// clusters have stayed healthy; the update run fails as soon as any of the clusters is found unhealthy.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	SoakTime metav1.Duration `json:"soakTime"`
 }
 
 // UpdateRunStatus defines the observed state of the ClusterStagedUpdateRun.
@@ -550,7 +653,7 @@ const (
 
 type StageTaskStatus struct {
 	// The type of the pre or post update task.
-	// +kubebuilder:validation:Enum=TimedWait;Approval
+	// +kubebuilder:validation:Enum=TimedWait;Approval;HealthCheck;Webhook
 	// +kubebuilder:validation:Required
 	Type StageTaskType `json:"type"`
 
@@ -559,13 +662,25 @@ type StageTaskStatus struct {
 	// +kubebuilder:validation:Optional
 	ApprovalRequestName string `json:"approvalRequestName,omitempty"`
 
+	// The number of calls that have been made to the webhook of this task.
+	// Only valid if the task type is Webhook.
+	// +kubebuilder:validation:Optional
+	WebhookAttempts int32 `json:"webhookAttempts,omitempty"`
+
+	// The time of the last call to the webhook of this task; a failed call is retried with an
+	// exponential backoff from this time.
+	// Only valid if the task type is Webhook.
+	// +kubebuilder:validation:Optional
+	LastWebhookAttemptTime *metav1.Time `json:"lastWebhookAttemptTime,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=type
 	//
 	// Conditions is an array of current observed conditions for the specific type of pre or post update task.
-	// Known conditions are "ApprovalRequestCreated", "WaitTimeElapsed", and "ApprovalRequestApproved".
+	// Known conditions are "ApprovalRequestCreated", "WaitTimeElapsed", "ApprovalRequestApproved", "HealthCheckSucceeded",
+	// and "WebhookApproved".
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -580,6 +695,12 @@ const (
 
 	// StageTaskTypeApproval indicates the stage task is an approval.
 	StageTaskTypeApproval StageTaskType = "Approval"
+
+	// StageTaskTypeHealthCheck indicates the stage task is a health check.
+	StageTaskTypeHealthCheck StageTaskType = "HealthCheck"
+
+	// StageTaskTypeWebhook indicates the stage task is an external webhook call.
+	StageTaskTypeWebhook StageTaskType = "Webhook"
 )
 
 // StageTaskConditionType identifies a specific condition of the AfterStageTask or BeforeStageTask.
@@ -603,6 +724,20 @@ const (
 	// - "True": The wait time has elapsed.
 	// - "False": The wait time has not elapsed.
 	StageTaskConditionWaitTimeElapsed StageTaskConditionType = "WaitTimeElapsed"
+
+	// StageTaskConditionHealthCheckSucceeded indicates if the clusters in the stage have stayed healthy for the
+	// whole soak time of the health check after stage task.
+	// Its condition status can be:
+	// - "True": All the clusters have stayed healthy for the whole soak time.
+	// - "False": A cluster has been found unhealthy and the update run has failed.
+	StageTaskConditionHealthCheckSucceeded StageTaskConditionType = "HealthCheckSucceeded"
+
+	// StageTaskConditionWebhookApproved indicates if the external webhook has approved the stage.
+	// Its condition status can be:
+	// - "True": The webhook has approved the stage.
+	// - "False": The webhook has rejected the stage, or all the calls to the webhook have failed, and the
+	//   update run has failed.
+	StageTaskConditionWebhookApproved StageTaskConditionType = "WebhookApproved"
 )
 
 // ClusterStagedUpdateRunList contains a list of ClusterStagedUpdateRun.
@@ -254,6 +254,54 @@ type ManifestCondition struct {
 	//
 	// +kubebuilder:validation:Optional
 	BackReportedStatus *BackReportedStatus `json:"backReportedStatus,omitempty"`
+
+	// BatchJobStatus is the status of the resource in the member cluster if it is a batch workload,
+	// i.e., a Job or a CronJob.
+	//
+	// +kubebuilder:validation:Optional
+	BatchJobStatus *BatchJobStatus `json:"batchJobStatus,omitempty"`
+}
+
+// BatchJobPhase is the phase of a batch workload in the member cluster.
+// +enum
+type BatchJobPhase string
+
+const (
+	// BatchJobPhaseActive means that the batch workload has not finished yet.
+	//
+	// Note that a CronJob always stays in this phase, as it is never considered finished.
+	BatchJobPhaseActive BatchJobPhase = "Active"
+
+	// BatchJobPhaseSucceeded means that the batch workload (a Job) has finished successfully.
+	BatchJobPhaseSucceeded BatchJobPhase = "Succeeded"
+
+	// BatchJobPhaseFailed means that the batch workload (a Job) has finished with a failure.
+	BatchJobPhaseFailed BatchJobPhase = "Failed"
+)
+
+// BatchJobStatus describes the status of a batch workload (a Job or a CronJob) in the member cluster.
+type BatchJobStatus struct {
+	// Phase is the phase of the batch workload.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Active;Succeeded;Failed
+	Phase BatchJobPhase `json:"phase"`
+
+	// Active is the number of pending and running pods of a Job, or the number of running
+	// Jobs of a CronJob.
+	//
+	// +kubebuilder:validation:Optional
+	Active int32 `json:"active,omitempty"`
+
+	// Succeeded is the number of pods of a Job which have reached the Succeeded phase.
+	//
+	// +kubebuilder:validation:Optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed is the number of pods of a Job which have reached the Failed phase.
+	//
+	// +kubebuilder:validation:Optional
+	Failed int32 `json:"failed,omitempty"`
 }
 
 // +genclient
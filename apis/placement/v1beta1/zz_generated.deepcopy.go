@@ -34,6 +34,16 @@ func (in *Affinity) DeepCopyInto(out *Affinity) {
 		*out = new(ClusterAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlacementAffinity != nil {
+		in, out := &in.PlacementAffinity, &out.PlacementAffinity
+		*out = new(PlacementAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementAntiAffinity != nil {
+		in, out := &in.PlacementAntiAffinity, &out.PlacementAntiAffinity
+		*out = new(PlacementAntiAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
@@ -272,6 +282,26 @@ func (in *ApprovalRequestStatus) DeepCopy() *ApprovalRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollbackConfig) DeepCopyInto(out *AutoRollbackConfig) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackConfig.
+func (in *AutoRollbackConfig) DeepCopy() *AutoRollbackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollbackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackReportedStatus) DeepCopyInto(out *BackReportedStatus) {
 	*out = *in
@@ -289,6 +319,56 @@ func (in *BackReportedStatus) DeepCopy() *BackReportedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchJobStatus) DeepCopyInto(out *BatchJobStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchJobStatus.
+func (in *BatchJobStatus) DeepCopy() *BatchJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchJobSummary) DeepCopyInto(out *BatchJobSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchJobSummary.
+func (in *BatchJobSummary) DeepCopy() *BatchJobSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchJobSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchOptions) DeepCopyInto(out *BatchOptions) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchOptions.
+func (in *BatchOptions) DeepCopy() *BatchOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAffinity) DeepCopyInto(out *ClusterAffinity) {
 	*out = *in
@@ -297,6 +377,11 @@ func (in *ClusterAffinity) DeepCopyInto(out *ClusterAffinity) {
 		*out = new(ClusterSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequiredDuringSchedulingRequiredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingRequiredDuringExecution, &out.RequiredDuringSchedulingRequiredDuringExecution
+		*out = new(ClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
 		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
 		*out = make([]PreferredClusterSelector, len(*in))
@@ -1040,6 +1125,92 @@ func (in *ClusterSchedulingPolicySnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingTrace) DeepCopyInto(out *ClusterSchedulingTrace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Trace.DeepCopyInto(&out.Trace)
+	in.LastUpdatedTime.DeepCopyInto(&out.LastUpdatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingTrace.
+func (in *ClusterSchedulingTrace) DeepCopy() *ClusterSchedulingTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSchedulingTrace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingTraceEntry) DeepCopyInto(out *ClusterSchedulingTraceEntry) {
+	*out = *in
+	if in.FilterResult != nil {
+		in, out := &in.FilterResult, &out.FilterResult
+		*out = new(PluginFilterResult)
+		**out = **in
+	}
+	if in.PluginScores != nil {
+		in, out := &in.PluginScores, &out.PluginScores
+		*out = make([]PluginScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingTraceEntry.
+func (in *ClusterSchedulingTraceEntry) DeepCopy() *ClusterSchedulingTraceEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingTraceEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingTraceList) DeepCopyInto(out *ClusterSchedulingTraceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSchedulingTrace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingTraceList.
+func (in *ClusterSchedulingTraceList) DeepCopy() *ClusterSchedulingTraceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingTraceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSchedulingTraceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterScore) DeepCopyInto(out *ClusterScore) {
 	*out = *in
@@ -1117,6 +1288,33 @@ func (in *ClusterSelectorTerm) DeepCopy() *ClusterSelectorTerm {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSimulationResult) DeepCopyInto(out *ClusterSimulationResult) {
+	*out = *in
+	if in.FilterResults != nil {
+		in, out := &in.FilterResults, &out.FilterResults
+		*out = make([]PluginFilterResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.PluginScores != nil {
+		in, out := &in.PluginScores, &out.PluginScores
+		*out = make([]PluginScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSimulationResult.
+func (in *ClusterSimulationResult) DeepCopy() *ClusterSimulationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSimulationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStagedUpdateRun) DeepCopyInto(out *ClusterStagedUpdateRun) {
 	*out = *in
@@ -1413,6 +1611,26 @@ func (in *FailedResourcePlacement) DeepCopy() *FailedResourcePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverPolicy) DeepCopyInto(out *FailoverPolicy) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverPolicy.
+func (in *FailoverPolicy) DeepCopy() *FailoverPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOverride) DeepCopyInto(out *JSONPatchOverride) {
 	*out = *in
@@ -1471,6 +1689,11 @@ func (in *ManifestCondition) DeepCopyInto(out *ManifestCondition) {
 		*out = new(BackReportedStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BatchJobStatus != nil {
+		in, out := &in.BatchJobStatus, &out.BatchJobStatus
+		*out = new(BatchJobStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestCondition.
@@ -1596,6 +1819,11 @@ func (in *PerClusterPlacementStatus) DeepCopyInto(out *PerClusterPlacementStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BatchJobSummary != nil {
+		in, out := &in.BatchJobSummary, &out.BatchJobSummary
+		*out = new(BatchJobSummary)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1615,6 +1843,80 @@ func (in *PerClusterPlacementStatus) DeepCopy() *PerClusterPlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAffinity) DeepCopyInto(out *PlacementAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]PlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedPlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAffinity.
+func (in *PlacementAffinity) DeepCopy() *PlacementAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAffinityTerm) DeepCopyInto(out *PlacementAffinityTerm) {
+	*out = *in
+	in.PlacementSelector.DeepCopyInto(&out.PlacementSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAffinityTerm.
+func (in *PlacementAffinityTerm) DeepCopy() *PlacementAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAntiAffinity) DeepCopyInto(out *PlacementAntiAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]PlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedPlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAntiAffinity.
+func (in *PlacementAntiAffinity) DeepCopy() *PlacementAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementDisruptionBudgetSpec) DeepCopyInto(out *PlacementDisruptionBudgetSpec) {
 	*out = *in
@@ -1705,7 +2007,19 @@ func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]Toleration, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailoverPolicy != nil {
+		in, out := &in.FailoverPolicy, &out.FailoverPolicy
+		*out = new(FailoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaScheduling != nil {
+		in, out := &in.ReplicaScheduling, &out.ReplicaScheduling
+		*out = new(ReplicaSchedulingPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1734,6 +2048,121 @@ func (in *PlacementRef) DeepCopy() *PlacementRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulation) DeepCopyInto(out *PlacementSimulation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulation.
+func (in *PlacementSimulation) DeepCopy() *PlacementSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementSimulation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationList) DeepCopyInto(out *PlacementSimulationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementSimulation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationList.
+func (in *PlacementSimulationList) DeepCopy() *PlacementSimulationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementSimulationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationSpec) DeepCopyInto(out *PlacementSimulationSpec) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(PlacementPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationSpec.
+func (in *PlacementSimulationSpec) DeepCopy() *PlacementSimulationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationStatus) DeepCopyInto(out *PlacementSimulationStatus) {
+	*out = *in
+	if in.ClusterDecisions != nil {
+		in, out := &in.ClusterDecisions, &out.ClusterDecisions
+		*out = make([]ClusterDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterResults != nil {
+		in, out := &in.ClusterResults, &out.ClusterResults
+		*out = make([]ClusterSimulationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationStatus.
+func (in *PlacementSimulationStatus) DeepCopy() *PlacementSimulationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 	*out = *in
@@ -1755,6 +2184,16 @@ func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PinnedResourceSnapshotIndex != nil {
+		in, out := &in.PinnedResourceSnapshotIndex, &out.PinnedResourceSnapshotIndex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(BatchOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSpec.
@@ -1791,6 +2230,11 @@ func (in *PlacementStatus) DeepCopyInto(out *PlacementStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BatchJobSummary != nil {
+		in, out := &in.BatchJobSummary, &out.BatchJobSummary
+		*out = new(BatchJobSummary)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStatus.
@@ -1803,6 +2247,37 @@ func (in *PlacementStatus) DeepCopy() *PlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginFilterResult) DeepCopyInto(out *PluginFilterResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginFilterResult.
+func (in *PluginFilterResult) DeepCopy() *PluginFilterResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginFilterResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginScore) DeepCopyInto(out *PluginScore) {
+	*out = *in
+	in.Score.DeepCopyInto(&out.Score)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginScore.
+func (in *PluginScore) DeepCopy() *PluginScore {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferredClusterSelector) DeepCopyInto(out *PreferredClusterSelector) {
 	*out = *in
@@ -1876,6 +2351,41 @@ func (in *PropertySorter) DeepCopy() *PropertySorter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaDivision) DeepCopyInto(out *ReplicaDivision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaDivision.
+func (in *ReplicaDivision) DeepCopy() *ReplicaDivision {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaDivision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaSchedulingPolicy) DeepCopyInto(out *ReplicaSchedulingPolicy) {
+	*out = *in
+	if in.StaticWeights != nil {
+		in, out := &in.StaticWeights, &out.StaticWeights
+		*out = make([]StaticClusterWeight, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSchedulingPolicy.
+func (in *ReplicaSchedulingPolicy) DeepCopy() *ReplicaSchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportBackStrategy) DeepCopyInto(out *ReportBackStrategy) {
 	*out = *in
@@ -1974,6 +2484,11 @@ func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
 		*out = new(ApplyStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicaDivision != nil {
+		in, out := &in.ReplicaDivision, &out.ReplicaDivision
+		*out = new(ReplicaDivision)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
@@ -2010,6 +2525,11 @@ func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BatchJobSummary != nil {
+		in, out := &in.BatchJobSummary, &out.BatchJobSummary
+		*out = new(BatchJobSummary)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2511,6 +3031,16 @@ func (in *RollingUpdateConfig) DeepCopyInto(out *RollingUpdateConfig) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateConfig.
@@ -2558,6 +3088,28 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingCycleTrace) DeepCopyInto(out *SchedulingCycleTrace) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterSchedulingTraceEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingCycleTrace.
+func (in *SchedulingCycleTrace) DeepCopy() *SchedulingCycleTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingCycleTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingPolicySnapshot) DeepCopyInto(out *SchedulingPolicySnapshot) {
 	*out = *in
@@ -2671,6 +3223,65 @@ func (in *SchedulingPolicySnapshotStatus) DeepCopy() *SchedulingPolicySnapshotSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingTrace) DeepCopyInto(out *SchedulingTrace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Trace.DeepCopyInto(&out.Trace)
+	in.LastUpdatedTime.DeepCopyInto(&out.LastUpdatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingTrace.
+func (in *SchedulingTrace) DeepCopy() *SchedulingTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingTrace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingTraceList) DeepCopyInto(out *SchedulingTraceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulingTrace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingTraceList.
+func (in *SchedulingTraceList) DeepCopy() *SchedulingTraceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingTraceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingTraceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerSideApplyConfig) DeepCopyInto(out *ServerSideApplyConfig) {
 	*out = *in
@@ -2730,6 +3341,27 @@ func (in *StageConfig) DeepCopy() *StageConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageHealthCheck) DeepCopyInto(out *StageHealthCheck) {
+	*out = *in
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.SoakTime = in.SoakTime
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageHealthCheck.
+func (in *StageHealthCheck) DeepCopy() *StageHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(StageHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageTask) DeepCopyInto(out *StageTask) {
 	*out = *in
@@ -2738,6 +3370,16 @@ func (in *StageTask) DeepCopyInto(out *StageTask) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(StageHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(StageWebhook)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageTask.
@@ -2753,6 +3395,10 @@ func (in *StageTask) DeepCopy() *StageTask {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageTaskStatus) DeepCopyInto(out *StageTaskStatus) {
 	*out = *in
+	if in.LastWebhookAttemptTime != nil {
+		in, out := &in.LastWebhookAttemptTime, &out.LastWebhookAttemptTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2823,6 +3469,36 @@ func (in *StageUpdatingStatus) DeepCopy() *StageUpdatingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageWebhook) DeepCopyInto(out *StageWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageWebhook.
+func (in *StageWebhook) DeepCopy() *StageWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(StageWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StagedUpdateRun) DeepCopyInto(out *StagedUpdateRun) {
 	*out = *in
@@ -2940,9 +3616,29 @@ func (in *StagedUpdateStrategyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticClusterWeight) DeepCopyInto(out *StaticClusterWeight) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticClusterWeight.
+func (in *StaticClusterWeight) DeepCopy() *StaticClusterWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticClusterWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Toleration) DeepCopyInto(out *Toleration) {
 	*out = *in
+	if in.TolerationSeconds != nil {
+		in, out := &in.TolerationSeconds, &out.TolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Toleration.
@@ -3056,6 +3752,22 @@ func (in *UpdateStrategySpec) DeepCopy() *UpdateStrategySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedPlacementAffinityTerm) DeepCopyInto(out *WeightedPlacementAffinityTerm) {
+	*out = *in
+	in.PlacementAffinityTerm.DeepCopyInto(&out.PlacementAffinityTerm)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedPlacementAffinityTerm.
+func (in *WeightedPlacementAffinityTerm) DeepCopy() *WeightedPlacementAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedPlacementAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Work) DeepCopyInto(out *Work) {
 	*out = *in
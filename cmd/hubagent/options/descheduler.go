@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/descheduler"
+)
+
+// DeschedulerOptions is a set of options the KubeFleet hub agent exposes for the descheduler, which
+// periodically re-evaluates the placements made by the scheduler and evicts the ones that would have
+// been better placed elsewhere.
+type DeschedulerOptions struct {
+	// Enable the descheduler or not. The descheduler creates ClusterResourcePlacementEviction objects,
+	// and thus requires that the Eviction API support is enabled.
+	EnableDescheduler bool
+
+	// The interval between two descheduling cycles.
+	DeschedulingInterval metav1.Duration
+
+	// The descheduling strategies in use, evaluated in the order as specified.
+	DeschedulerStrategies []string
+
+	// The minimum period between two evictions the descheduler creates for the same placement, which
+	// keeps the descheduler and the scheduler from moving the resources of a placement back and forth.
+	DeschedulerPlacementCooldown metav1.Duration
+
+	// The resource utilization percentage below which a member cluster is considered under-utilized
+	// by the LowUtilization descheduling strategy.
+	LowUtilizationThreshold int
+
+	// The resource utilization percentage above which a member cluster is considered over-utilized
+	// by the LowUtilization descheduling strategy.
+	HighUtilizationThreshold int
+}
+
+// AddFlags adds flags for DeschedulerOptions to the specified FlagSet.
+func (o *DeschedulerOptions) AddFlags(flags *flag.FlagSet) {
+	flags.BoolVar(
+		&o.EnableDescheduler,
+		"enable-descheduler",
+		false,
+		"Enable the descheduler or not. The descheduler periodically re-evaluates the placements made by the scheduler and evicts the ones that would have been better placed elsewhere; it requires that the Eviction API support is enabled.",
+	)
+
+	flags.Var(
+		newDeschedulingIntervalValueWithValidation(5*time.Minute, &o.DeschedulingInterval),
+		"descheduling-interval",
+		"The interval between two descheduling cycles. Defaults to 5 minutes. Must be a duration in the range [1m, 24h].",
+	)
+
+	flags.Var(
+		newDeschedulerStrategiesValueWithValidation(descheduler.StrategyNames, &o.DeschedulerStrategies),
+		"descheduler-strategies",
+		fmt.Sprintf("A comma-separated list of descheduling strategies in use, evaluated in the order as specified. Defaults to all the supported strategies, i.e., %s.", strings.Join(descheduler.StrategyNames, ",")),
+	)
+
+	flags.Var(
+		newDeschedulerPlacementCooldownValueWithValidation(time.Hour, &o.DeschedulerPlacementCooldown),
+		"descheduler-placement-cooldown",
+		"The minimum period between two evictions the descheduler creates for the same placement. Defaults to 1 hour. Must be a duration in the range [0, 24h].",
+	)
+
+	flags.Var(
+		newUtilizationThresholdValueWithValidation(50, &o.LowUtilizationThreshold),
+		"descheduler-low-utilization-threshold",
+		"The resource utilization percentage below which a member cluster is considered under-utilized by the LowUtilization descheduling strategy. Defaults to 50. Must be an integer value in the range [0, 100].",
+	)
+
+	flags.Var(
+		newUtilizationThresholdValueWithValidation(80, &o.HighUtilizationThreshold),
+		"descheduler-high-utilization-threshold",
+		"The resource utilization percentage above which a member cluster is considered over-utilized by the LowUtilization descheduling strategy. Defaults to 80. Must be an integer value in the range [0, 100].",
+	)
+}
+
+// A list of flag variables that allow pluggable validation logic when parsing the input args.
+
+type DeschedulingIntervalValueWithValidation metav1.Duration
+
+func (v *DeschedulingIntervalValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *DeschedulingIntervalValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < time.Minute || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [1m, 24h]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newDeschedulingIntervalValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *DeschedulingIntervalValueWithValidation {
+	p.Duration = defaultVal
+	return (*DeschedulingIntervalValueWithValidation)(p)
+}
+
+type DeschedulerPlacementCooldownValueWithValidation metav1.Duration
+
+func (v *DeschedulerPlacementCooldownValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *DeschedulerPlacementCooldownValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < 0 || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [0, 24h]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newDeschedulerPlacementCooldownValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *DeschedulerPlacementCooldownValueWithValidation {
+	p.Duration = defaultVal
+	return (*DeschedulerPlacementCooldownValueWithValidation)(p)
+}
+
+type DeschedulerStrategiesValueWithValidation []string
+
+func (v *DeschedulerStrategiesValueWithValidation) String() string {
+	return strings.Join(*v, ",")
+}
+
+func (v *DeschedulerStrategiesValueWithValidation) Set(s string) error {
+	strategies := []string{}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
+		if !slices.Contains(descheduler.StrategyNames, name) {
+			return fmt.Errorf("unknown descheduling strategy %q; supported strategies are %s", name, strings.Join(descheduler.StrategyNames, ","))
+		}
+		if slices.Contains(strategies, name) {
+			return fmt.Errorf("descheduling strategy %q is specified more than once", name)
+		}
+		strategies = append(strategies, name)
+	}
+	if len(strategies) == 0 {
+		return fmt.Errorf("at least one descheduling strategy must be specified")
+	}
+	*v = strategies
+	return nil
+}
+
+func newDeschedulerStrategiesValueWithValidation(defaultVal []string, p *[]string) *DeschedulerStrategiesValueWithValidation {
+	*p = slices.Clone(defaultVal)
+	return (*DeschedulerStrategiesValueWithValidation)(p)
+}
+
+type UtilizationThresholdValueWithValidation int
+
+func (v *UtilizationThresholdValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *UtilizationThresholdValueWithValidation) Set(s string) error {
+	threshold, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if threshold < 0 || threshold > 100 {
+		return fmt.Errorf("utilization threshold must be in the range [0, 100]")
+	}
+	*v = UtilizationThresholdValueWithValidation(threshold)
+	return nil
+}
+
+func newUtilizationThresholdValueWithValidation(defaultVal int, p *int) *UtilizationThresholdValueWithValidation {
+	*p = defaultVal
+	return (*UtilizationThresholdValueWithValidation)(p)
+}
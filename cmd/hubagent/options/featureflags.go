@@ -51,6 +51,32 @@ type FeatureFlags struct {
 	// ResourcePlacement APIs are a set of KubeFleet APIs for processing namespace scoped resource placements.
 	// This flag does not concern the cluster-scoped placement APIs (`ClusterResourcePlacement` and its related APIs).
 	EnableResourcePlacementAPIs bool
+
+	// Enable the PlacementPolicy API support in the KubeFleet hub agent or not.
+	//
+	// PlacementPolicy APIs are a set of KubeFleet APIs (in the placement.kubefleet.dev API group) for placing
+	// resources to member clusters picked by cluster selectors.
+	EnablePlacementPolicyAPIs bool
+
+	// Enable the ClusterRequest API support in the KubeFleet hub agent or not.
+	//
+	// When enabled, placement policies submit ClusterRequest objects for the cluster selectors that cannot
+	// be fulfilled with the existing member clusters. This flag takes effect only when the PlacementPolicy
+	// API support is enabled.
+	EnableClusterRequestAPIs bool
+
+	// Enable the PlacementSimulation API support in the KubeFleet hub agent or not.
+	//
+	// PlacementSimulation APIs are a set of KubeFleet APIs for previewing the scheduling decisions for
+	// hypothetical placement policies.
+	EnablePlacementSimulationAPIs bool
+
+	// Enable the SchedulingTrace API support in the KubeFleet hub agent or not.
+	//
+	// SchedulingTrace APIs are a set of KubeFleet APIs for recording how the scheduler has evaluated every
+	// member cluster in the latest scheduling cycle of a placement, i.e., which plugin has filtered out
+	// a cluster and why, and the scores each plugin has assigned to a cluster.
+	EnableSchedulingTraceAPIs bool
 }
 
 // AddFlags adds flags for FeatureFlags to the specified FlagSet.
@@ -88,6 +114,34 @@ func (o *FeatureFlags) AddFlags(flags *flag.FlagSet) {
 		true,
 		"Enable the ResourcePlacement API support (for namespace-scoped placements) in the KubeFleet hub agent or not.",
 	)
+
+	flags.BoolVar(
+		&o.EnablePlacementPolicyAPIs,
+		"enable-placement-policy-apis",
+		false,
+		"Enable the PlacementPolicy API support (placement.kubefleet.dev) in the KubeFleet hub agent or not.",
+	)
+
+	flags.BoolVar(
+		&o.EnableClusterRequestAPIs,
+		"enable-cluster-request-apis",
+		false,
+		"Enable the ClusterRequest API support in the KubeFleet hub agent or not; it takes effect only when the PlacementPolicy API support is enabled.",
+	)
+
+	flags.BoolVar(
+		&o.EnablePlacementSimulationAPIs,
+		"enable-placement-simulation-apis",
+		false,
+		"Enable the PlacementSimulation API support in the KubeFleet hub agent or not.",
+	)
+
+	flags.BoolVar(
+		&o.EnableSchedulingTraceAPIs,
+		"enable-scheduling-trace-apis",
+		false,
+		"Enable the SchedulingTrace API support in the KubeFleet hub agent or not; when enabled, the scheduler records the results of its plugins for every evaluated cluster in a scheduling trace object per placement.",
+	)
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
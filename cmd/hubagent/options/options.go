@@ -39,6 +39,12 @@ type Options struct {
 
 	// Options that fine-tune how KubeFleet hub agent manages resources placements in the fleet.
 	PlacementMgmtOpts PlacementManagementOptions
+
+	// Options that concern the descheduler.
+	DeschedulerOpts DeschedulerOptions
+
+	// Options that concern the scheduler.
+	SchedulerOpts SchedulerOptions
 }
 
 func NewOptions() *Options {
@@ -52,4 +58,6 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	o.FeatureFlags.AddFlags(flags)
 	o.ClusterMgmtOpts.AddFlags(flags)
 	o.PlacementMgmtOpts.AddFlags(flags)
+	o.DeschedulerOpts.AddFlags(flags)
+	o.SchedulerOpts.AddFlags(flags)
 }
@@ -345,13 +345,21 @@ func TestFeatureFlags(t *testing.T) {
 				"--enable-staged-update-run-apis=false",
 				"--enable-eviction-apis=false",
 				"--enable-resource-placement=false",
+				"--enable-placement-policy-apis=true",
+				"--enable-cluster-request-apis=true",
+				"--enable-placement-simulation-apis=true",
+				"--enable-scheduling-trace-apis=true",
 			},
 			wantFeatureFlags: FeatureFlags{
-				EnableV1Beta1APIs:           true,
-				EnableClusterInventoryAPIs:  false,
-				EnableStagedUpdateRunAPIs:   false,
-				EnableEvictionAPIs:          false,
-				EnableResourcePlacementAPIs: false,
+				EnableV1Beta1APIs:             true,
+				EnableClusterInventoryAPIs:    false,
+				EnableStagedUpdateRunAPIs:     false,
+				EnableEvictionAPIs:            false,
+				EnableResourcePlacementAPIs:   false,
+				EnablePlacementPolicyAPIs:     true,
+				EnableClusterRequestAPIs:      true,
+				EnablePlacementSimulationAPIs: true,
+				EnableSchedulingTraceAPIs:     true,
 			},
 		},
 		{
@@ -546,6 +554,7 @@ func TestPlacementManagementOptions(t *testing.T) {
 				"--skipped-propagating-apis=apps/v1/Deployment",
 				"--allowed-propagating-apis=batch/v1/Job",
 				"--skipped-propagating-namespaces=ns1,ns2",
+				"--allowed-stage-webhook-hosts=approvals.example.com",
 				"--concurrent-resource-change-syncs=30",
 				"--max-fleet-size=150",
 				"--max-concurrent-cluster-placement=120",
@@ -557,6 +566,7 @@ func TestPlacementManagementOptions(t *testing.T) {
 				SkippedPropagatingAPIs:        "apps/v1/Deployment",
 				AllowedPropagatingAPIs:        "batch/v1/Job",
 				SkippedPropagatingNamespaces:  "ns1,ns2",
+				AllowedStageWebhookHosts:      "approvals.example.com",
 				ConcurrentResourceChangeSyncs: 30,
 				MaxFleetSize:                  150,
 				MaxConcurrentClusterPlacement: 120,
@@ -989,3 +999,219 @@ func TestRateLimitOptions(t *testing.T) {
 		})
 	}
 }
+
+// TestDeschedulerOptions tests the parsing and validation logic of the descheduler options defined in DeschedulerOptions.
+func TestDeschedulerOptions(t *testing.T) {
+	testCases := []struct {
+		name                string
+		flagSetName         string
+		args                []string
+		wantDeschedulerOpts DeschedulerOptions
+		wantErred           bool
+		wantErrMsgSubStr    string
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantDeschedulerOpts: DeschedulerOptions{
+				EnableDescheduler:            false,
+				DeschedulingInterval:         metav1.Duration{Duration: 5 * time.Minute},
+				DeschedulerStrategies:        []string{"LowUtilization", "TopologySpreadViolation", "UntoleratedTaint"},
+				DeschedulerPlacementCooldown: metav1.Duration{Duration: time.Hour},
+				LowUtilizationThreshold:      50,
+				HighUtilizationThreshold:     80,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--enable-descheduler=true",
+				"--descheduling-interval=10m",
+				"--descheduler-strategies=UntoleratedTaint, LowUtilization",
+				"--descheduler-placement-cooldown=30m",
+				"--descheduler-low-utilization-threshold=20",
+				"--descheduler-high-utilization-threshold=90",
+			},
+			wantDeschedulerOpts: DeschedulerOptions{
+				EnableDescheduler:            true,
+				DeschedulingInterval:         metav1.Duration{Duration: 10 * time.Minute},
+				DeschedulerStrategies:        []string{"UntoleratedTaint", "LowUtilization"},
+				DeschedulerPlacementCooldown: metav1.Duration{Duration: 30 * time.Minute},
+				LowUtilizationThreshold:      20,
+				HighUtilizationThreshold:     90,
+			},
+		},
+		{
+			name:             "descheduling interval parse error",
+			flagSetName:      "deschedulingIntervalParseError",
+			args:             []string{"--descheduling-interval=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse duration",
+		},
+		{
+			name:             "descheduling interval out of range (too small)",
+			flagSetName:      "deschedulingIntervalOutOfRangeTooSmall",
+			args:             []string{"--descheduling-interval=59s"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [1m, 24h]",
+		},
+		{
+			name:             "descheduling interval out of range (too large)",
+			flagSetName:      "deschedulingIntervalOutOfRangeTooLarge",
+			args:             []string{"--descheduling-interval=24h1s"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [1m, 24h]",
+		},
+		{
+			name:             "descheduler placement cooldown out of range",
+			flagSetName:      "deschedulerPlacementCooldownOutOfRange",
+			args:             []string{"--descheduler-placement-cooldown=25h"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [0, 24h]",
+		},
+		{
+			name:             "unknown descheduler strategy",
+			flagSetName:      "unknownDeschedulerStrategy",
+			args:             []string{"--descheduler-strategies=LowUtilization,Unknown"},
+			wantErred:        true,
+			wantErrMsgSubStr: "unknown descheduling strategy",
+		},
+		{
+			name:             "duplicate descheduler strategies",
+			flagSetName:      "duplicateDeschedulerStrategies",
+			args:             []string{"--descheduler-strategies=LowUtilization,LowUtilization"},
+			wantErred:        true,
+			wantErrMsgSubStr: "is specified more than once",
+		},
+		{
+			name:             "no descheduler strategies",
+			flagSetName:      "noDeschedulerStrategies",
+			args:             []string{"--descheduler-strategies=,"},
+			wantErred:        true,
+			wantErrMsgSubStr: "at least one descheduling strategy must be specified",
+		},
+		{
+			name:             "utilization threshold parse error",
+			flagSetName:      "utilizationThresholdParseError",
+			args:             []string{"--descheduler-low-utilization-threshold=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "utilization threshold out of range",
+			flagSetName:      "utilizationThresholdOutOfRange",
+			args:             []string{"--descheduler-high-utilization-threshold=101"},
+			wantErred:        true,
+			wantErrMsgSubStr: "utilization threshold must be in the range [0, 100]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			deschedulerOpts := DeschedulerOptions{}
+			deschedulerOpts.AddFlags(flags)
+
+			err := flags.Parse(tc.args)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("flag Parse() = nil, want erred")
+				}
+
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("flag Parse() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(deschedulerOpts, tc.wantDeschedulerOpts); diff != "" {
+				t.Errorf("descheduler options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSchedulerOptions tests the parsing and validation logic of the scheduler options defined in SchedulerOptions.
+func TestSchedulerOptions(t *testing.T) {
+	testCases := []struct {
+		name              string
+		flagSetName       string
+		args              []string
+		wantSchedulerOpts SchedulerOptions
+		wantErred         bool
+		wantErrMsgSubStr  string
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantSchedulerOpts: SchedulerOptions{
+				SchedulerConfig:                    "",
+				EnableResourceCapacityPlugin:       false,
+				ResourceCapacityHeadroomPercentage: 10,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--scheduler-config=/etc/kubefleet/scheduler-config.yaml",
+				"--enable-resource-capacity-plugin=true",
+				"--resource-capacity-headroom-percentage=25",
+			},
+			wantSchedulerOpts: SchedulerOptions{
+				SchedulerConfig:                    "/etc/kubefleet/scheduler-config.yaml",
+				EnableResourceCapacityPlugin:       true,
+				ResourceCapacityHeadroomPercentage: 25,
+			},
+		},
+		{
+			name:             "headroom percentage parse error",
+			flagSetName:      "headroomPercentageParseError",
+			args:             []string{"--resource-capacity-headroom-percentage=ten"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "headroom percentage out of range",
+			flagSetName:      "headroomPercentageOutOfRange",
+			args:             []string{"--resource-capacity-headroom-percentage=100"},
+			wantErred:        true,
+			wantErrMsgSubStr: "headroom percentage must be in the range [0, 99]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			schedulerOpts := SchedulerOptions{}
+			schedulerOpts.AddFlags(flags)
+
+			err := flags.Parse(tc.args)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("flag Parse() = nil, want erred")
+				}
+
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("flag Parse() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(schedulerOpts, tc.wantSchedulerOpts); diff != "" {
+				t.Errorf("scheduler options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -85,6 +85,14 @@ type PlacementManagementOptions struct {
 	// those that are prefixed with `kube-`, and `fleet-system`.
 	SkippedPropagatingNamespaces string
 
+	// A list of hosts that the stage webhooks of namespaced update runs (StagedUpdateRun) may target.
+	//
+	// This list is a collection of host names separated by commas, such as `approvals.example.com,10.0.0.8`.
+	// The hub agent calls stage webhooks from within the hub cluster network; as namespaced update strategies
+	// can be authored by namespace users, the webhooks of namespaced update runs are rejected unless they
+	// target one of the listed hosts. The webhooks of cluster-scoped update runs are not restricted.
+	AllowedStageWebhookHosts string
+
 	// The number of concurrent workers that help process resource changes for the placement APIs.
 	ConcurrentResourceChangeSyncs int
 
@@ -144,6 +152,13 @@ func (o *PlacementManagementOptions) AddFlags(flags *flag.FlagSet) {
 		"A list of comma-separated namespace names that are block-listed for resource placement. The KubeFleet hub agent will ignore the namespaces and any resources within them when selecting resources for placement.",
 	)
 
+	flags.StringVar(
+		&o.AllowedStageWebhookHosts,
+		"allowed-stage-webhook-hosts",
+		"",
+		"A list of comma-separated host names that the stage webhooks of namespaced update runs (StagedUpdateRun) may target. The webhooks of namespaced update runs are rejected unless they target one of the listed hosts; the webhooks of cluster-scoped update runs are not restricted.",
+	)
+
 	flags.Var(
 		newConcurrentResourceChangeSyncsValueWithValidation(20, &o.ConcurrentResourceChangeSyncs),
 		"concurrent-resource-change-syncs",
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+)
+
+// SchedulerOptions is a set of options the KubeFleet hub agent exposes for the scheduler.
+type SchedulerOptions struct {
+	// A file path to the scheduler configuration file, which declares the scheduling profiles in use
+	// by the scheduler. If not specified, the scheduler uses the default profile only.
+	SchedulerConfig string
+
+	// Enable the resource capacity plugin in the default scheduling profile or not. The plugin filters
+	// out the clusters whose available resources cannot accommodate the workloads selected by a placement,
+	// and prefers the clusters with more resources left after the placement.
+	EnableResourceCapacityPlugin bool
+
+	// The percentage of the available resources of a cluster that must remain unrequested after a
+	// placement, as checked by the resource capacity plugin.
+	ResourceCapacityHeadroomPercentage int
+}
+
+// AddFlags adds flags for SchedulerOptions to the specified FlagSet.
+func (o *SchedulerOptions) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(
+		&o.SchedulerConfig,
+		"scheduler-config",
+		"",
+		"A file path to the scheduler configuration file. The file is a JSON or YAML file of the SchedulerConfiguration kind that declares the scheduling profiles in use by the scheduler, i.e., the plugins enabled at each extension point and their score weights; a placement selects a profile by its scheduler name. See the KubeFleet source code for more information. If not specified, the scheduler uses the default profile only.",
+	)
+
+	flags.BoolVar(
+		&o.EnableResourceCapacityPlugin,
+		"enable-resource-capacity-plugin",
+		false,
+		"Enable the resource capacity plugin in the default scheduling profile or not. The plugin sums up the resource requests of the Deployments, StatefulSets, and Jobs selected by a placement, filters out the clusters whose reported available resources cannot accommodate them, and prefers the clusters with more resources left after the placement.",
+	)
+
+	flags.Var(
+		newResourceCapacityHeadroomPercentageValueWithValidation(10, &o.ResourceCapacityHeadroomPercentage),
+		"resource-capacity-headroom-percentage",
+		"The percentage of the available resources of a cluster that must remain unrequested after a placement, as checked by the resource capacity plugin. Defaults to 10. Must be an integer value in the range [0, 99].",
+	)
+}
+
+// A list of flag variables that allow pluggable validation logic when parsing the input args.
+
+type ResourceCapacityHeadroomPercentageValueWithValidation int
+
+func (v *ResourceCapacityHeadroomPercentageValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *ResourceCapacityHeadroomPercentageValueWithValidation) Set(s string) error {
+	percentage, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if percentage < 0 || percentage > 99 {
+		return fmt.Errorf("headroom percentage must be in the range [0, 99]")
+	}
+	*v = ResourceCapacityHeadroomPercentageValueWithValidation(percentage)
+	return nil
+}
+
+func newResourceCapacityHeadroomPercentageValueWithValidation(defaultVal int, p *int) *ResourceCapacityHeadroomPercentageValueWithValidation {
+	*p = defaultVal
+	return (*ResourceCapacityHeadroomPercentageValueWithValidation)(p)
+}
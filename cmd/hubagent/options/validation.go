@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	"github.com/kubefleet-dev/kubefleet/pkg/admissionpolicymanager"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/profile"
 )
 
 // Validate checks Options and return a slice of found errs.
@@ -71,11 +72,25 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("PlacementControllerWorkQueueRateLimiterOpts").Child("RateLimiterQPS"), o.PlacementMgmtOpts.PlacementControllerWorkQueueRateLimiterOpts.RateLimiterQPS, "the QPS for the placement controller set rate limiter must be less than its bucket size"))
 	}
 
+	// Cross-field validation for descheduler options.
+	if o.DeschedulerOpts.EnableDescheduler && !o.FeatureFlags.EnableEvictionAPIs {
+		errs = append(errs, field.Invalid(newPath.Child("EnableDescheduler"), o.DeschedulerOpts.EnableDescheduler, "the descheduler requires that the Eviction API support is enabled"))
+	}
+
+	if o.DeschedulerOpts.LowUtilizationThreshold >= o.DeschedulerOpts.HighUtilizationThreshold {
+		errs = append(errs, field.Invalid(newPath.Child("DeschedulerOpts").Child("LowUtilizationThreshold"), o.DeschedulerOpts.LowUtilizationThreshold, "the low utilization threshold for the descheduler must be less than its high utilization threshold"))
+	}
+
 	// Validate admission policy manager setup (if enabled).
 	if err := o.validateAdmissionPolicyManagerConfig(newPath); err != nil {
 		errs = append(errs, err)
 	}
 
+	// Validate the scheduler configuration (if any).
+	if err := o.validateSchedulerConfig(newPath); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errs
 }
 
@@ -101,3 +116,19 @@ func (o *Options) validateAdmissionPolicyManagerConfig(newPath *field.Path) *fie
 	}
 	return nil
 }
+
+func (o *Options) validateSchedulerConfig(newPath *field.Path) *field.Error {
+	schedulerConfigPath := o.SchedulerOpts.SchedulerConfig
+	if len(schedulerConfigPath) == 0 {
+		return nil
+	}
+
+	data, err := os.ReadFile(schedulerConfigPath)
+	if err != nil {
+		return field.Invalid(newPath.Child("SchedulerConfig"), schedulerConfigPath, "failed to read the scheduler config file: "+err.Error())
+	}
+	if _, err := profile.LoadConfiguration(data); err != nil {
+		return field.Invalid(newPath.Child("SchedulerConfig"), schedulerConfigPath, "invalid scheduler config: "+err.Error())
+	}
+	return nil
+}
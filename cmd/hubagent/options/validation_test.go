@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/kubefleet-dev/kubefleet/pkg/admissionpolicymanager"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/profile"
 )
 
 const (
@@ -62,6 +63,10 @@ func newTestOptions(modifyOptions ModifyOptions) Options {
 				RateLimiterBucketSize: 100,
 			},
 		},
+		DeschedulerOpts: DeschedulerOptions{
+			LowUtilizationThreshold:  50,
+			HighUtilizationThreshold: 80,
+		},
 	}
 
 	if modifyOptions != nil {
@@ -139,6 +144,27 @@ func TestValidation(t *testing.T) {
 			}),
 			want: field.ErrorList{field.Invalid(newPath.Child("PlacementControllerWorkQueueRateLimiterOpts").Child("RateLimiterQPS"), 100, "the QPS for the placement controller set rate limiter must be less than its bucket size")},
 		},
+		"descheduler requires the eviction APIs": {
+			opt: newTestOptions(func(option *Options) {
+				option.DeschedulerOpts.EnableDescheduler = true
+				option.FeatureFlags.EnableEvictionAPIs = false
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnableDescheduler"), true, "the descheduler requires that the Eviction API support is enabled")},
+		},
+		"descheduler enabled with the eviction APIs": {
+			opt: newTestOptions(func(option *Options) {
+				option.DeschedulerOpts.EnableDescheduler = true
+				option.FeatureFlags.EnableEvictionAPIs = true
+			}),
+			want: field.ErrorList{},
+		},
+		"descheduler low utilization threshold must be less than high utilization threshold": {
+			opt: newTestOptions(func(option *Options) {
+				option.DeschedulerOpts.LowUtilizationThreshold = 80
+				option.DeschedulerOpts.HighUtilizationThreshold = 80
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("DeschedulerOpts").Child("LowUtilizationThreshold"), 80, "the low utilization threshold for the descheduler must be less than its high utilization threshold")},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -248,6 +274,84 @@ func TestValidateAdmissionPolicyManagerConfig(t *testing.T) {
 	}
 }
 
+func TestValidateSchedulerConfig(t *testing.T) {
+	newPath := field.NewPath("Options")
+	tmpDir := t.TempDir()
+
+	// Non-existent file: capture the OS error to mirror the exact detail string.
+	nonExistentPath := filepath.Join(tmpDir, "nonexistent.yaml")
+	_, readErr := os.ReadFile(nonExistentPath)
+
+	// Config file that fails validation (unknown plugin).
+	invalidConfigData := []byte(`apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- schedulerName: custom
+  plugins:
+    filter:
+      disabled: ["Unknown"]
+`)
+	invalidConfigPath := filepath.Join(tmpDir, "invalid-config.yaml")
+	if err := os.WriteFile(invalidConfigPath, invalidConfigData, 0600); err != nil {
+		t.Fatalf("TestValidateSchedulerConfig: failed to write invalid config file: %v", err)
+	}
+	_, configValidateErr := profile.LoadConfiguration(invalidConfigData)
+
+	// Valid config file.
+	validConfigPath := filepath.Join(tmpDir, "valid-config.yaml")
+	validConfigData := []byte(`apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- schedulerName: custom
+  scoreWeights:
+    SamePlacementAntiAffinity: 10
+`)
+	if err := os.WriteFile(validConfigPath, validConfigData, 0600); err != nil {
+		t.Fatalf("TestValidateSchedulerConfig: failed to write valid config file: %v", err)
+	}
+
+	testCases := map[string]struct {
+		opt  Options
+		want field.ErrorList
+	}{
+		"no config path specified": {
+			opt:  newTestOptions(nil),
+			want: field.ErrorList{},
+		},
+		"config file does not exist": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulerOpts.SchedulerConfig = nonExistentPath
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("SchedulerConfig"), nonExistentPath, "failed to read the scheduler config file: "+readErr.Error()),
+			},
+		},
+		"config file fails validation": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulerOpts.SchedulerConfig = invalidConfigPath
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("SchedulerConfig"), invalidConfigPath, "invalid scheduler config: "+configValidateErr.Error()),
+			},
+		},
+		"valid config file": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulerOpts.SchedulerConfig = validConfigPath
+			}),
+			want: field.ErrorList{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.opt.Validate()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Validate() errs mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAddFlags(t *testing.T) {
 	g := gomega.NewWithT(t)
 	opts := NewOptions()
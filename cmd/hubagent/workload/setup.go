@@ -19,6 +19,7 @@ package workload
 import (
 	"context"
 	"math"
+	"os"
 	"strings"
 	"sync"
 
@@ -31,24 +32,31 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/bindingwatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterinventory/clusterprofile"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementeviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementstatuswatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clustertainteviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/overrider"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placement"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementbinding"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementpolicy"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementsimulation"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementwatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/resourcechange"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/rollout"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/schedulingpolicysnapshot"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/updaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workgenerator"
+	"github.com/kubefleet-dev/kubefleet/pkg/descheduler"
 	"github.com/kubefleet-dev/kubefleet/pkg/resourcewatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/resourcecapacity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/profile"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 	schedulerbindingwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/binding"
@@ -116,6 +124,28 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementEvictionKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementDisruptionBudgetKind),
 	}
+
+	placementPolicyGVKs = []schema.GroupVersionKind{
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.ClusterPlacementPolicyKind),
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.ClusterPlacementBindingKind),
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.ClusterPlacementResourceSnapshotKind),
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.PlacementPolicyKind),
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.PlacementBindingKind),
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.PlacementResourceSnapshotKind),
+	}
+
+	clusterRequestGVKs = []schema.GroupVersionKind{
+		kfplacementv1alpha1.GroupVersion.WithKind(kfplacementv1alpha1.ClusterRequestKind),
+	}
+
+	placementSimulationGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.PlacementSimulationKind),
+	}
+
+	schedulingTraceGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterSchedulingTraceKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.SchedulingTraceKind),
+	}
 )
 
 // SetupControllers set up the customized controllers we developed
@@ -151,6 +181,14 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		}
 	}
 
+	// setup the hosts that the stage webhooks of namespaced update runs may target
+	var allowedStageWebhookHosts []string
+	for _, host := range strings.Split(opts.PlacementMgmtOpts.AllowedStageWebhookHosts, ",") {
+		if host = strings.TrimSpace(host); len(host) > 0 {
+			allowedStageWebhookHosts = append(allowedStageWebhookHosts, host)
+		}
+	}
+
 	// the manager for all the dynamically created informers
 	dynamicInformerManager := informer.NewInformerManager(dynamicClient, opts.CtrlMgrOpts.ResyncPeriod.Duration, ctx.Done())
 	validator.ResourceInformer = dynamicInformerManager // webhook needs this to check resource scope
@@ -298,6 +336,38 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				klog.ErrorS(err, "Unable to set up cluster resource placement eviction controller")
 				return err
 			}
+
+			klog.Info("Setting up cluster taint eviction controller")
+			if err := (&clustertainteviction.Reconciler{
+				Client:   mgr.GetClient(),
+				Recorder: mgr.GetEventRecorderFor("cluster-taint-eviction-controller"),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up cluster taint eviction controller")
+				return err
+			}
+
+			if opts.DeschedulerOpts.EnableDescheduler {
+				klog.Info("Setting up the descheduler")
+				strategies, err := descheduler.NewStrategies(opts.DeschedulerOpts.DeschedulerStrategies, descheduler.StrategyOptions{
+					LowUtilizationThreshold:  opts.DeschedulerOpts.LowUtilizationThreshold,
+					HighUtilizationThreshold: opts.DeschedulerOpts.HighUtilizationThreshold,
+				})
+				if err != nil {
+					klog.ErrorS(err, "Unable to build the descheduling strategies")
+					return err
+				}
+				if err := mgr.Add(&descheduler.Descheduler{
+					Client:                    mgr.GetClient(),
+					Recorder:                  mgr.GetEventRecorderFor("descheduler"),
+					ClusterEligibilityChecker: clustereligibilitychecker.New(),
+					Strategies:                strategies,
+					Interval:                  opts.DeschedulerOpts.DeschedulingInterval.Duration,
+					Cooldown:                  opts.DeschedulerOpts.DeschedulerPlacementCooldown.Duration,
+				}); err != nil {
+					klog.ErrorS(err, "Unable to set up the descheduler")
+					return err
+				}
+			}
 		}
 
 		// Set up a controller to do staged update run, rolling out resources to clusters in a stage by stage manner.
@@ -332,6 +402,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 					InformerManager:          dynamicInformerManager,
 					ResourceSelectorResolver: resourceSelectorResolver,
 					ResourceSnapshotResolver: resourceSnapshotResolver,
+					AllowedStageWebhookHosts: allowedStageWebhookHosts,
 				}).SetupWithManagerForStagedUpdateRun(mgr); err != nil {
 					klog.ErrorS(err, "Unable to set up stagedUpdateRun controller")
 					return err
@@ -363,8 +434,50 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		// Set up the scheduler
 		klog.Info("Setting up scheduler")
-		defaultProfile := profile.NewDefaultProfile()
-		defaultFramework := framework.NewFramework(defaultProfile, mgr)
+		profileOpts := profile.Options{}
+		if opts.SchedulerOpts.EnableResourceCapacityPlugin {
+			klog.InfoS("Enabling the resource capacity plugin", "headroomPercentage", opts.SchedulerOpts.ResourceCapacityHeadroomPercentage)
+			resourceCapacityPlugin := resourcecapacity.New(
+				resourcecapacity.WithHeadroomPercentage(opts.SchedulerOpts.ResourceCapacityHeadroomPercentage),
+			)
+			profileOpts.ResourceCapacityPlugin = &resourceCapacityPlugin
+		}
+		var schedulerConfig *profile.Configuration
+		if len(opts.SchedulerOpts.SchedulerConfig) != 0 {
+			cfgData, err := os.ReadFile(opts.SchedulerOpts.SchedulerConfig)
+			if err != nil {
+				klog.ErrorS(err, "Failed to read the scheduler config file")
+				return err
+			}
+			// Note that validation has been performed when the flags are parsed.
+			if schedulerConfig, err = profile.LoadConfiguration(cfgData); err != nil {
+				klog.ErrorS(err, "Failed to load the scheduler config file")
+				return err
+			}
+		}
+		profiles, err := profile.NewProfiles(schedulerConfig, profileOpts)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create the scheduling profiles")
+			return err
+		}
+		if opts.FeatureFlags.EnableSchedulingTraceAPIs {
+			for _, gvk := range schedulingTraceGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+		}
+		defaultFramework, err := framework.NewMultiProfileFramework(profiles, profile.DefaultProfileName, mgr,
+			framework.WithClusterEligibilityChecker(clustereligibilitychecker.New(
+				clustereligibilitychecker.WithClusterUnhealthyThreshold(opts.ClusterMgmtOpts.UnhealthyThreshold.Duration),
+			)),
+			framework.WithSchedulingTrace(opts.FeatureFlags.EnableSchedulingTraceAPIs),
+		)
+		if err != nil {
+			klog.ErrorS(err, "Failed to set up the scheduler framework")
+			return err
+		}
 		defaultSchedulingQueue := queue.NewSimplePlacementSchedulingQueue(
 			schedulerQueueName, nil,
 		)
@@ -383,6 +496,23 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			klog.InfoS("The scheduler has exited")
 		}()
 
+		if opts.FeatureFlags.EnablePlacementSimulationAPIs {
+			for _, gvk := range placementSimulationGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up the placement simulation controller")
+			if err := (&placementsimulation.Reconciler{
+				Client:    mgr.GetClient(),
+				Simulator: defaultFramework,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up placement simulation controller")
+				return err
+			}
+		}
+
 		// Set up the watchers for the controller
 		klog.Info("Setting up the clusterResourcePlacement watcher for scheduler")
 		if err := (&schedulerplacementwatcher.Reconciler{
@@ -451,6 +581,19 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			return err
 		}
 
+		klog.Info("Setting up the lost memberCluster watcher for scheduler")
+		if err := (&membercluster.LostClusterReconciler{
+			Client:             mgr.GetClient(),
+			SchedulerWorkQueue: defaultSchedulingQueue,
+			ClusterEligibilityChecker: clustereligibilitychecker.New(
+				clustereligibilitychecker.WithClusterUnhealthyThreshold(opts.ClusterMgmtOpts.UnhealthyThreshold.Duration),
+			),
+			EnableResourcePlacement: opts.FeatureFlags.EnableResourcePlacementAPIs,
+		}).SetupWithManager(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up lost memberCluster watcher for scheduler")
+			return err
+		}
+
 		// Set up the controllers for overriding resources.
 		klog.Info("Setting up the clusterResourceOverride controller")
 		if err := (&overrider.ClusterResourceReconciler{
@@ -490,6 +633,61 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				return err
 			}
 		}
+
+		// Set up the controllers for the placement policy APIs.
+		if opts.FeatureFlags.EnablePlacementPolicyAPIs {
+			for _, gvk := range placementPolicyGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			if opts.FeatureFlags.EnableClusterRequestAPIs {
+				for _, gvk := range clusterRequestGVKs {
+					if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+						klog.ErrorS(err, "unable to find the required CRD", "GVK", gvk)
+						return err
+					}
+				}
+			}
+			klog.Info("Setting up the placement policy controllers")
+			if err = (&placementpolicy.Reconciler{
+				Client:                    mgr.GetClient(),
+				UncachedReader:            mgr.GetAPIReader(),
+				Scheme:                    mgr.GetScheme(),
+				ResourceSelectorResolver:  resourceSelectorResolver,
+				ClusterEligibilityChecker: clustereligibilitychecker.New(),
+				EnableClusterRequests:     opts.FeatureFlags.EnableClusterRequestAPIs,
+			}).SetupWithManagerForClusterPlacementPolicy(mgr); err != nil {
+				klog.ErrorS(err, "unable to set up cluster placement policy controller")
+				return err
+			}
+			if err = (&placementpolicy.Reconciler{
+				Client:                    mgr.GetClient(),
+				UncachedReader:            mgr.GetAPIReader(),
+				Scheme:                    mgr.GetScheme(),
+				ResourceSelectorResolver:  resourceSelectorResolver,
+				ClusterEligibilityChecker: clustereligibilitychecker.New(),
+				EnableClusterRequests:     opts.FeatureFlags.EnableClusterRequestAPIs,
+			}).SetupWithManagerForPlacementPolicy(mgr); err != nil {
+				klog.ErrorS(err, "unable to set up placement policy controller")
+				return err
+			}
+
+			klog.Info("Setting up the placement binding controllers")
+			if err = (&placementbinding.Reconciler{
+				Client: mgr.GetClient(),
+			}).SetupWithManagerForClusterPlacementBinding(mgr); err != nil {
+				klog.ErrorS(err, "unable to set up cluster placement binding controller")
+				return err
+			}
+			if err = (&placementbinding.Reconciler{
+				Client: mgr.GetClient(),
+			}).SetupWithManagerForPlacementBinding(mgr); err != nil {
+				klog.ErrorS(err, "unable to set up placement binding controller")
+				return err
+			}
+		}
 	}
 
 	// Set up a new controller to reconcile any resources in the cluster
@@ -55,6 +55,8 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workapplier"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/generic"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/httpclient"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
@@ -63,7 +65,9 @@ import (
 
 const (
 	// The list of available property provider names.
-	azurePropertyProvider = "azure"
+	azurePropertyProvider    = "azure"
+	genericPropertyProvider  = "generic"
+	externalPropertyProvider = "external"
 )
 
 var (
@@ -380,6 +384,17 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		globalOpts.ApplierOpts.RequeueRateLimiterSkipToFastBackoffForAvailableOrDiffReportedWorkObjs,
 	)
 
+	// Load the custom health check rules (if any) for the work applier.
+	var customHealthChecks *workapplier.CustomHealthChecks
+	if len(globalOpts.ApplierOpts.CustomHealthChecksConfigFile) > 0 {
+		customHealthChecks, err = workapplier.LoadCustomHealthChecks(globalOpts.ApplierOpts.CustomHealthChecksConfigFile)
+		if err != nil {
+			klog.ErrorS(err, "Failed to load the custom health check rules", "file", globalOpts.ApplierOpts.CustomHealthChecksConfigFile)
+			return err
+		}
+		klog.V(2).InfoS("Loaded the custom health check rules", "ruleCount", len(customHealthChecks.Rules))
+	}
+
 	workApplier := workapplier.NewReconciler(
 		"work-applier",
 		hubMgr.GetClient(),
@@ -398,6 +413,7 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		globalOpts.ApplierOpts.EnablePriorityQueue,
 		&globalOpts.ApplierOpts.PriorityLinearEquationCoEffA,
 		&globalOpts.ApplierOpts.PriorityLinearEquationCoEffB,
+		customHealthChecks,
 	)
 
 	if err = workApplier.SetupWithManager(hubMgr); err != nil {
@@ -420,6 +436,18 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 			globalOpts.PropertyProviderOpts.EnableAzProviderCostProperties,
 			globalOpts.PropertyProviderOpts.EnableAzProviderAvailableResourceProperties,
 			globalOpts.PropertyProviderOpts.EnableAzProviderNamespaceCollection)
+	case globalOpts.PropertyProviderOpts.Name == genericPropertyProvider:
+		klog.V(2).Info("setting up the generic property provider")
+		// Similar to the Azure property provider, the generic property provider is not started
+		// until the specific instance wins the leader election.
+		pp = generic.New(
+			globalOpts.PropertyProviderOpts.EnableGenericProviderAvailableResourceProperties,
+			globalOpts.PropertyProviderOpts.EnableAzProviderNamespaceCollection)
+	case globalOpts.PropertyProviderOpts.Name == externalPropertyProvider:
+		klog.V(2).InfoS("setting up the external property provider", "endpoint", globalOpts.PropertyProviderOpts.ExternalProviderEndpoint)
+		// The external property provider serves out of process (typically as a sidecar); it is
+		// asked to start only when the specific instance wins the leader election.
+		pp = external.New(globalOpts.PropertyProviderOpts.ExternalProviderEndpoint)
 	default:
 		// Fall back to not using any property provider if the provided type is none or
 		// not recognizable.
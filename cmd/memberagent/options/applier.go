@@ -104,6 +104,13 @@ type ApplierOptions struct {
 
 	// The coefficient B in the linear equation for calculating the priority score of a placement.
 	PriorityLinearEquationCoEffB int
+
+	// The path to a file (usually a ConfigMap mounted as a volume) which specifies custom health check
+	// rules for tracking the availability of applied resources, typically custom resources whose
+	// availability KubeFleet cannot determine on its own. Each rule applies to objects of a specific
+	// API group and kind, and specifies a CEL expression over the objects, which must evaluate to true
+	// for an object to be considered available.
+	CustomHealthChecksConfigFile string
 }
 
 func (o *ApplierOptions) AddFlags(flags *flag.FlagSet) {
@@ -168,6 +175,12 @@ func (o *ApplierOptions) AddFlags(flags *flag.FlagSet) {
 		newPriCoEffBValue(100, &o.PriorityLinearEquationCoEffB),
 		"work-applier-priority-linear-equation-coeff-b",
 		"The coefficient B in the linear equation for calculating the priority score of a placement. The value must be a positive integer no greater than 1000. Default is 100.")
+
+	flags.StringVar(
+		&o.CustomHealthChecksConfigFile,
+		"work-applier-custom-health-checks-config-file",
+		"",
+		"The path to a file which specifies custom health check rules for tracking the availability of applied resources. If not set, the KubeFleet member agent will use only the built-in availability checks.")
 }
 
 type ResForceDeletionWaitTimeMinutes int
@@ -394,6 +394,7 @@ func TestApplierOptions(t *testing.T) {
 				"--work-applier-requeue-rate-limiter-skip-to-fast-backoff-for-available-or-diff-reported-work-objs=false",
 				"--work-applier-priority-linear-equation-coeff-a=-10",
 				"--work-applier-priority-linear-equation-coeff-b=500",
+				"--work-applier-custom-health-checks-config-file=/etc/kubefleet/health-checks/rules.yaml",
 			},
 			wantApplierOpts: ApplierOptions{
 				ResourceForceDeletionWaitTimeMinutes:                                  10,
@@ -408,6 +409,7 @@ func TestApplierOptions(t *testing.T) {
 				RequeueRateLimiterSkipToFastBackoffForAvailableOrDiffReportedWorkObjs: false,
 				PriorityLinearEquationCoEffA:                                          -10,
 				PriorityLinearEquationCoEffB:                                          500,
+				CustomHealthChecksConfigFile:                                          "/etc/kubefleet/health-checks/rules.yaml",
 			},
 		},
 		{
@@ -656,8 +658,10 @@ func TestPropertyProviderOptions(t *testing.T) {
 				Name:                           "none",
 				CloudConfigFilePath:            "/etc/kubernetes/provider/config.json",
 				EnableAzProviderCostProperties: true,
-				EnableAzProviderAvailableResourceProperties: true,
-				EnableAzProviderNamespaceCollection:         false,
+				EnableAzProviderAvailableResourceProperties:      true,
+				EnableAzProviderNamespaceCollection:              false,
+				EnableGenericProviderAvailableResourceProperties: true,
+				ExternalProviderEndpoint:                         "localhost:50051",
 			},
 		},
 		{
@@ -670,14 +674,18 @@ func TestPropertyProviderOptions(t *testing.T) {
 				"--use-cost-properties-in-azure-provider=false",
 				"--use-available-res-properties-in-azure-provider=false",
 				"--enable-namespace-collection-in-property-provider=true",
+				"--use-available-res-properties-in-generic-provider=false",
+				"--external-property-provider-endpoint=unix:///var/run/kubefleet/property-provider.sock",
 			},
 			wantPropertyProvOpts: PropertyProviderOptions{
 				Region:                         "eastus",
 				Name:                           "azure",
 				CloudConfigFilePath:            "/custom/path/config.json",
 				EnableAzProviderCostProperties: false,
-				EnableAzProviderAvailableResourceProperties: false,
-				EnableAzProviderNamespaceCollection:         true,
+				EnableAzProviderAvailableResourceProperties:      false,
+				EnableAzProviderNamespaceCollection:              true,
+				EnableGenericProviderAvailableResourceProperties: false,
+				ExternalProviderEndpoint:                         "unix:///var/run/kubefleet/property-provider.sock",
 			},
 		},
 	}
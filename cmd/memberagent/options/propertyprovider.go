@@ -39,8 +39,17 @@ type PropertyProviderOptions struct {
 	// This option applies only when the Azure property provider is in use.
 	EnableAzProviderAvailableResourceProperties bool
 
-	// Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.
+	// Enable support for namespace collection in the property provider or not. This option applies only when the Azure
+	// or the generic property provider is in use.
 	EnableAzProviderNamespaceCollection bool
+
+	// Enable support for available resource properties in the generic property provider or not.
+	// This option applies only when the generic property provider is in use.
+	EnableGenericProviderAvailableResourceProperties bool
+
+	// The endpoint at which the external property provider serves, in the gRPC name syntax. This option
+	// applies only when the external property provider is in use.
+	ExternalProviderEndpoint string
 }
 
 func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
@@ -78,5 +87,17 @@ func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
 		&o.EnableAzProviderNamespaceCollection,
 		"enable-namespace-collection-in-property-provider",
 		false,
-		"Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.")
+		"Enable support for namespace collection in the property provider or not. This option applies only when the Azure or the generic property provider is in use.")
+
+	flags.BoolVar(
+		&o.EnableGenericProviderAvailableResourceProperties,
+		"use-available-res-properties-in-generic-provider",
+		true,
+		"Enable support for available resource properties in the generic property provider or not. This option applies only when the generic property provider is in use.")
+
+	flags.StringVar(
+		&o.ExternalProviderEndpoint,
+		"external-property-provider-endpoint",
+		"localhost:50051",
+		"The endpoint at which the external property provider serves over gRPC, e.g., localhost:50051 or unix:///var/run/kubefleet/property-provider.sock. This option applies only when the external property provider is in use.")
 }
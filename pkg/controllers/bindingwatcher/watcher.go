@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -179,6 +180,10 @@ func isBindingStatusUpdated(oldBinding, newBinding fleetv1beta1.BindingObj) bool
 		klog.V(2).InfoS("Diffed placements reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
 		return true
 	}
+	if !equality.Semantic.DeepEqual(oldStatus.BatchJobSummary, newStatus.BatchJobSummary) {
+		klog.V(2).InfoS("Batch job summary reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
+		return true
+	}
 
 	klog.V(5).InfoS("The binding status has not changed, no need to refresh the placement status", "binding", klog.KObj(oldBinding))
 	return false
@@ -240,7 +240,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	}
 
 	totalBindings := len(bindingList)
-	allowed, availableBindings := isEvictionAllowed(bindingList, *crp, db)
+	allowed, availableBindings := evictionutils.IsEvictionAllowed(bindingList, *crp, db)
 	if allowed {
 		if err := r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
 			return err
@@ -252,46 +252,6 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	return nil
 }
 
-// isEvictionAllowed calculates if eviction allowed based on available bindings and spec specified in placement disruption budget.
-func isEvictionAllowed(bindings []placementv1beta1.ClusterResourceBinding, crp placementv1beta1.ClusterResourcePlacement, db placementv1beta1.ClusterResourcePlacementDisruptionBudget) (bool, int) {
-	availableBindings := 0
-	for i := range bindings {
-		availableCondition := bindings[i].GetCondition(string(placementv1beta1.ResourceBindingAvailable))
-		if condition.IsConditionStatusTrue(availableCondition, bindings[i].GetGeneration()) {
-			availableBindings++
-		}
-	}
-
-	var desiredBindings int
-	placementType := crp.Spec.Policy.PlacementType
-	// we don't know the desired bindings for PickAll and we won't evict a binding for PickFixed CRP.
-	if placementType == placementv1beta1.PickNPlacementType {
-		desiredBindings = int(*crp.Spec.Policy.NumberOfClusters)
-	}
-
-	var disruptionsAllowed int
-	switch {
-	// For PickAll CRPs, MaxUnavailable won't be specified in DB.
-	case db.Spec.MaxUnavailable != nil:
-		maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(db.Spec.MaxUnavailable, desiredBindings, true)
-		unavailableBindings := len(bindings) - availableBindings
-		disruptionsAllowed = maxUnavailable - unavailableBindings
-	case db.Spec.MinAvailable != nil:
-		var minAvailable int
-		if placementType == placementv1beta1.PickAllPlacementType {
-			// MinAvailable will be an Integer value for PickAll CRP.
-			minAvailable = db.Spec.MinAvailable.IntValue()
-		} else {
-			minAvailable, _ = intstr.GetScaledValueFromIntOrPercent(db.Spec.MinAvailable, desiredBindings, true)
-		}
-		disruptionsAllowed = availableBindings - minAvailable
-	}
-	if disruptionsAllowed < 0 {
-		disruptionsAllowed = 0
-	}
-	return disruptionsAllowed > 0, availableBindings
-}
-
 // markEvictionValid sets the valid condition as true in eviction status.
 func markEvictionValid(eviction *placementv1beta1.ClusterResourcePlacementEviction) {
 	cond := metav1.Condition{
@@ -0,0 +1,429 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustertainteviction features a controller that evicts the placements from the member
+// clusters which have been tainted with NoExecute taints the placements do not tolerate.
+package clustertainteviction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+)
+
+const (
+	// retryInterval is the interval at which the controller re-checks a member cluster when some
+	// of its placements are due for eviction but cannot be evicted yet, e.g., blocked by the
+	// disruption budget or an in-flight eviction.
+	retryInterval = 30 * time.Second
+
+	// finishedEvictionRetentionPeriod is the period for which the controller keeps the evictions
+	// it has created after they have finished, for auditing purposes.
+	finishedEvictionRetentionPeriod = 24 * time.Hour
+
+	// evictionCreatedEventReason is the reason of the event emitted on a placement when the
+	// controller has requested an eviction.
+	evictionCreatedEventReason = "TaintEvictionCreated"
+)
+
+// Reconciler reconciles a MemberCluster object and evicts the placements of the PickAll and PickN
+// placement types from the cluster if the placements do not tolerate the NoExecute taints on it.
+//
+// A placement that tolerates a NoExecute taint with a limited toleration seconds is evicted once the
+// toleration seconds have passed since the controller first observed the taint; the observation times
+// are kept in an annotation on the member cluster, so that the countdowns survive controller restarts.
+// The evictions are executed by the eviction controller, subject to the disruption budget of the placement.
+type Reconciler struct {
+	// Client is used to read the placement related objects (from the cache), to create evictions, and
+	// to keep track of the observation times of the NoExecute taints.
+	Client client.Client
+
+	// Recorder is used to emit events on the placements.
+	Recorder record.EventRecorder
+}
+
+// taintAddedTime is an entry in the NoExecuteTaintsAddedTimeAnnotation annotation, which records the time
+// when a NoExecute taint was first observed.
+type taintAddedTime struct {
+	Key       string      `json:"key"`
+	Value     string      `json:"value,omitempty"`
+	TimeAdded metav1.Time `json:"timeAdded"`
+}
+
+// Reconcile evicts the placements that do not tolerate the NoExecute taints on a member cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	mcRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation starts (cluster taint eviction controller)", "memberCluster", mcRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation ends (cluster taint eviction controller)", "memberCluster", mcRef, "latency", latency)
+	}()
+
+	mc := &clusterv1beta1.MemberCluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Member cluster object is not found", "memberCluster", mcRef)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get member cluster", "memberCluster", mcRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	var taints []clusterv1beta1.Taint
+	for _, taint := range mc.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			taints = append(taints, taint)
+		}
+	}
+	observedTimes, changed := observeTaints(loadTaintAddedTimes(mc), taints, startTime)
+	if changed {
+		if err := r.persistTaintAddedTimes(ctx, mc, taints, observedTimes); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if len(taints) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	bindingsByPlacement, err := r.collectBoundPlacements(ctx, mc.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(bindingsByPlacement) == 0 {
+		return ctrl.Result{}, nil
+	}
+	inflightPlacements, err := r.collectEvictions(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	crpNames := make([]string, 0, len(bindingsByPlacement))
+	for crpName := range bindingsByPlacement {
+		crpNames = append(crpNames, crpName)
+	}
+	sort.Strings(crpNames)
+
+	var requeueAfter time.Duration
+	requeueIn := func(d time.Duration) {
+		if requeueAfter == 0 || d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+	var errs []error
+	for _, crpName := range crpNames {
+		crp := &placementv1beta1.ClusterResourcePlacement{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: crpName}, crp); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get clusterResourcePlacement", "clusterResourcePlacement", crpName)
+			errs = append(errs, controller.NewAPIServerError(true, err))
+			continue
+		}
+		defaulter.SetPlacementDefaults(crp)
+		if crp.DeletionTimestamp != nil || crp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+			// PickFixed placements cannot be evicted.
+			continue
+		}
+
+		evictAt, due := evictionTime(taints, observedTimes, crp.Spec.Tolerations())
+		if !due {
+			continue
+		}
+		if wait := time.Until(evictAt); wait > 0 {
+			requeueIn(wait)
+			continue
+		}
+		if inflightPlacements.Has(crp.Name) {
+			klog.V(2).InfoS("Waiting for the in-flight eviction of the placement to finish", "clusterResourcePlacement", klog.KObj(crp), "memberCluster", mcRef)
+			requeueIn(retryInterval)
+			continue
+		}
+		allowed, err := r.isDisruptionAllowed(ctx, crp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !allowed {
+			requeueIn(retryInterval)
+			continue
+		}
+		if err := r.createEviction(ctx, crp, mc.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// Re-check the cluster later in case the eviction is blocked.
+		requeueIn(retryInterval)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// loadTaintAddedTimes loads the observation times of the NoExecute taints of a member cluster from its
+// annotation; a malformed annotation is discarded, in which case the countdowns start over.
+func loadTaintAddedTimes(mc *clusterv1beta1.MemberCluster) map[clusterv1beta1.Taint]time.Time {
+	observedTimes := make(map[clusterv1beta1.Taint]time.Time)
+	raw, ok := mc.Annotations[placementv1beta1.NoExecuteTaintsAddedTimeAnnotation]
+	if !ok {
+		return observedTimes
+	}
+	var entries []taintAddedTime
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		klog.ErrorS(err, "Failed to parse the observation times of the NoExecute taints; discarding them", "memberCluster", klog.KObj(mc))
+		return observedTimes
+	}
+	for _, entry := range entries {
+		taint := clusterv1beta1.Taint{Key: entry.Key, Value: entry.Value, Effect: corev1.TaintEffectNoExecute}
+		observedTimes[taint] = entry.TimeAdded.Time
+	}
+	return observedTimes
+}
+
+// observeTaints records the time when each of the given NoExecute taints of a member cluster is
+// first observed and forgets the taints that are no longer present; it returns the observation times,
+// and whether they differ from the previous ones.
+func observeTaints(previous map[clusterv1beta1.Taint]time.Time, taints []clusterv1beta1.Taint, now time.Time) (map[clusterv1beta1.Taint]time.Time, bool) {
+	current := make(map[clusterv1beta1.Taint]time.Time, len(taints))
+	changed := false
+	for _, taint := range taints {
+		if observedTime, ok := previous[taint]; ok {
+			current[taint] = observedTime
+			continue
+		}
+		current[taint] = now
+		changed = true
+	}
+	if len(current) != len(previous) {
+		changed = true
+	}
+	return current, changed
+}
+
+// persistTaintAddedTimes keeps the observation times of the NoExecute taints of a member cluster in
+// its annotation.
+func (r *Reconciler) persistTaintAddedTimes(ctx context.Context, mc *clusterv1beta1.MemberCluster, taints []clusterv1beta1.Taint, observedTimes map[clusterv1beta1.Taint]time.Time) error {
+	mcRef := klog.KObj(mc)
+	original := mc.DeepCopy()
+	if len(taints) == 0 {
+		delete(mc.Annotations, placementv1beta1.NoExecuteTaintsAddedTimeAnnotation)
+	} else {
+		entries := make([]taintAddedTime, 0, len(taints))
+		for _, taint := range taints {
+			entries = append(entries, taintAddedTime{
+				Key:       taint.Key,
+				Value:     taint.Value,
+				TimeAdded: metav1.NewTime(observedTimes[taint]),
+			})
+		}
+		raw, err := json.Marshal(entries)
+		if err != nil {
+			klog.ErrorS(err, "Failed to marshal the observation times of the NoExecute taints", "memberCluster", mcRef)
+			return controller.NewUnexpectedBehaviorError(err)
+		}
+		if mc.Annotations == nil {
+			mc.Annotations = make(map[string]string)
+		}
+		mc.Annotations[placementv1beta1.NoExecuteTaintsAddedTimeAnnotation] = string(raw)
+	}
+	if err := r.Client.Patch(ctx, mc, client.MergeFrom(original)); err != nil {
+		klog.ErrorS(err, "Failed to update the observation times of the NoExecute taints", "memberCluster", mcRef)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Updated the observation times of the NoExecute taints", "memberCluster", mcRef, "taintCount", len(taints))
+	return nil
+}
+
+// evictionTime returns the time at which a placement with the given tolerations should be evicted
+// from a cluster with the given NoExecute taints; it returns false if the placement tolerates all
+// the taints forever.
+//
+// A placement is evicted right away if any of the taints is not tolerated; otherwise, for each taint,
+// the shortest toleration seconds among the matching tolerations apply, and the placement is evicted
+// once the earliest of these periods has passed.
+func evictionTime(taints []clusterv1beta1.Taint, observedTimes map[clusterv1beta1.Taint]time.Time, tolerations []placementv1beta1.Toleration) (time.Time, bool) {
+	var evictAt time.Time
+	due := false
+	for _, taint := range taints {
+		tolerated := false
+		var minSeconds *int64
+		for _, toleration := range tolerations {
+			if !tainttoleration.CanTolerationTolerateTaint(taint, toleration) {
+				continue
+			}
+			if toleration.TolerationSeconds == nil {
+				// The taint is tolerated forever.
+				tolerated = true
+				minSeconds = nil
+				break
+			}
+			tolerated = true
+			if minSeconds == nil || *toleration.TolerationSeconds < *minSeconds {
+				minSeconds = toleration.TolerationSeconds
+			}
+		}
+		if !tolerated {
+			return observedTimes[taint], true
+		}
+		if minSeconds == nil {
+			continue
+		}
+		taintEvictAt := observedTimes[taint].Add(time.Duration(*minSeconds) * time.Second)
+		if !due || taintEvictAt.Before(evictAt) {
+			evictAt = taintEvictAt
+			due = true
+		}
+	}
+	return evictAt, due
+}
+
+// collectBoundPlacements returns the bound bindings on the given member cluster, keyed by the name
+// of the placement they belong to.
+func (r *Reconciler) collectBoundPlacements(ctx context.Context, clusterName string) (map[string][]*placementv1beta1.ClusterResourceBinding, error) {
+	bindingList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, bindingList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourceBindings")
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindingsByPlacement := make(map[string][]*placementv1beta1.ClusterResourceBinding)
+	for idx := range bindingList.Items {
+		binding := &bindingList.Items[idx]
+		if binding.Spec.TargetCluster != clusterName || binding.DeletionTimestamp != nil || binding.Spec.State != placementv1beta1.BindingStateBound {
+			continue
+		}
+		crpName := binding.Labels[placementv1beta1.PlacementTrackingLabel]
+		if len(crpName) == 0 {
+			continue
+		}
+		bindingsByPlacement[crpName] = append(bindingsByPlacement[crpName], binding)
+	}
+	return bindingsByPlacement, nil
+}
+
+// collectEvictions returns the names of the placements that have in-flight evictions; it also
+// cleans up the finished evictions created by the controller once the retention period has passed.
+func (r *Reconciler) collectEvictions(ctx context.Context) (sets.Set[string], error) {
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	if err := r.Client.List(ctx, evictionList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourcePlacementEvictions")
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	inflightPlacements := sets.New[string]()
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		if !evictionutils.IsEvictionInTerminalState(eviction) {
+			inflightPlacements.Insert(eviction.Spec.PlacementName)
+			continue
+		}
+		if _, ok := eviction.Labels[placementv1beta1.TaintEvictionLabel]; !ok {
+			// Leave the evictions created by others alone.
+			continue
+		}
+		if time.Since(eviction.CreationTimestamp.Time) < finishedEvictionRetentionPeriod {
+			continue
+		}
+		if err := r.Client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete a finished eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+			return nil, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted a finished eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	}
+	return inflightPlacements, nil
+}
+
+// isDisruptionAllowed checks if the disruption budget of a placement allows one more disruption
+// at this moment.
+//
+// The eviction controller always enforces the disruption budget when executing an eviction; the
+// check here only helps avoid creating evictions that are bound to be blocked.
+func (r *Reconciler) isDisruptionAllowed(ctx context.Context, crp *placementv1beta1.ClusterResourcePlacement) (bool, error) {
+	crpRef := klog.KObj(crp)
+	var db placementv1beta1.ClusterResourcePlacementDisruptionBudget
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: crp.Name}, &db); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		klog.ErrorS(err, "Failed to get the disruption budget", "clusterResourcePlacement", crpRef)
+		return false, controller.NewAPIServerError(true, err)
+	}
+	bindingList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, bindingList, client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: crp.Name}); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourceBindings", "clusterResourcePlacement", crpRef)
+		return false, controller.NewAPIServerError(true, err)
+	}
+	allowed, availableBindings := evictionutils.IsEvictionAllowed(bindingList.Items, *crp, db)
+	if !allowed {
+		klog.V(2).InfoS("The disruption budget of the placement does not allow more disruptions", "clusterResourcePlacement", crpRef, "availableBindings", availableBindings, "totalBindings", len(bindingList.Items))
+	}
+	return allowed, nil
+}
+
+// createEviction creates an eviction for the placement on the given cluster.
+func (r *Reconciler) createEviction(ctx context.Context, crp *placementv1beta1.ClusterResourcePlacement, clusterName string) error {
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", crp.Name, clusterName),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crp.Name,
+				placementv1beta1.TaintEvictionLabel:     clusterName,
+			},
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: crp.Name,
+			ClusterName:   clusterName,
+		},
+	}
+	if err := r.Client.Create(ctx, eviction); err != nil {
+		klog.ErrorS(err, "Failed to create an eviction", "clusterResourcePlacement", klog.KObj(crp), "memberCluster", clusterName)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created an eviction", "clusterResourcePlacement", klog.KObj(crp), "clusterResourcePlacementEviction", klog.KObj(eviction), "memberCluster", clusterName)
+	r.Recorder.Eventf(crp, corev1.EventTypeNormal, evictionCreatedEventReason,
+		"Requested to evict the placement on cluster %s via eviction %s, as the placement does not tolerate the NoExecute taints of the cluster", clusterName, eviction.Name)
+	return nil
+}
+
+// SetupWithManager sets up the controller with the controller manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("cluster-taint-eviction-controller").
+		For(&clusterv1beta1.MemberCluster{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
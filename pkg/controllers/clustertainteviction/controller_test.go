@@ -0,0 +1,431 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertainteviction
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	crpName     = "test-crp"
+	clusterName = "member-1"
+)
+
+var (
+	outageTaint      = clusterv1beta1.Taint{Key: "outage", Value: "true", Effect: corev1.TaintEffectNoExecute}
+	maintenanceTaint = clusterv1beta1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoExecute}
+)
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestEvictionTime(t *testing.T) {
+	observedTime := time.Now().Add(-time.Hour)
+	observedTimes := map[clusterv1beta1.Taint]time.Time{
+		outageTaint:      observedTime,
+		maintenanceTaint: observedTime.Add(time.Minute),
+	}
+	testCases := []struct {
+		name        string
+		taints      []clusterv1beta1.Taint
+		tolerations []placementv1beta1.Toleration
+		wantEvictAt time.Time
+		wantDue     bool
+	}{
+		{
+			name:        "taint is not tolerated",
+			taints:      []clusterv1beta1.Taint{outageTaint},
+			wantEvictAt: observedTime,
+			wantDue:     true,
+		},
+		{
+			name:   "taint is tolerated forever",
+			taints: []clusterv1beta1.Taint{outageTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "outage", Operator: corev1.TolerationOpExists},
+			},
+		},
+		{
+			name:   "taint is tolerated forever by one of the matching tolerations",
+			taints: []clusterv1beta1.Taint{outageTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "outage", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))},
+				{Key: "outage", Operator: corev1.TolerationOpEqual, Value: "true"},
+			},
+		},
+		{
+			name:   "taint is tolerated for the shortest toleration seconds",
+			taints: []clusterv1beta1.Taint{outageTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "outage", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(600))},
+				{Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(300))},
+			},
+			wantEvictAt: observedTime.Add(300 * time.Second),
+			wantDue:     true,
+		},
+		{
+			name:   "placement is evicted at the earliest time among the taints",
+			taints: []clusterv1beta1.Taint{outageTaint, maintenanceTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "outage", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(600))},
+				{Key: "maintenance", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))},
+			},
+			wantEvictAt: observedTime.Add(2 * time.Minute),
+			wantDue:     true,
+		},
+		{
+			name:   "one of the taints is not tolerated",
+			taints: []clusterv1beta1.Taint{outageTaint, maintenanceTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "outage", Operator: corev1.TolerationOpExists},
+			},
+			wantEvictAt: observedTime.Add(time.Minute),
+			wantDue:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotEvictAt, gotDue := evictionTime(tc.taints, observedTimes, tc.tolerations)
+			if gotDue != tc.wantDue || !gotEvictAt.Equal(tc.wantEvictAt) {
+				t.Errorf("evictionTime() = (%v, %t), want (%v, %t)", gotEvictAt, gotDue, tc.wantEvictAt, tc.wantDue)
+			}
+		})
+	}
+}
+
+func TestObserveTaints(t *testing.T) {
+	firstTime := time.Now().Add(-time.Hour)
+	secondTime := time.Now()
+
+	got, changed := observeTaints(nil, []clusterv1beta1.Taint{outageTaint}, firstTime)
+	want := map[clusterv1beta1.Taint]time.Time{outageTaint: firstTime}
+	if diff := cmp.Diff(want, got); diff != "" || !changed {
+		t.Errorf("observeTaints() = %v, %t, want %v, true; diff (-want, +got):\n%s", got, changed, want, diff)
+	}
+
+	// The observation time of a taint that is still present is kept.
+	got, changed = observeTaints(got, []clusterv1beta1.Taint{outageTaint}, secondTime)
+	if diff := cmp.Diff(want, got); diff != "" || changed {
+		t.Errorf("observeTaints() = %v, %t, want %v, false; diff (-want, +got):\n%s", got, changed, want, diff)
+	}
+	got, changed = observeTaints(got, []clusterv1beta1.Taint{outageTaint, maintenanceTaint}, secondTime)
+	want = map[clusterv1beta1.Taint]time.Time{outageTaint: firstTime, maintenanceTaint: secondTime}
+	if diff := cmp.Diff(want, got); diff != "" || !changed {
+		t.Errorf("observeTaints() = %v, %t, want %v, true; diff (-want, +got):\n%s", got, changed, want, diff)
+	}
+
+	// A taint that has been removed is forgotten.
+	got, changed = observeTaints(got, []clusterv1beta1.Taint{maintenanceTaint}, secondTime)
+	want = map[clusterv1beta1.Taint]time.Time{maintenanceTaint: secondTime}
+	if diff := cmp.Diff(want, got); diff != "" || !changed {
+		t.Errorf("observeTaints() = %v, %t, want %v, true; diff (-want, +got):\n%s", got, changed, want, diff)
+	}
+	got, changed = observeTaints(got, nil, secondTime)
+	if len(got) != 0 || !changed {
+		t.Errorf("observeTaints() = %v, %t, want no taints, true", got, changed)
+	}
+}
+
+func TestLoadTaintAddedTimes(t *testing.T) {
+	addedTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		want        map[clusterv1beta1.Taint]time.Time
+	}{
+		{
+			name: "no annotation",
+			want: map[clusterv1beta1.Taint]time.Time{},
+		},
+		{
+			name: "malformed annotation",
+			annotations: map[string]string{
+				placementv1beta1.NoExecuteTaintsAddedTimeAnnotation: "not-a-json-list",
+			},
+			want: map[clusterv1beta1.Taint]time.Time{},
+		},
+		{
+			name: "valid annotation",
+			annotations: map[string]string{
+				placementv1beta1.NoExecuteTaintsAddedTimeAnnotation: `[{"key":"outage","value":"true","timeAdded":"` + addedTime.UTC().Format(time.RFC3339) + `"}]`,
+			},
+			want: map[clusterv1beta1.Taint]time.Time{outageTaint: addedTime},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc := taintedCluster(outageTaint)
+			mc.Annotations = tc.annotations
+			got := loadTaintAddedTimes(mc)
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("loadTaintAddedTimes() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func taintedCluster(taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Taints: taints,
+		},
+	}
+}
+
+// taintedClusterAddedAt returns a member cluster with the given NoExecute taint, which was first
+// observed at the given time.
+func taintedClusterAddedAt(taint clusterv1beta1.Taint, addedAt time.Time) *clusterv1beta1.MemberCluster {
+	mc := taintedCluster(taint)
+	mc.Annotations = map[string]string{
+		placementv1beta1.NoExecuteTaintsAddedTimeAnnotation: fmt.Sprintf(`[{"key":%q,"value":%q,"timeAdded":%q}]`, taint.Key, taint.Value, addedAt.UTC().Format(time.RFC3339)),
+	}
+	return mc
+}
+
+func placement(placementType placementv1beta1.PlacementType, tolerations ...placementv1beta1.Toleration) *placementv1beta1.ClusterResourcePlacement {
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementType,
+				Tolerations:   tolerations,
+			},
+		},
+	}
+	if placementType == placementv1beta1.PickNPlacementType {
+		crp.Spec.Policy.NumberOfClusters = ptr.To(int32(1))
+	}
+	return crp
+}
+
+func boundBinding(targetCluster string) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   crpName + "-" + targetCluster,
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: targetCluster,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	testCases := []struct {
+		name             string
+		objects          []client.Object
+		wantEvictions    []string
+		wantRequeueAfter bool
+	}{
+		{
+			name: "placement does not tolerate the NoExecute taint",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickAllPlacementType),
+				boundBinding(clusterName),
+			},
+			wantEvictions:    []string{clusterName},
+			wantRequeueAfter: true,
+		},
+		{
+			name: "placement tolerates the NoExecute taint forever",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{Key: "outage", Operator: corev1.TolerationOpExists}),
+				boundBinding(clusterName),
+			},
+		},
+		{
+			name: "placement tolerates the NoExecute taint for a limited time",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{
+					Key:               "outage",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				}),
+				boundBinding(clusterName),
+			},
+			wantRequeueAfter: true,
+		},
+		{
+			name: "toleration period observed before a restart has passed",
+			objects: []client.Object{
+				taintedClusterAddedAt(outageTaint, time.Now().Add(-time.Hour)),
+				placement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{
+					Key:               "outage",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				}),
+				boundBinding(clusterName),
+			},
+			wantEvictions:    []string{clusterName},
+			wantRequeueAfter: true,
+		},
+		{
+			name: "NoSchedule taints are ignored",
+			objects: []client.Object{
+				taintedCluster(clusterv1beta1.Taint{Key: "outage", Effect: corev1.TaintEffectNoSchedule}),
+				placement(placementv1beta1.PickAllPlacementType),
+				boundBinding(clusterName),
+			},
+		},
+		{
+			name: "PickFixed placements are not evicted",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickFixedPlacementType),
+				boundBinding(clusterName),
+			},
+		},
+		{
+			name: "placements on other clusters are not evicted",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickAllPlacementType),
+				boundBinding("member-2"),
+			},
+		},
+		{
+			name: "disruption budget does not allow the eviction",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickNPlacementType),
+				boundBinding(clusterName),
+				&placementv1beta1.ClusterResourcePlacementDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: crpName,
+					},
+					Spec: placementv1beta1.PlacementDisruptionBudgetSpec{
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+			wantRequeueAfter: true,
+		},
+		{
+			name: "placement has an in-flight eviction",
+			objects: []client.Object{
+				taintedCluster(outageTaint),
+				placement(placementv1beta1.PickAllPlacementType),
+				boundBinding(clusterName),
+				&placementv1beta1.ClusterResourcePlacementEviction{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "in-flight",
+					},
+					Spec: placementv1beta1.PlacementEvictionSpec{
+						PlacementName: crpName,
+						ClusterName:   "member-2",
+					},
+				},
+			},
+			wantEvictions:    []string{"member-2"},
+			wantRequeueAfter: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(tc.objects...).
+				Build()
+			r := &Reconciler{
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			if gotRequeueAfter := res.RequeueAfter > 0; gotRequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("Reconcile() requeueAfter = %v, want requeue %t", res.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := fakeClient.List(ctx, evictionList); err != nil {
+				t.Fatalf("failed to list evictions: %v", err)
+			}
+			var gotEvictions []string
+			for _, eviction := range evictionList.Items {
+				gotEvictions = append(gotEvictions, eviction.Spec.ClusterName)
+			}
+			if diff := cmp.Diff(tc.wantEvictions, gotEvictions); diff != "" {
+				t.Errorf("evictions mismatch (-want, +got):\n%s", diff)
+			}
+
+			// Verify that the observation times of the NoExecute taints have been persisted.
+			mc := &clusterv1beta1.MemberCluster{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: clusterName}, mc); err != nil {
+				t.Fatalf("failed to get member cluster: %v", err)
+			}
+			var wantTaints []clusterv1beta1.Taint
+			for _, taint := range mc.Spec.Taints {
+				if taint.Effect == corev1.TaintEffectNoExecute {
+					wantTaints = append(wantTaints, taint)
+				}
+			}
+			observedTimes := loadTaintAddedTimes(mc)
+			if len(observedTimes) != len(wantTaints) {
+				t.Errorf("persisted observation times = %v, want one for each of the NoExecute taints %v", observedTimes, wantTaints)
+			}
+			for _, taint := range wantTaints {
+				if _, ok := observedTimes[taint]; !ok {
+					t.Errorf("persisted observation times = %v, want one for taint %v", observedTimes, taint)
+				}
+			}
+		})
+	}
+}
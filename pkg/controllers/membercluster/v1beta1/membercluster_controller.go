@@ -47,6 +47,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 const (
@@ -123,6 +124,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 
 	// Copy status from InternalMemberCluster to MemberCluster.
 	r.syncInternalMemberClusterStatus(currentIMC, &mc)
+	// Refresh the maintenance window in the status.
+	requeueAfter := syncMaintenanceWindowStatus(&mc, time.Now())
 	if err := r.updateMemberClusterStatus(ctx, &mc); err != nil {
 		if apierrors.IsConflict(err) {
 			klog.V(2).InfoS("Failed to update status due to conflicts", "memberCluster", mcObjRef)
@@ -132,7 +135,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, client.IgnoreNotFound(err)
 	}
 
-	return runtime.Result{}, nil
+	// Requeue at the next boundary of the maintenance window, if any, so that the status is kept
+	// up to date.
+	return runtime.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // handleDelete handles the delete event of the member cluster, makes sure the agent has finished leaving the fleet first and
@@ -573,6 +578,32 @@ func (r *Reconciler) syncInternalMemberClusterStatus(imc *clusterv1beta1.Interna
 	mc.Status.Properties = imc.Status.Properties
 }
 
+// syncMaintenanceWindowStatus sets the current (or the next) maintenance window of a member cluster
+// in its status; it returns the time left until the window opens or closes, or zero if the member
+// cluster has no valid maintenance window.
+func syncMaintenanceWindowStatus(mc *clusterv1beta1.MemberCluster, now time.Time) time.Duration {
+	if mc.Spec.MaintenanceWindow == nil {
+		mc.Status.MaintenanceWindow = nil
+		return 0
+	}
+
+	start, end, err := maintenancewindow.CurrentOrNext(mc.Spec.MaintenanceWindow, now)
+	if err != nil {
+		// The maintenance window should have been validated by the webhook; there is no need to retry.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Failed to evaluate the maintenance window", "memberCluster", klog.KObj(mc))
+		mc.Status.MaintenanceWindow = nil
+		return 0
+	}
+	mc.Status.MaintenanceWindow = &clusterv1beta1.MaintenanceWindowStatus{
+		StartTime: metav1.NewTime(start),
+		EndTime:   metav1.NewTime(end),
+	}
+	if start.After(now) {
+		return start.Sub(now)
+	}
+	return end.Sub(now)
+}
+
 // updateMemberClusterStatus is used to update member cluster status.
 func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
 	joined := condition.IsConditionStatusTrue(meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterJoined)), mc.Generation)
@@ -1729,6 +1729,78 @@ func TestSyncInternalMemberClusterStatus(t *testing.T) {
 	}
 }
 
+func TestSyncMaintenanceWindowStatus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		memberCluster    *clusterv1beta1.MemberCluster
+		wantStatus       *clusterv1beta1.MaintenanceWindowStatus
+		wantRequeueAfter time.Duration
+	}{
+		"no maintenance window": {
+			memberCluster: &clusterv1beta1.MemberCluster{
+				Status: clusterv1beta1.MemberClusterStatus{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindowStatus{
+						StartTime: metav1.NewTime(now),
+						EndTime:   metav1.NewTime(now.Add(time.Hour)),
+					},
+				},
+			},
+		},
+		"outside the maintenance window": {
+			memberCluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 14 * * *",
+						Duration: metav1.Duration{Duration: 2 * time.Hour},
+					},
+				},
+			},
+			wantStatus: &clusterv1beta1.MaintenanceWindowStatus{
+				StartTime: metav1.NewTime(now.Add(2 * time.Hour)),
+				EndTime:   metav1.NewTime(now.Add(4 * time.Hour)),
+			},
+			wantRequeueAfter: 2 * time.Hour,
+		},
+		"inside the maintenance window": {
+			memberCluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 11 * * *",
+						Duration: metav1.Duration{Duration: 2 * time.Hour},
+					},
+				},
+			},
+			wantStatus: &clusterv1beta1.MaintenanceWindowStatus{
+				StartTime: metav1.NewTime(now.Add(-time.Hour)),
+				EndTime:   metav1.NewTime(now.Add(time.Hour)),
+			},
+			wantRequeueAfter: time.Hour,
+		},
+		"invalid maintenance window": {
+			memberCluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "invalid",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotRequeueAfter := syncMaintenanceWindowStatus(tt.memberCluster, now)
+			if gotRequeueAfter != tt.wantRequeueAfter {
+				t.Errorf("syncMaintenanceWindowStatus() = %v, want %v", gotRequeueAfter, tt.wantRequeueAfter)
+			}
+			if diff := cmp.Diff(tt.wantStatus, tt.memberCluster.Status.MaintenanceWindow); diff != "" {
+				t.Errorf("syncMaintenanceWindowStatus() status mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdateMemberClusterStatus(t *testing.T) {
 	var count int
 	tests := map[string]struct {
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// getPlacementCompletedConditionType returns the Completed condition type based on the placement type.
+func getPlacementCompletedConditionType(placementObj fleetv1beta1.PlacementObj) string {
+	if isClusterScopedPlacement(placementObj) {
+		return string(fleetv1beta1.ClusterResourcePlacementCompletedConditionType)
+	}
+	return string(fleetv1beta1.ResourcePlacementCompletedConditionType)
+}
+
+// setPlacementBatchStatus summarizes the status of the batch workloads across all the selected
+// clusters and sets the Completed condition for placements running in the batch mode.
+//
+// It must be called after the per cluster placement statuses have been built.
+func setPlacementBatchStatus(placementObj fleetv1beta1.PlacementObj) {
+	placementStatus := placementObj.GetPlacementStatus()
+	condType := getPlacementCompletedConditionType(placementObj)
+	if placementObj.GetPlacementSpec().Batch == nil {
+		// The placement does not run in the batch mode; reset the batch related status (if any).
+		placementStatus.BatchJobSummary = nil
+		meta.RemoveStatusCondition(&placementStatus.Conditions, condType)
+		return
+	}
+
+	var summary *fleetv1beta1.BatchJobSummary
+	selectedClusterCount := 0
+	unreportedClusterCount := 0
+	for idx := range placementStatus.PerClusterPlacementStatuses {
+		perClusterStatus := &placementStatus.PerClusterPlacementStatuses[idx]
+		if len(perClusterStatus.ClusterName) == 0 {
+			// Skip the statuses for the clusters that failed to get scheduled.
+			continue
+		}
+		selectedClusterCount++
+		if summary == nil {
+			summary = &fleetv1beta1.BatchJobSummary{}
+		}
+		if perClusterStatus.BatchJobSummary == nil {
+			// The batch job summary is only reported on clusters where the selected resources
+			// include batch workloads; a cluster that has applied all the resources without
+			// reporting any batch workload has nothing to run and is considered as completed.
+			if !meta.IsStatusConditionTrue(perClusterStatus.Conditions, string(fleetv1beta1.PerClusterAppliedConditionType)) {
+				unreportedClusterCount++
+			}
+			continue
+		}
+		summary.Total += perClusterStatus.BatchJobSummary.Total
+		summary.Active += perClusterStatus.BatchJobSummary.Active
+		summary.Succeeded += perClusterStatus.BatchJobSummary.Succeeded
+		summary.Failed += perClusterStatus.BatchJobSummary.Failed
+		summary.ActivePods += perClusterStatus.BatchJobSummary.ActivePods
+		summary.SucceededPods += perClusterStatus.BatchJobSummary.SucceededPods
+		summary.FailedPods += perClusterStatus.BatchJobSummary.FailedPods
+	}
+	placementStatus.BatchJobSummary = summary
+
+	completedCond := metav1.Condition{
+		Type:               condType,
+		ObservedGeneration: placementObj.GetGeneration(),
+	}
+	switch {
+	case selectedClusterCount == 0 || unreportedClusterCount > 0:
+		completedCond.Status = metav1.ConditionUnknown
+		completedCond.Reason = condition.BatchJobsCompletionUnknownReason
+		completedCond.Message = fmt.Sprintf("The status of the batch workloads has not been reported on %d of %d selected cluster(s) yet", unreportedClusterCount, selectedClusterCount)
+	case summary.Active > 0:
+		completedCond.Status = metav1.ConditionFalse
+		completedCond.Reason = condition.BatchJobsActiveReason
+		completedCond.Message = fmt.Sprintf("%d of %d batch workload(s) have not finished yet", summary.Active, summary.Total)
+	case summary.Failed > 0:
+		completedCond.Status = metav1.ConditionTrue
+		completedCond.Reason = condition.BatchJobsFailedReason
+		completedCond.Message = fmt.Sprintf("All the batch workloads have finished; %d of %d batch workload(s) have failed", summary.Failed, summary.Total)
+	case summary.Total == 0:
+		completedCond.Status = metav1.ConditionTrue
+		completedCond.Reason = condition.BatchJobsSucceededReason
+		completedCond.Message = "The resources have been applied on all the selected clusters and there are no batch workloads to run"
+	default:
+		completedCond.Status = metav1.ConditionTrue
+		completedCond.Reason = condition.BatchJobsSucceededReason
+		completedCond.Message = fmt.Sprintf("All the %d batch workload(s) have finished successfully", summary.Total)
+	}
+	placementObj.SetConditions(completedCond)
+	klog.V(2).InfoS("Populated the batch status", "placement", klog.KObj(placementObj), "batchJobSummary", summary, "completedCondition", completedCond)
+}
+
+// handleCompletedBatchPlacement deletes a placement running in the batch mode once it has been completed
+// for longer than the specified TTL; if the TTL has not expired yet, it makes sure that the request will be
+// requeued when the TTL expires.
+//
+// It must be called after the placement status has been refreshed.
+func (r *Reconciler) handleCompletedBatchPlacement(ctx context.Context, placementObj fleetv1beta1.PlacementObj, res ctrl.Result) (ctrl.Result, error) {
+	batch := placementObj.GetPlacementSpec().Batch
+	if batch == nil || batch.TTLSecondsAfterFinished == nil {
+		return res, nil
+	}
+	completedCond := placementObj.GetCondition(getPlacementCompletedConditionType(placementObj))
+	if !condition.IsConditionStatusTrue(completedCond, placementObj.GetGeneration()) {
+		return res, nil
+	}
+
+	placementKObj := klog.KObj(placementObj)
+	expireAt := completedCond.LastTransitionTime.Add(time.Duration(*batch.TTLSecondsAfterFinished) * time.Second)
+	if remaining := time.Until(expireAt); remaining > 0 {
+		klog.V(2).InfoS("Placement has completed and will be deleted once the TTL expires", "placement", placementKObj, "expireAt", expireAt)
+		if res.RequeueAfter == 0 || remaining < res.RequeueAfter {
+			res.RequeueAfter = remaining
+		}
+		return res, nil
+	}
+
+	klog.V(2).InfoS("Deleting the placement as it has completed and the TTL has expired", "placement", placementKObj, "expireAt", expireAt)
+	if err := r.Client.Delete(ctx, placementObj); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete the completed placement", "placement", placementKObj)
+		return ctrl.Result{}, controller.NewAPIServerError(false, err)
+	}
+	r.Recorder.Event(placementObj, corev1.EventTypeNormal, "PlacementCompletedAndExpired", "Deleted the placement as all the batch workloads have finished and the TTL has expired")
+	return ctrl.Result{}, nil
+}
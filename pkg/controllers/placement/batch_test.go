@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestSetPlacementBatchStatus(t *testing.T) {
+	completedCondType := string(fleetv1beta1.ClusterResourcePlacementCompletedConditionType)
+	appliedCond := metav1.Condition{Type: string(fleetv1beta1.PerClusterAppliedConditionType), Status: metav1.ConditionTrue}
+	notAppliedCond := metav1.Condition{Type: string(fleetv1beta1.PerClusterAppliedConditionType), Status: metav1.ConditionFalse}
+	tests := []struct {
+		name                 string
+		batch                *fleetv1beta1.BatchOptions
+		perClusterStatuses   []fleetv1beta1.PerClusterPlacementStatus
+		existingConditions   []metav1.Condition
+		wantSummary          *fleetv1beta1.BatchJobSummary
+		wantCompletedCondSet bool
+		wantStatus           metav1.ConditionStatus
+		wantReason           string
+	}{
+		{
+			name: "not in the batch mode",
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1}},
+			},
+			existingConditions: []metav1.Condition{
+				{Type: completedCondType, Status: metav1.ConditionTrue, Reason: condition.BatchJobsSucceededReason},
+			},
+		},
+		{
+			name:  "some clusters have not reported yet",
+			batch: &fleetv1beta1.BatchOptions{},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+				{ClusterName: "member-2"},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionUnknown,
+			wantReason:           condition.BatchJobsCompletionUnknownReason,
+		},
+		{
+			name:                 "no selected clusters",
+			batch:                &fleetv1beta1.BatchOptions{},
+			perClusterStatuses:   []fleetv1beta1.PerClusterPlacementStatus{{}},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionUnknown,
+			wantReason:           condition.BatchJobsCompletionUnknownReason,
+		},
+		{
+			name:  "some jobs are active",
+			batch: &fleetv1beta1.BatchOptions{},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 2, Active: 1, Succeeded: 1, ActivePods: 3, SucceededPods: 1}},
+				{ClusterName: "member-2", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 2}},
+				{}, // A cluster that failed to get scheduled.
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 3, Active: 1, Succeeded: 2, ActivePods: 3, SucceededPods: 3},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionFalse,
+			wantReason:           condition.BatchJobsActiveReason,
+		},
+		{
+			name:  "all jobs have finished with failures",
+			batch: &fleetv1beta1.BatchOptions{},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Failed: 1, FailedPods: 6}},
+				{ClusterName: "member-2", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 2, Succeeded: 1, Failed: 1, SucceededPods: 1, FailedPods: 6},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionTrue,
+			wantReason:           condition.BatchJobsFailedReason,
+		},
+		{
+			name:  "all jobs have succeeded",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(60))},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+				{ClusterName: "member-2", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 2, Succeeded: 2, SucceededPods: 2},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionTrue,
+			wantReason:           condition.BatchJobsSucceededReason,
+		},
+		{
+			name:  "mixed placement, clusters without batch workloads have applied the resources",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(60))},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+				{ClusterName: "member-2", Conditions: []metav1.Condition{appliedCond}},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionTrue,
+			wantReason:           condition.BatchJobsSucceededReason,
+		},
+		{
+			name:  "mixed placement, clusters without batch workloads have not applied the resources yet",
+			batch: &fleetv1beta1.BatchOptions{},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", BatchJobSummary: &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1}},
+				{ClusterName: "member-2", Conditions: []metav1.Condition{notAppliedCond}},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{Total: 1, Succeeded: 1, SucceededPods: 1},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionUnknown,
+			wantReason:           condition.BatchJobsCompletionUnknownReason,
+		},
+		{
+			name:  "no batch workloads on any cluster",
+			batch: &fleetv1beta1.BatchOptions{},
+			perClusterStatuses: []fleetv1beta1.PerClusterPlacementStatus{
+				{ClusterName: "member-1", Conditions: []metav1.Condition{appliedCond}},
+				{ClusterName: "member-2", Conditions: []metav1.Condition{appliedCond}},
+			},
+			wantSummary:          &fleetv1beta1.BatchJobSummary{},
+			wantCompletedCondSet: true,
+			wantStatus:           metav1.ConditionTrue,
+			wantReason:           condition.BatchJobsSucceededReason,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.Batch = tc.batch
+			crp.Status.PerClusterPlacementStatuses = tc.perClusterStatuses
+			crp.Status.Conditions = tc.existingConditions
+			if !tc.wantCompletedCondSet {
+				// Populate a stale summary to verify that it will be reset.
+				crp.Status.BatchJobSummary = &fleetv1beta1.BatchJobSummary{Total: 1}
+			}
+
+			setPlacementBatchStatus(crp)
+
+			if diff := cmp.Diff(crp.Status.BatchJobSummary, tc.wantSummary); diff != "" {
+				t.Errorf("setPlacementBatchStatus() batch job summary mismatch (-got, +want):\n%s", diff)
+			}
+			gotCond := crp.GetCondition(completedCondType)
+			if !tc.wantCompletedCondSet {
+				if gotCond != nil {
+					t.Errorf("setPlacementBatchStatus() Completed condition = %+v, want no condition", gotCond)
+				}
+				return
+			}
+			wantCond := &metav1.Condition{
+				Type:               completedCondType,
+				Status:             tc.wantStatus,
+				Reason:             tc.wantReason,
+				ObservedGeneration: placementGeneration,
+			}
+			if diff := cmp.Diff(gotCond, wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "Message", "LastTransitionTime")); diff != "" {
+				t.Errorf("setPlacementBatchStatus() Completed condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleCompletedBatchPlacement(t *testing.T) {
+	completedCondType := string(fleetv1beta1.ClusterResourcePlacementCompletedConditionType)
+	tests := []struct {
+		name                 string
+		batch                *fleetv1beta1.BatchOptions
+		completedCond        *metav1.Condition
+		res                  ctrl.Result
+		wantDeleted          bool
+		wantRequeueAfter     time.Duration
+		maxRequeueAfterDelta time.Duration
+	}{
+		{
+			name:             "not in the batch mode",
+			res:              ctrl.Result{RequeueAfter: controllerResyncPeriod},
+			wantRequeueAfter: controllerResyncPeriod,
+		},
+		{
+			name:  "no TTL",
+			batch: &fleetv1beta1.BatchOptions{},
+			completedCond: &metav1.Condition{
+				Type:               completedCondType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: placementGeneration,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+		},
+		{
+			name:  "not completed yet",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(0))},
+			completedCond: &metav1.Condition{
+				Type:               completedCondType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: placementGeneration,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			res:              ctrl.Result{RequeueAfter: controllerResyncPeriod},
+			wantRequeueAfter: controllerResyncPeriod,
+		},
+		{
+			name:  "stale completed condition",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(0))},
+			completedCond: &metav1.Condition{
+				Type:               completedCondType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: placementGeneration - 1,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+		},
+		{
+			name:  "TTL has not expired yet",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(600))},
+			completedCond: &metav1.Condition{
+				Type:               completedCondType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: placementGeneration,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			res:                  ctrl.Result{RequeueAfter: controllerResyncPeriod},
+			wantRequeueAfter:     9 * time.Minute,
+			maxRequeueAfterDelta: 10 * time.Second,
+		},
+		{
+			name:  "TTL has expired",
+			batch: &fleetv1beta1.BatchOptions{TTLSecondsAfterFinished: ptr.To(int32(60))},
+			completedCond: &metav1.Condition{
+				Type:               completedCondType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: placementGeneration,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			res:         ctrl.Result{RequeueAfter: controllerResyncPeriod},
+			wantDeleted: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.Batch = tc.batch
+			if tc.completedCond != nil {
+				crp.Status.Conditions = []metav1.Condition{*tc.completedCond}
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(crp).
+				Build()
+			r := Reconciler{
+				Client:   fakeClient,
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			gotRes, err := r.handleCompletedBatchPlacement(ctx, crp, tc.res)
+			if err != nil {
+				t.Fatalf("handleCompletedBatchPlacement() = %v, want no error", err)
+			}
+			delta := gotRes.RequeueAfter - tc.wantRequeueAfter
+			if delta < 0 {
+				delta = -delta
+			}
+			if delta > tc.maxRequeueAfterDelta {
+				t.Errorf("handleCompletedBatchPlacement() requeueAfter = %v, want %v", gotRes.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: crp.Name}, &fleetv1beta1.ClusterResourcePlacement{})
+			if gotDeleted := apierrors.IsNotFound(err); gotDeleted != tc.wantDeleted {
+				t.Errorf("handleCompletedBatchPlacement() deleted = %t (get error: %v), want %t", gotDeleted, err, tc.wantDeleted)
+			}
+		})
+	}
+}
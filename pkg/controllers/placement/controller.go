@@ -113,7 +113,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, key controller.QueueKey) (ct
 		}
 	}
 	defer emitPlacementStatusMetric(placementObj)
-	return r.handleUpdate(ctx, placementObj)
+	res, err := r.handleUpdate(ctx, placementObj)
+	if err != nil {
+		return res, err
+	}
+	return r.handleCompletedBatchPlacement(ctx, placementObj, res)
 }
 
 func (r *Reconciler) handleDelete(ctx context.Context, placementObj fleetv1beta1.PlacementObj) (ctrl.Result, error) {
@@ -219,11 +223,22 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 		return ctrl.Result{}, err
 	}
 
+	// Track the rollout status against the resource snapshot that the bindings are rolled out to, which is not the
+	// latest one if the placement is pinned to a resource snapshot or has been rolled back.
+	targetResourceSnapshot, selectedResourceIDs, err := r.resolveTargetResourceSnapshot(ctx, placementObj, latestResourceSnapshot, selectedResourceIDs)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// isScheduleFullfilled is to indicate whether we need to requeue the placement request to track the rollout status.
-	isScheduleFullfilled, err := r.setPlacementStatus(ctx, placementObj, selectedResourceIDs, latestSchedulingPolicySnapshot, latestResourceSnapshot)
+	isScheduleFullfilled, err := r.setPlacementStatus(ctx, placementObj, selectedResourceIDs, latestSchedulingPolicySnapshot, targetResourceSnapshot)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	setPlacementBatchStatus(placementObj)
+	setPlacementRollbackStatus(placementObj, latestResourceSnapshot)
+	setPlacementPinnedStatus(placementObj, targetResourceSnapshot)
+	setPlacementPausedStatus(placementObj)
 
 	if err := r.Client.Status().Update(ctx, placementObj); err != nil {
 		klog.ErrorS(err, "Failed to update the status", "placement", placementKObj)
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// getPlacementPausedConditionType returns the Paused condition type based on the placement type.
+func getPlacementPausedConditionType(placementObj fleetv1beta1.PlacementObj) string {
+	if isClusterScopedPlacement(placementObj) {
+		return string(fleetv1beta1.ClusterResourcePlacementPausedConditionType)
+	}
+	return string(fleetv1beta1.ResourcePlacementPausedConditionType)
+}
+
+// setPlacementPausedStatus sets the Paused condition for placements whose rollout is paused, and
+// removes it once the rollout is resumed.
+func setPlacementPausedStatus(placementObj fleetv1beta1.PlacementObj) {
+	placementStatus := placementObj.GetPlacementStatus()
+	condType := getPlacementPausedConditionType(placementObj)
+	strategy := placementObj.GetPlacementSpec().Strategy
+	if !strategy.Paused || strategy.Type == fleetv1beta1.ExternalRolloutStrategyType {
+		meta.RemoveStatusCondition(&placementStatus.Conditions, condType)
+		return
+	}
+
+	pausedCond := metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.RolloutPausedReason,
+		Message:            "The rollout is paused; Fleet holds back all the updates to the selected clusters until the rollout is resumed",
+		ObservedGeneration: placementObj.GetGeneration(),
+	}
+	placementObj.SetConditions(pausedCond)
+	klog.V(2).InfoS("Populated the paused status", "placement", klog.KObj(placementObj), "pausedCondition", pausedCond)
+}
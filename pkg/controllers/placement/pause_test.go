@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestSetPlacementPausedStatus(t *testing.T) {
+	pausedCondType := string(fleetv1beta1.ClusterResourcePlacementPausedConditionType)
+	tests := []struct {
+		name              string
+		strategy          fleetv1beta1.RolloutStrategy
+		existingCondition *metav1.Condition
+		wantPaused        bool
+	}{
+		{
+			name: "the rollout is paused",
+			strategy: fleetv1beta1.RolloutStrategy{
+				Type:   fleetv1beta1.RollingUpdateRolloutStrategyType,
+				Paused: true,
+			},
+			wantPaused: true,
+		},
+		{
+			name: "the rollout is resumed",
+			strategy: fleetv1beta1.RolloutStrategy{
+				Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+			},
+			existingCondition: &metav1.Condition{Type: pausedCondType, Status: metav1.ConditionTrue, Reason: condition.RolloutPausedReason},
+		},
+		{
+			name: "the rollout is managed externally",
+			strategy: fleetv1beta1.RolloutStrategy{
+				Type:   fleetv1beta1.ExternalRolloutStrategyType,
+				Paused: true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.Strategy = tc.strategy
+			if tc.existingCondition != nil {
+				crp.Status.Conditions = []metav1.Condition{*tc.existingCondition}
+			}
+
+			setPlacementPausedStatus(crp)
+
+			gotCond := crp.GetCondition(pausedCondType)
+			if !tc.wantPaused {
+				if gotCond != nil {
+					t.Errorf("setPlacementPausedStatus() Paused condition = %+v, want no condition", gotCond)
+				}
+				return
+			}
+			wantCond := &metav1.Condition{
+				Type:               pausedCondType,
+				Status:             metav1.ConditionTrue,
+				Reason:             condition.RolloutPausedReason,
+				ObservedGeneration: placementGeneration,
+			}
+			if diff := cmp.Diff(gotCond, wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "Message", "LastTransitionTime")); diff != "" {
+				t.Errorf("setPlacementPausedStatus() Paused condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
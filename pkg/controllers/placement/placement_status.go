@@ -399,6 +399,10 @@ func setResourcePlacementStatusBasedOnBinding(
 	expectedCondTypes []condition.ResourceCondition,
 	setStatusByCondType map[condition.ResourceCondition]metav1.ConditionStatus,
 ) {
+	if placementObj.GetPlacementSpec().Batch != nil {
+		status.BatchJobSummary = binding.GetBindingStatus().BatchJobSummary.DeepCopy()
+	}
+
 	for _, i := range expectedCondTypes {
 		bindingCond := binding.GetCondition(string(i.ResourceBindingConditionType()))
 		if !condition.IsConditionStatusTrue(bindingCond, binding.GetGeneration()) &&
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// resolveTargetResourceSnapshot returns the master resource snapshot that the bindings of a placement are rolled
+// out to, along with the identifiers of the resources it selects. The target resource snapshot is the latest one,
+// unless the placement is pinned to a resource snapshot, or has been rolled back automatically. Pinning only applies
+// to the RollingUpdate rollout strategy; with the External rollout strategy, the external controller decides which
+// resource snapshot to roll out.
+//
+// If the pinned resource snapshot cannot be found, the rollout controller holds back all the updates to the bindings,
+// and the rollout status is tracked against the latest resource snapshot; see setPlacementPinnedStatus.
+func (r *Reconciler) resolveTargetResourceSnapshot(
+	ctx context.Context,
+	placementObj fleetv1beta1.PlacementObj,
+	latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj,
+	selectedResourceIDs []fleetv1beta1.ResourceIdentifier,
+) (fleetv1beta1.ResourceSnapshotObj, []fleetv1beta1.ResourceIdentifier, error) {
+	if latestResourceSnapshot == nil {
+		// The resource snapshots are managed by an external controller.
+		return nil, selectedResourceIDs, nil
+	}
+	placementKObj := klog.KObj(placementObj)
+	placementSpec := placementObj.GetPlacementSpec()
+	rollingUpdate := placementSpec.Strategy.RollingUpdate
+
+	var targetIndex string
+	switch {
+	case placementSpec.PinnedResourceSnapshotIndex != nil && placementSpec.Strategy.Type != fleetv1beta1.ExternalRolloutStrategyType:
+		targetIndex = *placementSpec.PinnedResourceSnapshotIndex
+	case rollingUpdate != nil && rollingUpdate.AutoRollback != nil:
+		targetIndex = latestResourceSnapshot.GetAnnotations()[fleetv1beta1.RolledBackToResourceIndexAnnotation]
+	}
+	if len(targetIndex) == 0 || targetIndex == latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel] {
+		return latestResourceSnapshot, selectedResourceIDs, nil
+	}
+
+	targetResourceSnapshot, err := controller.FetchMasterResourceSnapshotWithIndex(ctx, r.Client, targetIndex, placementObj.GetName(), placementObj.GetNamespace())
+	if err != nil {
+		klog.ErrorS(err, "Failed to fetch the target resource snapshot", "placement", placementKObj, "resourceIndex", targetIndex)
+		return nil, nil, err
+	}
+	if targetResourceSnapshot == nil {
+		klog.V(2).InfoS("The target resource snapshot of the placement is not found", "placement", placementKObj, "resourceIndex", targetIndex)
+		return latestResourceSnapshot, selectedResourceIDs, nil
+	}
+
+	placementKey := controller.GetObjectKeyFromNamespaceName(placementObj.GetNamespace(), placementObj.GetName())
+	selectedResourceIDs, err = controller.CollectResourceIdentifiersUsingMasterResourceSnapshot(ctx, r.Client, placementKey, targetResourceSnapshot, targetIndex)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect resource identifiers from the target resource snapshot", "placement", placementKObj, "resourceSnapshot", klog.KObj(targetResourceSnapshot))
+		return nil, nil, err
+	}
+	klog.V(2).InfoS("Tracking the rollout status against the target resource snapshot", "placement", placementKObj, "resourceSnapshot", klog.KObj(targetResourceSnapshot))
+	return targetResourceSnapshot, selectedResourceIDs, nil
+}
+
+// getPlacementRolledBackConditionType returns the RolledBack condition type based on the placement type.
+func getPlacementRolledBackConditionType(placementObj fleetv1beta1.PlacementObj) string {
+	if isClusterScopedPlacement(placementObj) {
+		return string(fleetv1beta1.ClusterResourcePlacementRolledBackConditionType)
+	}
+	return string(fleetv1beta1.ResourcePlacementRolledBackConditionType)
+}
+
+// setPlacementRollbackStatus sets the RolledBack condition for placements with automatic rollback
+// enabled, based on the rollback recorded by the rollout controller on the latest resource snapshot.
+func setPlacementRollbackStatus(placementObj fleetv1beta1.PlacementObj, latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj) {
+	placementStatus := placementObj.GetPlacementStatus()
+	condType := getPlacementRolledBackConditionType(placementObj)
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	if placementObj.GetPlacementSpec().Strategy.Type != fleetv1beta1.RollingUpdateRolloutStrategyType ||
+		rollingUpdate == nil || rollingUpdate.AutoRollback == nil || latestResourceSnapshot == nil {
+		// Automatic rollback is not enabled; reset the rollback related status (if any).
+		meta.RemoveStatusCondition(&placementStatus.Conditions, condType)
+		return
+	}
+
+	rolledBackCond := metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionFalse,
+		Reason:             condition.RolloutNotRolledBackReason,
+		Message:            "The placement follows the latest resource snapshot",
+		ObservedGeneration: placementObj.GetGeneration(),
+	}
+	annotations := latestResourceSnapshot.GetAnnotations()
+	if rolledBackToIndex, ok := annotations[fleetv1beta1.RolledBackToResourceIndexAnnotation]; ok {
+		rolledBackCond.Status = metav1.ConditionTrue
+		rolledBackCond.Reason = condition.RolloutRolledBackReason
+		rolledBackCond.Message = fmt.Sprintf("The placement has been rolled back from resource snapshot index %s to %s, as %s",
+			latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel], rolledBackToIndex, annotations[fleetv1beta1.RollbackReasonAnnotation])
+	}
+	placementObj.SetConditions(rolledBackCond)
+	klog.V(2).InfoS("Populated the rollback status", "placement", klog.KObj(placementObj), "rolledBackCondition", rolledBackCond)
+}
+
+// getPlacementPinnedConditionType returns the Pinned condition type based on the placement type.
+func getPlacementPinnedConditionType(placementObj fleetv1beta1.PlacementObj) string {
+	if isClusterScopedPlacement(placementObj) {
+		return string(fleetv1beta1.ClusterResourcePlacementPinnedConditionType)
+	}
+	return string(fleetv1beta1.ResourcePlacementPinnedConditionType)
+}
+
+// setPlacementPinnedStatus sets the Pinned condition for placements pinned to a resource snapshot, based on
+// whether the pinned resource snapshot has been resolved as the target resource snapshot of the placement.
+func setPlacementPinnedStatus(placementObj fleetv1beta1.PlacementObj, targetResourceSnapshot fleetv1beta1.ResourceSnapshotObj) {
+	placementStatus := placementObj.GetPlacementStatus()
+	condType := getPlacementPinnedConditionType(placementObj)
+	placementSpec := placementObj.GetPlacementSpec()
+	pinnedIndex := placementSpec.PinnedResourceSnapshotIndex
+	if pinnedIndex == nil || targetResourceSnapshot == nil || placementSpec.Strategy.Type == fleetv1beta1.ExternalRolloutStrategyType {
+		// The placement is not pinned; reset the pinning related status (if any).
+		meta.RemoveStatusCondition(&placementStatus.Conditions, condType)
+		return
+	}
+
+	pinnedCond := metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.RolloutPinnedReason,
+		Message:            fmt.Sprintf("The placement is pinned to resource snapshot index %s", *pinnedIndex),
+		ObservedGeneration: placementObj.GetGeneration(),
+	}
+	if targetResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel] != *pinnedIndex {
+		pinnedCond.Status = metav1.ConditionFalse
+		pinnedCond.Reason = condition.PinnedResourceSnapshotNotFoundReason
+		pinnedCond.Message = fmt.Sprintf("The pinned resource snapshot with index %s is not found, as it might have been deleted per the revision history limit; "+
+			"Fleet holds back all the updates to the selected clusters until the placement is unpinned or pinned to an existing resource snapshot", *pinnedIndex)
+	}
+	placementObj.SetConditions(pinnedCond)
+	klog.V(2).InfoS("Populated the pinned status", "placement", klog.KObj(placementObj), "pinnedCondition", pinnedCond)
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func masterResourceSnapshotWithConfigMapForTest(index int, configMapName string, annotations map[string]string) *fleetv1beta1.ClusterResourceSnapshot {
+	snapshotAnnotations := map[string]string{
+		fleetv1beta1.ResourceGroupHashAnnotation:         "hash",
+		fleetv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+	}
+	for k, v := range annotations {
+		snapshotAnnotations[k] = v
+	}
+	return &fleetv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, index),
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				fleetv1beta1.ResourceIndexLabel:     fmt.Sprint(index),
+			},
+			Annotations: snapshotAnnotations,
+		},
+		Spec: fleetv1beta1.ResourceSnapshotSpec{
+			SelectedResources: []fleetv1beta1.ResourceContent{
+				{
+					RawExtension: runtime.RawExtension{
+						Raw: []byte(fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":%q,"namespace":"app"}}`, configMapName)),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResolveTargetResourceSnapshot(t *testing.T) {
+	configMapID := func(name string) []fleetv1beta1.ResourceIdentifier {
+		return []fleetv1beta1.ResourceIdentifier{{Version: "v1", Kind: "ConfigMap", Name: name, Namespace: "app"}}
+	}
+	tests := []struct {
+		name              string
+		pinnedIndex       *string
+		strategyType      fleetv1beta1.RolloutStrategyType
+		autoRollback      *fleetv1beta1.AutoRollbackConfig
+		latestAnnotations map[string]string
+		wantTargetName    string
+		wantSelectedIDs   []fleetv1beta1.ResourceIdentifier
+	}{
+		{
+			name:            "not pinned or rolled back",
+			wantTargetName:  fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 2),
+			wantSelectedIDs: configMapID("latest"),
+		},
+		{
+			name:            "pinned to an older resource snapshot",
+			pinnedIndex:     ptr.To("0"),
+			wantTargetName:  fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 0),
+			wantSelectedIDs: configMapID("cm-0"),
+		},
+		{
+			name:            "pinned to the latest resource snapshot",
+			pinnedIndex:     ptr.To("2"),
+			wantTargetName:  fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 2),
+			wantSelectedIDs: configMapID("latest"),
+		},
+		{
+			name:            "the pinned resource snapshot is not found",
+			pinnedIndex:     ptr.To("7"),
+			wantTargetName:  fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 2),
+			wantSelectedIDs: configMapID("latest"),
+		},
+		{
+			name:            "the pin is ignored with the External rollout strategy",
+			pinnedIndex:     ptr.To("0"),
+			strategyType:    fleetv1beta1.ExternalRolloutStrategyType,
+			wantTargetName:  fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 2),
+			wantSelectedIDs: configMapID("latest"),
+		},
+		{
+			name:              "rolled back automatically",
+			autoRollback:      &fleetv1beta1.AutoRollbackConfig{FailureThreshold: ptr.To(1)},
+			latestAnnotations: map[string]string{fleetv1beta1.RolledBackToResourceIndexAnnotation: "1"},
+			wantTargetName:    fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 1),
+			wantSelectedIDs:   configMapID("cm-1"),
+		},
+		{
+			name:              "the rollback is ignored if automatic rollback has been disabled",
+			latestAnnotations: map[string]string{fleetv1beta1.RolledBackToResourceIndexAnnotation: "1"},
+			wantTargetName:    fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 2),
+			wantSelectedIDs:   configMapID("latest"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.PinnedResourceSnapshotIndex = tc.pinnedIndex
+			crp.Spec.Strategy = fleetv1beta1.RolloutStrategy{
+				Type:          fleetv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &fleetv1beta1.RollingUpdateConfig{AutoRollback: tc.autoRollback},
+			}
+			if tc.strategyType != "" {
+				crp.Spec.Strategy.Type = tc.strategyType
+			}
+			latest := masterResourceSnapshotWithConfigMapForTest(2, "latest", tc.latestAnnotations)
+			objects := []client.Object{
+				masterResourceSnapshotWithConfigMapForTest(0, "cm-0", nil),
+				masterResourceSnapshotWithConfigMapForTest(1, "cm-1", nil),
+				latest,
+			}
+			scheme := serviceScheme(t)
+			r := Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+				Scheme: scheme,
+			}
+
+			gotTarget, gotSelectedIDs, err := r.resolveTargetResourceSnapshot(context.Background(), crp, latest, configMapID("latest"))
+			if err != nil {
+				t.Fatalf("resolveTargetResourceSnapshot() got error %v, want no error", err)
+			}
+			if gotTarget.GetName() != tc.wantTargetName {
+				t.Errorf("resolveTargetResourceSnapshot() = %s, want %s", gotTarget.GetName(), tc.wantTargetName)
+			}
+			if diff := cmp.Diff(gotSelectedIDs, tc.wantSelectedIDs); diff != "" {
+				t.Errorf("resolveTargetResourceSnapshot() selected resources mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetPlacementRollbackStatus(t *testing.T) {
+	rolledBackCondType := string(fleetv1beta1.ClusterResourcePlacementRolledBackConditionType)
+	autoRollbackStrategy := fleetv1beta1.RolloutStrategy{
+		Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+		RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+			AutoRollback: &fleetv1beta1.AutoRollbackConfig{FailureThreshold: ptr.To(1)},
+		},
+	}
+	tests := []struct {
+		name                  string
+		strategy              fleetv1beta1.RolloutStrategy
+		snapshotAnnotations   map[string]string
+		nilResourceSnapshot   bool
+		existingConditions    []metav1.Condition
+		wantRolledBackCondSet bool
+		wantStatus            metav1.ConditionStatus
+		wantReason            string
+		wantMessage           string
+	}{
+		{
+			name: "automatic rollback is not enabled",
+			strategy: fleetv1beta1.RolloutStrategy{
+				Type:          fleetv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &fleetv1beta1.RollingUpdateConfig{},
+			},
+			existingConditions: []metav1.Condition{
+				{Type: rolledBackCondType, Status: metav1.ConditionTrue, Reason: condition.RolloutRolledBackReason},
+			},
+		},
+		{
+			name:                "no resource snapshot",
+			strategy:            autoRollbackStrategy,
+			nilResourceSnapshot: true,
+		},
+		{
+			name:                  "not rolled back",
+			strategy:              autoRollbackStrategy,
+			snapshotAnnotations:   map[string]string{fleetv1beta1.FullyAvailableAnnotation: "true"},
+			wantRolledBackCondSet: true,
+			wantStatus:            metav1.ConditionFalse,
+			wantReason:            condition.RolloutNotRolledBackReason,
+			wantMessage:           "The placement follows the latest resource snapshot",
+		},
+		{
+			name:     "rolled back",
+			strategy: autoRollbackStrategy,
+			snapshotAnnotations: map[string]string{
+				fleetv1beta1.RolledBackToResourceIndexAnnotation: "1",
+				fleetv1beta1.RollbackReasonAnnotation:            "the rollout did not complete within the progress deadline of 60 seconds",
+			},
+			wantRolledBackCondSet: true,
+			wantStatus:            metav1.ConditionTrue,
+			wantReason:            condition.RolloutRolledBackReason,
+			wantMessage:           "The placement has been rolled back from resource snapshot index 2 to 1, as the rollout did not complete within the progress deadline of 60 seconds",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.Strategy = tc.strategy
+			crp.Status.Conditions = tc.existingConditions
+			var resourceSnapshot fleetv1beta1.ResourceSnapshotObj
+			if !tc.nilResourceSnapshot {
+				resourceSnapshot = &fleetv1beta1.ClusterResourceSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test-snapshot-2",
+						Labels:      map[string]string{fleetv1beta1.ResourceIndexLabel: "2"},
+						Annotations: tc.snapshotAnnotations,
+					},
+				}
+			}
+
+			setPlacementRollbackStatus(crp, resourceSnapshot)
+
+			gotCond := crp.GetCondition(rolledBackCondType)
+			if !tc.wantRolledBackCondSet {
+				if gotCond != nil {
+					t.Errorf("setPlacementRollbackStatus() RolledBack condition = %+v, want no condition", gotCond)
+				}
+				return
+			}
+			wantCond := &metav1.Condition{
+				Type:               rolledBackCondType,
+				Status:             tc.wantStatus,
+				Reason:             tc.wantReason,
+				Message:            tc.wantMessage,
+				ObservedGeneration: placementGeneration,
+			}
+			if diff := cmp.Diff(gotCond, wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("setPlacementRollbackStatus() RolledBack condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetPlacementPinnedStatus(t *testing.T) {
+	pinnedCondType := string(fleetv1beta1.ClusterResourcePlacementPinnedConditionType)
+	tests := []struct {
+		name                string
+		pinnedIndex         *string
+		strategyType        fleetv1beta1.RolloutStrategyType
+		targetIndex         string
+		nilResourceSnapshot bool
+		existingConditions  []metav1.Condition
+		wantCond            *metav1.Condition
+	}{
+		{
+			name:        "not pinned",
+			targetIndex: "2",
+			existingConditions: []metav1.Condition{
+				{Type: pinnedCondType, Status: metav1.ConditionTrue, Reason: condition.RolloutPinnedReason},
+			},
+		},
+		{
+			name:                "no resource snapshot",
+			pinnedIndex:         ptr.To("1"),
+			nilResourceSnapshot: true,
+		},
+		{
+			name:         "pinned with the External rollout strategy",
+			pinnedIndex:  ptr.To("1"),
+			strategyType: fleetv1beta1.ExternalRolloutStrategyType,
+			targetIndex:  "1",
+			existingConditions: []metav1.Condition{
+				{Type: pinnedCondType, Status: metav1.ConditionTrue, Reason: condition.RolloutPinnedReason},
+			},
+		},
+		{
+			name:        "pinned",
+			pinnedIndex: ptr.To("1"),
+			targetIndex: "1",
+			wantCond: &metav1.Condition{
+				Type:    pinnedCondType,
+				Status:  metav1.ConditionTrue,
+				Reason:  condition.RolloutPinnedReason,
+				Message: "The placement is pinned to resource snapshot index 1",
+			},
+		},
+		{
+			name:        "the pinned resource snapshot is not found",
+			pinnedIndex: ptr.To("7"),
+			targetIndex: "2",
+			wantCond: &metav1.Condition{
+				Type:   pinnedCondType,
+				Status: metav1.ConditionFalse,
+				Reason: condition.PinnedResourceSnapshotNotFoundReason,
+				Message: "The pinned resource snapshot with index 7 is not found, as it might have been deleted per the revision history limit; " +
+					"Fleet holds back all the updates to the selected clusters until the placement is unpinned or pinned to an existing resource snapshot",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest()
+			crp.Spec.PinnedResourceSnapshotIndex = tc.pinnedIndex
+			crp.Spec.Strategy.Type = tc.strategyType
+			crp.Status.Conditions = tc.existingConditions
+			var resourceSnapshot fleetv1beta1.ResourceSnapshotObj
+			if !tc.nilResourceSnapshot {
+				resourceSnapshot = masterResourceSnapshotWithConfigMapForTest(0, "cm", nil)
+				resourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel] = tc.targetIndex
+			}
+
+			setPlacementPinnedStatus(crp, resourceSnapshot)
+
+			gotCond := crp.GetCondition(pinnedCondType)
+			if tc.wantCond != nil {
+				tc.wantCond.ObservedGeneration = crp.Generation
+			}
+			if diff := cmp.Diff(gotCond, tc.wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("setPlacementPinnedStatus() Pinned condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementbinding features a controller that reconciles the PlacementBinding and ClusterPlacementBinding
+// objects of the kubefleet.dev/placement API group: it generates the work that synchronizes the snapshotted
+// resources to the target cluster of a binding, and reports the status of the work back on the binding.
+package placementbinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// workCleanupRequeueDelay is the delay before checking again if the work of a deleted (or suspended)
+	// binding is gone; work deletions are watched, so this is only a fallback.
+	workCleanupRequeueDelay = 30 * time.Second
+)
+
+// Reconciler reconciles a (cluster) placement binding object.
+type Reconciler struct {
+	// Client is used to update objects which goes to the api server directly.
+	Client client.Client
+
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles a placement binding.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	bindingKey := req.NamespacedName
+	startTime := time.Now()
+	klog.V(2).InfoS("Placement binding reconciliation starts", "placementBinding", bindingKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Placement binding reconciliation ends", "placementBinding", bindingKey, "latency", latency)
+	}()
+
+	binding, err := controller.FetchPlacementBindingFromKey(ctx, r.Client, bindingKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound placement binding", "placementBinding", bindingKey)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get placement binding", "placementBinding", bindingKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	if binding.GetDeletionTimestamp() != nil {
+		return r.handleDelete(ctx, binding)
+	}
+
+	// register finalizer
+	if !controllerutil.ContainsFinalizer(binding, kfplacementv1alpha1.PlacementBindingCleanupFinalizer) {
+		controllerutil.AddFinalizer(binding, kfplacementv1alpha1.PlacementBindingCleanupFinalizer)
+		if err := r.Client.Update(ctx, binding); err != nil {
+			klog.ErrorS(err, "Failed to add placement binding finalizer", "placementBinding", klog.KObj(binding))
+			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+	}
+	return r.handleUpdate(ctx, binding)
+}
+
+// handleDelete deletes the work generated for a binding before removing its cleanup finalizer.
+func (r *Reconciler) handleDelete(ctx context.Context, binding kfplacementv1alpha1.PlacementBindingObj) (ctrl.Result, error) {
+	bindingKObj := klog.KObj(binding)
+	if !controllerutil.ContainsFinalizer(binding, kfplacementv1alpha1.PlacementBindingCleanupFinalizer) {
+		klog.V(4).InfoS("Placement binding is being deleted and no cleanup work needs to be done", "placementBinding", bindingKObj)
+		return ctrl.Result{}, nil
+	}
+
+	isGone, err := r.deleteWork(ctx, binding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !isGone {
+		klog.V(2).InfoS("Waiting for the work to be deleted", "placementBinding", bindingKObj)
+		return ctrl.Result{RequeueAfter: workCleanupRequeueDelay}, nil
+	}
+
+	controllerutil.RemoveFinalizer(binding, kfplacementv1alpha1.PlacementBindingCleanupFinalizer)
+	if err := r.Client.Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to remove placement binding finalizer", "placementBinding", bindingKObj)
+		return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Removed placement-binding-cleanup finalizer", "placementBinding", bindingKObj)
+	return ctrl.Result{}, nil
+}
+
+// handleUpdate keeps the work of a binding in sync with the binding, and reports the status of the
+// work back on the binding.
+func (r *Reconciler) handleUpdate(ctx context.Context, binding kfplacementv1alpha1.PlacementBindingObj) (ctrl.Result, error) {
+	bindingKObj := klog.KObj(binding)
+	spec := binding.GetPlacementBindingSpec()
+
+	snapshotKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: spec.ResourceSnapshotName}
+	snapshot, err := controller.FetchPlacementResourceSnapshotFromKey(ctx, r.Client, snapshotKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the resource snapshot of the placement binding", "placementBinding", bindingKObj, "resourceSnapshot", snapshotKey)
+		if apierrors.IsNotFound(err) {
+			// The placement policy controller will point the binding to a new resource snapshot.
+			return ctrl.Result{}, controller.NewExpectedBehaviorError(err)
+		}
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	selectedResources := len(snapshot.GetPlacementResourceSnapshotSpec().Resources)
+
+	var work *fleetv1beta1.Work
+	var result ctrl.Result
+	if spec.Suspended {
+		isGone, err := r.deleteWork(ctx, binding)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !isGone {
+			result = ctrl.Result{RequeueAfter: workCleanupRequeueDelay}
+		}
+	} else {
+		if work, err = r.syncWork(ctx, binding, snapshot); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	oldStatus := binding.GetPlacementBindingStatus().DeepCopy()
+	binding.SetPlacementBindingStatus(buildBindingStatus(binding, selectedResources, work))
+	if equality.Semantic.DeepEqual(oldStatus, binding.GetPlacementBindingStatus()) {
+		return result, nil
+	}
+	if err := r.Client.Status().Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to update the placement binding status", "placementBinding", bindingKObj)
+		return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the placement binding status", "placementBinding", bindingKObj)
+	return result, nil
+}
+
+// syncWork creates or updates the work of a binding so that it matches the resource snapshot and the
+// sync strategy of the binding.
+//
+// It returns the existing work if it is up-to-date, so that its status can be reported; otherwise,
+// it returns nil as the status of the work no longer reflects the binding.
+func (r *Reconciler) syncWork(
+	ctx context.Context,
+	binding kfplacementv1alpha1.PlacementBindingObj,
+	snapshot kfplacementv1alpha1.PlacementResourceSnapshotObj,
+) (*fleetv1beta1.Work, error) {
+	bindingKObj := klog.KObj(binding)
+	desired := buildWork(binding, snapshot)
+
+	existing := &fleetv1beta1.Work{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the work of the placement binding", "placementBinding", bindingKObj, "work", klog.KObj(desired))
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		if err := r.Client.Create(ctx, desired); err != nil {
+			klog.ErrorS(err, "Failed to create the work of the placement binding", "placementBinding", bindingKObj, "work", klog.KObj(desired))
+			return nil, controller.NewCreateIgnoreAlreadyExistError(err)
+		}
+		klog.V(2).InfoS("Created the work of the placement binding", "placementBinding", bindingKObj, "work", klog.KObj(desired))
+		return nil, nil
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) &&
+		equality.Semantic.DeepEqual(existing.Labels, desired.Labels) &&
+		equality.Semantic.DeepEqual(existing.Annotations, desired.Annotations) {
+		return existing, nil
+	}
+	existing.Labels = desired.Labels
+	existing.Annotations = desired.Annotations
+	existing.Spec = desired.Spec
+	if err := r.Client.Update(ctx, existing); err != nil {
+		klog.ErrorS(err, "Failed to update the work of the placement binding", "placementBinding", bindingKObj, "work", klog.KObj(existing))
+		return nil, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the work of the placement binding", "placementBinding", bindingKObj, "work", klog.KObj(existing))
+	return nil, nil
+}
+
+// deleteWork deletes the work of a binding; it returns true if the work is gone.
+func (r *Reconciler) deleteWork(ctx context.Context, binding kfplacementv1alpha1.PlacementBindingObj) (bool, error) {
+	work := &fleetv1beta1.Work{}
+	workKey := types.NamespacedName{
+		Namespace: fmt.Sprintf(utils.NamespaceNameFormat, binding.GetPlacementBindingSpec().ClusterName),
+		Name:      workNameFor(binding),
+	}
+	if err := r.Client.Get(ctx, workKey, work); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		klog.ErrorS(err, "Failed to get the work of the placement binding", "placementBinding", klog.KObj(binding), "work", workKey)
+		return false, controller.NewAPIServerError(true, err)
+	}
+	// Note: the work cannot be garbage collected via owner references, as the work and the binding
+	// live in different namespaces.
+	if work.GetDeletionTimestamp() == nil {
+		if err := r.Client.Delete(ctx, work); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the work of the placement binding", "placementBinding", klog.KObj(binding), "work", workKey)
+			return false, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the work of the placement binding", "placementBinding", klog.KObj(binding), "work", workKey)
+	}
+	return false, nil
+}
+
+// SetupWithManagerForClusterPlacementBinding sets up the controller with the Manager for ClusterPlacementBinding objects.
+func (r *Reconciler) SetupWithManagerForClusterPlacementBinding(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("cluster-placement-binding-controller")
+	return ctrl.NewControllerManagedBy(mgr).Named("cluster-placement-binding-controller").
+		For(&kfplacementv1alpha1.ClusterPlacementBinding{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&fleetv1beta1.Work{}, handler.EnqueueRequestsFromMapFunc(workToBindingMapFunc(true))).
+		Complete(r)
+}
+
+// SetupWithManagerForPlacementBinding sets up the controller with the Manager for PlacementBinding objects.
+func (r *Reconciler) SetupWithManagerForPlacementBinding(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("placement-binding-controller")
+	return ctrl.NewControllerManagedBy(mgr).Named("placement-binding-controller").
+		For(&kfplacementv1alpha1.PlacementBinding{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&fleetv1beta1.Work{}, handler.EnqueueRequestsFromMapFunc(workToBindingMapFunc(false))).
+		Complete(r)
+}
+
+// workToBindingMapFunc returns a function that maps a work to the binding it is generated from; works
+// that are not generated for bindings of the given scope are ignored.
+func workToBindingMapFunc(isClusterScoped bool) handler.MapFunc {
+	return func(_ context.Context, work client.Object) []reconcile.Request {
+		bindingName, ok := work.GetLabels()[kfplacementv1alpha1.ParentPlacementBindingLabel]
+		if !ok {
+			return nil
+		}
+		bindingNamespace := work.GetLabels()[kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel]
+		if isClusterScoped != (bindingNamespace == "") {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: bindingNamespace, Name: bindingName}}}
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementbinding
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// maxFailedResourceCount is the maximum number of failed resources reported in the status of a binding.
+	maxFailedResourceCount = 50
+)
+
+// buildBindingStatus builds the status of a binding from the status of the work generated for it.
+//
+// A nil work means that no work is expected for the binding (e.g., the binding is suspended), or
+// that the work has not reported the status for its latest generation yet.
+func buildBindingStatus(binding kfplacementv1alpha1.PlacementBindingObj, selectedResources int, work *fleetv1beta1.Work) kfplacementv1alpha1.PlacementBindingStatus {
+	status := kfplacementv1alpha1.PlacementBindingStatus{
+		// Keep the existing conditions so that the transition timestamps are preserved.
+		Conditions:        slices.Clone(binding.GetPlacementBindingStatus().Conditions),
+		SelectedResources: ptr.To(int32(selectedResources)),
+	}
+	var appliedCond *metav1.Condition
+	if work != nil {
+		appliedCond = meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeApplied)
+	}
+	if work == nil || !isConditionUpToDate(appliedCond, work.Generation) {
+		meta.RemoveStatusCondition(&status.Conditions, kfplacementv1alpha1.PlacementBindingCondTypeSynchronized)
+		meta.RemoveStatusCondition(&status.Conditions, kfplacementv1alpha1.PlacementBindingCondTypeAvailable)
+		return status
+	}
+
+	// Note that the observed generations of the manifest conditions track the generations of the
+	// applied resources rather than that of the work; the work-level conditions have been checked
+	// above to make sure that the manifest conditions are up-to-date.
+	synchronized, available := 0, 0
+	for i := range work.Status.ManifestConditions {
+		mc := &work.Status.ManifestConditions[i]
+		manifestAppliedCond := meta.FindStatusCondition(mc.Conditions, fleetv1beta1.WorkConditionTypeApplied)
+		manifestAvailableCond := meta.FindStatusCondition(mc.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+		isApplied := manifestAppliedCond != nil && manifestAppliedCond.Status == metav1.ConditionTrue
+		isAvailable := manifestAvailableCond != nil && manifestAvailableCond.Status == metav1.ConditionTrue
+		if isApplied {
+			synchronized++
+			if isAvailable {
+				available++
+			}
+		}
+		hasFailed := (manifestAppliedCond != nil && manifestAppliedCond.Status == metav1.ConditionFalse) ||
+			(manifestAvailableCond != nil && manifestAvailableCond.Status == metav1.ConditionFalse)
+		if hasFailed && len(status.FailedResources) < maxFailedResourceCount {
+			status.FailedResources = append(status.FailedResources, failedResourceFrom(mc))
+		}
+	}
+	status.SynchronizedResources = ptr.To(int32(synchronized))
+	status.AvailableResources = ptr.To(int32(available))
+
+	generation := binding.GetGeneration()
+	syncedCond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementBindingCondTypeSynchronized,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonAllResourcesSynchronized,
+		Message:            "All the selected resources have been synchronized to the target cluster",
+		ObservedGeneration: generation,
+	}
+	if appliedCond.Status != metav1.ConditionTrue {
+		syncedCond.Status = metav1.ConditionFalse
+		syncedCond.Reason = kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonFailedToSynchronizeSomeResources
+		syncedCond.Message = fmt.Sprintf("%d out of %d selected resources have been synchronized to the target cluster: %s",
+			synchronized, len(work.Spec.Workload.Manifests), appliedCond.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, syncedCond)
+
+	availableWorkCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+	if syncedCond.Status != metav1.ConditionTrue || !isConditionUpToDate(availableWorkCond, work.Generation) {
+		meta.RemoveStatusCondition(&status.Conditions, kfplacementv1alpha1.PlacementBindingCondTypeAvailable)
+		return status
+	}
+	availableCond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementBindingCondTypeAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementBindingAvailableCondReasonAllResourcesAvailable,
+		Message:            "All the selected resources are available in the target cluster",
+		ObservedGeneration: generation,
+	}
+	if availableWorkCond.Status != metav1.ConditionTrue {
+		availableCond.Status = metav1.ConditionFalse
+		availableCond.Reason = kfplacementv1alpha1.PlacementBindingAvailableCondReasonSomeResourcesUnavailable
+		availableCond.Message = fmt.Sprintf("%d out of %d selected resources are available in the target cluster: %s",
+			available, len(work.Spec.Workload.Manifests), availableWorkCond.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, availableCond)
+	return status
+}
+
+// isConditionUpToDate returns if a condition has been reported for the given generation.
+func isConditionUpToDate(cond *metav1.Condition, generation int64) bool {
+	return cond != nil && cond.ObservedGeneration == generation
+}
+
+// failedResourceFrom converts the status of a manifest that has failed to apply or become available
+// into a failed resource entry.
+func failedResourceFrom(mc *fleetv1beta1.ManifestCondition) kfplacementv1alpha1.FailedResource {
+	failed := kfplacementv1alpha1.FailedResource{
+		ObjectRef: kfplacementv1alpha1.ObjectReference{
+			Namespace:  mc.Identifier.Namespace,
+			Name:       mc.Identifier.Name,
+			APIGroup:   mc.Identifier.Group,
+			APIVersion: mc.Identifier.Version,
+			Kind:       mc.Identifier.Kind,
+		},
+		Conditions: mc.Conditions,
+	}
+	switch {
+	case mc.DriftDetails != nil:
+		failed.DiffDetails = &kfplacementv1alpha1.DiffDetails{
+			ObservedInMemberClusterGeneration: ptr.To(mc.DriftDetails.ObservedInMemberClusterGeneration),
+			FirstDiffedObservedTimestamp:      mc.DriftDetails.FirstDriftedObservedTime,
+			ObservedDiffs:                     patchDetailsFrom(mc.DriftDetails.ObservedDrifts),
+		}
+	case mc.DiffDetails != nil:
+		failed.DiffDetails = &kfplacementv1alpha1.DiffDetails{
+			ObservedInMemberClusterGeneration: mc.DiffDetails.ObservedInMemberClusterGeneration,
+			FirstDiffedObservedTimestamp:      mc.DiffDetails.FirstDiffedObservedTime,
+			ObservedDiffs:                     patchDetailsFrom(mc.DiffDetails.ObservedDiffs),
+		}
+	}
+	return failed
+}
+
+// patchDetailsFrom converts the patch details reported in a work into those reported in a binding.
+func patchDetailsFrom(details []fleetv1beta1.PatchDetail) []kfplacementv1alpha1.PatchDetail {
+	if len(details) == 0 {
+		return nil
+	}
+	converted := make([]kfplacementv1alpha1.PatchDetail, len(details))
+	for i := range details {
+		converted[i] = kfplacementv1alpha1.PatchDetail{
+			Path:          details[i].Path,
+			ValueInMember: details[i].ValueInMember,
+			ValueInHub:    details[i].ValueInHub,
+		}
+	}
+	return converted
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementbinding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	bindingGeneration = 2
+	workGeneration    = 3
+)
+
+var ignoreConditionFields = cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "Message")
+
+func manifestCondition(name string, applied, available metav1.ConditionStatus) fleetv1beta1.ManifestCondition {
+	return fleetv1beta1.ManifestCondition{
+		Identifier: fleetv1beta1.WorkResourceIdentifier{
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Namespace: bindingNS,
+			Name:      name,
+		},
+		Conditions: []metav1.Condition{
+			{Type: fleetv1beta1.WorkConditionTypeApplied, Status: applied},
+			{Type: fleetv1beta1.WorkConditionTypeAvailable, Status: available},
+		},
+	}
+}
+
+// TestBuildBindingStatus tests the buildBindingStatus function.
+func TestBuildBindingStatus(t *testing.T) {
+	binding := &kfplacementv1alpha1.PlacementBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       bindingName,
+			Namespace:  bindingNS,
+			Generation: bindingGeneration,
+		},
+		Status: kfplacementv1alpha1.PlacementBindingStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               kfplacementv1alpha1.PlacementBindingCondTypeSynchronized,
+					Status:             metav1.ConditionTrue,
+					Reason:             kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonAllResourcesSynchronized,
+					ObservedGeneration: bindingGeneration - 1,
+				},
+			},
+		},
+	}
+	manifests := []fleetv1beta1.Manifest{{}, {}}
+
+	testCases := []struct {
+		name       string
+		work       *fleetv1beta1.Work
+		wantStatus kfplacementv1alpha1.PlacementBindingStatus
+	}{
+		{
+			name: "no work",
+			wantStatus: kfplacementv1alpha1.PlacementBindingStatus{
+				Conditions:        []metav1.Condition{},
+				SelectedResources: ptr.To(int32(2)),
+			},
+		},
+		{
+			name: "work status is stale",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Generation: workGeneration},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{Type: fleetv1beta1.WorkConditionTypeApplied, Status: metav1.ConditionTrue, ObservedGeneration: workGeneration - 1},
+					},
+				},
+			},
+			wantStatus: kfplacementv1alpha1.PlacementBindingStatus{
+				Conditions:        []metav1.Condition{},
+				SelectedResources: ptr.To(int32(2)),
+			},
+		},
+		{
+			name: "all resources available",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Generation: workGeneration},
+				Spec: fleetv1beta1.WorkSpec{
+					Workload: fleetv1beta1.WorkloadTemplate{Manifests: manifests},
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{Type: fleetv1beta1.WorkConditionTypeApplied, Status: metav1.ConditionTrue, ObservedGeneration: workGeneration},
+						{Type: fleetv1beta1.WorkConditionTypeAvailable, Status: metav1.ConditionTrue, ObservedGeneration: workGeneration},
+					},
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						manifestCondition("cm-1", metav1.ConditionTrue, metav1.ConditionTrue),
+						manifestCondition("cm-2", metav1.ConditionTrue, metav1.ConditionTrue),
+					},
+				},
+			},
+			wantStatus: kfplacementv1alpha1.PlacementBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               kfplacementv1alpha1.PlacementBindingCondTypeSynchronized,
+						Status:             metav1.ConditionTrue,
+						Reason:             kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonAllResourcesSynchronized,
+						ObservedGeneration: bindingGeneration,
+					},
+					{
+						Type:               kfplacementv1alpha1.PlacementBindingCondTypeAvailable,
+						Status:             metav1.ConditionTrue,
+						Reason:             kfplacementv1alpha1.PlacementBindingAvailableCondReasonAllResourcesAvailable,
+						ObservedGeneration: bindingGeneration,
+					},
+				},
+				SelectedResources:     ptr.To(int32(2)),
+				SynchronizedResources: ptr.To(int32(2)),
+				AvailableResources:    ptr.To(int32(2)),
+			},
+		},
+		{
+			name: "some resources unavailable",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Generation: workGeneration},
+				Spec: fleetv1beta1.WorkSpec{
+					Workload: fleetv1beta1.WorkloadTemplate{Manifests: manifests},
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{Type: fleetv1beta1.WorkConditionTypeApplied, Status: metav1.ConditionTrue, ObservedGeneration: workGeneration},
+						{Type: fleetv1beta1.WorkConditionTypeAvailable, Status: metav1.ConditionFalse, ObservedGeneration: workGeneration},
+					},
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						manifestCondition("cm-1", metav1.ConditionTrue, metav1.ConditionTrue),
+						manifestCondition("cm-2", metav1.ConditionTrue, metav1.ConditionFalse),
+					},
+				},
+			},
+			wantStatus: kfplacementv1alpha1.PlacementBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               kfplacementv1alpha1.PlacementBindingCondTypeSynchronized,
+						Status:             metav1.ConditionTrue,
+						Reason:             kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonAllResourcesSynchronized,
+						ObservedGeneration: bindingGeneration,
+					},
+					{
+						Type:               kfplacementv1alpha1.PlacementBindingCondTypeAvailable,
+						Status:             metav1.ConditionFalse,
+						Reason:             kfplacementv1alpha1.PlacementBindingAvailableCondReasonSomeResourcesUnavailable,
+						ObservedGeneration: bindingGeneration,
+					},
+				},
+				SelectedResources:     ptr.To(int32(2)),
+				SynchronizedResources: ptr.To(int32(2)),
+				AvailableResources:    ptr.To(int32(1)),
+				FailedResources: []kfplacementv1alpha1.FailedResource{
+					{
+						ObjectRef: kfplacementv1alpha1.ObjectReference{
+							Namespace:  bindingNS,
+							Name:       "cm-2",
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+						},
+						Conditions: manifestCondition("cm-2", metav1.ConditionTrue, metav1.ConditionFalse).Conditions,
+					},
+				},
+			},
+		},
+		{
+			name: "some resources failed to apply, with drifts",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Generation: workGeneration},
+				Spec: fleetv1beta1.WorkSpec{
+					Workload: fleetv1beta1.WorkloadTemplate{Manifests: manifests},
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{Type: fleetv1beta1.WorkConditionTypeApplied, Status: metav1.ConditionFalse, ObservedGeneration: workGeneration},
+					},
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						manifestCondition("cm-1", metav1.ConditionTrue, metav1.ConditionTrue),
+						func() fleetv1beta1.ManifestCondition {
+							mc := manifestCondition("cm-2", metav1.ConditionFalse, metav1.ConditionUnknown)
+							mc.DriftDetails = &fleetv1beta1.DriftDetails{
+								ObservedInMemberClusterGeneration: 1,
+								ObservedDrifts: []fleetv1beta1.PatchDetail{
+									{Path: "/data/key", ValueInMember: "a", ValueInHub: "b"},
+								},
+							}
+							return mc
+						}(),
+					},
+				},
+			},
+			wantStatus: kfplacementv1alpha1.PlacementBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               kfplacementv1alpha1.PlacementBindingCondTypeSynchronized,
+						Status:             metav1.ConditionFalse,
+						Reason:             kfplacementv1alpha1.PlacementBindingSynchronizedCondReasonFailedToSynchronizeSomeResources,
+						ObservedGeneration: bindingGeneration,
+					},
+				},
+				SelectedResources:     ptr.To(int32(2)),
+				SynchronizedResources: ptr.To(int32(1)),
+				AvailableResources:    ptr.To(int32(1)),
+				FailedResources: []kfplacementv1alpha1.FailedResource{
+					{
+						ObjectRef: kfplacementv1alpha1.ObjectReference{
+							Namespace:  bindingNS,
+							Name:       "cm-2",
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+						},
+						Conditions: manifestCondition("cm-2", metav1.ConditionFalse, metav1.ConditionUnknown).Conditions,
+						DiffDetails: &kfplacementv1alpha1.DiffDetails{
+							ObservedInMemberClusterGeneration: ptr.To(int64(1)),
+							ObservedDiffs: []kfplacementv1alpha1.PatchDetail{
+								{Path: "/data/key", ValueInMember: "a", ValueInHub: "b"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildBindingStatus(binding, 2, tc.work)
+			if diff := cmp.Diff(got, tc.wantStatus, ignoreConditionFields); diff != "" {
+				t.Errorf("buildBindingStatus() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
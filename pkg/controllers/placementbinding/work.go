@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementbinding
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+// workNameFor returns the name of the work generated for a binding; the names of works generated
+// for namespaced bindings are prefixed with the namespace to avoid collisions.
+func workNameFor(binding kfplacementv1alpha1.PlacementBindingObj) string {
+	baseName := binding.GetName()
+	if binding.GetNamespace() != "" {
+		baseName = fmt.Sprintf(fleetv1beta1.WorkNameBaseFmt, binding.GetNamespace(), binding.GetName())
+	}
+	return fmt.Sprintf(fleetv1beta1.FirstWorkNameFmt, baseName)
+}
+
+// buildWork builds the work that synchronizes the resources in a resource snapshot to the target
+// cluster of a binding.
+func buildWork(binding kfplacementv1alpha1.PlacementBindingObj, snapshot kfplacementv1alpha1.PlacementResourceSnapshotObj) *fleetv1beta1.Work {
+	bindingSpec := binding.GetPlacementBindingSpec()
+	labels := map[string]string{
+		kfplacementv1alpha1.ParentPlacementPolicyLabel:  bindingSpec.PlacementPolicyName,
+		kfplacementv1alpha1.ParentPlacementBindingLabel: binding.GetName(),
+	}
+	if binding.GetNamespace() != "" {
+		labels[kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel] = binding.GetNamespace()
+	}
+
+	resources := snapshot.GetPlacementResourceSnapshotSpec().Resources
+	manifests := make([]fleetv1beta1.Manifest, len(resources))
+	for i := range resources {
+		manifests[i] = fleetv1beta1.Manifest{RawExtension: *resources[i].Manifest.DeepCopy()}
+	}
+
+	return &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workNameFor(binding),
+			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, bindingSpec.ClusterName),
+			Labels:    labels,
+			Annotations: map[string]string{
+				kfplacementv1alpha1.ParentResourceSnapshotNameAnnotation: snapshot.GetName(),
+				kfplacementv1alpha1.WhenNamespaceDoesNotExistAnnotation:  string(whenNamespaceDoesNotExistFrom(bindingSpec.SyncStrategy)),
+			},
+			// OwnerReferences cannot be added, as the work and the binding live in different namespaces.
+		},
+		Spec: fleetv1beta1.WorkSpec{
+			Workload: fleetv1beta1.WorkloadTemplate{
+				Manifests: manifests,
+			},
+			ApplyStrategy: applyStrategyFrom(bindingSpec.SyncStrategy),
+		},
+	}
+}
+
+// applyStrategyFrom translates the sync strategy of a binding into the apply strategy understood by
+// the work applier. Unset options fall back to the defaults of the SyncStrategy API, which differ from
+// the defaults of the ApplyStrategy API in some cases (e.g., takeovers).
+func applyStrategyFrom(syncStrategy *kfplacementv1alpha1.SyncStrategy) *fleetv1beta1.ApplyStrategy {
+	if syncStrategy == nil {
+		syncStrategy = &kfplacementv1alpha1.SyncStrategy{}
+	}
+	applyStrategy := &fleetv1beta1.ApplyStrategy{
+		Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+		ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+		WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+		WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeNever,
+	}
+
+	if syncStrategy.ApplyMethod == kfplacementv1alpha1.ApplyMethodServerSideApply {
+		applyStrategy.Type = fleetv1beta1.ApplyStrategyTypeServerSideApply
+		applyStrategy.ServerSideApplyConfig = &fleetv1beta1.ServerSideApplyConfig{}
+		if syncStrategy.ServerSideApplyOptions != nil {
+			applyStrategy.ServerSideApplyConfig.ForceConflicts = syncStrategy.ServerSideApplyOptions.ForceConflicts
+		}
+	}
+
+	if syncStrategy.ComparisonOption == kfplacementv1alpha1.ComparisonOptionFullComparison {
+		applyStrategy.ComparisonOption = fleetv1beta1.ComparisonOptionTypeFullComparison
+	}
+
+	applyStrategy.AllowCoOwnership = syncStrategy.WhenOwnedByOthers == kfplacementv1alpha1.WhenOwnedByOthersOptionShareOwnership
+
+	// With IfNotDrifted, the work applier reports the drifts found and leaves them as they are.
+	if syncStrategy.WhenDrifted == kfplacementv1alpha1.WhenDriftedOptionReportError {
+		applyStrategy.WhenToApply = fleetv1beta1.WhenToApplyTypeIfNotDrifted
+	}
+
+	switch syncStrategy.WhenAlreadyExists {
+	case kfplacementv1alpha1.WhenAlreadyExistsOptionAlwaysTakeOver:
+		applyStrategy.WhenToTakeOver = fleetv1beta1.WhenToTakeOverTypeAlways
+	case kfplacementv1alpha1.WhenAlreadyExistsOptionTakeOverIfNoDiff:
+		applyStrategy.WhenToTakeOver = fleetv1beta1.WhenToTakeOverTypeIfNoDiff
+	}
+	return applyStrategy
+}
+
+// whenNamespaceDoesNotExistFrom returns the action to take when the namespace of a namespaced resource does
+// not exist on the target cluster, falling back to the default of the SyncStrategy API if unset.
+func whenNamespaceDoesNotExistFrom(syncStrategy *kfplacementv1alpha1.SyncStrategy) kfplacementv1alpha1.WhenNamespaceDoesNotExistOption {
+	if syncStrategy == nil || syncStrategy.WhenNamespaceDoesNotExist == "" {
+		return kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace
+	}
+	return syncStrategy.WhenNamespaceDoesNotExist
+}
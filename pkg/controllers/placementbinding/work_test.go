@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementbinding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	policyName    = "policy"
+	bindingName   = "policy-cluster-1-abcdef"
+	bindingNS     = "app"
+	clusterName   = "cluster-1"
+	snapshotName  = "policy-0-snapshot"
+	configMapJSON = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm","namespace":"app"}}`
+)
+
+// TestWorkNameFor tests the workNameFor function.
+func TestWorkNameFor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		binding  kfplacementv1alpha1.PlacementBindingObj
+		wantName string
+	}{
+		{
+			name: "cluster placement binding",
+			binding: &kfplacementv1alpha1.ClusterPlacementBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: bindingName},
+			},
+			wantName: "policy-cluster-1-abcdef-work",
+		},
+		{
+			name: "placement binding",
+			binding: &kfplacementv1alpha1.PlacementBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: bindingNS},
+			},
+			wantName: "app.policy-cluster-1-abcdef-work",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := workNameFor(tc.binding); got != tc.wantName {
+				t.Errorf("workNameFor() = %s, want %s", got, tc.wantName)
+			}
+		})
+	}
+}
+
+// TestBuildWork tests the buildWork function.
+func TestBuildWork(t *testing.T) {
+	binding := &kfplacementv1alpha1.PlacementBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: bindingNS},
+		Spec: kfplacementv1alpha1.PlacementBindingSpec{
+			PlacementPolicyName:  policyName,
+			ClusterName:          clusterName,
+			ResourceSnapshotName: snapshotName,
+		},
+	}
+	snapshot := &kfplacementv1alpha1.PlacementResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: snapshotName, Namespace: bindingNS},
+		Spec: kfplacementv1alpha1.PlacementResourceSnapshotSpec{
+			Resources: []kfplacementv1alpha1.SnapshottedResource{
+				{
+					Identifier: kfplacementv1alpha1.ObjectReference{
+						Namespace:  bindingNS,
+						Name:       "cm",
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+					},
+					Manifest: runtime.RawExtension{Raw: []byte(configMapJSON)},
+				},
+			},
+		},
+	}
+
+	want := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app.policy-cluster-1-abcdef-work",
+			Namespace: "fleet-member-cluster-1",
+			Labels: map[string]string{
+				kfplacementv1alpha1.ParentPlacementPolicyLabel:          policyName,
+				kfplacementv1alpha1.ParentPlacementBindingLabel:         bindingName,
+				kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel: bindingNS,
+			},
+			Annotations: map[string]string{
+				kfplacementv1alpha1.ParentResourceSnapshotNameAnnotation: snapshotName,
+				kfplacementv1alpha1.WhenNamespaceDoesNotExistAnnotation:  string(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			},
+		},
+		Spec: fleetv1beta1.WorkSpec{
+			Workload: fleetv1beta1.WorkloadTemplate{
+				Manifests: []fleetv1beta1.Manifest{
+					{RawExtension: runtime.RawExtension{Raw: []byte(configMapJSON)}},
+				},
+			},
+			ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+				WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+				WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeNever,
+			},
+		},
+	}
+	if diff := cmp.Diff(buildWork(binding, snapshot), want); diff != "" {
+		t.Errorf("buildWork() diff (-got, +want):\n%s", diff)
+	}
+}
+
+// TestApplyStrategyFrom tests the applyStrategyFrom function.
+func TestApplyStrategyFrom(t *testing.T) {
+	testCases := []struct {
+		name         string
+		syncStrategy *kfplacementv1alpha1.SyncStrategy
+		want         *fleetv1beta1.ApplyStrategy
+	}{
+		{
+			name: "nil sync strategy",
+			want: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+				WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+				WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeNever,
+			},
+		},
+		{
+			name: "all options set",
+			syncStrategy: &kfplacementv1alpha1.SyncStrategy{
+				ApplyMethod: kfplacementv1alpha1.ApplyMethodServerSideApply,
+				ServerSideApplyOptions: &kfplacementv1alpha1.ServerSideApplyOptions{
+					ForceConflicts: true,
+				},
+				WhenOwnedByOthers: kfplacementv1alpha1.WhenOwnedByOthersOptionShareOwnership,
+				WhenDrifted:       kfplacementv1alpha1.WhenDriftedOptionReportError,
+				WhenAlreadyExists: kfplacementv1alpha1.WhenAlreadyExistsOptionTakeOverIfNoDiff,
+				ComparisonOption:  kfplacementv1alpha1.ComparisonOptionFullComparison,
+			},
+			want: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypeFullComparison,
+				WhenToApply:      fleetv1beta1.WhenToApplyTypeIfNotDrifted,
+				WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeIfNoDiff,
+				AllowCoOwnership: true,
+				ServerSideApplyConfig: &fleetv1beta1.ServerSideApplyConfig{
+					ForceConflicts: true,
+				},
+			},
+		},
+		{
+			name: "always take over",
+			syncStrategy: &kfplacementv1alpha1.SyncStrategy{
+				ApplyMethod:       kfplacementv1alpha1.ApplyMethodClientSideApply,
+				WhenOwnedByOthers: kfplacementv1alpha1.WhenOwnedByOthersOptionReportError,
+				WhenDrifted:       kfplacementv1alpha1.WhenDriftedOptionApplyAnyway,
+				WhenAlreadyExists: kfplacementv1alpha1.WhenAlreadyExistsOptionAlwaysTakeOver,
+			},
+			want: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+				WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+				WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(applyStrategyFrom(tc.syncStrategy), tc.want); diff != "" {
+				t.Errorf("applyStrategyFrom() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestWhenNamespaceDoesNotExistFrom tests the whenNamespaceDoesNotExistFrom function.
+func TestWhenNamespaceDoesNotExistFrom(t *testing.T) {
+	testCases := []struct {
+		name         string
+		syncStrategy *kfplacementv1alpha1.SyncStrategy
+		want         kfplacementv1alpha1.WhenNamespaceDoesNotExistOption
+	}{
+		{
+			name: "nil sync strategy",
+			want: kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace,
+		},
+		{
+			name:         "unset",
+			syncStrategy: &kfplacementv1alpha1.SyncStrategy{},
+			want:         kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace,
+		},
+		{
+			name: "report error",
+			syncStrategy: &kfplacementv1alpha1.SyncStrategy{
+				WhenNamespaceDoesNotExist: kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionReportError,
+			},
+			want: kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionReportError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := whenNamespaceDoesNotExistFrom(tc.syncStrategy); got != tc.want {
+				t.Errorf("whenNamespaceDoesNotExistFrom() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
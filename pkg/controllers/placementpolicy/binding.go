@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/uniquename"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// buildBindingSpec builds the desired spec of the binding for a picked cluster.
+func buildBindingSpec(
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	clusterName string,
+	selectors []kfplacementv1alpha1.ClusterSelectorWithTermsOnly,
+	resourceSnapshotName string,
+) kfplacementv1alpha1.PlacementBindingSpec {
+	spec := kfplacementv1alpha1.PlacementBindingSpec{
+		PlacementPolicyName:  policy.GetName(),
+		ClusterSelectors:     selectors,
+		ClusterName:          clusterName,
+		ResourceSnapshotName: resourceSnapshotName,
+	}
+	if s := policy.GetPlacementPolicySpec().SyncStrategy; s != nil {
+		spec.SyncStrategy = s.DeepCopy()
+	}
+	return spec
+}
+
+// newBinding returns a new binding object, of the same scope as the placement policy.
+func newBinding(policy kfplacementv1alpha1.PlacementPolicyObj, name string) kfplacementv1alpha1.PlacementBindingObj {
+	objMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: policy.GetNamespace(),
+		Labels: map[string]string{
+			kfplacementv1alpha1.ParentPlacementPolicyLabel: policy.GetName(),
+		},
+	}
+	if policy.GetNamespace() != "" {
+		return &kfplacementv1alpha1.PlacementBinding{ObjectMeta: objMeta}
+	}
+	return &kfplacementv1alpha1.ClusterPlacementBinding{ObjectMeta: objMeta}
+}
+
+// syncBindings makes sure that each picked cluster has exactly one binding with the desired spec,
+// and deletes the bindings of the clusters that are no longer picked.
+//
+// It returns the up-to-date list of bindings of the placement policy.
+func (r *Reconciler) syncBindings(
+	ctx context.Context,
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	decision *schedulingDecision,
+	bindings []kfplacementv1alpha1.PlacementBindingObj,
+	resourceSnapshotName string,
+) ([]kfplacementv1alpha1.PlacementBindingObj, error) {
+	policyKObj := klog.KObj(policy)
+	synced := make([]kfplacementv1alpha1.PlacementBindingObj, 0, len(decision.selectorHashesByCluster))
+	bound := make(map[string]bool, len(bindings))
+	for _, binding := range bindings {
+		if binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+		clusterName := binding.GetPlacementBindingSpec().ClusterName
+		hashes, isPicked := decision.selectorHashesByCluster[clusterName]
+		if !isPicked || bound[clusterName] {
+			// The cluster is no longer picked, or a binding has been found for the cluster already.
+			if err := r.Client.Delete(ctx, binding); err != nil && !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to delete a stale binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding))
+				return nil, controller.NewAPIServerError(false, err)
+			}
+			klog.V(2).InfoS("Deleted a stale binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding), "cluster", clusterName)
+			continue
+		}
+		bound[clusterName] = true
+
+		desiredSpec := buildBindingSpec(policy, clusterName, decision.selectorsByCluster[clusterName], resourceSnapshotName)
+		desiredHashes := strings.Join(hashes, ",")
+		if equality.Semantic.DeepEqual(*binding.GetPlacementBindingSpec(), desiredSpec) &&
+			binding.GetAnnotations()[kfplacementv1alpha1.ClusterSelectorHashesAnnotation] == desiredHashes {
+			synced = append(synced, binding)
+			continue
+		}
+		*binding.GetPlacementBindingSpec() = desiredSpec
+		setClusterSelectorHashes(binding, desiredHashes)
+		if err := r.Client.Update(ctx, binding); err != nil {
+			klog.ErrorS(err, "Failed to update the binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding))
+			return nil, controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Updated the binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding), "cluster", clusterName)
+		synced = append(synced, binding)
+	}
+
+	toBind := make([]string, 0, len(decision.selectorHashesByCluster))
+	for clusterName := range decision.selectorHashesByCluster {
+		if !bound[clusterName] {
+			toBind = append(toBind, clusterName)
+		}
+	}
+	sort.Strings(toBind)
+	for _, clusterName := range toBind {
+		hashes := decision.selectorHashesByCluster[clusterName]
+		name, err := uniquename.NewBindingName(policy.GetName(), clusterName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to generate a binding name", "placementPolicy", policyKObj, "cluster", clusterName)
+			return nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		binding := newBinding(policy, name)
+		*binding.GetPlacementBindingSpec() = buildBindingSpec(policy, clusterName, decision.selectorsByCluster[clusterName], resourceSnapshotName)
+		setClusterSelectorHashes(binding, strings.Join(hashes, ","))
+		if err := controllerutil.SetControllerReference(policy, binding, r.Scheme); err != nil {
+			klog.ErrorS(err, "Failed to set the owner reference on the binding", "binding", klog.KObj(binding))
+			return nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		if err := r.Client.Create(ctx, binding); err != nil {
+			klog.ErrorS(err, "Failed to create the binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding))
+			return nil, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Created a binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding), "cluster", clusterName)
+		synced = append(synced, binding)
+	}
+	return synced, nil
+}
+
+// setClusterSelectorHashes tracks the hashes of the cluster selectors a binding fulfills in its annotations.
+func setClusterSelectorHashes(binding kfplacementv1alpha1.PlacementBindingObj, hashes string) {
+	annotations := binding.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[kfplacementv1alpha1.ClusterSelectorHashesAnnotation] = hashes
+	binding.SetAnnotations(annotations)
+}
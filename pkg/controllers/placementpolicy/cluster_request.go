@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// clusterRequestSettlingPeriod is the maximum period a stale cluster request waits for the newly
+	// created member clusters to become eligible for resource placement before it is re-evaluated.
+	clusterRequestSettlingPeriod = 5 * time.Minute
+
+	// clusterRequestPrefixHashLength is the length of the hash that replaces the truncated part of
+	// the name of a cluster request.
+	clusterRequestPrefixHashLength = 8
+)
+
+// clusterRequestNameFor returns the name of the cluster request submitted for a cluster selector of a
+// placement policy; the names of the requests submitted by placement policies are prefixed with the
+// namespace to avoid collisions, as cluster requests are cluster-scoped.
+//
+// If the name would exceed the maximum length of an object name, the prefix is truncated and
+// suffixed with a short hash of its full form, so that the name stays deterministic and unique.
+func clusterRequestNameFor(policy kfplacementv1alpha1.PlacementPolicyObj, selectorHash string) string {
+	prefix := policy.GetName()
+	if policy.GetNamespace() != "" {
+		prefix = fmt.Sprintf("%s.%s", policy.GetNamespace(), policy.GetName())
+	}
+	if len(prefix)+len(selectorHash)+1 <= validation.DNS1123SubdomainMaxLength {
+		return fmt.Sprintf("%s-%s", prefix, selectorHash)
+	}
+	prefixHash := fmt.Sprintf("%x", sha256.Sum256([]byte(prefix)))[:clusterRequestPrefixHashLength]
+	maxPrefixLen := validation.DNS1123SubdomainMaxLength - len(selectorHash) - len(prefixHash) - 2
+	truncated := strings.TrimRight(prefix[:maxPrefixLen], "-.")
+	return fmt.Sprintf("%s-%s-%s", truncated, prefixHash, selectorHash)
+}
+
+// clusterRequestSelectorFor returns the label selector that matches all the cluster requests submitted
+// by a placement policy.
+func clusterRequestSelectorFor(policy kfplacementv1alpha1.PlacementPolicyObj) (labels.Selector, error) {
+	parentReq, err := labels.NewRequirement(kfplacementv1alpha1.ParentPlacementPolicyLabel, selection.Equals, []string{policy.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	// Cluster requests submitted by cluster placement policies do not have the namespace label.
+	namespaceReq, err := labels.NewRequirement(kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel, selection.DoesNotExist, nil)
+	if policy.GetNamespace() != "" {
+		namespaceReq, err = labels.NewRequirement(kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel, selection.Equals, []string{policy.GetNamespace()})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return labels.NewSelector().Add(*parentReq, *namespaceReq), nil
+}
+
+// buildClusterRequest builds the cluster request for an unfulfilled cluster selector of a placement policy.
+func buildClusterRequest(
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	selectorHash string,
+	terms []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm,
+) *kfplacementv1alpha1.ClusterRequest {
+	requestLabels := map[string]string{
+		kfplacementv1alpha1.ParentPlacementPolicyLabel: policy.GetName(),
+		kfplacementv1alpha1.ClusterSelectorHashLabel:   selectorHash,
+	}
+	kind := kfplacementv1alpha1.ClusterPlacementPolicyKind
+	if policy.GetNamespace() != "" {
+		requestLabels[kfplacementv1alpha1.ParentPlacementPolicyNamespaceLabel] = policy.GetNamespace()
+		kind = kfplacementv1alpha1.PlacementPolicyKind
+	}
+	return &kfplacementv1alpha1.ClusterRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterRequestNameFor(policy, selectorHash),
+			Labels: requestLabels,
+		},
+		Spec: kfplacementv1alpha1.ClusterRequestSpec{
+			PlacementPolicyRef: &kfplacementv1alpha1.ObjectReference{
+				Namespace:  policy.GetNamespace(),
+				Name:       policy.GetName(),
+				APIGroup:   kfplacementv1alpha1.GroupVersion.Group,
+				APIVersion: kfplacementv1alpha1.GroupVersion.Version,
+				Kind:       kind,
+			},
+			ClusterSelectorTerms: terms,
+		},
+	}
+}
+
+// mostRecentClusterCreationTimestamp returns the most recent creation timestamp across the given
+// member clusters; it returns nil if there is no member cluster.
+func mostRecentClusterCreationTimestamp(clusters []clusterv1beta1.MemberCluster) *metav1.Time {
+	var mostRecent *metav1.Time
+	for i := range clusters {
+		if mostRecent == nil || clusters[i].CreationTimestamp.After(mostRecent.Time) {
+			mostRecent = clusters[i].CreationTimestamp.DeepCopy()
+		}
+	}
+	return mostRecent
+}
+
+// isClusterRequestStale returns if a member cluster has been created since the cluster request
+// last observed the member clusters.
+func isClusterRequestStale(request *kfplacementv1alpha1.ClusterRequest, mostRecent *metav1.Time) bool {
+	lastObserved := request.Status.LastObservedMostRecentClusterCreationTimestamp
+	if mostRecent == nil {
+		return false
+	}
+	return lastObserved == nil || mostRecent.After(lastObserved.Time)
+}
+
+// haveNewerClustersSettled returns if all the member clusters created since a cluster request last
+// observed the member clusters have become eligible for resource placement, or have been given
+// enough time to do so; only then can the cluster request be re-evaluated reliably, as the cluster
+// properties are not reported until a member cluster joins the fleet.
+func (r *Reconciler) haveNewerClustersSettled(request *kfplacementv1alpha1.ClusterRequest, clusters []clusterv1beta1.MemberCluster, mostRecent *metav1.Time) bool {
+	if time.Since(mostRecent.Time) > clusterRequestSettlingPeriod {
+		return true
+	}
+	lastObserved := request.Status.LastObservedMostRecentClusterCreationTimestamp
+	for i := range clusters {
+		cluster := &clusters[i]
+		if lastObserved != nil && !cluster.CreationTimestamp.After(lastObserved.Time) {
+			continue
+		}
+		if eligible, _ := r.ClusterEligibilityChecker.IsEligible(cluster); !eligible {
+			return false
+		}
+	}
+	return true
+}
+
+// syncClusterRequests submits a cluster request for each cluster selector of a placement policy that
+// cannot be fulfilled and asks for new clusters in such cases, marks the cluster requests as stale when
+// newer member clusters are created, and withdraws the cluster requests that are no longer needed.
+//
+// It returns the number of ongoing cluster requests of the placement policy.
+func (r *Reconciler) syncClusterRequests(
+	ctx context.Context,
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	decision *schedulingDecision,
+	clusters []clusterv1beta1.MemberCluster,
+) (int, error) {
+	policyKObj := klog.KObj(policy)
+
+	// Find the cluster selectors that need new clusters.
+	selectors := policy.GetPlacementPolicySpec().ClusterSelectors
+	requestedTerms := make(map[string][]kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm)
+	for i := range selectors {
+		d := &decision.selectorDecisions[i]
+		if d.isFulfilled() || selectors[i].WhenUnfulfilled == kfplacementv1alpha1.WhenUnfulfilledOptionKeepSearching {
+			continue
+		}
+		if _, found := requestedTerms[d.hash]; !found {
+			requestedTerms[d.hash] = selectors[i].Terms
+		}
+	}
+
+	selector, err := clusterRequestSelectorFor(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to build the label selector for cluster requests", "placementPolicy", policyKObj)
+		return 0, controller.NewUnexpectedBehaviorError(err)
+	}
+	requestList := &kfplacementv1alpha1.ClusterRequestList{}
+	if err := r.UncachedReader.List(ctx, requestList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster requests of the placement policy", "placementPolicy", policyKObj)
+		return 0, controller.NewAPIServerError(false, err)
+	}
+
+	mostRecent := mostRecentClusterCreationTimestamp(clusters)
+	ongoing := 0
+	submitted := make(map[string]bool, len(requestList.Items))
+	for i := range requestList.Items {
+		request := &requestList.Items[i]
+		if request.GetDeletionTimestamp() != nil {
+			// Do not submit a new request until the old one is gone.
+			submitted[request.Labels[kfplacementv1alpha1.ClusterSelectorHashLabel]] = true
+			continue
+		}
+		hash := request.Labels[kfplacementv1alpha1.ClusterSelectorHashLabel]
+		_, isRequested := requestedTerms[hash]
+		if !isRequested || submitted[hash] {
+			// The cluster selector has been fulfilled or removed.
+			if err := r.withdrawClusterRequest(ctx, policy, request); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		submitted[hash] = true
+
+		isCompleted := condition.IsConditionStatusTrue(meta.FindStatusCondition(request.Status.Conditions, kfplacementv1alpha1.ClusterRequestCondTypeCompleted), request.Generation)
+		if isClusterRequestStale(request, mostRecent) {
+			if !r.haveNewerClustersSettled(request, clusters, mostRecent) {
+				// Wait for the newer clusters to become eligible before re-evaluating the request.
+				if err := r.markClusterRequestStale(ctx, request); err != nil {
+					return 0, err
+				}
+				continue
+			}
+			if isCompleted {
+				// The provisioned cluster does not fulfill the cluster selector; withdraw the completed
+				// request, and submit a new one later.
+				if err := r.withdrawClusterRequest(ctx, policy, request); err != nil {
+					return 0, err
+				}
+				continue
+			}
+			// The newer clusters do not fulfill the cluster selector; re-submit the request.
+			if err := r.refreshClusterRequest(ctx, request, mostRecent); err != nil {
+				return 0, err
+			}
+		}
+		if !isCompleted {
+			ongoing++
+		}
+	}
+
+	for hash, terms := range requestedTerms {
+		if submitted[hash] {
+			continue
+		}
+		request := buildClusterRequest(policy, hash, terms)
+		if err := r.Client.Create(ctx, request); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				// The request has been submitted in the meantime; it is picked up on the next sync.
+				klog.V(2).InfoS("The cluster request has already been submitted", "placementPolicy", policyKObj, "clusterRequest", klog.KObj(request))
+				ongoing++
+				continue
+			}
+			klog.ErrorS(err, "Failed to submit a cluster request", "placementPolicy", policyKObj, "clusterRequest", klog.KObj(request))
+			return 0, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Submitted a cluster request", "placementPolicy", policyKObj, "clusterRequest", klog.KObj(request))
+		if err := r.refreshClusterRequest(ctx, request, mostRecent); err != nil {
+			return 0, err
+		}
+		ongoing++
+	}
+	return ongoing, nil
+}
+
+// markClusterRequestStale sets the Stale condition on a cluster request, which signals that a newer
+// member cluster has been created and the request is pending re-evaluation.
+func (r *Reconciler) markClusterRequestStale(ctx context.Context, request *kfplacementv1alpha1.ClusterRequest) error {
+	if condition.IsConditionStatusTrue(meta.FindStatusCondition(request.Status.Conditions, kfplacementv1alpha1.ClusterRequestCondTypeStale), request.Generation) {
+		return nil
+	}
+	meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+		Type:               kfplacementv1alpha1.ClusterRequestCondTypeStale,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.ClusterRequestStaleCondReasonNewerClusterObserved,
+		Message:            "A newer member cluster has been created; the cluster request will be re-evaluated once the cluster joins the fleet",
+		ObservedGeneration: request.Generation,
+	})
+	if err := r.Client.Status().Update(ctx, request); err != nil {
+		klog.ErrorS(err, "Failed to mark the cluster request as stale", "clusterRequest", klog.KObj(request))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Marked the cluster request as stale", "clusterRequest", klog.KObj(request))
+	return nil
+}
+
+// refreshClusterRequest records the most recent member cluster creation timestamp on a cluster request,
+// which (re-)submits the cluster request for consideration.
+func (r *Reconciler) refreshClusterRequest(ctx context.Context, request *kfplacementv1alpha1.ClusterRequest, mostRecent *metav1.Time) error {
+	request.Status.LastObservedMostRecentClusterCreationTimestamp = mostRecent
+	meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+		Type:               kfplacementv1alpha1.ClusterRequestCondTypeStale,
+		Status:             metav1.ConditionFalse,
+		Reason:             kfplacementv1alpha1.ClusterRequestStaleCondReasonReevaluated,
+		Message:            "The cluster request is still needed as no existing member cluster can fulfill it",
+		ObservedGeneration: request.Generation,
+	})
+	if err := r.Client.Status().Update(ctx, request); err != nil {
+		klog.ErrorS(err, "Failed to refresh the cluster request", "clusterRequest", klog.KObj(request))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Refreshed the cluster request", "clusterRequest", klog.KObj(request), "lastObservedMostRecentClusterCreationTimestamp", mostRecent)
+	return nil
+}
+
+// withdrawClusterRequest deletes a cluster request that is no longer needed.
+func (r *Reconciler) withdrawClusterRequest(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj, request *kfplacementv1alpha1.ClusterRequest) error {
+	if err := r.Client.Delete(ctx, request); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to withdraw the cluster request", "placementPolicy", klog.KObj(policy), "clusterRequest", klog.KObj(request))
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Withdrew the cluster request", "placementPolicy", klog.KObj(policy), "clusterRequest", klog.KObj(request))
+	return nil
+}
+
+// deleteClusterRequests deletes all the cluster requests submitted by a placement policy.
+func (r *Reconciler) deleteClusterRequests(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj) error {
+	selector, err := clusterRequestSelectorFor(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to build the label selector for cluster requests", "placementPolicy", klog.KObj(policy))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if err := r.Client.DeleteAllOf(ctx, &kfplacementv1alpha1.ClusterRequest{}, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		klog.ErrorS(err, "Failed to delete the cluster requests of the placement policy", "placementPolicy", klog.KObj(policy))
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
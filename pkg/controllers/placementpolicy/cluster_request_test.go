@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+)
+
+const (
+	policyName      = "policy"
+	policyNamespace = "app"
+)
+
+// TestClusterRequestNameFor tests the clusterRequestNameFor function.
+func TestClusterRequestNameFor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   kfplacementv1alpha1.PlacementPolicyObj
+		wantName string
+	}{
+		{
+			name: "cluster placement policy",
+			policy: &kfplacementv1alpha1.ClusterPlacementPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyName},
+			},
+			wantName: "policy-0123456789abcdef",
+		},
+		{
+			name: "placement policy",
+			policy: &kfplacementv1alpha1.PlacementPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyName, Namespace: policyNamespace},
+			},
+			wantName: "app.policy-0123456789abcdef",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clusterRequestNameFor(tc.policy, "0123456789abcdef"); got != tc.wantName {
+				t.Errorf("clusterRequestNameFor() = %s, want %s", got, tc.wantName)
+			}
+		})
+	}
+}
+
+// TestClusterRequestNameFor_LongName tests that the clusterRequestNameFor function bounds the length
+// of the name of a cluster request while keeping it deterministic and unique.
+func TestClusterRequestNameFor_LongName(t *testing.T) {
+	longName := strings.Repeat("a", validation.DNS1123SubdomainMaxLength-10)
+	policy := &kfplacementv1alpha1.PlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: longName + "-1", Namespace: policyNamespace},
+	}
+	otherPolicy := &kfplacementv1alpha1.PlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: longName + "-2", Namespace: policyNamespace},
+	}
+
+	got := clusterRequestNameFor(policy, "0123456789abcdef")
+	if len(got) > validation.DNS1123SubdomainMaxLength {
+		t.Errorf("clusterRequestNameFor() = %s of length %d, want at most %d", got, len(got), validation.DNS1123SubdomainMaxLength)
+	}
+	if errs := validation.IsDNS1123Subdomain(got); len(errs) != 0 {
+		t.Errorf("clusterRequestNameFor() = %s, want a valid object name: %v", got, errs)
+	}
+	if again := clusterRequestNameFor(policy, "0123456789abcdef"); again != got {
+		t.Errorf("clusterRequestNameFor() = %s, want the same name %s on each call", again, got)
+	}
+	if other := clusterRequestNameFor(otherPolicy, "0123456789abcdef"); other == got {
+		t.Errorf("clusterRequestNameFor() = %s for both policies, want different names", got)
+	}
+}
+
+// TestSyncClusterRequests tests the syncClusterRequests method.
+func TestSyncClusterRequests(t *testing.T) {
+	now := time.Now()
+	oldTimestamp := metav1.NewTime(now.Add(-time.Hour).Truncate(time.Second))
+	recentTimestamp := metav1.NewTime(now.Add(-time.Minute).Truncate(time.Second))
+	unsettledTimestamp := metav1.NewTime(now.Truncate(time.Second))
+
+	eastTerms := []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+		{
+			MatchLabels: map[string]string{regionLabel: regionEast},
+		},
+	}
+	eastHash := mustHashTerms(t, eastTerms)
+	policy := &kfplacementv1alpha1.ClusterPlacementPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: policyName},
+		Spec: kfplacementv1alpha1.PlacementPolicySpec{
+			ClusterSelectors: []kfplacementv1alpha1.ClusterSelector{
+				{
+					Terms: eastTerms,
+					Count: ptr.To(intstr.FromInt32(2)),
+				},
+			},
+		},
+	}
+	keepSearchingPolicy := policy.DeepCopy()
+	keepSearchingPolicy.Spec.ClusterSelectors[0].WhenUnfulfilled = kfplacementv1alpha1.WhenUnfulfilledOptionKeepSearching
+
+	clusterWithCreationTime := func(name string, ts metav1.Time) clusterv1beta1.MemberCluster {
+		c := memberCluster(name, map[string]string{regionLabel: regionWest})
+		c.CreationTimestamp = ts
+		return c
+	}
+	existingRequest := func(lastObserved *metav1.Time, completed bool) *kfplacementv1alpha1.ClusterRequest {
+		request := buildClusterRequest(policy, eastHash, eastTerms)
+		request.Status.LastObservedMostRecentClusterCreationTimestamp = lastObserved
+		if completed {
+			request.Status.Conditions = []metav1.Condition{
+				{
+					Type:               kfplacementv1alpha1.ClusterRequestCondTypeCompleted,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Provisioned",
+					LastTransitionTime: oldTimestamp,
+				},
+			}
+		}
+		return request
+	}
+	unfulfilledDecision := &schedulingDecision{
+		selectorDecisions: []selectorDecision{
+			{hash: eastHash, desiredCount: 2, minCount: 2, pickedClusters: []string{clusterName1}},
+		},
+	}
+	fulfilledDecision := &schedulingDecision{
+		selectorDecisions: []selectorDecision{
+			{hash: eastHash, desiredCount: 2, minCount: 2, pickedClusters: []string{clusterName1, clusterName2}},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		policy           kfplacementv1alpha1.PlacementPolicyObj
+		decision         *schedulingDecision
+		clusters         []clusterv1beta1.MemberCluster
+		existing         []client.Object
+		createErr        error
+		wantErr          bool
+		wantOngoing      int
+		wantLastObserved *metav1.Time
+		wantStale        *metav1.ConditionStatus
+		wantNoRequest    bool
+	}{
+		{
+			name:             "submit a request for an unfulfilled cluster selector",
+			policy:           policy,
+			decision:         unfulfilledDecision,
+			clusters:         []clusterv1beta1.MemberCluster{clusterWithCreationTime(clusterName1, oldTimestamp)},
+			wantOngoing:      1,
+			wantLastObserved: &oldTimestamp,
+			wantStale:        ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:          "do not submit a request when asked to keep searching",
+			policy:        keepSearchingPolicy,
+			decision:      unfulfilledDecision,
+			clusters:      []clusterv1beta1.MemberCluster{clusterWithCreationTime(clusterName1, oldTimestamp)},
+			wantNoRequest: true,
+		},
+		{
+			name:          "withdraw the request once the cluster selector is fulfilled",
+			policy:        policy,
+			decision:      fulfilledDecision,
+			clusters:      []clusterv1beta1.MemberCluster{clusterWithCreationTime(clusterName1, oldTimestamp)},
+			existing:      []client.Object{existingRequest(&oldTimestamp, false)},
+			wantNoRequest: true,
+		},
+		{
+			name:     "keep the request as is when no newer cluster is created",
+			policy:   policy,
+			decision: unfulfilledDecision,
+			clusters: []clusterv1beta1.MemberCluster{
+				clusterWithCreationTime(clusterName1, oldTimestamp),
+			},
+			existing:         []client.Object{existingRequest(&oldTimestamp, false)},
+			wantOngoing:      1,
+			wantLastObserved: &oldTimestamp,
+		},
+		{
+			name:     "mark the request as stale when a newer cluster has not joined yet",
+			policy:   policy,
+			decision: unfulfilledDecision,
+			clusters: []clusterv1beta1.MemberCluster{
+				clusterWithCreationTime(clusterName1, oldTimestamp),
+				clusterWithCreationTime(clusterName2, unsettledTimestamp),
+			},
+			existing:         []client.Object{existingRequest(&oldTimestamp, false)},
+			wantOngoing:      0,
+			wantLastObserved: &oldTimestamp,
+			wantStale:        ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:     "re-submit the request when the newer cluster does not fulfill the cluster selector",
+			policy:   policy,
+			decision: unfulfilledDecision,
+			clusters: []clusterv1beta1.MemberCluster{
+				clusterWithCreationTime(clusterName1, oldTimestamp),
+				joinedCluster(clusterWithCreationTime(clusterName2, recentTimestamp)),
+			},
+			existing:         []client.Object{existingRequest(&oldTimestamp, false)},
+			wantOngoing:      1,
+			wantLastObserved: &recentTimestamp,
+			wantStale:        ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:     "withdraw a completed request when the provisioned cluster does not fulfill the cluster selector",
+			policy:   policy,
+			decision: unfulfilledDecision,
+			clusters: []clusterv1beta1.MemberCluster{
+				clusterWithCreationTime(clusterName1, oldTimestamp),
+				joinedCluster(clusterWithCreationTime(clusterName2, recentTimestamp)),
+			},
+			existing:      []client.Object{existingRequest(&oldTimestamp, true)},
+			wantNoRequest: true,
+		},
+		{
+			name:          "count the request as ongoing when it has already been submitted",
+			policy:        policy,
+			decision:      unfulfilledDecision,
+			clusters:      []clusterv1beta1.MemberCluster{clusterWithCreationTime(clusterName1, oldTimestamp)},
+			createErr:     apierrors.NewAlreadyExists(schema.GroupResource{}, clusterRequestNameFor(policy, eastHash)),
+			wantOngoing:   1,
+			wantNoRequest: true,
+		},
+		{
+			name:      "fail to submit the request",
+			policy:    policy,
+			decision:  unfulfilledDecision,
+			clusters:  []clusterv1beta1.MemberCluster{clusterWithCreationTime(clusterName1, oldTimestamp)},
+			createErr: apierrors.NewServiceUnavailable("unavailable"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			if err := kfplacementv1alpha1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.existing...).
+				WithStatusSubresource(&kfplacementv1alpha1.ClusterRequest{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						if tc.createErr != nil {
+							return tc.createErr
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+			r := &Reconciler{
+				Client:                    fakeClient,
+				UncachedReader:            fakeClient,
+				ClusterEligibilityChecker: clustereligibilitychecker.New(),
+			}
+
+			gotOngoing, err := r.syncClusterRequests(ctx, tc.policy, tc.decision, tc.clusters)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("syncClusterRequests() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("syncClusterRequests() = %v, want no error", err)
+			}
+			if gotOngoing != tc.wantOngoing {
+				t.Errorf("syncClusterRequests() = %d, want %d", gotOngoing, tc.wantOngoing)
+			}
+
+			requestList := &kfplacementv1alpha1.ClusterRequestList{}
+			if err := fakeClient.List(ctx, requestList); err != nil {
+				t.Fatalf("List() = %v, want no error", err)
+			}
+			if tc.wantNoRequest {
+				if len(requestList.Items) != 0 {
+					t.Fatalf("got %d cluster requests, want none", len(requestList.Items))
+				}
+				return
+			}
+			if len(requestList.Items) != 1 {
+				t.Fatalf("got %d cluster requests, want 1", len(requestList.Items))
+			}
+			got := &requestList.Items[0]
+			want := buildClusterRequest(policy, eastHash, eastTerms)
+			if diff := cmp.Diff(got.Spec, want.Spec); diff != "" {
+				t.Errorf("cluster request spec diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(got.Labels, want.Labels); diff != "" {
+				t.Errorf("cluster request labels diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(got.Status.LastObservedMostRecentClusterCreationTimestamp, tc.wantLastObserved, cmpopts.EquateApproxTime(time.Second)); diff != "" {
+				t.Errorf("lastObservedMostRecentClusterCreationTimestamp diff (-got, +want):\n%s", diff)
+			}
+			staleCond := meta.FindStatusCondition(got.Status.Conditions, kfplacementv1alpha1.ClusterRequestCondTypeStale)
+			switch {
+			case tc.wantStale == nil && staleCond != nil:
+				t.Errorf("got Stale condition %+v, want none", staleCond)
+			case tc.wantStale != nil && (staleCond == nil || staleCond.Status != *tc.wantStale):
+				t.Errorf("got Stale condition %+v, want status %s", staleCond, *tc.wantStale)
+			}
+		})
+	}
+}
+
+// joinedCluster returns a copy of the given member cluster which has joined the fleet and is
+// eligible for resource placement.
+func joinedCluster(cluster clusterv1beta1.MemberCluster) clusterv1beta1.MemberCluster {
+	now := metav1.Now()
+	cluster.Status.AgentStatus = []clusterv1beta1.AgentStatus{
+		{
+			Type: clusterv1beta1.MemberAgent,
+			Conditions: []metav1.Condition{
+				{Type: string(clusterv1beta1.AgentJoined), Status: metav1.ConditionTrue, LastTransitionTime: now},
+				{Type: string(clusterv1beta1.AgentHealthy), Status: metav1.ConditionTrue, LastTransitionTime: now},
+			},
+			LastReceivedHeartbeat: now,
+		},
+	}
+	return cluster
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementpolicy features a controller that reconciles the PlacementPolicy and ClusterPlacementPolicy
+// objects of the kubefleet.dev/placement API group: it snapshots the selected resources, picks member clusters
+// per the cluster selectors, and keeps one binding per picked cluster.
+package placementpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// controllerResyncPeriod is the period at which a placement policy is re-reconciled, so that
+	// changes on the selected resources are picked up.
+	controllerResyncPeriod = time.Minute
+
+	// bindingCleanupRequeueDelay is the delay before checking again if all the bindings of a
+	// deleted placement policy are gone.
+	bindingCleanupRequeueDelay = 5 * time.Second
+)
+
+// Reconciler reconciles a (cluster) placement policy object.
+type Reconciler struct {
+	// Client is used to update objects which goes to the api server directly.
+	Client client.Client
+
+	// UncachedReader is the uncached read-only client for accessing Kubernetes API server; it is
+	// used when reading bindings and resource snapshots to avoid making decisions on stale data.
+	UncachedReader client.Reader
+
+	Recorder record.EventRecorder
+
+	Scheme *runtime.Scheme
+
+	// ResourceSelectorResolver selects the resources for placement policies.
+	ResourceSelectorResolver controller.ResourceSelectorResolver
+
+	// ClusterEligibilityChecker checks if a member cluster is eligible for resource placement.
+	ClusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// EnableClusterRequests indicates whether cluster requests are submitted for the cluster selectors
+	// that cannot be fulfilled.
+	EnableClusterRequests bool
+}
+
+// Reconcile reconciles a placement policy.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policyKey := req.NamespacedName
+	startTime := time.Now()
+	klog.V(2).InfoS("Placement policy reconciliation starts", "placementPolicy", policyKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Placement policy reconciliation ends", "placementPolicy", policyKey, "latency", latency)
+	}()
+
+	policy, err := controller.FetchPlacementPolicyFromKey(ctx, r.Client, policyKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound placement policy", "placementPolicy", policyKey)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get placement policy", "placementPolicy", policyKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	if policy.GetDeletionTimestamp() != nil {
+		return r.handleDelete(ctx, policy)
+	}
+
+	// register finalizer
+	if !controllerutil.ContainsFinalizer(policy, kfplacementv1alpha1.PlacementPolicyCleanupFinalizer) {
+		controllerutil.AddFinalizer(policy, kfplacementv1alpha1.PlacementPolicyCleanupFinalizer)
+		if err := r.Client.Update(ctx, policy); err != nil {
+			klog.ErrorS(err, "Failed to add placement policy finalizer", "placementPolicy", klog.KObj(policy))
+			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+	}
+	return r.handleUpdate(ctx, policy)
+}
+
+// handleDelete deletes all the bindings, resource snapshots, and cluster requests of a placement
+// policy before removing its cleanup finalizer.
+func (r *Reconciler) handleDelete(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj) (ctrl.Result, error) {
+	policyKObj := klog.KObj(policy)
+	if !controllerutil.ContainsFinalizer(policy, kfplacementv1alpha1.PlacementPolicyCleanupFinalizer) {
+		klog.V(4).InfoS("Placement policy is being deleted and no cleanup work needs to be done", "placementPolicy", policyKObj)
+		return ctrl.Result{}, nil
+	}
+
+	bindings, err := controller.ListPlacementBindingsFromKey(ctx, r.UncachedReader, controller.GetNamespacedNameFromObject(policy), false)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the bindings of the placement policy", "placementPolicy", policyKObj)
+		return ctrl.Result{}, err
+	}
+	if len(bindings) > 0 {
+		// Wait until all the bindings are gone, so that the resources are removed from the member
+		// clusters before the resource snapshots are deleted.
+		for _, binding := range bindings {
+			if binding.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if err := r.Client.Delete(ctx, binding); err != nil && !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to delete the binding", "placementPolicy", policyKObj, "binding", klog.KObj(binding))
+				return ctrl.Result{}, controller.NewAPIServerError(false, err)
+			}
+		}
+		klog.V(2).InfoS("Waiting for the bindings to be deleted", "placementPolicy", policyKObj, "bindingCount", len(bindings))
+		return ctrl.Result{RequeueAfter: bindingCleanupRequeueDelay}, nil
+	}
+
+	var snapshot kfplacementv1alpha1.PlacementResourceSnapshotObj = &kfplacementv1alpha1.ClusterPlacementResourceSnapshot{}
+	deleteOptions := []client.DeleteAllOfOption{
+		client.MatchingLabels{kfplacementv1alpha1.ParentPlacementPolicyLabel: policy.GetName()},
+	}
+	if policy.GetNamespace() != "" {
+		snapshot = &kfplacementv1alpha1.PlacementResourceSnapshot{}
+		deleteOptions = append(deleteOptions, client.InNamespace(policy.GetNamespace()))
+	}
+	if err := r.Client.DeleteAllOf(ctx, snapshot, deleteOptions...); err != nil {
+		klog.ErrorS(err, "Failed to delete the resource snapshots of the placement policy", "placementPolicy", policyKObj)
+		return ctrl.Result{}, controller.NewAPIServerError(false, err)
+	}
+	if r.EnableClusterRequests {
+		if err := r.deleteClusterRequests(ctx, policy); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(policy, kfplacementv1alpha1.PlacementPolicyCleanupFinalizer)
+	if err := r.Client.Update(ctx, policy); err != nil {
+		klog.ErrorS(err, "Failed to remove placement policy finalizer", "placementPolicy", policyKObj)
+		return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Removed placement-policy-cleanup finalizer", "placementPolicy", policyKObj)
+	r.Recorder.Event(policy, corev1.EventTypeNormal, "PlacementPolicyCleanupFinalizerRemoved", "Deleted the bindings, snapshots, and cluster requests and removed the placement policy cleanup finalizer")
+	return ctrl.Result{}, nil
+}
+
+// handleUpdate snapshots the selected resources, schedules the placement policy, syncs the
+// bindings, and refreshes the status of the placement policy.
+func (r *Reconciler) handleUpdate(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj) (ctrl.Result, error) {
+	policyKObj := klog.KObj(policy)
+	policyKey := controller.GetNamespacedNameFromObject(policy)
+	spec := policy.GetPlacementPolicySpec()
+	oldStatus := policy.GetPlacementPolicyStatus().DeepCopy()
+
+	resources, err := r.ResourceSelectorResolver.SelectResourcesForPlacementPolicy(policyKey, spec.ResourceSelectors)
+	if err != nil {
+		klog.ErrorS(err, "Failed to select the resources", "placementPolicy", policyKObj)
+		if !errors.Is(err, controller.ErrUserError) {
+			return ctrl.Result{}, err
+		}
+		// The user needs to fix the resource selectors; no need to retry until the spec changes.
+		setResourceCollectedCondition(policy, err)
+		return ctrl.Result{}, r.updateStatusIfChanged(ctx, policy, oldStatus)
+	}
+
+	snapshot, err := r.getOrCreateResourceSnapshot(ctx, policy, resources)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	policy.GetPlacementPolicyStatus().LatestResourceRevisionName = ptr.To(snapshot.GetName())
+	setResourceCollectedCondition(policy, nil)
+
+	clusters, err := r.listMemberClusters(ctx, policy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	bindings, err := controller.ListPlacementBindingsFromKey(ctx, r.UncachedReader, policyKey, false)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the bindings of the placement policy", "placementPolicy", policyKObj)
+		return ctrl.Result{}, err
+	}
+
+	decision, err := makeSchedulingDecision(spec.ClusterSelectors, r.placeableClustersOf(policy, clusters), bindings)
+	if err != nil {
+		// All the errors from the scheduling decision stem from invalid cluster selectors.
+		klog.ErrorS(err, "Failed to schedule the placement policy", "placementPolicy", policyKObj)
+		policy.SetConditions(newSchedulingFailedCondition(policy, err))
+		return ctrl.Result{}, r.updateStatusIfChanged(ctx, policy, oldStatus)
+	}
+
+	syncedBindings, err := r.syncBindings(ctx, policy, decision, bindings, snapshot.GetName())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	setScheduledCondition(policy, decision)
+	setBindingAggregatedConditions(policy, syncedBindings)
+
+	if r.EnableClusterRequests {
+		ongoing, err := r.syncClusterRequests(ctx, policy, decision, clusters)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		policy.GetPlacementPolicyStatus().OngoingClusterRequests = ptr.To(int32(ongoing))
+	}
+
+	if err := r.updateStatusIfChanged(ctx, policy, oldStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: controllerResyncPeriod}, nil
+}
+
+// listMemberClusters returns all the member clusters in the fleet.
+func (r *Reconciler) listMemberClusters(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj) ([]clusterv1beta1.MemberCluster, error) {
+	clusterList := &clusterv1beta1.MemberClusterList{}
+	if err := r.Client.List(ctx, clusterList); err != nil {
+		klog.ErrorS(err, "Failed to list member clusters", "placementPolicy", klog.KObj(policy))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return clusterList.Items, nil
+}
+
+// placeableClustersOf returns the member clusters that are eligible for resource placement and
+// whose taints are all tolerated by the placement policy.
+func (r *Reconciler) placeableClustersOf(policy kfplacementv1alpha1.PlacementPolicyObj, allClusters []clusterv1beta1.MemberCluster) []clusterv1beta1.MemberCluster {
+	tolerations := policy.GetPlacementPolicySpec().Tolerations
+	clusters := make([]clusterv1beta1.MemberCluster, 0, len(allClusters))
+	for i := range allClusters {
+		cluster := &allClusters[i]
+		if eligible, reason := r.ClusterEligibilityChecker.IsEligible(cluster); !eligible {
+			klog.V(2).InfoS("Skipped an ineligible cluster", "placementPolicy", klog.KObj(policy), "cluster", cluster.Name, "reason", reason)
+			continue
+		}
+		if taint, isUntolerated := findUntoleratedTaint(cluster.Spec.Taints, tolerations); isUntolerated {
+			klog.V(2).InfoS("Skipped a cluster with an untolerated taint", "placementPolicy", klog.KObj(policy), "cluster", cluster.Name, "taint", taint)
+			continue
+		}
+		clusters = append(clusters, *cluster)
+	}
+	return clusters
+}
+
+// updateStatusIfChanged updates the status of a placement policy if it has changed.
+func (r *Reconciler) updateStatusIfChanged(ctx context.Context, policy kfplacementv1alpha1.PlacementPolicyObj, oldStatus *kfplacementv1alpha1.PlacementPolicyStatus) error {
+	if equality.Semantic.DeepEqual(oldStatus, policy.GetPlacementPolicyStatus()) {
+		return nil
+	}
+	if err := r.Client.Status().Update(ctx, policy); err != nil {
+		klog.ErrorS(err, "Failed to update the placement policy status", "placementPolicy", klog.KObj(policy))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the placement policy status", "placementPolicy", klog.KObj(policy))
+	return nil
+}
+
+// SetupWithManagerForClusterPlacementPolicy sets up the controller with the Manager for ClusterPlacementPolicy objects.
+func (r *Reconciler) SetupWithManagerForClusterPlacementPolicy(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("cluster-placement-policy-controller")
+	return ctrl.NewControllerManagedBy(mgr).Named("cluster-placement-policy-controller").
+		For(&kfplacementv1alpha1.ClusterPlacementPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&kfplacementv1alpha1.ClusterPlacementBinding{}).
+		Watches(&clusterv1beta1.MemberCluster{}, memberClusterHandlerFuncs(r.Client, &kfplacementv1alpha1.ClusterPlacementPolicyList{})).
+		Complete(r)
+}
+
+// SetupWithManagerForPlacementPolicy sets up the controller with the Manager for PlacementPolicy objects.
+func (r *Reconciler) SetupWithManagerForPlacementPolicy(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("placement-policy-controller")
+	return ctrl.NewControllerManagedBy(mgr).Named("placement-policy-controller").
+		For(&kfplacementv1alpha1.PlacementPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&kfplacementv1alpha1.PlacementBinding{}).
+		Watches(&clusterv1beta1.MemberCluster{}, memberClusterHandlerFuncs(r.Client, &kfplacementv1alpha1.PlacementPolicyList{})).
+		Complete(r)
+}
+
+// memberClusterHandlerFuncs returns the handler functions that enqueue all the placement policies
+// in the given list type when a member cluster joins, leaves, or changes its labels, taints,
+// properties, or resource usage.
+func memberClusterHandlerFuncs(c client.Reader, policyList kfplacementv1alpha1.PlacementPolicyObjList) handler.Funcs {
+	enqueueAll := func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		list := policyList.DeepCopyObject().(kfplacementv1alpha1.PlacementPolicyObjList)
+		if err := c.List(ctx, list); err != nil {
+			klog.ErrorS(err, "Failed to list placement policies")
+			return
+		}
+		for _, policy := range list.GetPlacementPolicyObjs() {
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: policy.GetNamespace(), Name: policy.GetName()}})
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			klog.V(2).InfoS("Handling a member cluster create event", "memberCluster", klog.KObj(e.Object))
+			enqueueAll(ctx, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldCluster, oldOK := e.ObjectOld.(*clusterv1beta1.MemberCluster)
+			newCluster, newOK := e.ObjectNew.(*clusterv1beta1.MemberCluster)
+			if !oldOK || !newOK {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("received non-member-cluster objects in update event: %T, %T", e.ObjectOld, e.ObjectNew)),
+					"Failed to process a member cluster update event")
+				return
+			}
+			if equality.Semantic.DeepEqual(oldCluster.Labels, newCluster.Labels) &&
+				equality.Semantic.DeepEqual(oldCluster.Spec.Taints, newCluster.Spec.Taints) &&
+				oldCluster.GetDeletionTimestamp().Equal(newCluster.GetDeletionTimestamp()) &&
+				!clusterPropertiesChanged(oldCluster, newCluster) {
+				return
+			}
+			klog.V(2).InfoS("Handling a member cluster update event", "memberCluster", klog.KObj(newCluster))
+			enqueueAll(ctx, q)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			klog.V(2).InfoS("Handling a member cluster delete event", "memberCluster", klog.KObj(e.Object))
+			enqueueAll(ctx, q)
+		},
+	}
+}
+
+// clusterPropertiesChanged returns if the property values or the resource usage of a member
+// cluster have changed; the observation times are ignored, as they are refreshed with every
+// property collection.
+func clusterPropertiesChanged(oldCluster, newCluster *clusterv1beta1.MemberCluster) bool {
+	if len(oldCluster.Status.Properties) != len(newCluster.Status.Properties) {
+		return true
+	}
+	for name, oldValue := range oldCluster.Status.Properties {
+		newValue, found := newCluster.Status.Properties[name]
+		if !found || newValue.Value != oldValue.Value {
+			return true
+		}
+	}
+
+	oldUsage, newUsage := &oldCluster.Status.ResourceUsage, &newCluster.Status.ResourceUsage
+	return !equality.Semantic.DeepEqual(oldUsage.Capacity, newUsage.Capacity) ||
+		!equality.Semantic.DeepEqual(oldUsage.Allocatable, newUsage.Allocatable) ||
+		!equality.Semantic.DeepEqual(oldUsage.Available, newUsage.Available)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// TestClusterPropertiesChanged tests the clusterPropertiesChanged function.
+func TestClusterPropertiesChanged(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	clusterWith := func(nodeCount, allocatableCPU string, observedAt metav1.Time) *clusterv1beta1.MemberCluster {
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName1,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {Value: nodeCount, ObservationTime: observedAt},
+				},
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse(allocatableCPU),
+					},
+					ObservationTime: observedAt,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		oldCluster  *clusterv1beta1.MemberCluster
+		newCluster  *clusterv1beta1.MemberCluster
+		wantChanged bool
+	}{
+		{
+			name:       "only the observation times have changed",
+			oldCluster: clusterWith("3", "10", now),
+			newCluster: clusterWith("3", "10000m", later),
+		},
+		{
+			name:        "property value has changed",
+			oldCluster:  clusterWith("3", "10", now),
+			newCluster:  clusterWith("4", "10", later),
+			wantChanged: true,
+		},
+		{
+			name:        "property has been removed",
+			oldCluster:  clusterWith("3", "10", now),
+			newCluster:  &clusterv1beta1.MemberCluster{},
+			wantChanged: true,
+		},
+		{
+			name:        "resource usage has changed",
+			oldCluster:  clusterWith("3", "10", now),
+			newCluster:  clusterWith("3", "8", later),
+			wantChanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clusterPropertiesChanged(tc.oldCluster, tc.newCluster); got != tc.wantChanged {
+				t.Errorf("clusterPropertiesChanged() = %t, want %t", got, tc.wantChanged)
+			}
+		})
+	}
+}
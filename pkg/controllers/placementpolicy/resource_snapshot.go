@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	resourceutil "github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
+)
+
+const (
+	// defaultResourceRevisionHistoryLimit is the default number of resource snapshots kept for a placement policy.
+	defaultResourceRevisionHistoryLimit = 3
+)
+
+// resourceIndexOf returns the resource index of a resource snapshot, as tracked in its labels.
+func resourceIndexOf(snapshot client.Object) (int, error) {
+	v, ok := snapshot.GetLabels()[kfplacementv1alpha1.ResourceIndexLabel]
+	if !ok {
+		return -1, fmt.Errorf("resource snapshot %s has no resource index label", snapshot.GetName())
+	}
+	index, err := strconv.Atoi(v)
+	if err != nil || index < 0 {
+		return -1, fmt.Errorf("resource snapshot %s has an invalid resource index label %q", snapshot.GetName(), v)
+	}
+	return index, nil
+}
+
+// buildResourceSnapshot builds a resource snapshot for a placement policy.
+func buildResourceSnapshot(
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	index int,
+	resourceHash string,
+	resources []kfplacementv1alpha1.SnapshottedResource,
+) kfplacementv1alpha1.PlacementResourceSnapshotObj {
+	objMeta := metav1.ObjectMeta{
+		Name:      fmt.Sprintf(kfplacementv1alpha1.PlacementResourceSnapshotNameFmt, policy.GetName(), index),
+		Namespace: policy.GetNamespace(),
+		Labels: map[string]string{
+			kfplacementv1alpha1.ParentPlacementPolicyLabel:    policy.GetName(),
+			kfplacementv1alpha1.ResourceIndexLabel:            strconv.Itoa(index),
+			kfplacementv1alpha1.IsLatestResourceSnapshotLabel: strconv.FormatBool(true),
+		},
+		Annotations: map[string]string{
+			kfplacementv1alpha1.ResourceHashAnnotation: resourceHash,
+		},
+	}
+	spec := kfplacementv1alpha1.PlacementResourceSnapshotSpec{
+		Resources: resources,
+	}
+	if policy.GetNamespace() != "" {
+		return &kfplacementv1alpha1.PlacementResourceSnapshot{ObjectMeta: objMeta, Spec: spec}
+	}
+	return &kfplacementv1alpha1.ClusterPlacementResourceSnapshot{ObjectMeta: objMeta, Spec: spec}
+}
+
+// getOrCreateResourceSnapshot returns the latest resource snapshot of a placement policy if it
+// still matches the selected resources; otherwise it creates a new resource snapshot and marks it
+// as the latest one. Resource snapshots that exceed the revision history limit are deleted.
+func (r *Reconciler) getOrCreateResourceSnapshot(
+	ctx context.Context,
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	resources []kfplacementv1alpha1.SnapshottedResource,
+) (kfplacementv1alpha1.PlacementResourceSnapshotObj, error) {
+	policyKObj := klog.KObj(policy)
+	resourceHash, err := resourceutil.HashOf(resources)
+	if err != nil {
+		klog.ErrorS(err, "Failed to hash the selected resources", "placementPolicy", policyKObj)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+
+	// Read from the API server directly to avoid creating duplicate snapshots due to stale caches.
+	snapshots, err := controller.ListPlacementResourceSnapshotsFromKey(ctx, r.UncachedReader, controller.GetNamespacedNameFromObject(policy), false)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list resource snapshots", "placementPolicy", policyKObj)
+		return nil, err
+	}
+
+	var latest kfplacementv1alpha1.PlacementResourceSnapshotObj
+	maxIndex := -1
+	for _, s := range snapshots {
+		index, err := resourceIndexOf(s)
+		if err != nil {
+			klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found a resource snapshot with an invalid index", "resourceSnapshot", klog.KObj(s))
+			return nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		if index > maxIndex {
+			maxIndex = index
+		}
+		if s.GetLabels()[kfplacementv1alpha1.IsLatestResourceSnapshotLabel] == strconv.FormatBool(true) {
+			latest = s
+		}
+	}
+
+	if latest != nil && latest.GetAnnotations()[kfplacementv1alpha1.ResourceHashAnnotation] == resourceHash {
+		klog.V(2).InfoS("The selected resources have not changed", "placementPolicy", policyKObj, "resourceSnapshot", klog.KObj(latest))
+		return latest, nil
+	}
+
+	if latest != nil {
+		// Mark the current latest snapshot as outdated before creating a new one.
+		latest.GetLabels()[kfplacementv1alpha1.IsLatestResourceSnapshotLabel] = strconv.FormatBool(false)
+		if err := r.Client.Update(ctx, latest); err != nil {
+			klog.ErrorS(err, "Failed to mark the resource snapshot as outdated", "resourceSnapshot", klog.KObj(latest))
+			return nil, controller.NewUpdateIgnoreConflictError(err)
+		}
+	}
+
+	snapshot := buildResourceSnapshot(policy, maxIndex+1, resourceHash, resources)
+	if err := controllerutil.SetControllerReference(policy, snapshot, r.Scheme); err != nil {
+		klog.ErrorS(err, "Failed to set the owner reference on the resource snapshot", "resourceSnapshot", klog.KObj(snapshot))
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	if err := r.Client.Create(ctx, snapshot); err != nil {
+		klog.ErrorS(err, "Failed to create the resource snapshot", "resourceSnapshot", klog.KObj(snapshot))
+		return nil, controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created a new resource snapshot", "placementPolicy", policyKObj, "resourceSnapshot", klog.KObj(snapshot))
+
+	if err := r.deleteRedundantResourceSnapshots(ctx, policy, append(snapshots, snapshot)); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// deleteRedundantResourceSnapshots deletes the oldest resource snapshots of a placement policy that
+// exceed its revision history limit.
+func (r *Reconciler) deleteRedundantResourceSnapshots(
+	ctx context.Context,
+	policy kfplacementv1alpha1.PlacementPolicyObj,
+	snapshots []kfplacementv1alpha1.PlacementResourceSnapshotObj,
+) error {
+	limit := defaultResourceRevisionHistoryLimit
+	if l := policy.GetPlacementPolicySpec().ResourceRevisionHistoryLimit; l != nil && *l > 0 {
+		limit = int(*l)
+	}
+	if len(snapshots) <= limit {
+		return nil
+	}
+
+	// The indices have been validated when the snapshots are listed.
+	sort.Slice(snapshots, func(i, j int) bool {
+		a, _ := resourceIndexOf(snapshots[i])
+		b, _ := resourceIndexOf(snapshots[j])
+		return a < b
+	})
+	for _, s := range snapshots[:len(snapshots)-limit] {
+		if err := r.Client.Delete(ctx, s); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the redundant resource snapshot", "resourceSnapshot", klog.KObj(s))
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted a redundant resource snapshot", "placementPolicy", klog.KObj(policy), "resourceSnapshot", klog.KObj(s))
+	}
+	return nil
+}
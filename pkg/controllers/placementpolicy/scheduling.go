@@ -0,0 +1,402 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clusterselector"
+	resourceutil "github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
+)
+
+const (
+	// countAll is the value of the Count field in a cluster selector that asks KubeFleet to
+	// select all matching clusters.
+	countAll = "All"
+
+	// clusterSelectorHashLength is the number of characters kept from the hash of a cluster selector.
+	clusterSelectorHashLength = 16
+)
+
+// selectorDecision is the scheduling decision made for a single cluster selector.
+type selectorDecision struct {
+	// hash is the hash of the terms in the cluster selector.
+	hash string
+	// desiredCount is the number of clusters that the cluster selector asks for.
+	desiredCount int
+	// minCount is the minimum number of clusters that the cluster selector must be fulfilled with.
+	minCount int
+	// pickedClusters are the names of the clusters picked for the cluster selector.
+	pickedClusters []string
+}
+
+// isFulfilled returns if the cluster selector has been assigned at least the minimum number of clusters.
+func (d *selectorDecision) isFulfilled() bool {
+	return len(d.pickedClusters) >= d.minCount
+}
+
+// schedulingDecision is the scheduling decision made for a placement policy.
+type schedulingDecision struct {
+	// selectorDecisions are the decisions made for each cluster selector, in the same order as the
+	// cluster selectors in the placement policy.
+	selectorDecisions []selectorDecision
+	// selectorHashesByCluster maps each picked cluster to the (sorted) hashes of the cluster
+	// selectors it fulfills.
+	selectorHashesByCluster map[string][]string
+	// selectorsByCluster maps each picked cluster to the cluster selectors it fulfills.
+	selectorsByCluster map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly
+}
+
+// desiredClusters returns the total number of clusters the cluster selectors ask for.
+func (d *schedulingDecision) desiredClusters() int {
+	total := 0
+	for i := range d.selectorDecisions {
+		total += d.selectorDecisions[i].desiredCount
+	}
+	return total
+}
+
+// scheduledClusters returns the total number of clusters picked for the cluster selectors.
+func (d *schedulingDecision) scheduledClusters() int {
+	total := 0
+	for i := range d.selectorDecisions {
+		total += len(d.selectorDecisions[i].pickedClusters)
+	}
+	return total
+}
+
+// unfulfilledSelectors returns the indices of the cluster selectors that have not been assigned
+// the minimum number of clusters.
+func (d *schedulingDecision) unfulfilledSelectors() []int {
+	var unfulfilled []int
+	for i := range d.selectorDecisions {
+		if !d.selectorDecisions[i].isFulfilled() {
+			unfulfilled = append(unfulfilled, i)
+		}
+	}
+	return unfulfilled
+}
+
+// clusterSelectorHash returns the hash of the terms of a cluster selector, which identifies
+// the cluster selector among all the cluster selectors of a placement policy.
+func clusterSelectorHash(terms []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm) (string, error) {
+	hash, err := resourceutil.HashOf(terms)
+	if err != nil {
+		return "", err
+	}
+	return hash[:clusterSelectorHashLength], nil
+}
+
+// desiredAndMinCountOf returns the desired and minimum number of clusters of a cluster selector,
+// given the number of clusters that match the cluster selector.
+func desiredAndMinCountOf(selector *kfplacementv1alpha1.ClusterSelector, matched int) (int, int, error) {
+	desired := 1
+	isAll := false
+	if selector.Count != nil {
+		switch {
+		case selector.Count.Type == intstr.Int:
+			desired = selector.Count.IntValue()
+		case selector.Count.StrVal == countAll:
+			desired = matched
+			isAll = true
+		default:
+			c, err := strconv.Atoi(selector.Count.StrVal)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid count %s in cluster selector: %w", selector.Count.StrVal, err)
+			}
+			desired = c
+		}
+	}
+
+	minCount := desired
+	if isAll {
+		minCount = 1
+	}
+	if selector.MinCount != nil {
+		minCount = int(*selector.MinCount)
+	}
+	if !isAll && minCount > desired {
+		minCount = desired
+	}
+	return desired, minCount, nil
+}
+
+// makeSchedulingDecision picks clusters for each cluster selector of a placement policy.
+//
+// The clusters are expected to have been filtered for eligibility and taints; bindings are the
+// existing bindings of the placement policy, which are used to keep the decisions stable, i.e.,
+// a cluster that has been picked for a cluster selector will be picked again as long as it
+// still matches the cluster selector.
+func makeSchedulingDecision(
+	selectors []kfplacementv1alpha1.ClusterSelector,
+	clusters []clusterv1beta1.MemberCluster,
+	bindings []kfplacementv1alpha1.PlacementBindingObj,
+) (*schedulingDecision, error) {
+	decision := &schedulingDecision{
+		selectorHashesByCluster: make(map[string][]string),
+		selectorsByCluster:      make(map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly),
+	}
+
+	if len(selectors) == 0 {
+		// No cluster selector is specified; place resources on all the clusters.
+		picked := make([]string, 0, len(clusters))
+		for i := range clusters {
+			picked = append(picked, clusters[i].Name)
+			decision.selectorHashesByCluster[clusters[i].Name] = nil
+			decision.selectorsByCluster[clusters[i].Name] = nil
+		}
+		decision.selectorDecisions = []selectorDecision{{
+			desiredCount:   len(clusters),
+			minCount:       0,
+			pickedClusters: picked,
+		}}
+		return decision, nil
+	}
+
+	// Index the existing bindings by their cluster names.
+	boundSelectorHashes := make(map[string][]string, len(bindings))
+	for _, binding := range bindings {
+		if binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+		boundSelectorHashes[binding.GetPlacementBindingSpec().ClusterName] = parseClusterSelectorHashes(binding.GetAnnotations())
+	}
+
+	for i := range selectors {
+		selector := &selectors[i]
+		hash, err := clusterSelectorHash(selector.Terms)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash cluster selector %d: %w", i, err)
+		}
+
+		matched := make([]*clusterv1beta1.MemberCluster, 0, len(clusters))
+		for j := range clusters {
+			isMatched, err := matchesAnyTerm(&clusters[j], selector.Terms)
+			if err != nil {
+				return nil, fmt.Errorf("failed to match cluster %s against cluster selector %d: %w", clusters[j].Name, i, err)
+			}
+			if isMatched {
+				matched = append(matched, &clusters[j])
+			}
+		}
+
+		desired, minCount, err := desiredAndMinCountOf(selector, len(matched))
+		if err != nil {
+			return nil, err
+		}
+
+		// Rank the matched clusters. Prefer, in order,
+		// * clusters that have been bound for this cluster selector;
+		// * clusters that have been picked for other cluster selectors in this round;
+		// * clusters that have been bound for the placement policy;
+		// and break ties by cluster names.
+		rank := func(c *clusterv1beta1.MemberCluster) int {
+			hashes, isBound := boundSelectorHashes[c.Name]
+			switch {
+			case isBound && slices.Contains(hashes, hash):
+				return 0
+			case len(decision.selectorHashesByCluster[c.Name]) > 0:
+				return 1
+			case isBound:
+				return 2
+			default:
+				return 3
+			}
+		}
+		sort.SliceStable(matched, func(a, b int) bool {
+			ra, rb := rank(matched[a]), rank(matched[b])
+			if ra != rb {
+				return ra < rb
+			}
+			return matched[a].Name < matched[b].Name
+		})
+
+		picked := make([]string, 0, desired)
+		for j := 0; j < len(matched) && j < desired; j++ {
+			name := matched[j].Name
+			picked = append(picked, name)
+			decision.selectorHashesByCluster[name] = append(decision.selectorHashesByCluster[name], hash)
+			decision.selectorsByCluster[name] = append(decision.selectorsByCluster[name], kfplacementv1alpha1.ClusterSelectorWithTermsOnly{
+				Terms: selector.Terms,
+			})
+		}
+		decision.selectorDecisions = append(decision.selectorDecisions, selectorDecision{
+			hash:           hash,
+			desiredCount:   desired,
+			minCount:       minCount,
+			pickedClusters: picked,
+		})
+	}
+
+	for name := range decision.selectorHashesByCluster {
+		sort.Strings(decision.selectorHashesByCluster[name])
+	}
+	return decision, nil
+}
+
+// parseClusterSelectorHashes returns the cluster selector hashes tracked in the annotations of a binding.
+func parseClusterSelectorHashes(annotations map[string]string) []string {
+	v, ok := annotations[kfplacementv1alpha1.ClusterSelectorHashesAnnotation]
+	if !ok || len(v) == 0 {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+// matchesAnyTerm returns if a cluster matches any of the given terms; the terms are ORed, and
+// no terms at all match every cluster.
+func matchesAnyTerm(cluster *clusterv1beta1.MemberCluster, terms []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm) (bool, error) {
+	if len(terms) == 0 {
+		return true, nil
+	}
+	for i := range terms {
+		isMatched, err := matchesTerm(cluster, &terms[i])
+		if err != nil {
+			return false, err
+		}
+		if isMatched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesTerm returns if a cluster matches a term; all the requirements in a term are ANDed.
+func matchesTerm(cluster *clusterv1beta1.MemberCluster, term *kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm) (bool, error) {
+	for k, v := range term.MatchLabels {
+		if observed, ok := cluster.Labels[k]; !ok || observed != v {
+			return false, nil
+		}
+	}
+
+	for i := range term.MatchLabelExpressions {
+		exp := &term.MatchLabelExpressions[i]
+		observed, found := cluster.Labels[exp.Key]
+		isMatched, err := matchesStringExpression(exp, observed, found)
+		if err != nil {
+			return false, fmt.Errorf("invalid label expression on key %s: %w", exp.Key, err)
+		}
+		if !isMatched {
+			return false, nil
+		}
+	}
+
+	for i := range term.MatchClusterPropertyExpressions {
+		exp := &term.MatchClusterPropertyExpressions[i]
+		isMatched, err := matchesPropertyExpression(cluster, exp)
+		if err != nil {
+			return false, fmt.Errorf("invalid cluster property expression on key %s: %w", exp.Key, err)
+		}
+		if !isMatched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchesStringExpression evaluates a string-based expression against an observed value.
+func matchesStringExpression(exp *kfplacementv1alpha1.LabelClusterPropertyExpression, observed string, found bool) (bool, error) {
+	switch exp.Operator {
+	case kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorIn:
+		return found && slices.Contains(exp.Values, observed), nil
+	case kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorNotIn:
+		return !found || !slices.Contains(exp.Values, observed), nil
+	case kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorExists:
+		return found, nil
+	case kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorDoesNotExist:
+		return !found, nil
+	default:
+		return false, fmt.Errorf("operator %s is not applicable to string values", exp.Operator)
+	}
+}
+
+// propertySelectorOperators maps the operators of cluster property expressions to the operators
+// of the property selector requirements that the scheduler matches clusters with.
+var propertySelectorOperators = map[kfplacementv1alpha1.LabelClusterPropertyExpressionOperator]placementv1beta1.PropertySelectorOperator{
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorIn:     placementv1beta1.PropertySelectorIn,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorNotIn:  placementv1beta1.PropertySelectorNotIn,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorExists: placementv1beta1.PropertySelectorExists,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGt:     placementv1beta1.PropertySelectorGreaterThan,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorLt:     placementv1beta1.PropertySelectorLessThan,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGe:     placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorLe:     placementv1beta1.PropertySelectorLessThanOrEqualTo,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorEq:     placementv1beta1.PropertySelectorEqualTo,
+	kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorNe:     placementv1beta1.PropertySelectorNotEqualTo,
+}
+
+// matchesPropertyExpression evaluates a cluster property expression against a cluster, the same
+// way the scheduler matches property selector requirements.
+//
+// Note that, as with the scheduler, a cluster never matches an expression on a property that is
+// not available for the cluster, except for the DoesNotExist operator.
+func matchesPropertyExpression(cluster *clusterv1beta1.MemberCluster, exp *kfplacementv1alpha1.LabelClusterPropertyExpression) (bool, error) {
+	if exp.Operator == kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorDoesNotExist {
+		exists, err := clusterselector.MatchesPropertySelectorRequirement(cluster, &placementv1beta1.PropertySelectorRequirement{
+			Name:     exp.Key,
+			Operator: placementv1beta1.PropertySelectorExists,
+		})
+		return !exists, err
+	}
+
+	op, ok := propertySelectorOperators[exp.Operator]
+	if !ok {
+		return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+	}
+	return clusterselector.MatchesPropertySelectorRequirement(cluster, &placementv1beta1.PropertySelectorRequirement{
+		Name:     exp.Key,
+		Operator: op,
+		Values:   exp.Values,
+	})
+}
+
+// findUntoleratedTaint returns the first taint on a cluster that the given tolerations cannot tolerate.
+func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []kfplacementv1alpha1.Toleration) (*clusterv1beta1.Taint, bool) {
+	for i := range taints {
+		if !slices.ContainsFunc(tolerations, func(t kfplacementv1alpha1.Toleration) bool {
+			return canTolerationTolerateTaint(&taints[i], &t)
+		}) {
+			return &taints[i], true
+		}
+	}
+	return nil, false
+}
+
+// canTolerationTolerateTaint returns if a toleration tolerates a taint.
+func canTolerationTolerateTaint(taint *clusterv1beta1.Taint, toleration *kfplacementv1alpha1.Toleration) bool {
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		return toleration.Key == "" || toleration.Key == taint.Key
+	case corev1.TolerationOpEqual, "":
+		// Equal is the default operator.
+		return toleration.Key == taint.Key && toleration.Value == taint.Value
+	default:
+		return false
+	}
+}
@@ -0,0 +1,592 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+	clusterName3 = "cluster-3"
+
+	regionLabel = "region"
+	regionEast  = "east"
+	regionWest  = "west"
+)
+
+func memberCluster(name string, labels map[string]string) clusterv1beta1.MemberCluster {
+	return clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+func mustHashTerms(t *testing.T, terms []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm) string {
+	t.Helper()
+	hash, err := clusterSelectorHash(terms)
+	if err != nil {
+		t.Fatalf("clusterSelectorHash() = %v, want no error", err)
+	}
+	return hash
+}
+
+// TestDesiredAndMinCountOf tests the desiredAndMinCountOf function.
+func TestDesiredAndMinCountOf(t *testing.T) {
+	testCases := []struct {
+		name        string
+		selector    kfplacementv1alpha1.ClusterSelector
+		matched     int
+		wantDesired int
+		wantMin     int
+		wantErr     bool
+	}{
+		{
+			name:        "count not set",
+			selector:    kfplacementv1alpha1.ClusterSelector{},
+			matched:     3,
+			wantDesired: 1,
+			wantMin:     1,
+		},
+		{
+			name: "integer count",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count: ptr.To(intstr.FromInt32(2)),
+			},
+			matched:     3,
+			wantDesired: 2,
+			wantMin:     2,
+		},
+		{
+			name: "string count",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count: ptr.To(intstr.FromString("5")),
+			},
+			matched:     3,
+			wantDesired: 5,
+			wantMin:     5,
+		},
+		{
+			name: "count all",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count: ptr.To(intstr.FromString(countAll)),
+			},
+			matched:     3,
+			wantDesired: 3,
+			wantMin:     1,
+		},
+		{
+			name: "count all, min count set",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count:    ptr.To(intstr.FromString(countAll)),
+				MinCount: ptr.To(int32(5)),
+			},
+			matched:     3,
+			wantDesired: 3,
+			wantMin:     5,
+		},
+		{
+			name: "min count set",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count:    ptr.To(intstr.FromInt32(3)),
+				MinCount: ptr.To(int32(1)),
+			},
+			matched:     3,
+			wantDesired: 3,
+			wantMin:     1,
+		},
+		{
+			name: "min count larger than count",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count:    ptr.To(intstr.FromInt32(2)),
+				MinCount: ptr.To(int32(4)),
+			},
+			matched:     3,
+			wantDesired: 2,
+			wantMin:     2,
+		},
+		{
+			name: "invalid count",
+			selector: kfplacementv1alpha1.ClusterSelector{
+				Count: ptr.To(intstr.FromString("some")),
+			},
+			matched: 3,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotDesired, gotMin, err := desiredAndMinCountOf(&tc.selector, tc.matched)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("desiredAndMinCountOf() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("desiredAndMinCountOf() = %v, want no error", err)
+			}
+			if gotDesired != tc.wantDesired || gotMin != tc.wantMin {
+				t.Errorf("desiredAndMinCountOf() = (%d, %d), want (%d, %d)", gotDesired, gotMin, tc.wantDesired, tc.wantMin)
+			}
+		})
+	}
+}
+
+// TestMakeSchedulingDecision tests the makeSchedulingDecision function.
+func TestMakeSchedulingDecision(t *testing.T) {
+	eastTerms := []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+		{
+			MatchLabels: map[string]string{regionLabel: regionEast},
+		},
+	}
+	westTerms := []kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+		{
+			MatchLabels: map[string]string{regionLabel: regionWest},
+		},
+	}
+	eastHash := mustHashTerms(t, eastTerms)
+	westHash := mustHashTerms(t, westTerms)
+
+	clusters := []clusterv1beta1.MemberCluster{
+		memberCluster(clusterName1, map[string]string{regionLabel: regionEast}),
+		memberCluster(clusterName2, map[string]string{regionLabel: regionEast}),
+		memberCluster(clusterName3, map[string]string{regionLabel: regionWest}),
+	}
+
+	testCases := []struct {
+		name         string
+		selectors    []kfplacementv1alpha1.ClusterSelector
+		bindings     []kfplacementv1alpha1.PlacementBindingObj
+		wantDecision *schedulingDecision
+	}{
+		{
+			name: "no cluster selectors",
+			wantDecision: &schedulingDecision{
+				selectorDecisions: []selectorDecision{
+					{
+						desiredCount:   3,
+						pickedClusters: []string{clusterName1, clusterName2, clusterName3},
+					},
+				},
+				selectorHashesByCluster: map[string][]string{
+					clusterName1: nil,
+					clusterName2: nil,
+					clusterName3: nil,
+				},
+				selectorsByCluster: map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly{
+					clusterName1: nil,
+					clusterName2: nil,
+					clusterName3: nil,
+				},
+			},
+		},
+		{
+			name: "pick clusters by names",
+			selectors: []kfplacementv1alpha1.ClusterSelector{
+				{
+					Terms: eastTerms,
+				},
+				{
+					Terms: westTerms,
+					Count: ptr.To(intstr.FromInt32(2)),
+				},
+			},
+			wantDecision: &schedulingDecision{
+				selectorDecisions: []selectorDecision{
+					{
+						hash:           eastHash,
+						desiredCount:   1,
+						minCount:       1,
+						pickedClusters: []string{clusterName1},
+					},
+					{
+						hash:           westHash,
+						desiredCount:   2,
+						minCount:       2,
+						pickedClusters: []string{clusterName3},
+					},
+				},
+				selectorHashesByCluster: map[string][]string{
+					clusterName1: {eastHash},
+					clusterName3: {westHash},
+				},
+				selectorsByCluster: map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly{
+					clusterName1: {{Terms: eastTerms}},
+					clusterName3: {{Terms: westTerms}},
+				},
+			},
+		},
+		{
+			name: "prefer bound clusters",
+			selectors: []kfplacementv1alpha1.ClusterSelector{
+				{
+					Terms: eastTerms,
+				},
+			},
+			bindings: []kfplacementv1alpha1.PlacementBindingObj{
+				&kfplacementv1alpha1.ClusterPlacementBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "binding-1",
+						Annotations: map[string]string{
+							kfplacementv1alpha1.ClusterSelectorHashesAnnotation: eastHash,
+						},
+					},
+					Spec: kfplacementv1alpha1.PlacementBindingSpec{
+						ClusterName: clusterName2,
+					},
+				},
+			},
+			wantDecision: &schedulingDecision{
+				selectorDecisions: []selectorDecision{
+					{
+						hash:           eastHash,
+						desiredCount:   1,
+						minCount:       1,
+						pickedClusters: []string{clusterName2},
+					},
+				},
+				selectorHashesByCluster: map[string][]string{
+					clusterName2: {eastHash},
+				},
+				selectorsByCluster: map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly{
+					clusterName2: {{Terms: eastTerms}},
+				},
+			},
+		},
+		{
+			name: "prefer clusters picked by other cluster selectors",
+			selectors: []kfplacementv1alpha1.ClusterSelector{
+				{
+					Terms: westTerms,
+				},
+				{
+					Count: ptr.To(intstr.FromInt32(1)),
+				},
+			},
+			wantDecision: &schedulingDecision{
+				selectorDecisions: []selectorDecision{
+					{
+						hash:           westHash,
+						desiredCount:   1,
+						minCount:       1,
+						pickedClusters: []string{clusterName3},
+					},
+					{
+						hash:           mustHashTerms(t, nil),
+						desiredCount:   1,
+						minCount:       1,
+						pickedClusters: []string{clusterName3},
+					},
+				},
+				selectorHashesByCluster: map[string][]string{
+					clusterName3: sortedStrings(westHash, mustHashTerms(t, nil)),
+				},
+				selectorsByCluster: map[string][]kfplacementv1alpha1.ClusterSelectorWithTermsOnly{
+					clusterName3: {{Terms: westTerms}, {}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := makeSchedulingDecision(tc.selectors, clusters, tc.bindings)
+			if err != nil {
+				t.Fatalf("makeSchedulingDecision() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.wantDecision, cmp.AllowUnexported(schedulingDecision{}, selectorDecision{})); diff != "" {
+				t.Errorf("makeSchedulingDecision() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func sortedStrings(a, b string) []string {
+	if a < b {
+		return []string{a, b}
+	}
+	return []string{b, a}
+}
+
+// TestMatchesTerm tests the matchesTerm function.
+func TestMatchesTerm(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterName1,
+			Labels: map[string]string{regionLabel: regionEast},
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty:  {Value: "3"},
+				"tier":                              {Value: "gold"},
+				propertyprovider.K8sVersionProperty: {Value: "v1.30.2"},
+			},
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("10"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		term      kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm
+		wantMatch bool
+		wantErr   bool
+	}{
+		{
+			name:      "empty term",
+			term:      kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{},
+			wantMatch: true,
+		},
+		{
+			name: "label mismatch",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchLabels: map[string]string{regionLabel: regionWest},
+			},
+		},
+		{
+			name: "label expression NotIn",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchLabelExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      regionLabel,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorNotIn,
+						Values:   []string{regionWest},
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "label expression with numeric operator",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchLabelExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      regionLabel,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGt,
+						Values:   []string{"1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-resource property, numeric",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      propertyprovider.NodeCountProperty,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGe,
+						Values:   []string{"3"},
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "non-resource property, string",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      "tier",
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorIn,
+						Values:   []string{"silver", "gold"},
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "resource property",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      propertyprovider.AllocatableCPUCapacityProperty,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorLt,
+						Values:   []string{"8"},
+					},
+				},
+			},
+		},
+		{
+			name: "missing property",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      "zone-count",
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGt,
+						Values:   []string{"1"},
+					},
+				},
+			},
+		},
+		{
+			name: "non-resource property, NotIn",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      "tier",
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorNotIn,
+						Values:   []string{"silver", "gold"},
+					},
+				},
+			},
+		},
+		{
+			name: "version property, In",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      propertyprovider.K8sVersionProperty,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorIn,
+						Values:   []string{"1.30.2"},
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "version property, numeric",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      propertyprovider.K8sVersionProperty,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorGe,
+						Values:   []string{"1.29"},
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "missing property, DoesNotExist",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      "zone-count",
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorDoesNotExist,
+					},
+				},
+			},
+			wantMatch: true,
+		},
+		{
+			name: "invalid quantity",
+			term: kfplacementv1alpha1.ClusterLabelAndPropertySelectorTerm{
+				MatchClusterPropertyExpressions: []kfplacementv1alpha1.LabelClusterPropertyExpression{
+					{
+						Key:      propertyprovider.NodeCountProperty,
+						Operator: kfplacementv1alpha1.LabelClusterPropertyExpressionOperatorEq,
+						Values:   []string{"abc"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := matchesTerm(cluster, &tc.term)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("matchesTerm() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("matchesTerm() = %v, want no error", err)
+			}
+			if got != tc.wantMatch {
+				t.Errorf("matchesTerm() = %t, want %t", got, tc.wantMatch)
+			}
+		})
+	}
+}
+
+// TestFindUntoleratedTaint tests the findUntoleratedTaint function.
+func TestFindUntoleratedTaint(t *testing.T) {
+	taint := clusterv1beta1.Taint{
+		Key:    "key",
+		Value:  "value",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+
+	testCases := []struct {
+		name            string
+		tolerations     []kfplacementv1alpha1.Toleration
+		wantUntolerated bool
+	}{
+		{
+			name:            "no tolerations",
+			wantUntolerated: true,
+		},
+		{
+			name: "equal toleration (default operator)",
+			tolerations: []kfplacementv1alpha1.Toleration{
+				{Key: "key", Value: "value"},
+			},
+		},
+		{
+			name: "equal toleration, value mismatch",
+			tolerations: []kfplacementv1alpha1.Toleration{
+				{Key: "key", Operator: corev1.TolerationOpEqual, Value: "other"},
+			},
+			wantUntolerated: true,
+		},
+		{
+			name: "exists toleration with empty key",
+			tolerations: []kfplacementv1alpha1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+		},
+		{
+			name: "exists toleration, effect mismatch",
+			tolerations: []kfplacementv1alpha1.Toleration{
+				{Key: "key", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+			},
+			wantUntolerated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, got := findUntoleratedTaint([]clusterv1beta1.Taint{taint}, tc.tolerations)
+			if got != tc.wantUntolerated {
+				t.Errorf("findUntoleratedTaint() = %t, want %t", got, tc.wantUntolerated)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementpolicy
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// isBindingConditionTrue returns if a binding reports the given condition as true for its current generation.
+func isBindingConditionTrue(binding kfplacementv1alpha1.PlacementBindingObj, condType string) bool {
+	return condition.IsConditionStatusTrue(binding.GetCondition(condType), binding.GetGeneration())
+}
+
+// setResourceCollectedCondition sets the ResourceCollected condition on a placement policy.
+func setResourceCollectedCondition(policy kfplacementv1alpha1.PlacementPolicyObj, collectErr error) {
+	cond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementPolicyCondTypeResourceCollected,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementPolicyResourceCollectedCondReasonAllResourcesCollected,
+		Message:            "All the selected resources have been collected",
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if collectErr != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = kfplacementv1alpha1.PlacementPolicyResourceCollectedCondReasonFailedToCollectSomeResources
+		cond.Message = fmt.Sprintf("Failed to collect the selected resources: %v", collectErr)
+	}
+	policy.SetConditions(cond)
+}
+
+// setScheduledCondition sets the Scheduled condition and the cluster counts on a placement policy.
+func setScheduledCondition(policy kfplacementv1alpha1.PlacementPolicyObj, decision *schedulingDecision) {
+	status := policy.GetPlacementPolicyStatus()
+	status.DesiredClusters = ptr.To(int32(decision.desiredClusters()))
+	status.ScheduledClusters = ptr.To(int32(decision.scheduledClusters()))
+
+	cond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementPolicyCondTypeScheduled,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementPolicyScheduledCondReasonFoundAllClusters,
+		Message:            fmt.Sprintf("Found %d cluster(s) for the placement policy", decision.scheduledClusters()),
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if unfulfilled := decision.unfulfilledSelectors(); len(unfulfilled) > 0 {
+		d := &decision.selectorDecisions[unfulfilled[0]]
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = kfplacementv1alpha1.PlacementPolicyScheduledCondReasonFailedToFindSomeClusters
+		cond.Message = fmt.Sprintf("%d cluster selector(s) cannot be fulfilled; e.g., cluster selector %d requires at least %d cluster(s), but only %d matching cluster(s) are found",
+			len(unfulfilled), unfulfilled[0], d.minCount, len(d.pickedClusters))
+	}
+	policy.SetConditions(cond)
+}
+
+// newSchedulingFailedCondition returns a Scheduled condition that reports an error when evaluating
+// the cluster selectors.
+func newSchedulingFailedCondition(policy kfplacementv1alpha1.PlacementPolicyObj, err error) metav1.Condition {
+	return metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementPolicyCondTypeScheduled,
+		Status:             metav1.ConditionFalse,
+		Reason:             kfplacementv1alpha1.PlacementPolicyScheduledCondReasonFailedToFindSomeClusters,
+		Message:            fmt.Sprintf("Failed to evaluate the cluster selectors: %v", err),
+		ObservedGeneration: policy.GetGeneration(),
+	}
+}
+
+// setBindingAggregatedConditions sets the Synchronized and Available conditions and the related
+// cluster counts on a placement policy, based on the status reported on its bindings.
+func setBindingAggregatedConditions(policy kfplacementv1alpha1.PlacementPolicyObj, bindings []kfplacementv1alpha1.PlacementBindingObj) {
+	synchronized, available := 0, 0
+	for _, binding := range bindings {
+		if isBindingConditionTrue(binding, kfplacementv1alpha1.PlacementBindingCondTypeSynchronized) {
+			synchronized++
+			if isBindingConditionTrue(binding, kfplacementv1alpha1.PlacementBindingCondTypeAvailable) {
+				available++
+			}
+		}
+	}
+	status := policy.GetPlacementPolicyStatus()
+	status.SynchronizedClusters = ptr.To(int32(synchronized))
+	status.ResourcesAvailableClusters = ptr.To(int32(available))
+
+	syncedCond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementPolicyCondTypeSynchronized,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementPolicySynchronizedCondReasonAllClustersSynchronized,
+		Message:            fmt.Sprintf("Resources have been synchronized to all the %d scheduled cluster(s)", len(bindings)),
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if synchronized < len(bindings) {
+		syncedCond.Status = metav1.ConditionFalse
+		syncedCond.Reason = kfplacementv1alpha1.PlacementPolicySynchronizedCondReasonFailedToSynchronizeSomeClusters
+		syncedCond.Message = fmt.Sprintf("Resources have been synchronized to %d out of %d scheduled cluster(s)", synchronized, len(bindings))
+	}
+
+	availableCond := metav1.Condition{
+		Type:               kfplacementv1alpha1.PlacementPolicyCondTypeAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             kfplacementv1alpha1.PlacementPolicyAvailableCondReasonAllClustersAvailable,
+		Message:            fmt.Sprintf("Resources are available on all the %d scheduled cluster(s)", len(bindings)),
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if available < len(bindings) {
+		availableCond.Status = metav1.ConditionFalse
+		availableCond.Reason = kfplacementv1alpha1.PlacementPolicyAvailableCondReasonSomeClustersUnavailable
+		availableCond.Message = fmt.Sprintf("Resources are available on %d out of %d scheduled cluster(s)", available, len(bindings))
+	}
+	policy.SetConditions(syncedCond, availableCond)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementsimulation features a controller that runs the scheduler plugins for the hypothetical
+// placement policies in PlacementSimulation objects, and reports the results in their status.
+package placementsimulation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
+)
+
+const (
+	// simulationSucceededReason is the reason of the Simulated condition when the simulation has been run.
+	simulationSucceededReason = "SimulationSucceeded"
+	// invalidPolicyReason is the reason of the Simulated condition when the hypothetical policy is invalid.
+	invalidPolicyReason = "InvalidPlacementPolicy"
+
+	simulationSucceededMessageFmt = "The scheduler would pick %d cluster(s) out of the %d cluster(s) evaluated"
+)
+
+// Simulator runs the scheduler plugins for scheduling policy snapshots that are not persisted.
+type Simulator interface {
+	SimulateSchedulingFor(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) ([]placementv1beta1.ClusterDecision, []placementv1beta1.ClusterSimulationResult, error)
+}
+
+// Reconciler reconciles a PlacementSimulation object.
+type Reconciler struct {
+	client.Client
+	// Simulator is the scheduler framework used to run the simulations.
+	Simulator Simulator
+}
+
+// Reconcile runs the simulation for a PlacementSimulation object, if it has not been run for the
+// current generation of the object yet.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	simulationRef := klog.KRef("", req.Name)
+	klog.V(2).InfoS("PlacementSimulation reconciliation starts", "placementSimulation", simulationRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("PlacementSimulation reconciliation ends", "placementSimulation", simulationRef, "latency", latency)
+	}()
+
+	var simulation placementv1beta1.PlacementSimulation
+	if err := r.Client.Get(ctx, req.NamespacedName, &simulation); err != nil {
+		klog.ErrorS(err, "Failed to get placement simulation", "placementSimulation", simulationRef)
+		return runtime.Result{}, client.IgnoreNotFound(err)
+	}
+	if simulation.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Placement simulation is being deleted", "placementSimulation", simulationRef)
+		return runtime.Result{}, nil
+	}
+
+	// Each generation of the object is simulated only once.
+	simulatedCond := simulation.GetCondition(string(placementv1beta1.PlacementSimulationConditionTypeSimulated))
+	if condition.IsConditionStatusTrue(simulatedCond, simulation.Generation) || condition.IsConditionStatusFalse(simulatedCond, simulation.Generation) {
+		klog.V(2).InfoS("Placement simulation has been run for the current generation", "placementSimulation", simulationRef, "generation", simulation.Generation)
+		return runtime.Result{}, nil
+	}
+
+	policySnapshot, err := buildPolicySnapshot(&simulation)
+	if err != nil {
+		klog.V(2).InfoS("Placement simulation has an invalid placement policy", "placementSimulation", simulationRef, "error", err)
+		simulation.Status.ClusterDecisions = nil
+		simulation.Status.ClusterResults = nil
+		simulation.SetConditions(metav1.Condition{
+			Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: simulation.Generation,
+			Reason:             invalidPolicyReason,
+			Message:            err.Error(),
+		})
+		return runtime.Result{}, r.updateStatus(ctx, &simulation)
+	}
+
+	decisions, results, err := r.Simulator.SimulateSchedulingFor(ctx, policySnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to run the placement simulation", "placementSimulation", simulationRef)
+		return runtime.Result{}, err
+	}
+	selected := 0
+	for _, decision := range decisions {
+		if decision.Selected {
+			selected++
+		}
+	}
+	simulation.Status.ClusterDecisions = decisions
+	simulation.Status.ClusterResults = results
+	simulation.SetConditions(metav1.Condition{
+		Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: simulation.Generation,
+		Reason:             simulationSucceededReason,
+		Message:            fmt.Sprintf(simulationSucceededMessageFmt, selected, len(decisions)),
+	})
+	klog.V(2).InfoS("Ran the placement simulation", "placementSimulation", simulationRef, "selectedClusterCount", selected)
+	return runtime.Result{}, r.updateStatus(ctx, &simulation)
+}
+
+// buildPolicySnapshot builds an in-memory scheduling policy snapshot from the hypothetical placement
+// policy of a PlacementSimulation object; the snapshot is never persisted.
+func buildPolicySnapshot(simulation *placementv1beta1.PlacementSimulation) (*placementv1beta1.ClusterSchedulingPolicySnapshot, error) {
+	if err := validator.ValidatePlacementSimulation(simulation); err != nil {
+		return nil, err
+	}
+
+	policy := simulation.Spec.Policy.DeepCopy()
+	defaulter.SetPlacementPolicyDefaults(policy)
+	snapshot := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       simulation.Name,
+			Generation: simulation.Generation,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: policy,
+		},
+	}
+	if policy.PlacementType == placementv1beta1.PickNPlacementType {
+		// The scheduler reads the number of clusters to pick from the annotation, as it does for
+		// the policy snapshots created by the placement controller.
+		snapshot.Annotations = map[string]string{
+			placementv1beta1.NumberOfClustersAnnotation: strconv.Itoa(int(*policy.NumberOfClusters)),
+		}
+	}
+	return snapshot, nil
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, simulation *placementv1beta1.PlacementSimulation) error {
+	if err := r.Client.Status().Update(ctx, simulation); err != nil {
+		klog.ErrorS(err, "Failed to update placement simulation status", "placementSimulation", klog.KObj(simulation))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("placementsimulation-controller").
+		For(&placementv1beta1.PlacementSimulation{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementsimulation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	simulationName = "test-simulation"
+)
+
+var (
+	testDecisions = []placementv1beta1.ClusterDecision{
+		{
+			ClusterName: "member-1",
+			Selected:    true,
+			Reason:      "picked",
+		},
+		{
+			ClusterName: "member-2",
+			Selected:    false,
+			Reason:      "filtered",
+		},
+	}
+	testResults = []placementv1beta1.ClusterSimulationResult{
+		{
+			ClusterName: "member-1",
+			Feasible:    true,
+		},
+		{
+			ClusterName: "member-2",
+			Feasible:    false,
+			FilterResults: []placementv1beta1.PluginFilterResult{
+				{
+					PluginName: "plugin",
+					Reason:     "filtered",
+				},
+			},
+		},
+	}
+)
+
+// fakeSimulator is a simulator that records the policy snapshot it runs for.
+type fakeSimulator struct {
+	policy placementv1beta1.PolicySnapshotObj
+	err    error
+}
+
+func (s *fakeSimulator) SimulateSchedulingFor(_ context.Context, policy placementv1beta1.PolicySnapshotObj) ([]placementv1beta1.ClusterDecision, []placementv1beta1.ClusterSimulationResult, error) {
+	s.policy = policy
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	return testDecisions, testResults, nil
+}
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func TestReconcile(t *testing.T) {
+	ignoreConditionOption := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "Message")
+	testCases := []struct {
+		name               string
+		policy             *placementv1beta1.PlacementPolicy
+		conditions         []metav1.Condition
+		simulatorErr       error
+		wantErr            bool
+		wantSimulated      bool
+		wantPolicySnapshot *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatus         placementv1beta1.PlacementSimulationStatus
+	}{
+		{
+			name: "PickN policy",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(2)),
+				TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+					{TopologyKey: "region"},
+				},
+			},
+			wantSimulated: true,
+			wantPolicySnapshot: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       simulationName,
+					Generation: 1,
+					Annotations: map[string]string{
+						placementv1beta1.NumberOfClustersAnnotation: "2",
+					},
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(2)),
+						TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+							{
+								TopologyKey:       "region",
+								MaxSkew:           ptr.To(int32(1)),
+								WhenUnsatisfiable: placementv1beta1.DoNotSchedule,
+							},
+						},
+					},
+				},
+			},
+			wantStatus: placementv1beta1.PlacementSimulationStatus{
+				ClusterDecisions: testDecisions,
+				ClusterResults:   testResults,
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+						Reason:             simulationSucceededReason,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid policy",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+			wantStatus: placementv1beta1.PlacementSimulationStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 1,
+						Reason:             invalidPolicyReason,
+					},
+				},
+			},
+		},
+		{
+			name: "already simulated for the current generation",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Reason:             simulationSucceededReason,
+				},
+			},
+			wantStatus: placementv1beta1.PlacementSimulationStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.PlacementSimulationConditionTypeSimulated),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+						Reason:             simulationSucceededReason,
+					},
+				},
+			},
+		},
+		{
+			name: "simulator error",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+			simulatorErr:  fmt.Errorf("simulator error"),
+			wantErr:       true,
+			wantSimulated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			simulation := &placementv1beta1.PlacementSimulation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       simulationName,
+					Generation: 1,
+				},
+				Spec: placementv1beta1.PlacementSimulationSpec{
+					Policy: tc.policy,
+				},
+				Status: placementv1beta1.PlacementSimulationStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(simulation).
+				WithStatusSubresource(simulation).
+				Build()
+			simulator := &fakeSimulator{err: tc.simulatorErr}
+			r := &Reconciler{
+				Client:    fakeClient,
+				Simulator: simulator,
+			}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: simulationName}})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Reconcile() = %v, want error %t", err, tc.wantErr)
+			}
+			if gotSimulated := simulator.policy != nil; gotSimulated != tc.wantSimulated {
+				t.Fatalf("Reconcile() simulated = %t, want %t", gotSimulated, tc.wantSimulated)
+			}
+			if tc.wantPolicySnapshot != nil {
+				if diff := cmp.Diff(simulator.policy, tc.wantPolicySnapshot); diff != "" {
+					t.Errorf("Reconcile() simulated policy snapshot diff (-got, +want): %s", diff)
+				}
+			}
+			if tc.wantErr {
+				return
+			}
+
+			got := &placementv1beta1.PlacementSimulation{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: simulationName}, got); err != nil {
+				t.Fatalf("Get() placement simulation = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got.Status, tc.wantStatus, ignoreConditionOption); diff != "" {
+				t.Errorf("Reconcile() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
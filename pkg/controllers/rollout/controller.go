@@ -39,12 +39,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 )
 
@@ -160,6 +163,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 	}
 	klog.V(2).InfoS("Found the masterResourceSnapshot for the placement", "placement", placementObjRef, "masterResourceSnapshot", klog.KObj(masterResourceSnapshot))
 
+	// Mark the resource snapshot that the placement runs as fully available if it has not been marked yet,
+	// e.g., when automatic rollback is enabled after its rollout, so that it can become a rollback target.
+	if err := r.markLiveResourceSnapshotFullyAvailable(ctx, placementObj, allBindings, masterResourceSnapshot); err != nil {
+		klog.ErrorS(err, "Failed to mark the live resource snapshot of the placement as fully available", "placement", placementObjRef)
+		return runtime.Result{}, err
+	}
+
+	// Roll the placement out to its pinned resource snapshot (if any), or roll the placement back to the
+	// last fully available resource snapshot if automatic rollback is enabled and the rollout of the latest
+	// resource snapshot has failed.
+	now := time.Now()
+	masterResourceSnapshot, err = r.resolveTargetResourceSnapshot(ctx, placementObj, allBindings, masterResourceSnapshot, now)
+	if err != nil {
+		klog.ErrorS(err, "Failed to resolve the target resource snapshot for the placement", "placement", placementObjRef)
+		return runtime.Result{}, err
+	}
+	if masterResourceSnapshot == nil {
+		klog.V(2).InfoS("The pinned resource snapshot of the placement is not found, stop rolling", "placement", placementObjRef)
+		// Unpinning or re-pinning the placement should trigger the rollout controller.
+		return runtime.Result{}, nil
+	}
+
+	// Keep track of how long the rollout of the target resource snapshot has been in progress, against
+	// which the progress deadline is measured.
+	masterResourceSnapshot, err = r.updateRolloutClock(ctx, placementObj, allBindings, masterResourceSnapshot, now)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+
 	// Note: there is a corner case that an override is in-between snapshots (the old one is marked as not the latest while the new one is not created yet)
 	// This will result in one of the override is removed by the rollout controller so the first instance of the updated cluster can experience
 	// a complete removal of the override effect following by applying the new override effect.
@@ -183,7 +215,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		// There is a corner case that rollout controller succeeds to update the binding spec to the latest one,
 		// but fails to update the binding conditions when it reconciled it last time.
 		// Here it will correct the binding status just in case this happens last time.
-		return runtime.Result{}, r.checkAndUpdateStaleBindingsStatus(ctx, allBindings)
+		if err := r.checkAndUpdateStaleBindingsStatus(ctx, allBindings); err != nil {
+			return runtime.Result{}, err
+		}
+		// Mark the resource snapshot as fully available once its rollout completes, so that it can become
+		// the target of future automatic rollbacks.
+		rolloutWaitTime, err := r.markResourceSnapshotFullyAvailable(ctx, placementObj, allBindings, masterResourceSnapshot)
+		if err != nil {
+			return runtime.Result{}, err
+		}
+		return runtime.Result{RequeueAfter: rolloutWaitTime}, nil
 	}
 	klog.V(2).InfoS("Picked the bindings to be updated",
 		"placement", placementObjRef,
@@ -191,6 +232,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		"numberOfStaleBindings", len(staleBoundBindings),
 		"numberOfUpToDateBindings", len(upToDateBoundBindings))
 
+	paused := placementSpec.Strategy.Paused
+	if paused {
+		// The rollout is paused; hold back all the binding updates, and report the bindings that would
+		// have been updated as blocked by the rollout strategy, so that the status reporting continues.
+		for _, binding := range toBeUpdatedBindings {
+			state := binding.currentBinding.GetBindingSpec().State
+			if state == placementv1beta1.BindingStateScheduled || state == placementv1beta1.BindingStateBound {
+				staleBoundBindings = append(staleBoundBindings, binding)
+			}
+		}
+		toBeUpdatedBindings = nil
+		klog.V(2).InfoS("The rollout of the placement is paused; hold back all the binding updates", "placement", placementObjRef, "numberOfStaleBindings", len(staleBoundBindings))
+	}
+
 	// StaleBindings is the list that contains bindings that need to be updated (binding to a
 	// cluster, upgrading to a newer resource/override snapshot) but are blocked by
 	// the rollout strategy.
@@ -219,10 +274,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 	}
 	klog.V(2).InfoS("Successfully updated status of the up-to-date bindings", "placement", placementObjRef, "numberOfUpToDateBindings", len(upToDateBoundBindings))
 
+	if paused {
+		// Resuming the rollout should trigger the rollout controller.
+		return runtime.Result{}, nil
+	}
+
 	// Update all the bindings in parallel according to the rollout plan.
 	// We need to requeue the request regardless if the binding updates succeed or not
 	// to avoid the case that the rollout process stalling because the time based binding readiness does not trigger any event.
 	// Wait the time we need to wait for the first applied but not ready binding to be ready
+	// Also requeue the request when the progress deadline passes (if any), so that a stalled rollout can be rolled back.
+	waitTime = minPositiveDuration(waitTime, progressDeadlineWaitTime(placementObj, masterResourceSnapshot, time.Now()))
 	return runtime.Result{Requeue: true, RequeueAfter: waitTime}, r.updateBindings(ctx, toBeUpdatedBindings)
 }
 
@@ -312,7 +374,8 @@ type toBeUpdatedBinding struct {
 }
 
 func createUpdateInfo(binding placementv1beta1.BindingObj,
-	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj, cro []string, ro []placementv1beta1.NamespacedName) toBeUpdatedBinding {
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj, cro []string, ro []placementv1beta1.NamespacedName,
+	replicaDivision *placementv1beta1.ReplicaDivision) toBeUpdatedBinding {
 	desiredBinding := binding.DeepCopyObject().(placementv1beta1.BindingObj)
 
 	// Apply strategy is updated separately for all bindings.
@@ -324,6 +387,7 @@ func createUpdateInfo(binding placementv1beta1.BindingObj,
 	// TODO: check the size of the cro and ro to not exceed the limit
 	desiredSpec.ClusterResourceOverrideSnapshots = cro
 	desiredSpec.ResourceOverrideSnapshots = ro
+	desiredSpec.ReplicaDivision = replicaDivision
 
 	return toBeUpdatedBinding{
 		currentBinding: binding,
@@ -379,9 +443,16 @@ func (r *Reconciler) pickBindingsToRoll(
 	// resource/override snapshots, but might or might not have the refresh status information.
 	upToDateBoundBindings := make([]toBeUpdatedBinding, 0)
 
+	// Those are the bindings that need to be bound or updated to latest resources, but whose target
+	// clusters are outside their maintenance windows; they are reported as stale bindings.
+	outOfMaintenanceWindowBindings := make([]toBeUpdatedBinding, 0)
+	// The time to wait for the earliest maintenance window to open.
+	var minMaintenanceWindowWaitTime time.Duration
+
 	// calculate the cutoff time for a binding to be applied before so that it can be considered ready
 	placementSpec := placementObj.GetPlacementSpec()
-	readyTimeCutOff := time.Now().Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
+	now := time.Now()
+	readyTimeCutOff := now.Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
 
 	// classify the bindings into different categories
 	// Wait for the first applied but not ready binding to be ready.
@@ -389,6 +460,14 @@ func (r *Reconciler) pickBindingsToRoll(
 	minWaitTime := time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second
 	allReady := true
 	placementKObj := klog.KObj(placementObj)
+
+	// Compute the share of the replicas that each selected cluster should run, if the placement divides
+	// the replicas; a binding with a stale division is rolled out like one with stale resources.
+	replicaDivisions, err := r.replicaDivisionsFor(ctx, placementObj, allBindings)
+	if err != nil {
+		return nil, nil, nil, false, minWaitTime, err
+	}
+
 	for idx := range allBindings {
 		binding := allBindings[idx]
 		bindingKObj := klog.KObj(binding)
@@ -428,7 +507,18 @@ func (r *Reconciler) pickBindingsToRoll(
 			if err != nil {
 				return nil, nil, nil, false, minWaitTime, err
 			}
-			boundingCandidates = append(boundingCandidates, createUpdateInfo(binding, masterResourceSnapshot, cro, ro))
+			updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro, replicaDivisions[binding.GetName()])
+			open, windowWaitTime, err := maintenancewindow.CheckCluster(ctx, r.Client, bindingSpec.TargetCluster, now)
+			if err != nil {
+				return nil, nil, nil, false, minWaitTime, err
+			}
+			if !open {
+				klog.V(2).InfoS("Found a scheduled binding whose target cluster is outside its maintenance window", "placement", placementKObj, "binding", bindingKObj, "waitTime", windowWaitTime)
+				outOfMaintenanceWindowBindings = append(outOfMaintenanceWindowBindings, updateInfo)
+				minMaintenanceWindowWaitTime = minPositiveDuration(minMaintenanceWindowWaitTime, windowWaitTime)
+				continue
+			}
+			boundingCandidates = append(boundingCandidates, updateInfo)
 		case placementv1beta1.BindingStateBound:
 			bindingFailed := false
 			schedulerTargetedBinds = append(schedulerTargetedBinds, binding)
@@ -457,10 +547,20 @@ func (r *Reconciler) pickBindingsToRoll(
 				if err != nil {
 					return nil, nil, nil, false, 0, err
 				}
-				// The binding needs update if it's not pointing to the latest resource binding or the overrides.
-				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) || !equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) {
-					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
-					if bindingFailed {
+				// The binding needs update if it's not pointing to the latest resource binding, the overrides, or the replica division.
+				replicaDivision := replicaDivisions[binding.GetName()]
+				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) || !equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) ||
+					!equality.Semantic.DeepEqual(bindingSpec.ReplicaDivision, replicaDivision) {
+					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro, replicaDivision)
+					open, windowWaitTime, err := maintenancewindow.CheckCluster(ctx, r.Client, bindingSpec.TargetCluster, now)
+					if err != nil {
+						return nil, nil, nil, false, 0, err
+					}
+					if !open {
+						klog.V(2).InfoS("Found a bound binding whose target cluster is outside its maintenance window", "placement", placementKObj, "binding", bindingKObj, "waitTime", windowWaitTime)
+						outOfMaintenanceWindowBindings = append(outOfMaintenanceWindowBindings, updateInfo)
+						minMaintenanceWindowWaitTime = minPositiveDuration(minMaintenanceWindowWaitTime, windowWaitTime)
+					} else if bindingFailed {
 						// the binding has been applied but failed to apply, we can safely update it to latest resources without affecting max unavailable count
 						applyFailedUpdateCandidates = append(applyFailedUpdateCandidates, updateInfo)
 					} else {
@@ -480,6 +580,10 @@ func (r *Reconciler) pickBindingsToRoll(
 	if allReady {
 		minWaitTime = 0
 	}
+	// Wait no longer than the time for the earliest maintenance window to open, if any.
+	if minMaintenanceWindowWaitTime > 0 && (minWaitTime == 0 || minMaintenanceWindowWaitTime < minWaitTime) {
+		minWaitTime = minMaintenanceWindowWaitTime
+	}
 
 	// Calculate target number
 	targetNumber := r.calculateRealTarget(placementObj, schedulerTargetedBinds)
@@ -487,20 +591,30 @@ func (r *Reconciler) pickBindingsToRoll(
 		"targetNumber", targetNumber, "readyBindingNumber", len(readyBindings), "canBeUnavailableBindingNumber", len(canBeUnavailableBindings),
 		"canBeReadyBindingNumber", len(canBeReadyBindings), "boundingCandidateNumber", len(boundingCandidates),
 		"removeCandidateNumber", len(removeCandidates), "updateCandidateNumber", len(updateCandidates), "applyFailedUpdateCandidateNumber",
-		len(applyFailedUpdateCandidates), "minWaitTime", minWaitTime)
+		len(applyFailedUpdateCandidates), "outOfMaintenanceWindowBindingNumber", len(outOfMaintenanceWindowBindings), "minWaitTime", minWaitTime)
 
 	// the list of bindings that are to be updated by this rolling phase
 	toBeUpdatedBindingList := make([]toBeUpdatedBinding, 0)
-	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(applyFailedUpdateCandidates) == 0 {
+	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(applyFailedUpdateCandidates)+len(outOfMaintenanceWindowBindings) == 0 {
 		return toBeUpdatedBindingList, nil, upToDateBoundBindings, false, minWaitTime, nil
 	}
 
 	toBeUpdatedBindingList, staleUnselectedBinding := determineBindingsToUpdate(placementObj, removeCandidates, updateCandidates, boundingCandidates, applyFailedUpdateCandidates, targetNumber,
 		readyBindings, canBeReadyBindings, canBeUnavailableBindings)
+	// The bindings blocked by the maintenance windows of their target clusters are stale as well.
+	staleUnselectedBinding = append(staleUnselectedBinding, outOfMaintenanceWindowBindings...)
 
 	return toBeUpdatedBindingList, staleUnselectedBinding, upToDateBoundBindings, true, minWaitTime, nil
 }
 
+// minPositiveDuration returns the smaller of two durations, ignoring the non-positive one.
+func minPositiveDuration(a, b time.Duration) time.Duration {
+	if a <= 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
 // determineBindingsToUpdate determines which bindings to update
 func determineBindingsToUpdate(
 	placementObj placementv1beta1.PlacementObj,
@@ -727,8 +841,12 @@ func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr runtime.Man
 		Watches(&placementv1beta1.ClusterResourceBinding{}, bindingHandlerFuncs()).
 		// Aside from resource snapshot and binding objects, the rollout
 		// controller also watches ClusterResourcePlacement objects,
-		// so that it can push apply strategy updates to all bindings right away.
+		// so that it can push apply strategy updates to all bindings right away, and roll out replica
+		// division updates.
 		Watches(&placementv1beta1.ClusterResourcePlacement{}, placementHandlerFuncs()).
+		// The rollout controller also watches member clusters, so that it can re-divide the replicas of
+		// the placements that weigh the selected clusters by a cluster property as the property changes.
+		Watches(&clusterv1beta1.MemberCluster{}, memberClusterHandlerFuncs(r.Client, &placementv1beta1.ClusterResourcePlacementList{})).
 		Complete(r)
 }
 
@@ -747,8 +865,12 @@ func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr runtime.Manager) e
 		Watches(&placementv1beta1.ResourceBinding{}, bindingHandlerFuncs()).
 		// Aside from resource snapshot and binding objects, the rollout
 		// controller also watches ResourcePlacement objects,
-		// so that it can push apply strategy updates to all bindings right away.
+		// so that it can push apply strategy updates to all bindings right away, and roll out replica
+		// division updates.
 		Watches(&placementv1beta1.ResourcePlacement{}, placementHandlerFuncs()).
+		// The rollout controller also watches member clusters, so that it can re-divide the replicas of
+		// the placements that weigh the selected clusters by a cluster property as the property changes.
+		Watches(&clusterv1beta1.MemberCluster{}, memberClusterHandlerFuncs(r.Client, &placementv1beta1.ResourcePlacementList{})).
 		Complete(r)
 }
 
@@ -822,6 +944,60 @@ func placementHandlerFuncs() handler.Funcs {
 	}
 }
 
+// memberClusterHandlerFuncs returns the handler functions for member cluster events.
+func memberClusterHandlerFuncs(c client.Reader, placementList placementv1beta1.PlacementObjList) handler.Funcs {
+	return handler.Funcs{
+		// Ignore all Create, Delete, and Generic events; clusters joining or leaving a placement are
+		// handled through the binding events.
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldCluster, oldOK := e.ObjectOld.(*clusterv1beta1.MemberCluster)
+			newCluster, newOK := e.ObjectNew.(*clusterv1beta1.MemberCluster)
+			if !oldOK || !newOK {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("received non-member-cluster objects in update event: %T, %T", e.ObjectOld, e.ObjectNew)),
+					"Failed to process a member cluster update event")
+				return
+			}
+			if equality.Semantic.DeepEqual(oldCluster.Status.Properties, newCluster.Status.Properties) &&
+				equality.Semantic.DeepEqual(oldCluster.Status.ResourceUsage, newCluster.Status.ResourceUsage) {
+				return
+			}
+			list := placementList.DeepCopyObject().(placementv1beta1.PlacementObjList)
+			if err := c.List(ctx, list); err != nil {
+				klog.ErrorS(err, "Failed to list placements", "memberCluster", klog.KObj(newCluster))
+				return
+			}
+			klog.V(2).InfoS("Handling a member cluster update event", "memberCluster", klog.KObj(newCluster))
+			handleMemberClusterUpdated(oldCluster, newCluster, list.GetPlacementObjs(), q)
+		},
+	}
+}
+
+// handleMemberClusterUpdated enqueues the placements that weigh the selected clusters by a cluster
+// property whose value has changed on the updated member cluster.
+func handleMemberClusterUpdated(oldCluster, newCluster *clusterv1beta1.MemberCluster, placements []placementv1beta1.PlacementObj, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	for _, placement := range placements {
+		if placement.GetDeletionTimestamp() != nil {
+			continue
+		}
+		policy := placement.GetPlacementSpec().Policy
+		if policy == nil || policy.ReplicaScheduling == nil ||
+			policy.ReplicaScheduling.Type != placementv1beta1.ReplicaSchedulingTypeDivided ||
+			policy.ReplicaScheduling.WeightType != placementv1beta1.ReplicaDivisionWeightTypeClusterProperty {
+			continue
+		}
+		propertyName := policy.ReplicaScheduling.WeightPropertyName
+		oldValue, oldErr := propertyprovider.RetrievePropertyValueFrom(oldCluster, propertyName)
+		newValue, newErr := propertyprovider.RetrievePropertyValueFrom(newCluster, propertyName)
+		if (oldErr == nil) == (newErr == nil) && equality.Semantic.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		klog.V(2).InfoS("Detected an update to the property that weighs the cluster for the placement", "memberCluster", klog.KObj(newCluster), "placement", klog.KObj(placement), "property", propertyName)
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: placement.GetName(), Namespace: placement.GetNamespace()},
+		})
+	}
+}
+
 // handleClusterResourceOverrideSnapshot parse the clusterResourceOverrideSnapshot label and enqueue the CRP name associated
 // with the clusterResourceOverrideSnapshot if set.
 func handleClusterResourceOverrideSnapshot(o client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -1099,11 +1275,17 @@ func (r *Reconciler) updateBindingStatus(ctx context.Context, binding placementv
 
 // processApplyStrategyUpdates processes apply strategy updates on the placement end; specifically
 // it will push the update to all applicable bindings.
+//
+// The updates are held back while the rollout of the placement is paused.
 func (r *Reconciler) processApplyStrategyUpdates(
 	ctx context.Context,
 	placementObj placementv1beta1.PlacementObj,
 	allBindings []placementv1beta1.BindingObj,
 ) (applyStrategyUpdated bool, err error) {
+	if placementObj.GetPlacementSpec().Strategy.Paused {
+		klog.V(2).InfoS("The rollout of the placement is paused; hold back the apply strategy updates", "placement", klog.KObj(placementObj))
+		return false, nil
+	}
 	applyStrategy := placementObj.GetPlacementSpec().Strategy.ApplyStrategy
 	if applyStrategy == nil {
 		// Initialize the apply strategy with default values; normally this would not happen
@@ -1204,5 +1386,56 @@ func handlePlacement(newPlacementObj, oldPlacementObj client.Object, q workqueue
 		return
 	}
 
-	klog.V(2).InfoS("No update to apply strategy detected; ignore the placement Update event", "placement", klog.KObj(newPlacement))
+	// Check if the replica scheduling policy has been updated.
+	var newReplicaScheduling, oldReplicaScheduling *placementv1beta1.ReplicaSchedulingPolicy
+	if newPlacementSpec.Policy != nil {
+		newReplicaScheduling = newPlacementSpec.Policy.ReplicaScheduling
+	}
+	if oldPlacementSpec.Policy != nil {
+		oldReplicaScheduling = oldPlacementSpec.Policy.ReplicaScheduling
+	}
+	if !equality.Semantic.DeepEqual(newReplicaScheduling, oldReplicaScheduling) {
+		klog.V(2).InfoS("Detected an update to the replica scheduling policy on the placement", "placement", klog.KObj(newPlacement))
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: newPlacement.GetName(), Namespace: newPlacement.GetNamespace()},
+		})
+		return
+	}
+
+	// Check if the automatic rollback config has been updated, so that Fleet can mark the resource
+	// snapshot that the placement runs as a rollback target right away.
+	var newAutoRollback, oldAutoRollback *placementv1beta1.AutoRollbackConfig
+	if newPlacementSpec.Strategy.RollingUpdate != nil {
+		newAutoRollback = newPlacementSpec.Strategy.RollingUpdate.AutoRollback
+	}
+	if oldPlacementSpec.Strategy.RollingUpdate != nil {
+		oldAutoRollback = oldPlacementSpec.Strategy.RollingUpdate.AutoRollback
+	}
+	if !equality.Semantic.DeepEqual(newAutoRollback, oldAutoRollback) {
+		klog.V(2).InfoS("Detected an update to the automatic rollback config on the placement", "placement", klog.KObj(newPlacement))
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: newPlacement.GetName(), Namespace: newPlacement.GetNamespace()},
+		})
+		return
+	}
+
+	// Check if the rollout has been paused or resumed.
+	if newPlacementSpec.Strategy.Paused != oldPlacementSpec.Strategy.Paused {
+		klog.V(2).InfoS("Detected an update to the paused flag on the placement", "placement", klog.KObj(newPlacement), "paused", newPlacementSpec.Strategy.Paused)
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: newPlacement.GetName(), Namespace: newPlacement.GetNamespace()},
+		})
+		return
+	}
+
+	// Check if the pinned resource snapshot index has been updated.
+	if !equality.Semantic.DeepEqual(newPlacementSpec.PinnedResourceSnapshotIndex, oldPlacementSpec.PinnedResourceSnapshotIndex) {
+		klog.V(2).InfoS("Detected an update to the pinned resource snapshot index on the placement", "placement", klog.KObj(newPlacement))
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: newPlacement.GetName(), Namespace: newPlacement.GetNamespace()},
+		})
+		return
+	}
+
+	klog.V(2).InfoS("No update to apply strategy, replica scheduling policy, automatic rollback config, paused flag, or pinned resource snapshot index detected; ignore the placement Update event", "placement", klog.KObj(newPlacement))
 }
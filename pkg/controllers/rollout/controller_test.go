@@ -19,6 +19,7 @@ package rollout
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -1217,6 +1218,7 @@ func TestIsBindingReady(t *testing.T) {
 }
 
 func TestPickBindingsToRoll(t *testing.T) {
+	closedWindow, closedWindowWaitTime := closedMaintenanceWindowForTest()
 	tests := map[string]struct {
 		// We have to generate the bindings before calling PickBindingsToRoll instead of building them
 		// during the initialization.
@@ -1257,6 +1259,87 @@ func TestPickBindingsToRoll(t *testing.T) {
 			wantNeedRoll: true,
 			wantWaitTime: 0,
 		},
+		"test scheduled binding to bound, target cluster outside its maintenance window - rollout blocked": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, "snapshot-1", cluster1),
+				}
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp: clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: cluster1,
+					},
+					Spec: clusterv1beta1.MemberClusterSpec{
+						MaintenanceWindow: closedWindow,
+					},
+				},
+			},
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-2",
+				},
+			},
+			wantStaleUnselectedBindings: []int{0},
+			wantNeedRoll:                true,
+			wantWaitTime:                closedWindowWaitTime,
+		},
+		"test bound bindings with outdated resources, one target cluster outside its maintenance window - rollout blocked for that cluster": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster1),
+					generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster2),
+				}
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp: clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: cluster1,
+					},
+					Spec: clusterv1beta1.MemberClusterSpec{
+						MaintenanceWindow: closedWindow,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: cluster2,
+					},
+					Spec: clusterv1beta1.MemberClusterSpec{
+						// The window opens every minute and lasts for an hour, i.e., it is always open.
+						MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+							Schedule: "* * * * *",
+							Duration: metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			},
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-2",
+				},
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster2,
+					ResourceSnapshotName: "snapshot-2",
+				},
+			},
+			wantTobeUpdatedBindings:     []int{1},
+			wantStaleUnselectedBindings: []int{0},
+			wantNeedRoll:                true,
+			wantWaitTime:                closedWindowWaitTime,
+		},
 		"test scheduled binding to bound, outdated resources and updated apply strategy - rollout allowed": {
 			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
 				return []*placementv1beta1.ClusterResourceBinding{
@@ -2567,6 +2650,81 @@ func TestPickBindingsToRoll(t *testing.T) {
 			wantNeedRoll:                true,
 			wantWaitTime:                0,
 		},
+		"test bound bindings with stale replica divisions - rollout allowed for one bound binding": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster1),
+					generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster2),
+				}
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			crp: clusterResourcePlacementForTest("test",
+				func() *placementv1beta1.PlacementPolicy {
+					policy := createPlacementPolicyForTest(placementv1beta1.PickNPlacementType, 2)
+					policy.ReplicaScheduling = &placementv1beta1.ReplicaSchedulingPolicy{
+						Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+						WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+					}
+					return policy
+				}(),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, &placementv1beta1.RollingUpdateConfig{
+					MaxUnavailable: &intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: 1,
+					},
+					MaxSurge: &intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: 0,
+					},
+					UnavailablePeriodSeconds: ptr.To(1),
+				}, nil)),
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-1",
+					ReplicaDivision:      &placementv1beta1.ReplicaDivision{WeightOffset: 0, Weight: 1, TotalWeight: 2},
+				},
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster2,
+					ResourceSnapshotName: "snapshot-1",
+					ReplicaDivision:      &placementv1beta1.ReplicaDivision{WeightOffset: 1, Weight: 1, TotalWeight: 2},
+				},
+			},
+			wantTobeUpdatedBindings:     []int{0}, // the replica division is rolled out like the resources, so that maxUnavailable allows only one binding to be updated.
+			wantStaleUnselectedBindings: []int{1},
+			wantNeedRoll:                true,
+			wantWaitTime:                0,
+		},
+		"test scheduled binding to bound with replica division - rollout allowed": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateScheduledClusterResourceBindingWithReplicaDivisionForTest(cluster1),
+				}
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp: clusterResourcePlacementForTest("test",
+				func() *placementv1beta1.PlacementPolicy {
+					policy := createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0)
+					policy.ReplicaScheduling = &placementv1beta1.ReplicaSchedulingPolicy{
+						Type: placementv1beta1.ReplicaSchedulingTypeDivided,
+					}
+					return policy
+				}(),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			wantTobeUpdatedBindings: []int{0},
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-2",
+					ReplicaDivision:      &placementv1beta1.ReplicaDivision{WeightOffset: 0, Weight: 1, TotalWeight: 1},
+				},
+			},
+			wantNeedRoll: true,
+			wantWaitTime: 0,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -2647,6 +2805,25 @@ func TestPickBindingsToRoll(t *testing.T) {
 	}
 }
 
+// generateScheduledClusterResourceBindingWithReplicaDivisionForTest returns a scheduled binding that
+// carries a replica division computed before other clusters left the placement.
+func generateScheduledClusterResourceBindingWithReplicaDivisionForTest(targetCluster string) *placementv1beta1.ClusterResourceBinding {
+	binding := generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, "snapshot-1", targetCluster)
+	binding.Spec.ReplicaDivision = &placementv1beta1.ReplicaDivision{WeightOffset: 0, Weight: 1, TotalWeight: 3}
+	return binding
+}
+
+// closedMaintenanceWindowForTest returns a daily maintenance window that opens in about 12 hours,
+// along with the time left until it opens.
+func closedMaintenanceWindowForTest() (*clusterv1beta1.MaintenanceWindow, time.Duration) {
+	start := time.Now().UTC().Add(12 * time.Hour).Truncate(time.Minute)
+	window := &clusterv1beta1.MaintenanceWindow{
+		Schedule: fmt.Sprintf("%d %d * * *", start.Minute(), start.Hour()),
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	return window, time.Until(start).Round(time.Second)
+}
+
 func createPlacementPolicyForTest(placementType placementv1beta1.PlacementType, numberOfClusters int32) *placementv1beta1.PlacementPolicy {
 	return &placementv1beta1.PlacementPolicy{
 		PlacementType:    placementType,
@@ -4161,6 +4338,48 @@ func TestProcessApplyStrategyUpdates(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "paused rollout",
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:             placementv1beta1.ApplyStrategyTypeServerSideApply,
+							ComparisonOption: placementv1beta1.ComparisonOptionTypeFullComparison,
+							WhenToApply:      placementv1beta1.WhenToApplyTypeIfNotDrifted,
+							WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeIfNoDiff,
+						},
+						Paused: true,
+					},
+				},
+			},
+			allBindings: []*placementv1beta1.ClusterResourceBinding{
+				// A bound binding with an outdated apply strategy should not be updated.
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "binding-1",
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:                placementv1beta1.BindingStateBound,
+						ResourceSnapshotName: "snapshot-1",
+					},
+				},
+			},
+			wantAllBindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "binding-1",
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:                placementv1beta1.BindingStateBound,
+						ResourceSnapshotName: "snapshot-1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
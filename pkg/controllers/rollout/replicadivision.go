@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// propertyWeightScale is the scale to which Fleet normalizes the cluster property values that
+	// weigh the clusters, i.e., the values are converted into percentages of their sum.
+	propertyWeightScale = 100
+)
+
+// replicaDivisionsFor computes the share of the replicas that the target cluster of each binding should
+// run, keyed by the binding name, as instructed by the replica scheduling policy of the placement.
+//
+// Only the scheduled and bound bindings take part in the division; the division on unscheduled
+// bindings is left intact, as the resources on their target clusters are to be removed.
+//
+// The divisions are rolled out to the bindings along with the resource and override snapshots, so
+// that re-dividing the replicas as clusters join or leave the placement honors the rollout strategy.
+func (r *Reconciler) replicaDivisionsFor(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+) (map[string]*placementv1beta1.ReplicaDivision, error) {
+	bindings := make([]placementv1beta1.BindingObj, 0, len(allBindings))
+	for idx := range allBindings {
+		binding := allBindings[idx]
+		if !binding.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		state := binding.GetBindingSpec().State
+		if state != placementv1beta1.BindingStateScheduled && state != placementv1beta1.BindingStateBound {
+			continue
+		}
+		bindings = append(bindings, binding)
+	}
+	return r.calculateReplicaDivisions(ctx, placementObj.GetPlacementSpec().Policy, bindings)
+}
+
+// calculateReplicaDivisions computes the replica division of each binding, keyed by the binding name,
+// as instructed by a placement policy. No division is returned if the policy does not divide replicas.
+//
+// The clusters are laid out in the alphabetical order of their names so that the division is stable.
+func (r *Reconciler) calculateReplicaDivisions(
+	ctx context.Context,
+	policy *placementv1beta1.PlacementPolicy,
+	bindings []placementv1beta1.BindingObj,
+) (map[string]*placementv1beta1.ReplicaDivision, error) {
+	if policy == nil || policy.ReplicaScheduling == nil || policy.ReplicaScheduling.Type != placementv1beta1.ReplicaSchedulingTypeDivided {
+		return nil, nil
+	}
+
+	sorted := make([]placementv1beta1.BindingObj, len(bindings))
+	copy(sorted, bindings)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetBindingSpec().TargetCluster < sorted[j].GetBindingSpec().TargetCluster
+	})
+
+	weights, err := r.clusterWeightsFor(ctx, policy.ReplicaScheduling, sorted)
+	if err != nil {
+		return nil, err
+	}
+	var totalWeight int64
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		// No cluster has a positive weight; fall back to an even division so that the replicas
+		// are still placed.
+		for idx := range weights {
+			weights[idx] = 1
+		}
+		totalWeight = int64(len(weights))
+	}
+
+	divisions := make(map[string]*placementv1beta1.ReplicaDivision, len(sorted))
+	var offset int64
+	for idx, binding := range sorted {
+		divisions[binding.GetName()] = &placementv1beta1.ReplicaDivision{
+			WeightOffset: offset,
+			Weight:       weights[idx],
+			TotalWeight:  totalWeight,
+		}
+		offset += weights[idx]
+	}
+	return divisions, nil
+}
+
+// clusterWeightsFor returns the weights of the target clusters of the given bindings, in the same order.
+func (r *Reconciler) clusterWeightsFor(
+	ctx context.Context,
+	replicaScheduling *placementv1beta1.ReplicaSchedulingPolicy,
+	bindings []placementv1beta1.BindingObj,
+) ([]int64, error) {
+	weights := make([]int64, len(bindings))
+	switch replicaScheduling.WeightType {
+	case placementv1beta1.ReplicaDivisionWeightTypeStatic:
+		staticWeights := make(map[string]int64, len(replicaScheduling.StaticWeights))
+		for _, w := range replicaScheduling.StaticWeights {
+			staticWeights[w.ClusterName] = int64(w.Weight)
+		}
+		for idx, binding := range bindings {
+			weights[idx] = staticWeights[binding.GetBindingSpec().TargetCluster]
+		}
+	case placementv1beta1.ReplicaDivisionWeightTypeClusterProperty:
+		values := make([]float64, len(bindings))
+		var sum float64
+		for idx, binding := range bindings {
+			value, err := r.clusterPropertyValueOf(ctx, binding.GetBindingSpec().TargetCluster, replicaScheduling.WeightPropertyName)
+			if err != nil {
+				return nil, err
+			}
+			values[idx] = value
+			sum += value
+		}
+		if sum > 0 {
+			for idx := range values {
+				weights[idx] = int64(values[idx] * propertyWeightScale / sum)
+			}
+		}
+	default:
+		// Divide the replicas evenly.
+		for idx := range weights {
+			weights[idx] = 1
+		}
+	}
+	return weights, nil
+}
+
+// clusterPropertyValueOf returns the value of a property on a member cluster; it returns 0 if the
+// cluster is not found, or it does not report a valid, positive value for the property.
+func (r *Reconciler) clusterPropertyValueOf(ctx context.Context, clusterName, propertyName string) (float64, error) {
+	var cluster clusterv1beta1.MemberCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: clusterName}, &cluster); err != nil {
+		if errors.IsNotFound(err) {
+			return 0, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", clusterName)
+		return 0, controller.NewAPIServerError(true, err)
+	}
+
+	q, err := propertyprovider.RetrievePropertyValueFrom(&cluster, propertyName)
+	if err != nil {
+		// The property value cannot be parsed; the cluster receives no replicas.
+		klog.ErrorS(err, "Failed to retrieve the property value that weighs the cluster", "memberCluster", clusterName, "property", propertyName)
+		return 0, nil
+	}
+	if q == nil || q.Sign() <= 0 {
+		return 0, nil
+	}
+	return q.AsApproximateFloat64(), nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+func bindingForReplicaDivisionTest(name, targetCluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: targetCluster,
+		},
+	}
+}
+
+func memberClusterWithAvailableCPU(name, cpu string) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse(cpu),
+				},
+			},
+		},
+	}
+}
+
+// TestCalculateReplicaDivisions tests the calculateReplicaDivisions method.
+func TestCalculateReplicaDivisions(t *testing.T) {
+	bindings := []*placementv1beta1.ClusterResourceBinding{
+		bindingForReplicaDivisionTest("binding-c", cluster3, placementv1beta1.BindingStateBound),
+		bindingForReplicaDivisionTest("binding-a", cluster1, placementv1beta1.BindingStateBound),
+		bindingForReplicaDivisionTest("binding-b", cluster2, placementv1beta1.BindingStateScheduled),
+	}
+	clusters := []client.Object{
+		memberClusterWithAvailableCPU(cluster1, "6"),
+		memberClusterWithAvailableCPU(cluster2, "2"),
+		memberClusterWithAvailableCPU(cluster3, "0"),
+	}
+
+	testCases := []struct {
+		name          string
+		policy        *placementv1beta1.PlacementPolicy
+		wantDivisions map[string]*placementv1beta1.ReplicaDivision
+	}{
+		{
+			name: "no replica scheduling policy",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+		},
+		{
+			name: "duplicated replicas",
+			policy: &placementv1beta1.PlacementPolicy{
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type: placementv1beta1.ReplicaSchedulingTypeDuplicated,
+				},
+			},
+		},
+		{
+			name: "even division",
+			policy: &placementv1beta1.PlacementPolicy{
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+				},
+			},
+			wantDivisions: map[string]*placementv1beta1.ReplicaDivision{
+				"binding-a": {WeightOffset: 0, Weight: 1, TotalWeight: 3},
+				"binding-b": {WeightOffset: 1, Weight: 1, TotalWeight: 3},
+				"binding-c": {WeightOffset: 2, Weight: 1, TotalWeight: 3},
+			},
+		},
+		{
+			name: "static weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: cluster1, Weight: 3},
+						{ClusterName: cluster3, Weight: 2},
+					},
+				},
+			},
+			wantDivisions: map[string]*placementv1beta1.ReplicaDivision{
+				"binding-a": {WeightOffset: 0, Weight: 3, TotalWeight: 5},
+				"binding-b": {WeightOffset: 3, Weight: 0, TotalWeight: 5},
+				"binding-c": {WeightOffset: 3, Weight: 2, TotalWeight: 5},
+			},
+		},
+		{
+			name: "static weights all zero",
+			policy: &placementv1beta1.PlacementPolicy{
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+				},
+			},
+			wantDivisions: map[string]*placementv1beta1.ReplicaDivision{
+				"binding-a": {WeightOffset: 0, Weight: 1, TotalWeight: 3},
+				"binding-b": {WeightOffset: 1, Weight: 1, TotalWeight: 3},
+				"binding-c": {WeightOffset: 2, Weight: 1, TotalWeight: 3},
+			},
+		},
+		{
+			name: "cluster property weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType:         placementv1beta1.ReplicaDivisionWeightTypeClusterProperty,
+					WeightPropertyName: propertyprovider.AvailableCPUCapacityProperty,
+				},
+			},
+			wantDivisions: map[string]*placementv1beta1.ReplicaDivision{
+				"binding-a": {WeightOffset: 0, Weight: 75, TotalWeight: 100},
+				"binding-b": {WeightOffset: 75, Weight: 25, TotalWeight: 100},
+				"binding-c": {WeightOffset: 100, Weight: 0, TotalWeight: 100},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(clusters...).
+				Build()
+			r := &Reconciler{
+				Client: fakeClient,
+			}
+
+			divisions, err := r.calculateReplicaDivisions(context.Background(), tc.policy, controller.ConvertCRBArrayToBindingObjs(bindings))
+			if err != nil {
+				t.Fatalf("calculateReplicaDivisions() error = %v, want no error", err)
+			}
+			if diff := cmp.Diff(divisions, tc.wantDivisions); diff != "" {
+				t.Errorf("calculateReplicaDivisions() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReplicaDivisionsFor tests the replicaDivisionsFor method.
+func TestReplicaDivisionsFor(t *testing.T) {
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-crp",
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+				},
+			},
+		},
+	}
+
+	boundBinding := bindingForReplicaDivisionTest("binding-a", cluster1, placementv1beta1.BindingStateBound)
+	scheduledBinding := bindingForReplicaDivisionTest("binding-b", cluster2, placementv1beta1.BindingStateScheduled)
+	unscheduledBinding := bindingForReplicaDivisionTest("binding-c", cluster3, placementv1beta1.BindingStateUnscheduled)
+	deletingBinding := bindingForReplicaDivisionTest("binding-d", cluster4, placementv1beta1.BindingStateBound)
+	deletingBinding.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	allBindings := []*placementv1beta1.ClusterResourceBinding{boundBinding, scheduledBinding, unscheduledBinding, deletingBinding}
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithScheme(serviceScheme(t)).Build(),
+	}
+	divisions, err := r.replicaDivisionsFor(context.Background(), crp, controller.ConvertCRBArrayToBindingObjs(allBindings))
+	if err != nil {
+		t.Fatalf("replicaDivisionsFor() error = %v, want no error", err)
+	}
+
+	// Only the scheduled and bound bindings that are not being deleted take part in the division.
+	wantDivisions := map[string]*placementv1beta1.ReplicaDivision{
+		"binding-a": {WeightOffset: 0, Weight: 1, TotalWeight: 2},
+		"binding-b": {WeightOffset: 1, Weight: 1, TotalWeight: 2},
+	}
+	if diff := cmp.Diff(divisions, wantDivisions); diff != "" {
+		t.Errorf("replicaDivisionsFor() mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestHandleMemberClusterUpdated tests the handleMemberClusterUpdated function.
+func TestHandleMemberClusterUpdated(t *testing.T) {
+	placementWith := func(name string, replicaScheduling *placementv1beta1.ReplicaSchedulingPolicy) placementv1beta1.PlacementObj {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:     placementv1beta1.PickAllPlacementType,
+					ReplicaScheduling: replicaScheduling,
+				},
+			},
+		}
+	}
+	placements := []placementv1beta1.PlacementObj{
+		placementWith("duplicated", nil),
+		placementWith("even", &placementv1beta1.ReplicaSchedulingPolicy{
+			Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+			WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+		}),
+		placementWith("by-cpu", &placementv1beta1.ReplicaSchedulingPolicy{
+			Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+			WeightType:         placementv1beta1.ReplicaDivisionWeightTypeClusterProperty,
+			WeightPropertyName: propertyprovider.AvailableCPUCapacityProperty,
+		}),
+	}
+
+	tests := map[string]struct {
+		oldCluster      *clusterv1beta1.MemberCluster
+		newCluster      *clusterv1beta1.MemberCluster
+		wantEnqueueKeys []reconcile.Request
+	}{
+		"weight property changed": {
+			oldCluster: memberClusterWithAvailableCPU(cluster1, "4"),
+			newCluster: memberClusterWithAvailableCPU(cluster1, "2"),
+			wantEnqueueKeys: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "by-cpu"}},
+			},
+		},
+		"weight property unchanged": {
+			oldCluster: memberClusterWithAvailableCPU(cluster1, "4"),
+			newCluster: memberClusterWithAvailableCPU(cluster1, "4000m"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := &controllertest.Queue{TypedInterface: workqueue.NewTypedRateLimitingQueue[reconcile.Request](workqueue.DefaultTypedItemBasedRateLimiter[reconcile.Request]())}
+			handleMemberClusterUpdated(tt.oldCluster, tt.newCluster, placements, queue)
+			validateEnqueueBehavior(t, queue, tt.wantEnqueueKeys)
+		})
+	}
+}
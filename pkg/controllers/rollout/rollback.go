@@ -0,0 +1,476 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/labels"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
+)
+
+const (
+	// eventReasonRolloutRolledBack is the reason of the event emitted when Fleet rolls a placement back.
+	eventReasonRolloutRolledBack = "RolloutRolledBack"
+
+	// maxFailedClustersInRollbackReason is the max number of failed clusters listed in the reason of a rollback.
+	maxFailedClustersInRollbackReason = 3
+)
+
+// resolveTargetResourceSnapshot returns the master resource snapshot that the bindings of a placement
+// should point to, which is the latest master resource snapshot, unless:
+//   - the placement is pinned to a resource snapshot, in which case the pinned master resource snapshot
+//     is returned, or nil if it cannot be found; or
+//   - the placement has automatic rollback enabled and the rollout of the latest resource snapshot has
+//     failed, in which case the placement is rolled back to the last resource snapshot that was fully available.
+func (r *Reconciler) resolveTargetResourceSnapshot(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	latestResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	now time.Time,
+) (placementv1beta1.ResourceSnapshotObj, error) {
+	placementSpec := placementObj.GetPlacementSpec()
+	placementKObj := klog.KObj(placementObj)
+	if pinnedIndex := placementSpec.PinnedResourceSnapshotIndex; pinnedIndex != nil {
+		pinned, err := controller.FetchMasterResourceSnapshotWithIndex(ctx, r.Client, *pinnedIndex, placementObj.GetName(), placementObj.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if pinned == nil {
+			// This is a user error; the rollout is held back until the placement is unpinned or re-pinned, and
+			// the placement controller reports it in the Pinned condition of the placement.
+			klog.V(2).InfoS("The pinned resource snapshot of the placement does not exist", "placement", placementKObj, "resourceIndex", *pinnedIndex)
+			return nil, nil
+		}
+		klog.V(2).InfoS("The placement is pinned to a resource snapshot", "placement", placementKObj, "pinnedResourceSnapshot", klog.KObj(pinned))
+		return pinned, nil
+	}
+
+	rollingUpdate := placementSpec.Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.AutoRollback == nil {
+		return latestResourceSnapshot, nil
+	}
+
+	annotations := latestResourceSnapshot.GetAnnotations()
+	if rolledBackToIndex, ok := annotations[placementv1beta1.RolledBackToResourceIndexAnnotation]; ok {
+		// The placement has been rolled back from the latest resource snapshot; keep it on the resource
+		// snapshot it has been rolled back to until a newer resource snapshot is created.
+		target, err := controller.FetchMasterResourceSnapshotWithIndex(ctx, r.Client, rolledBackToIndex, placementObj.GetName(), placementObj.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			err := controller.NewUnexpectedBehaviorError(fmt.Errorf("the resource snapshot with index %s that placement %s has been rolled back to is not found", rolledBackToIndex, placementKObj))
+			klog.ErrorS(err, "Failed to find the resource snapshot the placement has been rolled back to", "placement", placementKObj, "resourceIndex", rolledBackToIndex)
+			return nil, err
+		}
+		klog.V(2).InfoS("The placement has been rolled back from the latest resource snapshot", "placement", placementKObj,
+			"latestResourceSnapshot", klog.KObj(latestResourceSnapshot), "targetResourceSnapshot", klog.KObj(target))
+		return target, nil
+	}
+	if annotations[placementv1beta1.FullyAvailableAnnotation] == strconv.FormatBool(true) {
+		// The rollout of the latest resource snapshot has completed; there is no need to roll back.
+		return latestResourceSnapshot, nil
+	}
+	if placementSpec.Strategy.Paused {
+		// The rollout is held back while paused, which must not count against it; the rollout is
+		// evaluated again once it is resumed.
+		klog.V(2).InfoS("The rollout of the placement is paused, skip evaluating it for automatic rollback", "placement", placementKObj)
+		return latestResourceSnapshot, nil
+	}
+
+	reason, failed := rolloutFailureReason(placementObj, allBindings, latestResourceSnapshot, now)
+	if !failed {
+		return latestResourceSnapshot, nil
+	}
+	target, err := r.findRollbackTarget(ctx, placementObj, latestResourceSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		klog.V(2).InfoS("The rollout of the latest resource snapshot has failed, but there is no fully available resource snapshot to roll back to",
+			"placement", placementKObj, "latestResourceSnapshot", klog.KObj(latestResourceSnapshot), "reason", reason)
+		return latestResourceSnapshot, nil
+	}
+	targetIndex := target.GetLabels()[placementv1beta1.ResourceIndexLabel]
+
+	// Record the rollback on the latest resource snapshot, so that Fleet keeps the placement on the
+	// target resource snapshot in the subsequent reconciliations.
+	updatedResourceSnapshot := latestResourceSnapshot.DeepCopyObject().(placementv1beta1.ResourceSnapshotObj)
+	updatedAnnotations := updatedResourceSnapshot.GetAnnotations()
+	updatedAnnotations[placementv1beta1.RolledBackToResourceIndexAnnotation] = targetIndex
+	updatedAnnotations[placementv1beta1.RollbackReasonAnnotation] = reason
+	updatedResourceSnapshot.SetAnnotations(updatedAnnotations)
+	if err := r.Client.Patch(ctx, updatedResourceSnapshot, client.MergeFrom(latestResourceSnapshot)); err != nil {
+		klog.ErrorS(err, "Failed to record the rollback on the latest resource snapshot", "placement", placementKObj, "latestResourceSnapshot", klog.KObj(latestResourceSnapshot))
+		return nil, controller.NewUpdateIgnoreConflictError(err)
+	}
+	latestIndex := latestResourceSnapshot.GetLabels()[placementv1beta1.ResourceIndexLabel]
+	klog.V(2).InfoS("Rolled the placement back as the rollout of the latest resource snapshot has failed", "placement", placementKObj,
+		"latestResourceSnapshot", klog.KObj(latestResourceSnapshot), "targetResourceSnapshot", klog.KObj(target), "reason", reason)
+	r.recorder.Eventf(placementObj, corev1.EventTypeWarning, eventReasonRolloutRolledBack,
+		"Rolled back from resource snapshot index %s to %s: %s", latestIndex, targetIndex, reason)
+	return target, nil
+}
+
+// rolloutFailureReason checks if the rollout of a resource snapshot has failed per the automatic
+// rollback config of the placement; if so, it also returns the reason of the failure.
+func rolloutFailureReason(
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	resourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	now time.Time,
+) (string, bool) {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	failedClusters := make([]string, 0)
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if !binding.GetDeletionTimestamp().IsZero() || bindingSpec.State != placementv1beta1.BindingStateBound ||
+			bindingSpec.ResourceSnapshotName != resourceSnapshot.GetName() {
+			continue
+		}
+		if bindingutils.HasBindingFailed(binding) {
+			failedClusters = append(failedClusters, bindingSpec.TargetCluster)
+		}
+	}
+	if failureThreshold := *rollingUpdate.AutoRollback.FailureThreshold; len(failedClusters) >= failureThreshold {
+		shownClusters := failedClusters
+		if len(shownClusters) > maxFailedClustersInRollbackReason {
+			shownClusters = append(shownClusters[:maxFailedClustersInRollbackReason:maxFailedClustersInRollbackReason], "...")
+		}
+		return fmt.Sprintf("the resources failed to apply or to become available on %d cluster(s) (%s), reaching the failure threshold %d",
+			len(failedClusters), strings.Join(shownClusters, ", "), failureThreshold), true
+	}
+
+	if rollingUpdate.ProgressDeadlineSeconds != nil {
+		progressDeadline := time.Duration(*rollingUpdate.ProgressDeadlineSeconds) * time.Second
+		if progressTime, started := rolloutProgressTime(resourceSnapshot, now); started && progressTime > progressDeadline {
+			if _, completed := isRolloutCompleted(placementObj, allBindings, resourceSnapshot, now); !completed {
+				return fmt.Sprintf("the rollout did not complete within the progress deadline of %d seconds", *rollingUpdate.ProgressDeadlineSeconds), true
+			}
+		}
+	}
+	return "", false
+}
+
+// rolloutProgressTime returns how long the rollout of a master resource snapshot has been in progress,
+// excluding the time it has been held back; it returns false if the rollout has not started yet.
+func rolloutProgressTime(resourceSnapshot placementv1beta1.ResourceSnapshotObj, now time.Time) (time.Duration, bool) {
+	annotations := resourceSnapshot.GetAnnotations()
+	startTime, started := rolloutTimeFrom(annotations, placementv1beta1.RolloutStartTimeAnnotation)
+	if !started {
+		return 0, false
+	}
+	if heldSince, held := rolloutTimeFrom(annotations, placementv1beta1.RolloutHeldSinceAnnotation); held {
+		// The clock has stopped while the rollout is held back.
+		return heldSince.Sub(startTime), true
+	}
+	return now.Sub(startTime), true
+}
+
+// rolloutTimeFrom returns the time kept in an annotation of a master resource snapshot; it returns
+// false if the annotation is absent or invalid.
+func rolloutTimeFrom(annotations map[string]string, key string) (time.Time, bool) {
+	value, ok := annotations[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse the time in the resource snapshot annotation", "annotation", key, "value", value)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// updateRolloutClock keeps track of how long the rollout of a master resource snapshot has been in
+// progress, against which the progress deadline is measured. The clock starts when the rollout of the
+// resource snapshot starts, and stops while the rollout is held back, i.e., paused or waiting for the
+// maintenance windows of the selected clusters to open.
+//
+// It returns the resource snapshot with the clock updated; it is a no-op if the placement does not have
+// a progress deadline, or the rollout can no longer fail.
+func (r *Reconciler) updateRolloutClock(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	resourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	now time.Time,
+) (placementv1beta1.ResourceSnapshotObj, error) {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.AutoRollback == nil || rollingUpdate.ProgressDeadlineSeconds == nil {
+		return resourceSnapshot, nil
+	}
+	annotations := resourceSnapshot.GetAnnotations()
+	if _, rolledBack := annotations[placementv1beta1.RolledBackToResourceIndexAnnotation]; rolledBack ||
+		annotations[placementv1beta1.FullyAvailableAnnotation] == strconv.FormatBool(true) {
+		return resourceSnapshot, nil
+	}
+
+	held, err := r.isRolloutHeld(ctx, placementObj, allBindings, resourceSnapshot, now)
+	if err != nil {
+		return nil, err
+	}
+	startTime, started := rolloutTimeFrom(annotations, placementv1beta1.RolloutStartTimeAnnotation)
+	heldSince, wasHeld := rolloutTimeFrom(annotations, placementv1beta1.RolloutHeldSinceAnnotation)
+	updatedResourceSnapshot := resourceSnapshot.DeepCopyObject().(placementv1beta1.ResourceSnapshotObj)
+	updatedAnnotations := updatedResourceSnapshot.GetAnnotations()
+	switch {
+	case !started && held:
+		// The rollout has not started yet.
+		return resourceSnapshot, nil
+	case !started:
+		updatedAnnotations[placementv1beta1.RolloutStartTimeAnnotation] = now.Format(time.RFC3339)
+		delete(updatedAnnotations, placementv1beta1.RolloutHeldSinceAnnotation)
+	case held && !wasHeld:
+		updatedAnnotations[placementv1beta1.RolloutHeldSinceAnnotation] = now.Format(time.RFC3339)
+	case !held && wasHeld:
+		// Move the start time forward by how long the rollout has been held back.
+		updatedAnnotations[placementv1beta1.RolloutStartTimeAnnotation] = startTime.Add(now.Sub(heldSince)).Format(time.RFC3339)
+		delete(updatedAnnotations, placementv1beta1.RolloutHeldSinceAnnotation)
+	default:
+		return resourceSnapshot, nil
+	}
+	updatedResourceSnapshot.SetAnnotations(updatedAnnotations)
+	if err := r.Client.Patch(ctx, updatedResourceSnapshot, client.MergeFrom(resourceSnapshot)); err != nil {
+		klog.ErrorS(err, "Failed to update the rollout clock on the resource snapshot", "placement", klog.KObj(placementObj), "resourceSnapshot", klog.KObj(resourceSnapshot))
+		return nil, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the rollout clock on the resource snapshot", "placement", klog.KObj(placementObj), "resourceSnapshot", klog.KObj(resourceSnapshot), "held", held)
+	return updatedResourceSnapshot, nil
+}
+
+// isRolloutHeld checks if the rollout of a master resource snapshot is held back, i.e., the rollout is
+// paused, or a selected cluster that is yet to run the resource snapshot is outside its maintenance window.
+func (r *Reconciler) isRolloutHeld(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	resourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	now time.Time,
+) (bool, error) {
+	if placementObj.GetPlacementSpec().Strategy.Paused {
+		return true, nil
+	}
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if !binding.GetDeletionTimestamp().IsZero() ||
+			(bindingSpec.State != placementv1beta1.BindingStateScheduled && bindingSpec.State != placementv1beta1.BindingStateBound) ||
+			(bindingSpec.State == placementv1beta1.BindingStateBound && bindingSpec.ResourceSnapshotName == resourceSnapshot.GetName()) {
+			continue
+		}
+		open, _, err := maintenancewindow.CheckCluster(ctx, r.Client, bindingSpec.TargetCluster, now)
+		if err != nil {
+			return false, err
+		}
+		if !open {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isRolloutCompleted checks if the rollout of a resource snapshot has completed, i.e., all the
+// scheduled or bound bindings of the placement are bound to the resource snapshot and ready.
+// If the rollout has not completed, it also returns the time to wait for the bindings that are
+// not ready yet to become ready, which is not positive if the time is unknown.
+func isRolloutCompleted(
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	resourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	now time.Time,
+) (time.Duration, bool) {
+	readyTimeCutOff := now.Add(-time.Duration(*placementObj.GetPlacementSpec().Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
+	selectedBindingCount := 0
+	completed := true
+	var waitTime time.Duration
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if !binding.GetDeletionTimestamp().IsZero() ||
+			(bindingSpec.State != placementv1beta1.BindingStateScheduled && bindingSpec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		selectedBindingCount++
+		if bindingSpec.State != placementv1beta1.BindingStateBound || bindingSpec.ResourceSnapshotName != resourceSnapshot.GetName() {
+			completed = false
+			continue
+		}
+		if bindingWaitTime, ready := isBindingReady(binding, readyTimeCutOff); !ready {
+			completed = false
+			waitTime = minPositiveDuration(waitTime, bindingWaitTime)
+		}
+	}
+	return waitTime, completed && selectedBindingCount > 0
+}
+
+// progressDeadlineWaitTime returns the time left before the progress deadline of the rollout of a
+// resource snapshot passes; it returns 0 if the placement does not have a progress deadline, the
+// rollout has not started or is held back, or the rollout can no longer fail.
+func progressDeadlineWaitTime(placementObj placementv1beta1.PlacementObj, resourceSnapshot placementv1beta1.ResourceSnapshotObj, now time.Time) time.Duration {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.AutoRollback == nil || rollingUpdate.ProgressDeadlineSeconds == nil {
+		return 0
+	}
+	annotations := resourceSnapshot.GetAnnotations()
+	if _, rolledBack := annotations[placementv1beta1.RolledBackToResourceIndexAnnotation]; rolledBack ||
+		annotations[placementv1beta1.FullyAvailableAnnotation] == strconv.FormatBool(true) {
+		return 0
+	}
+	if _, held := annotations[placementv1beta1.RolloutHeldSinceAnnotation]; held {
+		// The clock has stopped; resuming the rollout triggers the rollout controller.
+		return 0
+	}
+	progressTime, started := rolloutProgressTime(resourceSnapshot, now)
+	if !started {
+		return 0
+	}
+	if waitTime := time.Duration(*rollingUpdate.ProgressDeadlineSeconds)*time.Second - progressTime; waitTime > 0 {
+		return waitTime
+	}
+	return 0
+}
+
+// markResourceSnapshotFullyAvailable marks a master resource snapshot as fully available if its rollout
+// has completed, so that it can become the target of future rollbacks; it is a no-op if the placement
+// does not have automatic rollback enabled.
+//
+// If the rollout has not completed yet, it returns the time to wait before checking the rollout again.
+func (r *Reconciler) markResourceSnapshotFullyAvailable(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	resourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) (time.Duration, error) {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.AutoRollback == nil {
+		return 0, nil
+	}
+	if resourceSnapshot.GetAnnotations()[placementv1beta1.FullyAvailableAnnotation] == strconv.FormatBool(true) {
+		return 0, nil
+	}
+	now := time.Now()
+	if waitTime, completed := isRolloutCompleted(placementObj, allBindings, resourceSnapshot, now); !completed {
+		return minPositiveDuration(waitTime, progressDeadlineWaitTime(placementObj, resourceSnapshot, now)), nil
+	}
+
+	updatedResourceSnapshot := resourceSnapshot.DeepCopyObject().(placementv1beta1.ResourceSnapshotObj)
+	updatedAnnotations := updatedResourceSnapshot.GetAnnotations()
+	updatedAnnotations[placementv1beta1.FullyAvailableAnnotation] = strconv.FormatBool(true)
+	updatedResourceSnapshot.SetAnnotations(updatedAnnotations)
+	if err := r.Client.Patch(ctx, updatedResourceSnapshot, client.MergeFrom(resourceSnapshot)); err != nil {
+		klog.ErrorS(err, "Failed to mark the resource snapshot as fully available", "placement", klog.KObj(placementObj), "resourceSnapshot", klog.KObj(resourceSnapshot))
+		return 0, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Marked the resource snapshot as fully available", "placement", klog.KObj(placementObj), "resourceSnapshot", klog.KObj(resourceSnapshot))
+	return 0, nil
+}
+
+// markLiveResourceSnapshotFullyAvailable marks the master resource snapshot that all the selected
+// clusters run, if it is not the latest one, as fully available once its rollout has completed, so that
+// it can become the target of future rollbacks even if automatic rollback was enabled after its rollout;
+// it is a no-op if the placement does not have automatic rollback enabled.
+func (r *Reconciler) markLiveResourceSnapshotFullyAvailable(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	latestResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) error {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.AutoRollback == nil {
+		return nil
+	}
+	liveResourceSnapshotName := ""
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if !binding.GetDeletionTimestamp().IsZero() || bindingSpec.State != placementv1beta1.BindingStateBound {
+			continue
+		}
+		if len(liveResourceSnapshotName) > 0 && bindingSpec.ResourceSnapshotName != liveResourceSnapshotName {
+			// The selected clusters run different resource snapshots.
+			return nil
+		}
+		liveResourceSnapshotName = bindingSpec.ResourceSnapshotName
+	}
+	if len(liveResourceSnapshotName) == 0 || liveResourceSnapshotName == latestResourceSnapshot.GetName() {
+		// The latest resource snapshot is marked once its own rollout completes.
+		return nil
+	}
+
+	resourceSnapshotList, err := controller.ListAllResourceSnapshots(ctx, r.Client, types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()})
+	if err != nil {
+		return err
+	}
+	for _, resourceSnapshot := range resourceSnapshotList.GetResourceSnapshotObjs() {
+		if resourceSnapshot.GetName() != liveResourceSnapshotName {
+			continue
+		}
+		_, err := r.markResourceSnapshotFullyAvailable(ctx, placementObj, allBindings, resourceSnapshot)
+		return err
+	}
+	return nil
+}
+
+// findRollbackTarget finds the fully available master resource snapshot with the highest index below
+// the index of the given resource snapshot; it returns nil if there is no such resource snapshot.
+func (r *Reconciler) findRollbackTarget(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	latestResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) (placementv1beta1.ResourceSnapshotObj, error) {
+	latestIndex, err := labels.ExtractResourceIndexFromResourceSnapshot(latestResourceSnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse the index of the latest resource snapshot", "resourceSnapshot", klog.KObj(latestResourceSnapshot))
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	resourceSnapshotList, err := controller.ListAllResourceSnapshots(ctx, r.Client, types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()})
+	if err != nil {
+		return nil, err
+	}
+
+	var target placementv1beta1.ResourceSnapshotObj
+	targetIndex := -1
+	for _, resourceSnapshot := range resourceSnapshotList.GetResourceSnapshotObjs() {
+		annotations := resourceSnapshot.GetAnnotations()
+		// Only the master resource snapshots have the resource group hash annotation.
+		if len(annotations[placementv1beta1.ResourceGroupHashAnnotation]) == 0 ||
+			annotations[placementv1beta1.FullyAvailableAnnotation] != strconv.FormatBool(true) {
+			continue
+		}
+		index, err := labels.ExtractResourceIndexFromResourceSnapshot(resourceSnapshot)
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse the index of the resource snapshot", "resourceSnapshot", klog.KObj(resourceSnapshot))
+			return nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		if index < latestIndex && index > targetIndex {
+			target, targetIndex = resourceSnapshot, index
+		}
+	}
+	return target, nil
+}
@@ -0,0 +1,682 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func masterResourceSnapshotForRollbackTest(index int, creationTime time.Time, annotations map[string]string) *placementv1beta1.ClusterResourceSnapshot {
+	snapshotAnnotations := map[string]string{
+		placementv1beta1.ResourceGroupHashAnnotation:         "hash",
+		placementv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+	}
+	for k, v := range annotations {
+		snapshotAnnotations[k] = v
+	}
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, crpName, index),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+				placementv1beta1.ResourceIndexLabel:     strconv.Itoa(index),
+			},
+			Annotations:       snapshotAnnotations,
+			CreationTimestamp: metav1.NewTime(creationTime),
+		},
+	}
+}
+
+func autoRollbackRollingUpdateConfigForTest(failureThreshold int, progressDeadlineSeconds *int) *placementv1beta1.RollingUpdateConfig {
+	rollingUpdate := generateDefaultRollingUpdateConfig()
+	rollingUpdate.ProgressDeadlineSeconds = progressDeadlineSeconds
+	rollingUpdate.AutoRollback = &placementv1beta1.AutoRollbackConfig{FailureThreshold: ptr.To(failureThreshold)}
+	return rollingUpdate
+}
+
+func TestResolveTargetResourceSnapshot(t *testing.T) {
+	fullyAvailable := map[string]string{placementv1beta1.FullyAvailableAnnotation: "true"}
+	startedAnHourAgo := map[string]string{placementv1beta1.RolloutStartTimeAnnotation: now.Add(-time.Hour).Format(time.RFC3339)}
+	snapshotName := func(index int) string {
+		return fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, crpName, index)
+	}
+	tests := []struct {
+		name              string
+		rollingUpdate     *placementv1beta1.RollingUpdateConfig
+		pinnedIndex       *string
+		paused            bool
+		olderSnapshots    []*placementv1beta1.ClusterResourceSnapshot
+		latestSnapshot    *placementv1beta1.ClusterResourceSnapshot
+		bindings          []placementv1beta1.BindingObj
+		wantTarget        string
+		wantRolledBackTo  string
+		wantRollbackEvent bool
+		wantErr           bool
+	}{
+		{
+			name:           "automatic rollback is not enabled",
+			rollingUpdate:  generateDefaultRollingUpdateConfig(),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the latest resource snapshot is fully available",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now.Add(-time.Hour), fullyAvailable),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the rollout has failed while it is paused",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			paused:         true,
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, startedAnHourAgo),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the number of failed clusters is below the threshold",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(2, nil),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster2),
+				// A failed binding on another resource snapshot does not count.
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster3),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:          "the number of failed clusters reaches the threshold",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(2, nil),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{
+				masterResourceSnapshotForRollbackTest(0, now, fullyAvailable),
+				masterResourceSnapshotForRollbackTest(1, now, fullyAvailable),
+				masterResourceSnapshotForRollbackTest(2, now, nil),
+			},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(3, now, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(3), cluster1),
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(3), cluster2),
+			},
+			wantTarget:        snapshotName(1),
+			wantRolledBackTo:  "1",
+			wantRollbackEvent: true,
+		},
+		{
+			name:           "the rollout does not complete within the progress deadline",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now.Add(-time.Hour), startedAnHourAgo),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+				generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster2),
+			},
+			wantTarget:        snapshotName(0),
+			wantRolledBackTo:  "0",
+			wantRollbackEvent: true,
+		},
+		{
+			name:           "the rollout completes after the progress deadline",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now.Add(-time.Hour), startedAnHourAgo),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the rollout started recently, long after the resource snapshot was created",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now.Add(-time.Hour), map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: now.Add(-time.Second).Format(time.RFC3339),
+			}),
+			bindings: []placementv1beta1.BindingObj{
+				generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the rollout was held back before the progress deadline passed",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now.Add(-time.Hour), map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: now.Add(-time.Hour).Format(time.RFC3339),
+				placementv1beta1.RolloutHeldSinceAnnotation: now.Add(-time.Hour + 30*time.Second).Format(time.RFC3339),
+			}),
+			bindings: []placementv1beta1.BindingObj{
+				generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the rollout has failed but there is no fully available resource snapshot",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, nil),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, nil)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster1),
+			},
+			wantTarget: snapshotName(1),
+		},
+		{
+			name:           "the placement has been rolled back",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, nil),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, fullyAvailable)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, map[string]string{
+				placementv1beta1.RolledBackToResourceIndexAnnotation: "0",
+				placementv1beta1.RollbackReasonAnnotation:            "test reason",
+			}),
+			wantTarget:       snapshotName(0),
+			wantRolledBackTo: "0",
+		},
+		{
+			name:          "the placement is pinned to a resource snapshot",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, nil),
+			pinnedIndex:   ptr.To("0"),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{
+				masterResourceSnapshotForRollbackTest(0, now, nil),
+				masterResourceSnapshotForRollbackTest(1, now, fullyAvailable),
+			},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(2, now, nil),
+			bindings: []placementv1beta1.BindingObj{
+				// Pinning takes precedence over automatic rollback.
+				generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(2), cluster1),
+			},
+			wantTarget: snapshotName(0),
+		},
+		{
+			name:           "the placement is pinned to the latest resource snapshot",
+			rollingUpdate:  generateDefaultRollingUpdateConfig(),
+			pinnedIndex:    ptr.To("1"),
+			olderSnapshots: []*placementv1beta1.ClusterResourceSnapshot{masterResourceSnapshotForRollbackTest(0, now, nil)},
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, nil),
+			wantTarget:     snapshotName(1),
+		},
+		{
+			name:           "the pinned resource snapshot does not exist",
+			rollingUpdate:  generateDefaultRollingUpdateConfig(),
+			pinnedIndex:    ptr.To("5"),
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, nil),
+		},
+		{
+			name:          "the resource snapshot the placement has been rolled back to is gone",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, nil),
+			latestSnapshot: masterResourceSnapshotForRollbackTest(1, now, map[string]string{
+				placementv1beta1.RolledBackToResourceIndexAnnotation: "0",
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest(crpName,
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, tc.rollingUpdate, nil))
+			crp.Spec.PinnedResourceSnapshotIndex = tc.pinnedIndex
+			crp.Spec.Strategy.Paused = tc.paused
+			objects := []client.Object{tc.latestSnapshot}
+			for _, snapshot := range tc.olderSnapshots {
+				objects = append(objects, snapshot)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				Build()
+			recorder := record.NewFakeRecorder(10)
+			r := Reconciler{Client: fakeClient, recorder: recorder}
+
+			gotTarget, err := r.resolveTargetResourceSnapshot(ctx, crp, tc.bindings, tc.latestSnapshot, now)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("resolveTargetResourceSnapshot() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			gotTargetName := ""
+			if gotTarget != nil {
+				gotTargetName = gotTarget.GetName()
+			}
+			if gotTargetName != tc.wantTarget {
+				t.Errorf("resolveTargetResourceSnapshot() = %q, want %q", gotTargetName, tc.wantTarget)
+			}
+
+			latestSnapshot := &placementv1beta1.ClusterResourceSnapshot{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: tc.latestSnapshot.Name}, latestSnapshot); err != nil {
+				t.Fatalf("Failed to get the latest resource snapshot: %v", err)
+			}
+			if got := latestSnapshot.Annotations[placementv1beta1.RolledBackToResourceIndexAnnotation]; got != tc.wantRolledBackTo {
+				t.Errorf("resolveTargetResourceSnapshot() rolled back to resource index = %q, want %q", got, tc.wantRolledBackTo)
+			}
+			if got := len(recorder.Events) > 0; got != tc.wantRollbackEvent {
+				t.Errorf("resolveTargetResourceSnapshot() emitted rollback event = %v, want %v", got, tc.wantRollbackEvent)
+			}
+		})
+	}
+}
+
+// TestPauseAndResumeRolloutPastProgressDeadline pauses a rollout, waits past its progress deadline, and
+// resumes it; the time spent paused must not count against the progress deadline.
+func TestPauseAndResumeRolloutPastProgressDeadline(t *testing.T) {
+	ctx := context.Background()
+	// The rollout clock is kept at the precision of seconds.
+	now := now.Truncate(time.Second)
+	startTime := now.Add(-2 * time.Hour)
+	pauseTime := startTime.Add(10 * time.Second)
+	crp := clusterResourcePlacementForTest(crpName,
+		createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+		createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)), nil))
+	olderSnapshot := masterResourceSnapshotForRollbackTest(0, startTime, map[string]string{placementv1beta1.FullyAvailableAnnotation: "true"})
+	var latestSnapshot placementv1beta1.ResourceSnapshotObj = masterResourceSnapshotForRollbackTest(1, startTime, map[string]string{
+		placementv1beta1.RolloutStartTimeAnnotation: startTime.Format(time.RFC3339),
+	})
+	bindings := []placementv1beta1.BindingObj{
+		generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, olderSnapshot.Name, cluster1),
+		generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, olderSnapshot.Name, cluster2),
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(serviceScheme(t)).
+		WithObjects(olderSnapshot, latestSnapshot).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := Reconciler{Client: fakeClient, recorder: recorder}
+
+	// reconcile resolves the target resource snapshot and updates the rollout clock as the rollout
+	// controller does, at the given time.
+	reconcile := func(reconcileTime time.Time) {
+		target, err := r.resolveTargetResourceSnapshot(ctx, crp, bindings, latestSnapshot, reconcileTime)
+		if err != nil {
+			t.Fatalf("resolveTargetResourceSnapshot() error = %v, want no error", err)
+		}
+		if target.GetName() != latestSnapshot.GetName() {
+			t.Fatalf("resolveTargetResourceSnapshot() = %q, want %q", target.GetName(), latestSnapshot.GetName())
+		}
+		if latestSnapshot, err = r.updateRolloutClock(ctx, crp, bindings, latestSnapshot, reconcileTime); err != nil {
+			t.Fatalf("updateRolloutClock() error = %v, want no error", err)
+		}
+	}
+
+	// Pause the rollout shortly after it starts.
+	crp.Spec.Strategy.Paused = true
+	reconcile(pauseTime)
+	// Stay paused well past the progress deadline.
+	reconcile(now)
+	if got := progressDeadlineWaitTime(crp, latestSnapshot, now); got != 0 {
+		t.Errorf("progressDeadlineWaitTime() while paused = %v, want 0", got)
+	}
+
+	// Resume the rollout.
+	crp.Spec.Strategy.Paused = false
+	reconcile(now)
+	if got, want := progressDeadlineWaitTime(crp, latestSnapshot, now), 50*time.Second; got != want {
+		t.Errorf("progressDeadlineWaitTime() after resuming = %v, want %v", got, want)
+	}
+	if len(recorder.Events) > 0 {
+		t.Errorf("The placement has been rolled back while paused: %v", <-recorder.Events)
+	}
+
+	// The rollout fails once it is held up past the progress deadline after resuming.
+	target, err := r.resolveTargetResourceSnapshot(ctx, crp, bindings, latestSnapshot, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("resolveTargetResourceSnapshot() error = %v, want no error", err)
+	}
+	if target.GetName() != olderSnapshot.Name {
+		t.Errorf("resolveTargetResourceSnapshot() after the progress deadline = %q, want %q", target.GetName(), olderSnapshot.Name)
+	}
+}
+
+func TestIsRolloutCompleted(t *testing.T) {
+	latestSnapshot := masterResourceSnapshotForRollbackTest(1, now, nil)
+	crp := clusterResourcePlacementForTest(crpName,
+		createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+		createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, autoRollbackRollingUpdateConfigForTest(1, nil), nil))
+	deletingBinding := generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "old-snapshot", cluster3)
+	deletingBinding.DeletionTimestamp = &metav1.Time{Time: now}
+	tests := []struct {
+		name          string
+		bindings      []placementv1beta1.BindingObj
+		wantCompleted bool
+		wantWaitTime  time.Duration
+	}{
+		{
+			name: "no selected clusters",
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateUnscheduled, latestSnapshot.Name, cluster1),
+			},
+		},
+		{
+			name: "all the bindings are ready on the resource snapshot",
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster1),
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster2),
+				deletingBinding,
+			},
+			wantCompleted: true,
+		},
+		{
+			name: "a binding is still scheduled",
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster1),
+				generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, latestSnapshot.Name, cluster2),
+			},
+		},
+		{
+			name: "a binding is on an older resource snapshot",
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster1),
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "old-snapshot", cluster2),
+			},
+		},
+		{
+			name: "a binding with untrackable resources is not ready yet",
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster1),
+				generateNotTrackableClusterResourceBinding(placementv1beta1.BindingStateBound, latestSnapshot.Name, cluster2, metav1.NewTime(now)),
+			},
+			wantWaitTime: defaultUnavailablePeriod * time.Second,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotWaitTime, gotCompleted := isRolloutCompleted(crp, tc.bindings, latestSnapshot, now)
+			if gotCompleted != tc.wantCompleted {
+				t.Errorf("isRolloutCompleted() completed = %v, want %v", gotCompleted, tc.wantCompleted)
+			}
+			if gotWaitTime != tc.wantWaitTime {
+				t.Errorf("isRolloutCompleted() wait time = %v, want %v", gotWaitTime, tc.wantWaitTime)
+			}
+		})
+	}
+}
+
+func TestMarkResourceSnapshotFullyAvailable(t *testing.T) {
+	tests := []struct {
+		name                 string
+		rollingUpdate        *placementv1beta1.RollingUpdateConfig
+		annotations          map[string]string
+		bindingState         placementv1beta1.BindingState
+		wantFullyAvailable   string
+		wantPositiveWaitTime bool
+	}{
+		{
+			name:          "automatic rollback is not enabled",
+			rollingUpdate: generateDefaultRollingUpdateConfig(),
+			bindingState:  placementv1beta1.BindingStateBound,
+		},
+		{
+			name:               "the rollout has completed",
+			rollingUpdate:      autoRollbackRollingUpdateConfigForTest(1, nil),
+			bindingState:       placementv1beta1.BindingStateBound,
+			wantFullyAvailable: "true",
+		},
+		{
+			name:                 "the rollout has not completed within the progress deadline yet",
+			rollingUpdate:        autoRollbackRollingUpdateConfigForTest(1, ptr.To(600)),
+			annotations:          map[string]string{placementv1beta1.RolloutStartTimeAnnotation: time.Now().Format(time.RFC3339)},
+			bindingState:         placementv1beta1.BindingStateScheduled,
+			wantPositiveWaitTime: true,
+		},
+		{
+			name:          "the rollout has not completed and is held back",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, ptr.To(600)),
+			annotations: map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: time.Now().Format(time.RFC3339),
+				placementv1beta1.RolloutHeldSinceAnnotation: time.Now().Format(time.RFC3339),
+			},
+			bindingState: placementv1beta1.BindingStateScheduled,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest(crpName,
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, tc.rollingUpdate, nil))
+			snapshot := masterResourceSnapshotForRollbackTest(1, time.Now(), tc.annotations)
+			bindings := []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(tc.bindingState, snapshot.Name, cluster1),
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(snapshot).
+				Build()
+			r := Reconciler{Client: fakeClient}
+
+			gotWaitTime, err := r.markResourceSnapshotFullyAvailable(ctx, crp, bindings, snapshot)
+			if err != nil {
+				t.Fatalf("markResourceSnapshotFullyAvailable() error = %v, want no error", err)
+			}
+			if got := gotWaitTime > 0; got != tc.wantPositiveWaitTime {
+				t.Errorf("markResourceSnapshotFullyAvailable() wait time = %v, want positive %v", gotWaitTime, tc.wantPositiveWaitTime)
+			}
+			gotSnapshot := &placementv1beta1.ClusterResourceSnapshot{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: snapshot.Name}, gotSnapshot); err != nil {
+				t.Fatalf("Failed to get the resource snapshot: %v", err)
+			}
+			if diff := cmp.Diff(gotSnapshot.Annotations[placementv1beta1.FullyAvailableAnnotation], tc.wantFullyAvailable); diff != "" {
+				t.Errorf("markResourceSnapshotFullyAvailable() fully available annotation mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateRolloutClock(t *testing.T) {
+	closedWindow, _ := closedMaintenanceWindowForTest()
+	longAgo := now.Add(-time.Hour)
+	tests := []struct {
+		name           string
+		rollingUpdate  *placementv1beta1.RollingUpdateConfig
+		paused         bool
+		annotations    map[string]string
+		bindingCluster string
+		clusters       []client.Object
+		wantStartTime  string
+		wantHeldSince  string
+	}{
+		{
+			name:           "the placement does not have a progress deadline",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, nil),
+			bindingCluster: cluster1,
+		},
+		{
+			name:           "the rollout starts",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			bindingCluster: cluster1,
+			wantStartTime:  now.Format(time.RFC3339),
+		},
+		{
+			name:           "the rollout has not started as it is paused",
+			rollingUpdate:  autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			paused:         true,
+			bindingCluster: cluster1,
+		},
+		{
+			name:          "the rollout is paused",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			paused:        true,
+			annotations: map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: longAgo.Format(time.RFC3339),
+			},
+			bindingCluster: cluster1,
+			wantStartTime:  longAgo.Format(time.RFC3339),
+			wantHeldSince:  now.Format(time.RFC3339),
+		},
+		{
+			name:          "the rollout is held back by a maintenance window",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			annotations: map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: longAgo.Format(time.RFC3339),
+			},
+			bindingCluster: cluster1,
+			clusters: []client.Object{
+				&clusterv1beta1.MemberCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: cluster1},
+					Spec:       clusterv1beta1.MemberClusterSpec{MaintenanceWindow: closedWindow},
+				},
+			},
+			wantStartTime: longAgo.Format(time.RFC3339),
+			wantHeldSince: now.Format(time.RFC3339),
+		},
+		{
+			name:          "the rollout is resumed",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, ptr.To(60)),
+			annotations: map[string]string{
+				placementv1beta1.RolloutStartTimeAnnotation: longAgo.Format(time.RFC3339),
+				placementv1beta1.RolloutHeldSinceAnnotation: longAgo.Add(30 * time.Second).Format(time.RFC3339),
+			},
+			bindingCluster: cluster1,
+			// The clock has run for 30 seconds before the rollout was held back.
+			wantStartTime: now.Add(-30 * time.Second).Format(time.RFC3339),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest(crpName,
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, tc.rollingUpdate, nil))
+			crp.Spec.Strategy.Paused = tc.paused
+			snapshot := masterResourceSnapshotForRollbackTest(1, longAgo, tc.annotations)
+			bindings := []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, crpName, 0), tc.bindingCluster),
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(append(tc.clusters, snapshot)...).
+				Build()
+			r := Reconciler{Client: fakeClient}
+
+			gotSnapshot, err := r.updateRolloutClock(ctx, crp, bindings, snapshot, now)
+			if err != nil {
+				t.Fatalf("updateRolloutClock() error = %v, want no error", err)
+			}
+			if got := gotSnapshot.GetAnnotations()[placementv1beta1.RolloutStartTimeAnnotation]; got != tc.wantStartTime {
+				t.Errorf("updateRolloutClock() rollout start time = %q, want %q", got, tc.wantStartTime)
+			}
+			if got := gotSnapshot.GetAnnotations()[placementv1beta1.RolloutHeldSinceAnnotation]; got != tc.wantHeldSince {
+				t.Errorf("updateRolloutClock() rollout held since = %q, want %q", got, tc.wantHeldSince)
+			}
+			storedSnapshot := &placementv1beta1.ClusterResourceSnapshot{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: snapshot.Name}, storedSnapshot); err != nil {
+				t.Fatalf("Failed to get the resource snapshot: %v", err)
+			}
+			if diff := cmp.Diff(storedSnapshot.Annotations, gotSnapshot.GetAnnotations()); diff != "" {
+				t.Errorf("updateRolloutClock() stored annotations mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMarkLiveResourceSnapshotFullyAvailable(t *testing.T) {
+	snapshotName := func(index int) string {
+		return fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, crpName, index)
+	}
+	tests := []struct {
+		name               string
+		rollingUpdate      *placementv1beta1.RollingUpdateConfig
+		bindings           []placementv1beta1.BindingObj
+		wantFullyAvailable string
+	}{
+		{
+			name:          "automatic rollback is not enabled",
+			rollingUpdate: generateDefaultRollingUpdateConfig(),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+			},
+		},
+		{
+			name:          "all the selected clusters run the live resource snapshot",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster2),
+			},
+			wantFullyAvailable: "true",
+		},
+		{
+			name:          "the rollout of the latest resource snapshot has started",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+				generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(1), cluster2),
+			},
+		},
+		{
+			name:          "the live resource snapshot is not available on all the selected clusters",
+			rollingUpdate: autoRollbackRollingUpdateConfigForTest(1, nil),
+			bindings: []placementv1beta1.BindingObj{
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster1),
+				generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, snapshotName(0), cluster2),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest(crpName,
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, tc.rollingUpdate, nil))
+			liveSnapshot := masterResourceSnapshotForRollbackTest(0, now, nil)
+			latestSnapshot := masterResourceSnapshotForRollbackTest(1, now, nil)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(liveSnapshot, latestSnapshot).
+				Build()
+			r := Reconciler{Client: fakeClient}
+
+			if err := r.markLiveResourceSnapshotFullyAvailable(ctx, crp, tc.bindings, latestSnapshot); err != nil {
+				t.Fatalf("markLiveResourceSnapshotFullyAvailable() error = %v, want no error", err)
+			}
+			gotSnapshot := &placementv1beta1.ClusterResourceSnapshot{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: liveSnapshot.Name}, gotSnapshot); err != nil {
+				t.Fatalf("Failed to get the resource snapshot: %v", err)
+			}
+			if got := gotSnapshot.Annotations[placementv1beta1.FullyAvailableAnnotation]; got != tc.wantFullyAvailable {
+				t.Errorf("markLiveResourceSnapshotFullyAvailable() fully available annotation = %q, want %q", got, tc.wantFullyAvailable)
+			}
+		})
+	}
+}
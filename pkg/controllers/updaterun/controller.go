@@ -66,6 +66,9 @@ type Reconciler struct {
 
 	// ResourceSnapshotResolver gets or creates resource snapshots.
 	ResourceSnapshotResolver controller.ResourceSnapshotResolver
+
+	// AllowedStageWebhookHosts is the list of hosts that the stage webhooks of namespaced update runs may target.
+	AllowedStageWebhookHosts []string
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
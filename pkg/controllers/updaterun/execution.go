@@ -37,6 +37,7 @@ import (
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 var (
@@ -83,14 +84,17 @@ func (r *Reconciler) execute(
 			// No need to wait to get to the next stage.
 			return false, 0, nil
 		}
-		approved, err := r.checkBeforeStageTasksStatus(ctx, updatingStageIndex, updateRun)
+		approved, beforeStageWaitTime, err := r.checkBeforeStageTasksStatus(ctx, updatingStageIndex, updateRun)
 		if err != nil {
 			return false, 0, err
 		}
 		if !approved {
 			markStageUpdatingWaiting(updatingStageStatus, updateRun.GetGeneration(), "Not all before-stage tasks are completed, waiting for approval")
 			markUpdateRunWaiting(updateRun, fmt.Sprintf(condition.UpdateRunWaitingMessageFmt, "before-stage", updatingStageStatus.StageName))
-			return false, stageUpdatingWaitTime, nil
+			if beforeStageWaitTime < 0 {
+				beforeStageWaitTime = stageUpdatingWaitTime
+			}
+			return false, beforeStageWaitTime, nil
 		}
 		maxConcurrency, err := calculateMaxConcurrencyValue(updateRunStatus, updatingStageIndex)
 		if err != nil {
@@ -106,33 +110,50 @@ func (r *Reconciler) execute(
 }
 
 // checkBeforeStageTasksStatus checks if the before stage tasks have finished.
-// It returns if the before stage tasks have finished or error if the before stage tasks failed.
-func (r *Reconciler) checkBeforeStageTasksStatus(ctx context.Context, updatingStageIndex int, updateRun placementv1beta1.UpdateRunObj) (bool, error) {
+// It returns if the before stage tasks have finished, the time to wait before rechecking them (-1 if unknown),
+// or error if the before stage tasks failed.
+func (r *Reconciler) checkBeforeStageTasksStatus(ctx context.Context, updatingStageIndex int, updateRun placementv1beta1.UpdateRunObj) (bool, time.Duration, error) {
 	updateRunRef := klog.KObj(updateRun)
 	updateRunStatus := updateRun.GetUpdateRunStatus()
 	updatingStage := &updateRunStatus.UpdateStrategySnapshot.Stages[updatingStageIndex]
 	if updatingStage.BeforeStageTasks == nil {
 		klog.V(2).InfoS("There is no before stage task for this stage", "stage", updatingStage.Name, "updateRun", updateRunRef)
-		return true, nil
+		return true, 0, nil
 	}
 
 	updatingStageStatus := &updateRunStatus.StagesStatus[updatingStageIndex]
+	passed := true
+	beforeStageWaitTime := time.Duration(-1)
 	for i, task := range updatingStage.BeforeStageTasks {
 		switch task.Type {
 		case placementv1beta1.StageTaskTypeApproval:
 			approved, err := r.handleStageApprovalTask(ctx, &updatingStageStatus.BeforeStageTaskStatus[i], updatingStage, updateRun, placementv1beta1.BeforeStageTaskLabelValue)
 			if err != nil {
-				return false, err
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			approved, waitTime, err := r.handleStageWebhookTask(ctx, &updatingStageStatus.BeforeStageTaskStatus[i], &updatingStage.BeforeStageTasks[i], updatingStageStatus, updateRun, placementv1beta1.BeforeStageTaskLabelValue)
+			if err != nil {
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+				beforeStageWaitTime = minAfterStageWaitTime(beforeStageWaitTime, waitTime)
 			}
-			return approved, nil // Ideally there should be only one approval task in before stage tasks.
 		default:
-			// Approval is the only supported before stage task.
+			// Approval and Webhook are the only supported before stage tasks.
 			unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("found unsupported task type in before stage tasks: %s", task.Type))
 			klog.ErrorS(unexpectedErr, "Task type is not supported in before stage tasks", "stage", updatingStage.Name, "updateRun", updateRunRef, "taskType", task.Type)
-			return false, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+			return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
 		}
 	}
-	return true, nil
+	if passed {
+		beforeStageWaitTime = 0
+	}
+	return passed, beforeStageWaitTime, nil
 }
 
 // executeUpdatingStage executes a single updating stage by updating the bindings.
@@ -159,6 +180,10 @@ func (r *Reconciler) executeUpdatingStage(
 
 	finishedClusterCount := 0
 	clusterUpdatingCount := 0
+	// The clusters that are outside their maintenance windows do not count towards the max concurrency.
+	outOfMaintenanceWindowClusterCount := 0
+	var maintenanceWindowWaitTime time.Duration
+	now := time.Now()
 	var stuckClusterNames []string
 	var clusterUpdateErrors []error
 	// Go through each cluster in the stage and check if it's updating/succeeded/failed.
@@ -190,6 +215,21 @@ func (r *Reconciler) executeUpdatingStage(
 		if !condition.IsConditionStatusTrue(clusterStartedCond, updateRun.GetGeneration()) {
 			// The cluster has not started updating yet.
 			if !isBindingSyncedWithClusterStatus(resourceSnapshotName, updateRun, binding, clusterStatus) {
+				// The binding can only be updated inside the maintenance window of the cluster.
+				open, windowWaitTime, err := maintenancewindow.CheckCluster(ctx, r.Client, clusterStatus.ClusterName, now)
+				if err != nil {
+					clusterUpdateErrors = append(clusterUpdateErrors, err)
+					continue
+				}
+				if !open {
+					klog.V(2).InfoS("The cluster is outside its maintenance window, skip updating it for now", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "waitTime", windowWaitTime, "updateRun", updateRunRef)
+					clusterUpdatingCount--
+					outOfMaintenanceWindowClusterCount++
+					if windowWaitTime > 0 && (maintenanceWindowWaitTime == 0 || windowWaitTime < maintenanceWindowWaitTime) {
+						maintenanceWindowWaitTime = windowWaitTime
+					}
+					continue
+				}
 				klog.V(2).InfoS("Found the first cluster that needs to be updated", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 				// The binding is not up-to-date with the cluster status.
 				bindingSpec := binding.GetBindingSpec()
@@ -299,6 +339,16 @@ func (r *Reconciler) executeUpdatingStage(
 		return r.handleStageCompletion(ctx, updatingStageIndex, updateRun, updatingStageStatus)
 	}
 
+	if clusterUpdatingCount == 0 && outOfMaintenanceWindowClusterCount > 0 {
+		// All the remaining clusters are outside their maintenance windows.
+		klog.V(2).InfoS("The stage is waiting for the maintenance windows of the remaining clusters to open", "stage", updatingStageStatus.StageName, "waitTime", maintenanceWindowWaitTime, "updateRun", updateRunRef)
+		markStageUpdatingWaiting(updatingStageStatus, updateRun.GetGeneration(), "Waiting for the maintenance windows of the remaining clusters to open")
+		markUpdateRunWaiting(updateRun, fmt.Sprintf(condition.UpdateRunWaitingForMaintenanceWindowMessageFmt, updatingStageStatus.StageName))
+		if maintenanceWindowWaitTime > 0 {
+			return maintenanceWindowWaitTime, nil
+		}
+	}
+
 	// Some clusters are still updating.
 	return clusterUpdatingWaitTime, nil
 }
@@ -423,7 +473,7 @@ func (r *Reconciler) checkAfterStageTasksStatus(ctx context.Context, updatingSta
 			if waitTime > 0 {
 				klog.V(2).InfoS("The after stage task still need to wait", "waitStartTime", waitStartTime, "waitTime", task.WaitTime, "stage", updatingStage.Name, "updateRun", updateRunRef)
 				passed = false
-				afterStageWaitTime = waitTime
+				afterStageWaitTime = minAfterStageWaitTime(afterStageWaitTime, waitTime)
 			} else {
 				markAfterStageWaitTimeElapsed(&updatingStageStatus.AfterStageTaskStatus[i], updateRun.GetGeneration())
 				klog.V(2).InfoS("The after stage wait task has completed", "stage", updatingStage.Name, "updateRun", updateRunRef)
@@ -436,6 +486,24 @@ func (r *Reconciler) checkAfterStageTasksStatus(ctx context.Context, updatingSta
 			if !approved {
 				passed = false
 			}
+		case placementv1beta1.StageTaskTypeHealthCheck:
+			completed, waitTime, err := r.handleStageHealthCheckTask(ctx, &updatingStageStatus.AfterStageTaskStatus[i], &updatingStage.AfterStageTasks[i], updatingStageStatus, updateRun)
+			if err != nil {
+				return false, -1, err
+			}
+			if !completed {
+				passed = false
+				afterStageWaitTime = minAfterStageWaitTime(afterStageWaitTime, waitTime)
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			approved, waitTime, err := r.handleStageWebhookTask(ctx, &updatingStageStatus.AfterStageTaskStatus[i], &updatingStage.AfterStageTasks[i], updatingStageStatus, updateRun, placementv1beta1.AfterStageTaskLabelValue)
+			if err != nil {
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+				afterStageWaitTime = minAfterStageWaitTime(afterStageWaitTime, waitTime)
+			}
 		}
 	}
 	if passed {
@@ -444,6 +512,17 @@ func (r *Reconciler) checkAfterStageTasksStatus(ctx context.Context, updatingSta
 	return passed, afterStageWaitTime, nil
 }
 
+// minAfterStageWaitTime returns the shorter of two wait times of the before or after stage tasks, where -1 means no wait.
+func minAfterStageWaitTime(a, b time.Duration) time.Duration {
+	if a < 0 {
+		return b
+	}
+	if b < 0 {
+		return a
+	}
+	return min(a, b)
+}
+
 // handleStageApprovalTask handles the approval task logic for before or after stage tasks.
 // It returns true if the task is approved, false otherwise, and any error encountered.
 func (r *Reconciler) handleStageApprovalTask(
@@ -35,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 )
@@ -845,6 +846,7 @@ func TestExecuteUpdatingStage_Error(t *testing.T) {
 			ctx := context.Background()
 			scheme := runtime.NewScheme()
 			_ = placementv1beta1.AddToScheme(scheme)
+			_ = clusterv1beta1.AddToScheme(scheme)
 
 			var fakeClient client.Client
 			objs := make([]client.Object, len(tt.bindings))
@@ -1251,7 +1253,7 @@ func TestCheckBeforeStageTasksStatus_NegativeCases(t *testing.T) {
 				Client: fakeClient,
 			}
 			ctx := context.Background()
-			_, gotErr := r.checkBeforeStageTasksStatus(ctx, tt.stageIndex, tt.updateRun)
+			_, _, gotErr := r.checkBeforeStageTasksStatus(ctx, tt.stageIndex, tt.updateRun)
 			if gotErr == nil {
 				t.Fatalf("checkBeforeStageTasksStatus() want error but got nil")
 			}
@@ -1309,6 +1311,148 @@ func TestGenerateStuckClustersString(t *testing.T) {
 	}
 }
 
+func TestExecuteUpdatingStage_MaintenanceWindow(t *testing.T) {
+	// The window opens in about 12 hours and lasts for an hour.
+	windowStart := time.Now().UTC().Add(12 * time.Hour).Truncate(time.Minute)
+	closedWindow := &clusterv1beta1.MaintenanceWindow{
+		Schedule: fmt.Sprintf("%d %d * * *", windowStart.Minute(), windowStart.Hour()),
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+
+	tests := []struct {
+		name                 string
+		clusterNames         []string
+		clusters             []*clusterv1beta1.MemberCluster
+		wantBoundClusters    []string
+		wantStageWaiting     bool
+		wantMaxWaitTime      time.Duration
+		wantMinWaitTime      time.Duration
+		wantUpdateRunMessage string
+	}{
+		{
+			name:         "cluster outside its maintenance window is skipped and does not count towards max concurrency",
+			clusterNames: []string{"cluster-1", "cluster-2"},
+			clusters: []*clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+					Spec: clusterv1beta1.MemberClusterSpec{
+						MaintenanceWindow: closedWindow,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-2"},
+				},
+			},
+			wantBoundClusters: []string{"cluster-2"},
+			wantMinWaitTime:   clusterUpdatingWaitTime,
+			wantMaxWaitTime:   clusterUpdatingWaitTime,
+		},
+		{
+			name:         "stage waits when all remaining clusters are outside their maintenance windows",
+			clusterNames: []string{"cluster-1"},
+			clusters: []*clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+					Spec: clusterv1beta1.MemberClusterSpec{
+						MaintenanceWindow: closedWindow,
+					},
+				},
+			},
+			wantStageWaiting:     true,
+			wantMinWaitTime:      time.Until(windowStart) - time.Minute,
+			wantMaxWaitTime:      time.Until(windowStart),
+			wantUpdateRunMessage: fmt.Sprintf(condition.UpdateRunWaitingForMaintenanceWindowMessageFmt, "test-stage"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-update-run",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:         "test-placement",
+					ResourceSnapshotIndex: "1",
+					State:                 placementv1beta1.StateRun,
+				},
+				Status: placementv1beta1.UpdateRunStatus{
+					ResourceSnapshotIndexUsed: "1",
+					StagesStatus: []placementv1beta1.StageUpdatingStatus{
+						{
+							StageName: "test-stage",
+						},
+					},
+				},
+			}
+			var objs []client.Object
+			var bindings []placementv1beta1.BindingObj
+			for _, clusterName := range tt.clusterNames {
+				updateRun.Status.StagesStatus[0].Clusters = append(updateRun.Status.StagesStatus[0].Clusters, placementv1beta1.ClusterUpdatingStatus{ClusterName: clusterName})
+				binding := &placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "binding-" + clusterName,
+						Generation: 1,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster:        clusterName,
+						ResourceSnapshotName: "test-placement-0-snapshot",
+						State:                placementv1beta1.BindingStateScheduled,
+					},
+				}
+				bindings = append(bindings, binding)
+				objs = append(objs, binding)
+			}
+			for i := range tt.clusters {
+				objs = append(objs, tt.clusters[i])
+			}
+
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			_ = clusterv1beta1.AddToScheme(scheme)
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(objs...).Build(),
+			}
+
+			waitTime, err := r.executeUpdatingStage(ctx, updateRun, 0, bindings, 1)
+			if err != nil {
+				t.Fatalf("executeUpdatingStage() got error: %v", err)
+			}
+			if waitTime < tt.wantMinWaitTime || waitTime > tt.wantMaxWaitTime {
+				t.Errorf("executeUpdatingStage() waitTime = %v, want in [%v, %v]", waitTime, tt.wantMinWaitTime, tt.wantMaxWaitTime)
+			}
+
+			var gotBoundClusters []string
+			for _, clusterName := range tt.clusterNames {
+				var binding placementv1beta1.ClusterResourceBinding
+				if err := r.Client.Get(ctx, types.NamespacedName{Name: "binding-" + clusterName}, &binding); err != nil {
+					t.Fatalf("failed to get binding: %v", err)
+				}
+				if binding.Spec.State == placementv1beta1.BindingStateBound {
+					gotBoundClusters = append(gotBoundClusters, clusterName)
+				}
+			}
+			if diff := cmp.Diff(tt.wantBoundClusters, gotBoundClusters); diff != "" {
+				t.Errorf("executeUpdatingStage() bound clusters mismatch (-want, +got):\n%s", diff)
+			}
+
+			progressingCond := meta.FindStatusCondition(updateRun.Status.StagesStatus[0].Conditions, string(placementv1beta1.StageUpdatingConditionProgressing))
+			gotStageWaiting := progressingCond != nil && progressingCond.Reason == condition.StageUpdatingWaitingReason
+			if gotStageWaiting != tt.wantStageWaiting {
+				t.Errorf("executeUpdatingStage() stage waiting = %t, want %t", gotStageWaiting, tt.wantStageWaiting)
+			}
+			if tt.wantUpdateRunMessage != "" {
+				updateRunCond := meta.FindStatusCondition(updateRun.Status.Conditions, string(placementv1beta1.StagedUpdateRunConditionProgressing))
+				if updateRunCond == nil || updateRunCond.Reason != condition.UpdateRunWaitingReason || updateRunCond.Message != tt.wantUpdateRunMessage {
+					t.Errorf("executeUpdatingStage() updateRun progressing condition = %v, want waiting with message %q", updateRunCond, tt.wantUpdateRunMessage)
+				}
+			}
+		})
+	}
+}
+
 func TestExecute_ZeroClustersSkipsEntireStage(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// healthCheckClusterVarName is the name of the CEL variable that refers to the status of the
+	// placement on a cluster in a health check expression.
+	healthCheckClusterVarName = "cluster"
+
+	// healthCheckResourcesVarName is the name of the CEL variable that refers to the list of resources
+	// placed on a cluster whose statuses are reported back, in a health check expression.
+	healthCheckResourcesVarName = "resources"
+
+	// healthCheckCostLimit is the cost limit for evaluating a health check expression once;
+	// it helps guard the controller against expressions that are too expensive to evaluate.
+	healthCheckCostLimit = 1000000
+)
+
+var (
+	// healthCheckInterval is the time to wait before rechecking the health of the clusters in a stage.
+	// Put it as a variable for convenient testing.
+	healthCheckInterval = 30 * time.Second
+)
+
+// compileHealthCheckExpressions compiles the expressions of a stage health check into CEL programs.
+func compileHealthCheckExpressions(healthCheck *placementv1beta1.StageHealthCheck) ([]cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(healthCheckClusterVarName, cel.DynType),
+		cel.Variable(healthCheckResourcesVarName, cel.ListType(cel.DynType)),
+		ext.Strings(),
+	)
+	if err != nil {
+		// Normally this branch should never run.
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+
+	programs := make([]cel.Program, len(healthCheck.Expressions))
+	for i, expr := range healthCheck.Expressions {
+		ast, iss := env.Compile(expr)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("failed to compile the expression %q: %w", expr, iss.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("the expression %q must evaluate to a bool, got %s", expr, ast.OutputType())
+		}
+		program, err := env.Program(ast, cel.CostLimit(healthCheckCostLimit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build a program for the expression %q: %w", expr, err)
+		}
+		programs[i] = program
+	}
+	return programs, nil
+}
+
+// handleStageHealthCheckTask handles the health check after stage task logic.
+// It checks the health of all the clusters in the stage until the soak time has elapsed, and fails the update run
+// as soon as any of the clusters is found unhealthy.
+// It returns true if the task has completed, the time to wait before rechecking, and any error encountered.
+func (r *Reconciler) handleStageHealthCheckTask(
+	ctx context.Context,
+	stageTaskStatus *placementv1beta1.StageTaskStatus,
+	task *placementv1beta1.StageTask,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	updateRun placementv1beta1.UpdateRunObj,
+) (bool, time.Duration, error) {
+	updateRunRef := klog.KObj(updateRun)
+
+	if condition.IsConditionStatusTrue(meta.FindStatusCondition(stageTaskStatus.Conditions, string(placementv1beta1.StageTaskConditionHealthCheckSucceeded)), updateRun.GetGeneration()) {
+		// The health check has completed.
+		return true, 0, nil
+	}
+	if task.HealthCheck == nil {
+		// This should never happen as the task has been validated during the initialization.
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the health check task in stage `%s` has no health check specified", updatingStageStatus.StageName))
+		klog.ErrorS(unexpectedErr, "Found a health check task without health check", "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+	}
+	programs, err := compileHealthCheckExpressions(task.HealthCheck)
+	if err != nil {
+		invalidErr := controller.NewUserError(fmt.Errorf("the health check task in stage `%s` is invalid: %w", updatingStageStatus.StageName, err))
+		klog.ErrorS(invalidErr, "Failed to compile the health check expressions", "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, invalidErr.Error())
+	}
+
+	// Check the time first so that the clusters are checked at least once after the soak time has elapsed.
+	soakStartTime := meta.FindStatusCondition(updatingStageStatus.Conditions, string(placementv1beta1.StageUpdatingConditionProgressing)).LastTransitionTime.Time
+	soakTimeLeft := time.Until(soakStartTime.Add(task.HealthCheck.SoakTime.Duration))
+
+	placementKey := types.NamespacedName{Name: updateRun.GetUpdateRunSpec().PlacementName, Namespace: updateRun.GetNamespace()}
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the placement for the health check", "placement", placementKey, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		return false, -1, controller.NewAPIServerError(true, err)
+	}
+	for i := range updatingStageStatus.Clusters {
+		clusterName := updatingStageStatus.Clusters[i].ClusterName
+		vars, err := r.buildHealthCheckVars(ctx, placement, clusterName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to collect the status for the health check", "cluster", clusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+			return false, -1, err
+		}
+		if healthy, reason := evaluateHealthCheck(programs, task.HealthCheck.Expressions, vars); !healthy {
+			unhealthyErr := controller.NewUserError(fmt.Errorf("the cluster `%s` in the stage `%s` is unhealthy: %s", clusterName, updatingStageStatus.StageName, reason))
+			klog.ErrorS(unhealthyErr, "The health check after stage task has found an unhealthy cluster", "cluster", clusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+			markAfterStageHealthCheckFailed(stageTaskStatus, updateRun.GetGeneration(), unhealthyErr.Error())
+			return false, -1, fmt.Errorf("%w: %w", errStagedUpdatedAborted, unhealthyErr)
+		}
+	}
+
+	if soakTimeLeft > 0 {
+		klog.V(2).InfoS("The clusters in the stage are healthy, the health check after stage task still needs to soak", "soakStartTime", soakStartTime, "soakTime", task.HealthCheck.SoakTime, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		return false, min(soakTimeLeft, healthCheckInterval), nil
+	}
+	markAfterStageHealthCheckSucceeded(stageTaskStatus, updateRun.GetGeneration())
+	klog.V(2).InfoS("The after stage health check task has completed", "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+	return true, 0, nil
+}
+
+// buildHealthCheckVars collects the status of the placement on a cluster and the statuses reported back from
+// the resources placed on the cluster, as the variables to evaluate the health check expressions with.
+func (r *Reconciler) buildHealthCheckVars(ctx context.Context, placement placementv1beta1.PlacementObj, clusterName string) (map[string]interface{}, error) {
+	var clusterStatus map[string]interface{}
+	perClusterStatuses := placement.GetPlacementStatus().PerClusterPlacementStatuses
+	for i := range perClusterStatuses {
+		if perClusterStatuses[i].ClusterName != clusterName {
+			continue
+		}
+		status, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&perClusterStatuses[i])
+		if err != nil {
+			return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the status of the placement on cluster `%s`: %w", clusterName, err))
+		}
+		clusterStatus = status
+		break
+	}
+
+	// List the works of the placement in the reserved namespace of the cluster.
+	labelMatcher := client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: placement.GetName()}
+	if placement.GetNamespace() != "" {
+		labelMatcher[placementv1beta1.ParentNamespaceLabel] = placement.GetNamespace()
+	}
+	workList := &placementv1beta1.WorkList{}
+	if err := r.Client.List(ctx, workList, client.InNamespace(fmt.Sprintf(utils.NamespaceNameFormat, clusterName)), labelMatcher); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	resources := make([]interface{}, 0)
+	for i := range workList.Items {
+		work := &workList.Items[i]
+		if placement.GetNamespace() == "" && work.Labels[placementv1beta1.ParentNamespaceLabel] != "" {
+			// The work belongs to a resource placement of the same name.
+			continue
+		}
+		for j := range work.Status.ManifestConditions {
+			manifestCond := &work.Status.ManifestConditions[j]
+			if manifestCond.BackReportedStatus == nil || len(manifestCond.BackReportedStatus.ObservedStatus.Raw) == 0 {
+				continue
+			}
+			statusWrapper := make(map[string]interface{})
+			if err := json.Unmarshal(manifestCond.BackReportedStatus.ObservedStatus.Raw, &statusWrapper); err != nil {
+				return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to unmarshal the back-reported status in work `%s`: %w", klog.KObj(work), err))
+			}
+			identifier := manifestCond.Identifier
+			resources = append(resources, map[string]interface{}{
+				"group":     identifier.Group,
+				"version":   identifier.Version,
+				"kind":      identifier.Kind,
+				"namespace": identifier.Namespace,
+				"name":      identifier.Name,
+				"status":    statusWrapper["status"],
+			})
+		}
+	}
+
+	vars := map[string]interface{}{
+		healthCheckResourcesVarName: resources,
+	}
+	if clusterStatus != nil {
+		vars[healthCheckClusterVarName] = clusterStatus
+	}
+	return vars, nil
+}
+
+// evaluateHealthCheck evaluates the health check expressions with the given variables.
+// It returns true if all the expressions evaluate to true, or false with the reason otherwise.
+func evaluateHealthCheck(programs []cel.Program, expressions []string, vars map[string]interface{}) (bool, string) {
+	if _, ok := vars[healthCheckClusterVarName]; !ok {
+		return false, "the placement does not report any status for the cluster"
+	}
+	for i, program := range programs {
+		out, _, err := program.Eval(vars)
+		if err != nil {
+			return false, fmt.Sprintf("failed to evaluate the expression %q: %v", expressions[i], err)
+		}
+		healthy, ok := out.(celtypes.Bool)
+		if !ok {
+			return false, fmt.Sprintf("the expression %q evaluated to a non-bool value of type %s", expressions[i], out.Type())
+		}
+		if !healthy {
+			return false, fmt.Sprintf("the expression %q evaluated to false", expressions[i])
+		}
+	}
+	return true, ""
+}
+
+// markAfterStageHealthCheckSucceeded marks the HealthCheck after stage task as succeeded in memory.
+func markAfterStageHealthCheckSucceeded(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionHealthCheckSucceeded),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskHealthCheckSucceededReason,
+		Message:            "All the clusters in the stage have stayed healthy for the soak time",
+	})
+}
+
+// markAfterStageHealthCheckFailed marks the HealthCheck after stage task as failed in memory.
+func markAfterStageHealthCheckFailed(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, message string) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionHealthCheckSucceeded),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskHealthCheckFailedReason,
+		Message:            message,
+	})
+}
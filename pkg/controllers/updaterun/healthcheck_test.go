@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestEvaluateHealthCheck(t *testing.T) {
+	clusterStatus := map[string]interface{}{
+		"clusterName": "cluster-1",
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Available", "status": "True"},
+		},
+	}
+	resources := []interface{}{
+		map[string]interface{}{
+			"group": "apps", "version": "v1", "kind": "Deployment", "namespace": "app", "name": "web",
+			"status": map[string]interface{}{"replicas": int64(3), "availableReplicas": int64(3)},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expressions []string
+		vars        map[string]interface{}
+		wantHealthy bool
+		wantReason  string
+	}{
+		{
+			name: "all expressions evaluate to true",
+			expressions: []string{
+				`cluster.conditions.exists(c, c.type == "Available" && c.status == "True")`,
+				`resources.all(r, r.kind != "Deployment" || r.status.availableReplicas == r.status.replicas)`,
+			},
+			vars:        map[string]interface{}{healthCheckClusterVarName: clusterStatus, healthCheckResourcesVarName: resources},
+			wantHealthy: true,
+		},
+		{
+			name:        "an expression evaluates to false",
+			expressions: []string{"true", "size(resources) == 0"},
+			vars:        map[string]interface{}{healthCheckClusterVarName: clusterStatus, healthCheckResourcesVarName: resources},
+			wantReason:  `the expression "size(resources) == 0" evaluated to false`,
+		},
+		{
+			name:        "an expression fails to evaluate",
+			expressions: []string{"resources.all(r, r.status.readyReplicas > 0)"},
+			vars:        map[string]interface{}{healthCheckClusterVarName: clusterStatus, healthCheckResourcesVarName: resources},
+			wantReason:  `failed to evaluate the expression "resources.all(r, r.status.readyReplicas > 0)": no such key: readyReplicas`,
+		},
+		{
+			name:        "no status for the cluster",
+			expressions: []string{"true"},
+			vars:        map[string]interface{}{healthCheckResourcesVarName: resources},
+			wantReason:  "the placement does not report any status for the cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			programs, err := compileHealthCheckExpressions(&placementv1beta1.StageHealthCheck{Expressions: tt.expressions})
+			if err != nil {
+				t.Fatalf("compileHealthCheckExpressions() got error: %v", err)
+			}
+			gotHealthy, gotReason := evaluateHealthCheck(programs, tt.expressions, tt.vars)
+			if gotHealthy != tt.wantHealthy || gotReason != tt.wantReason {
+				t.Errorf("evaluateHealthCheck() = (%t, %q), want (%t, %q)", gotHealthy, gotReason, tt.wantHealthy, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestHandleStageHealthCheckTask(t *testing.T) {
+	placementName := "test-placement"
+	clusterName := "cluster-1"
+	placementWithClusterStatus := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: placementName},
+		Status: placementv1beta1.PlacementStatus{
+			PerClusterPlacementStatuses: []placementv1beta1.PerClusterPlacementStatus{
+				{
+					ClusterName: clusterName,
+					Conditions: []metav1.Condition{
+						{Type: string(placementv1beta1.PerClusterAvailableConditionType), Status: metav1.ConditionTrue},
+					},
+				},
+			},
+		},
+	}
+	work := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(placementv1beta1.FirstWorkNameFmt, placementName),
+			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, clusterName),
+			Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: placementName},
+		},
+		Status: placementv1beta1.WorkStatus{
+			ManifestConditions: []placementv1beta1.ManifestCondition{
+				{
+					Identifier: placementv1beta1.WorkResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "app", Name: "web"},
+					BackReportedStatus: &placementv1beta1.BackReportedStatus{
+						ObservedStatus: runtime.RawExtension{Raw: []byte(`{"status":{"replicas":3,"availableReplicas":2}}`)},
+					},
+				},
+			},
+		},
+	}
+	healthyExpressions := []string{`cluster.conditions.exists(c, c.type == "Available" && c.status == "True")`}
+	unhealthyExpressions := []string{`resources.all(r, r.status.availableReplicas == r.status.replicas)`}
+
+	tests := []struct {
+		name                string
+		objs                []client.Object
+		expressions         []string
+		soakStartedAgo      time.Duration
+		taskConditions      []metav1.Condition
+		wantCompleted       bool
+		wantWaiting         bool
+		wantAborted         bool
+		wantConditionStatus metav1.ConditionStatus
+	}{
+		{
+			name:           "healthy clusters within the soak time",
+			objs:           []client.Object{placementWithClusterStatus, work},
+			expressions:    healthyExpressions,
+			soakStartedAgo: time.Minute,
+			wantWaiting:    true,
+		},
+		{
+			name:                "healthy clusters after the soak time",
+			objs:                []client.Object{placementWithClusterStatus, work},
+			expressions:         healthyExpressions,
+			soakStartedAgo:      time.Hour,
+			wantCompleted:       true,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name:                "unhealthy cluster within the soak time",
+			objs:                []client.Object{placementWithClusterStatus, work},
+			expressions:         unhealthyExpressions,
+			soakStartedAgo:      time.Minute,
+			wantAborted:         true,
+			wantConditionStatus: metav1.ConditionFalse,
+		},
+		{
+			name:                "cluster without placement status",
+			objs:                []client.Object{&placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: placementName}}},
+			expressions:         healthyExpressions,
+			soakStartedAgo:      time.Minute,
+			wantAborted:         true,
+			wantConditionStatus: metav1.ConditionFalse,
+		},
+		{
+			name:           "health check already succeeded",
+			expressions:    unhealthyExpressions,
+			soakStartedAgo: time.Hour,
+			taskConditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.StageTaskConditionHealthCheckSucceeded),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Reason:             condition.AfterStageTaskHealthCheckSucceededReason,
+				},
+			},
+			wantCompleted:       true,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-update-run",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName: placementName,
+				},
+			}
+			stageStatus := &placementv1beta1.StageUpdatingStatus{
+				StageName: "test-stage",
+				Clusters:  []placementv1beta1.ClusterUpdatingStatus{{ClusterName: clusterName}},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.StageUpdatingConditionProgressing),
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 1,
+						Reason:             condition.StageUpdatingWaitingReason,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-tt.soakStartedAgo)),
+					},
+				},
+			}
+			taskStatus := &placementv1beta1.StageTaskStatus{
+				Type:       placementv1beta1.StageTaskTypeHealthCheck,
+				Conditions: tt.taskConditions,
+			}
+			task := &placementv1beta1.StageTask{
+				Type: placementv1beta1.StageTaskTypeHealthCheck,
+				HealthCheck: &placementv1beta1.StageHealthCheck{
+					Expressions: tt.expressions,
+					SoakTime:    metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objs...).Build(),
+			}
+
+			gotCompleted, gotWaitTime, err := r.handleStageHealthCheckTask(ctx, taskStatus, task, stageStatus, updateRun)
+			if tt.wantAborted {
+				if !errors.Is(err, errStagedUpdatedAborted) {
+					t.Fatalf("handleStageHealthCheckTask() got error %v, want errStagedUpdatedAborted", err)
+				}
+			} else if err != nil {
+				t.Fatalf("handleStageHealthCheckTask() got error: %v", err)
+			}
+			if gotCompleted != tt.wantCompleted {
+				t.Errorf("handleStageHealthCheckTask() completed = %t, want %t", gotCompleted, tt.wantCompleted)
+			}
+			if tt.wantWaiting && (gotWaitTime <= 0 || gotWaitTime > healthCheckInterval) {
+				t.Errorf("handleStageHealthCheckTask() waitTime = %v, want in (0, %v]", gotWaitTime, healthCheckInterval)
+			}
+			cond := meta.FindStatusCondition(taskStatus.Conditions, string(placementv1beta1.StageTaskConditionHealthCheckSucceeded))
+			var gotConditionStatus metav1.ConditionStatus
+			if cond != nil {
+				gotConditionStatus = cond.Status
+			}
+			if gotConditionStatus != tt.wantConditionStatus {
+				t.Errorf("handleStageHealthCheckTask() HealthCheckSucceeded condition status = %q, want %q", gotConditionStatus, tt.wantConditionStatus)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -346,6 +348,17 @@ func (r *Reconciler) computeRunStageStatus(
 			invalidAfterStageErr := controller.NewUserError(fmt.Errorf("the after stage tasks are invalid, updateStrategy: `%s`, stage: %s, err: %s", strategyKey, stage.Name, err.Error()))
 			return fmt.Errorf("%w: %s", errValidationFailed, invalidAfterStageErr.Error())
 		}
+		for _, task := range append(slices.Clone(stage.BeforeStageTasks), stage.AfterStageTasks...) {
+			if task.Webhook == nil {
+				continue
+			}
+			if err := r.validateStageWebhookHost(updateRun, task.Webhook); err != nil {
+				klog.ErrorS(err, "The stage webhook targets a host that is not allowed", "updateStrategy", strategyKey, "stageName", stage.Name, "url", task.Webhook.URL, "updateRun", updateRunRef)
+				// no more retries here.
+				disallowedWebhookErr := controller.NewUserError(fmt.Errorf("the stage webhook is not allowed, updateStrategy: `%s`, stage: %s, err: %s", strategyKey, stage.Name, err.Error()))
+				return fmt.Errorf("%w: %s", errValidationFailed, disallowedWebhookErr.Error())
+			}
+		}
 
 		curStageUpdatingStatus := placementv1beta1.StageUpdatingStatus{StageName: stage.Name}
 		var curStageClusters []clusterv1beta1.MemberCluster
@@ -455,15 +468,27 @@ func (r *Reconciler) computeRunStageStatus(
 // validateBeforeStageTask validates the beforeStageTasks in the stage defined in the UpdateStrategy.
 // The error returned from this function is not retriable.
 func validateBeforeStageTask(tasks []placementv1beta1.StageTask) error {
-	if len(tasks) > 1 {
-		return fmt.Errorf("beforeStageTasks can have at most one task")
+	if len(tasks) > 2 {
+		return fmt.Errorf("beforeStageTasks can have at most two tasks")
+	}
+	if len(tasks) == 2 && tasks[0].Type == tasks[1].Type {
+		return fmt.Errorf("beforeStageTasks cannot have two tasks of the same type: %s", tasks[0].Type)
 	}
 	for i, task := range tasks {
-		if task.Type != placementv1beta1.StageTaskTypeApproval {
-			return fmt.Errorf("task %d of type %s is not allowed in beforeStageTasks, allowed type: Approval", i, task.Type)
+		if task.Type != placementv1beta1.StageTaskTypeWebhook && task.Webhook != nil {
+			return fmt.Errorf("task %d of type %s cannot have webhook set", i, task.Type)
 		}
-		if task.WaitTime != nil {
-			return fmt.Errorf("task %d of type Approval cannot have wait duration set", i)
+		switch task.Type {
+		case placementv1beta1.StageTaskTypeApproval:
+			if task.WaitTime != nil {
+				return fmt.Errorf("task %d of type Approval cannot have wait duration set", i)
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			if err := validateWebhookTask(i, task); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("task %d of type %s is not allowed in beforeStageTasks, allowed types: Approval, Webhook", i, task.Type)
 		}
 	}
 	return nil
@@ -472,22 +497,69 @@ func validateBeforeStageTask(tasks []placementv1beta1.StageTask) error {
 // validateAfterStageTask validates the afterStageTasks in the stage defined in the UpdateStrategy.
 // The error returned from this function is not retriable.
 func validateAfterStageTask(tasks []placementv1beta1.StageTask) error {
-	if len(tasks) == 2 && tasks[0].Type == tasks[1].Type {
-		return fmt.Errorf("afterStageTasks cannot have two tasks of the same type: %s", tasks[0].Type)
+	taskTypes := make(map[placementv1beta1.StageTaskType]struct{}, len(tasks))
+	for _, task := range tasks {
+		if _, ok := taskTypes[task.Type]; ok {
+			return fmt.Errorf("afterStageTasks cannot have two tasks of the same type: %s", task.Type)
+		}
+		taskTypes[task.Type] = struct{}{}
 	}
 	for i, task := range tasks {
-		if task.Type == placementv1beta1.StageTaskTypeTimedWait {
+		if task.Type != placementv1beta1.StageTaskTypeHealthCheck && task.HealthCheck != nil {
+			return fmt.Errorf("task %d of type %s cannot have health check set", i, task.Type)
+		}
+		if task.Type != placementv1beta1.StageTaskTypeWebhook && task.Webhook != nil {
+			return fmt.Errorf("task %d of type %s cannot have webhook set", i, task.Type)
+		}
+		switch task.Type {
+		case placementv1beta1.StageTaskTypeTimedWait:
 			if task.WaitTime == nil {
 				return fmt.Errorf("task %d of type TimedWait has wait duration set to nil", i)
 			}
 			if task.WaitTime.Duration <= 0 {
 				return fmt.Errorf("task %d of type TimedWait has wait duration <= 0", i)
 			}
+		case placementv1beta1.StageTaskTypeHealthCheck:
+			if task.HealthCheck == nil {
+				return fmt.Errorf("task %d of type HealthCheck has health check set to nil", i)
+			}
+			if task.WaitTime != nil {
+				return fmt.Errorf("task %d of type HealthCheck cannot have wait duration set", i)
+			}
+			if task.HealthCheck.SoakTime.Duration <= 0 {
+				return fmt.Errorf("task %d of type HealthCheck has soak time <= 0", i)
+			}
+			if _, err := compileHealthCheckExpressions(task.HealthCheck); err != nil {
+				return fmt.Errorf("task %d of type HealthCheck is invalid: %w", i, err)
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			if err := validateWebhookTask(i, task); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// validateWebhookTask validates a before or after stage task of type Webhook.
+// The error returned from this function is not retriable.
+func validateWebhookTask(i int, task placementv1beta1.StageTask) error {
+	if task.Webhook == nil {
+		return fmt.Errorf("task %d of type Webhook has webhook set to nil", i)
+	}
+	if task.WaitTime != nil {
+		return fmt.Errorf("task %d of type Webhook cannot have wait duration set", i)
+	}
+	webhookURL, err := url.Parse(task.Webhook.URL)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return fmt.Errorf("task %d of type Webhook has an invalid URL %q", i, task.Webhook.URL)
+	}
+	if _, err := newStageWebhookHTTPClient(task.Webhook.CABundle); err != nil {
+		return fmt.Errorf("task %d of type Webhook is invalid: %w", i, err)
+	}
+	return nil
+}
+
 // recordOverrideSnapshots finds all the override snapshots that are associated with each cluster and record them in the UpdateRun status.
 func (r *Reconciler) recordOverrideSnapshots(ctx context.Context, placement placementv1beta1.PlacementObj, updateRun placementv1beta1.UpdateRunObj) error {
 	updateRunRef := klog.KObj(updateRun)
@@ -44,7 +44,41 @@ func TestValidateBeforeStageTask(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "invalid BeforeTasks, greater than 1 task",
+			name: "valid BeforeTasks, with Approval and Webhook",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid BeforeTasks, greater than 2 tasks",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "beforeStageTasks can have at most two tasks",
+		},
+		{
+			name: "invalid BeforeTasks, same type of tasks",
 			task: []placementv1beta1.StageTask{
 				{
 					Type: placementv1beta1.StageTaskTypeApproval,
@@ -54,7 +88,7 @@ func TestValidateBeforeStageTask(t *testing.T) {
 				},
 			},
 			wantErr:    true,
-			wantErrMsg: "beforeStageTasks can have at most one task",
+			wantErrMsg: "beforeStageTasks cannot have two tasks of the same type: Approval",
 		},
 		{
 			name: "invalid BeforeTasks, with invalid task type",
@@ -65,7 +99,57 @@ func TestValidateBeforeStageTask(t *testing.T) {
 				},
 			},
 			wantErr:    true,
-			wantErrMsg: fmt.Sprintf("task %d of type %s is not allowed in beforeStageTasks, allowed type: Approval", 0, placementv1beta1.StageTaskTypeTimedWait),
+			wantErrMsg: fmt.Sprintf("task %d of type %s is not allowed in beforeStageTasks, allowed types: Approval, Webhook", 0, placementv1beta1.StageTaskTypeTimedWait),
+		},
+		{
+			name: "invalid BeforeTasks, with nil webhook for Webhook",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "task 0 of type Webhook has webhook set to nil",
+		},
+		{
+			name: "invalid BeforeTasks, with webhook for Approval",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "task 0 of type Approval cannot have webhook set",
+		},
+		{
+			name: "invalid BeforeTasks, with invalid webhook URL",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "ftp://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: `task 0 of type Webhook has an invalid URL "ftp://change-management.example.com/approve"`,
+		},
+		{
+			name: "invalid BeforeTasks, with invalid webhook CA bundle",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL:      "https://change-management.example.com/approve",
+						CABundle: []byte("not a certificate"),
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "task 0 of type Webhook is invalid: the CA bundle does not contain any valid PEM encoded certificate",
 		},
 		{
 			name: "invalid BeforeTasks, with duration for Approval",
@@ -150,6 +234,135 @@ func TestValidateAfterStageTask(t *testing.T) {
 			wantErr: true,
 			errMsg:  "task 0 of type TimedWait has wait duration <= 0",
 		},
+		{
+			name: "valid AfterTasks, with all task types",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type:     placementv1beta1.StageTaskTypeTimedWait,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeHealthCheck,
+					HealthCheck: &placementv1beta1.StageHealthCheck{
+						Expressions: []string{`cluster.conditions.exists(c, c.type == "Available" && c.status == "True")`},
+						SoakTime:    metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid AfterTasks, same type of tasks among three",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type:     placementv1beta1.StageTaskTypeTimedWait,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+			},
+			wantErr: true,
+			errMsg:  "afterStageTasks cannot have two tasks of the same type: Approval",
+		},
+		{
+			name: "invalid AfterTasks, with nil health check for HealthCheck",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeHealthCheck,
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type HealthCheck has health check set to nil",
+		},
+		{
+			name: "invalid AfterTasks, with health check for TimedWait",
+			task: []placementv1beta1.StageTask{
+				{
+					Type:     placementv1beta1.StageTaskTypeTimedWait,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+					HealthCheck: &placementv1beta1.StageHealthCheck{
+						Expressions: []string{"true"},
+						SoakTime:    metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type TimedWait cannot have health check set",
+		},
+		{
+			name: "invalid AfterTasks, with zero soak time for HealthCheck",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeHealthCheck,
+					HealthCheck: &placementv1beta1.StageHealthCheck{
+						Expressions: []string{"true"},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type HealthCheck has soak time <= 0",
+		},
+		{
+			name: "invalid AfterTasks, with non-bool expression for HealthCheck",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeHealthCheck,
+					HealthCheck: &placementv1beta1.StageHealthCheck{
+						Expressions: []string{"size(resources)"},
+						SoakTime:    metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  `task 0 of type HealthCheck is invalid: the expression "size(resources)" must evaluate to a bool, got int`,
+		},
+		{
+			name: "valid AfterTasks, with Webhook",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid AfterTasks, with duration for Webhook",
+			task: []placementv1beta1.StageTask{
+				{
+					Type:     placementv1beta1.StageTaskTypeWebhook,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type Webhook cannot have wait duration set",
+		},
+		{
+			name: "invalid AfterTasks, with webhook for TimedWait",
+			task: []placementv1beta1.StageTask{
+				{
+					Type:     placementv1beta1.StageTaskTypeTimedWait,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+					Webhook: &placementv1beta1.StageWebhook{
+						URL: "https://change-management.example.com/approve",
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type TimedWait cannot have webhook set",
+		},
 	}
 
 	for _, tt := range tests {
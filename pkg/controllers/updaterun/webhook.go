@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// defaultStageWebhookTimeout is the time to wait for a stage webhook to respond to a single call,
+	// if the timeout is not specified in the task.
+	defaultStageWebhookTimeout = 10 * time.Second
+
+	// maxStageWebhookTimeout is the maximum time to wait for a stage webhook to respond to a single call.
+	maxStageWebhookTimeout = 60 * time.Second
+
+	// defaultStageWebhookMaxRetries is the number of times to retry a failed call to a stage webhook,
+	// if the max retries are not specified in the task.
+	defaultStageWebhookMaxRetries = 3
+
+	// stageWebhookRetryInterval is the time to wait before the first retry of a failed call to a stage webhook;
+	// the wait time doubles with each retry.
+	stageWebhookRetryInterval = time.Second
+
+	// stageWebhookMaxRetryInterval is the maximum time to wait before retrying a failed call to a stage webhook.
+	stageWebhookMaxRetryInterval = 30 * time.Second
+
+	// stageWebhookResponseSizeLimit is the maximum size of a stage webhook response body that is read.
+	stageWebhookResponseSizeLimit = 1 << 20
+)
+
+// stageWebhookRequest is the body of the request that is sent to a stage webhook.
+type stageWebhookRequest struct {
+	UpdateRun             string   `json:"updateRun"`
+	Namespace             string   `json:"namespace,omitempty"`
+	PlacementName         string   `json:"placementName"`
+	StageName             string   `json:"stageName"`
+	TaskType              string   `json:"taskType"`
+	Clusters              []string `json:"clusters"`
+	ResourceSnapshotIndex string   `json:"resourceSnapshotIndex"`
+}
+
+// stageWebhookResponse is the body of the response that a stage webhook returns.
+type stageWebhookResponse struct {
+	Approved bool   `json:"approved"`
+	Message  string `json:"message,omitempty"`
+}
+
+// handleStageWebhookTask handles the webhook task logic for before or after stage tasks.
+// It calls the webhook at most once per reconciliation, and returns true if the webhook approves the stage,
+// along with the time to wait before retrying a failed call, which is -1 if there is no need to retry.
+// The update run is aborted if the webhook rejects the stage or all the attempts fail.
+func (r *Reconciler) handleStageWebhookTask(
+	ctx context.Context,
+	stageTaskStatus *placementv1beta1.StageTaskStatus,
+	task *placementv1beta1.StageTask,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	updateRun placementv1beta1.UpdateRunObj,
+	stageTaskType string,
+) (bool, time.Duration, error) {
+	updateRunRef := klog.KObj(updateRun)
+
+	if condition.IsConditionStatusTrue(meta.FindStatusCondition(stageTaskStatus.Conditions, string(placementv1beta1.StageTaskConditionWebhookApproved)), updateRun.GetGeneration()) {
+		// The webhook has approved the stage.
+		return true, -1, nil
+	}
+	if task.Webhook == nil {
+		// This should never happen as the task has been validated during the initialization.
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the webhook task in stage `%s` has no webhook specified", updatingStageStatus.StageName))
+		klog.ErrorS(unexpectedErr, "Found a webhook task without webhook", "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+	}
+	// Check the host again, as the allowed hosts might have changed since the initialization.
+	if err := r.validateStageWebhookHost(updateRun, task.Webhook); err != nil {
+		disallowedErr := controller.NewUserError(fmt.Errorf("the webhook of the %s task in stage `%s` is not allowed: %w", stageTaskType, updatingStageStatus.StageName, err))
+		klog.ErrorS(disallowedErr, "The stage webhook targets a host that is not allowed", "url", task.Webhook.URL, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		markStageTaskWebhookNotApproved(stageTaskStatus, updateRun.GetGeneration(), condition.StageTaskWebhookFailedReason, disallowedErr.Error())
+		return false, -1, fmt.Errorf("%w: %w", errStagedUpdatedAborted, disallowedErr)
+	}
+
+	now := time.Now()
+	if lastAttemptTime := stageTaskStatus.LastWebhookAttemptTime; lastAttemptTime != nil {
+		if waitTime := lastAttemptTime.Add(stageWebhookRetryBackoff(stageTaskStatus.WebhookAttempts)).Sub(now); waitTime > 0 {
+			klog.V(2).InfoS("Waiting to retry the stage webhook", "url", task.Webhook.URL, "attempts", stageTaskStatus.WebhookAttempts, "waitTime", waitTime, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+			return false, waitTime, nil
+		}
+	}
+
+	clusters := make([]string, len(updatingStageStatus.Clusters))
+	for i := range updatingStageStatus.Clusters {
+		clusters[i] = updatingStageStatus.Clusters[i].ClusterName
+	}
+	request := &stageWebhookRequest{
+		UpdateRun:             updateRun.GetName(),
+		Namespace:             updateRun.GetNamespace(),
+		PlacementName:         updateRun.GetUpdateRunSpec().PlacementName,
+		StageName:             updatingStageStatus.StageName,
+		TaskType:              stageTaskType,
+		Clusters:              clusters,
+		ResourceSnapshotIndex: updateRun.GetUpdateRunStatus().ResourceSnapshotIndexUsed,
+	}
+	response, err := sendStageWebhookRequest(ctx, task.Webhook, request)
+	if err != nil && ctx.Err() != nil {
+		// The controller is shutting down; retry the task in the next reconciliation.
+		return false, -1, err
+	}
+	stageTaskStatus.WebhookAttempts++
+	stageTaskStatus.LastWebhookAttemptTime = &metav1.Time{Time: now}
+	if err != nil {
+		maxAttempts := ptr.Deref(task.Webhook.MaxRetries, defaultStageWebhookMaxRetries) + 1
+		if stageTaskStatus.WebhookAttempts < maxAttempts {
+			waitTime := stageWebhookRetryBackoff(stageTaskStatus.WebhookAttempts)
+			klog.V(2).InfoS("Failed to call the stage webhook, will retry", "url", task.Webhook.URL, "attempts", stageTaskStatus.WebhookAttempts, "waitTime", waitTime, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef, "err", err)
+			return false, waitTime, nil
+		}
+		failedErr := controller.NewUserError(fmt.Errorf("failed to call the webhook of the %s task in stage `%s`: %d attempt(s) failed, last error: %w",
+			stageTaskType, updatingStageStatus.StageName, stageTaskStatus.WebhookAttempts, err))
+		klog.ErrorS(failedErr, "All the calls to the stage webhook have failed", "url", task.Webhook.URL, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		markStageTaskWebhookNotApproved(stageTaskStatus, updateRun.GetGeneration(), condition.StageTaskWebhookFailedReason, failedErr.Error())
+		return false, -1, fmt.Errorf("%w: %w", errStagedUpdatedAborted, failedErr)
+	}
+	if !response.Approved {
+		rejectedErr := controller.NewUserError(fmt.Errorf("the webhook of the %s task in stage `%s` has rejected the stage: %s", stageTaskType, updatingStageStatus.StageName, response.Message))
+		klog.ErrorS(rejectedErr, "The stage webhook has rejected the stage", "url", task.Webhook.URL, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+		markStageTaskWebhookNotApproved(stageTaskStatus, updateRun.GetGeneration(), condition.StageTaskWebhookRejectedReason, rejectedErr.Error())
+		return false, -1, fmt.Errorf("%w: %w", errStagedUpdatedAborted, rejectedErr)
+	}
+	klog.V(2).InfoS("The stage webhook has approved the stage", "url", task.Webhook.URL, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+	markStageTaskWebhookApproved(stageTaskStatus, updateRun.GetGeneration(), response.Message)
+	return true, -1, nil
+}
+
+// validateStageWebhookHost checks if the webhook of a namespaced update run targets one of the hosts that the
+// hub agent allows. A namespaced update strategy can be authored by namespace users, who must not be able to
+// make the hub agent send requests to arbitrary addresses, e.g., the internal endpoints of the hub cluster network.
+func (r *Reconciler) validateStageWebhookHost(updateRun placementv1beta1.UpdateRunObj, webhook *placementv1beta1.StageWebhook) error {
+	if updateRun.GetNamespace() == "" {
+		// The cluster-scoped update runs and update strategies are managed by the fleet administrators.
+		return nil
+	}
+	webhookURL, err := url.Parse(webhook.URL)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", webhook.URL, err)
+	}
+	if !slices.ContainsFunc(r.AllowedStageWebhookHosts, func(host string) bool {
+		return strings.EqualFold(host, webhookURL.Hostname())
+	}) {
+		return fmt.Errorf("the host %q is not in the list of hosts that the webhooks of namespaced update runs may target", webhookURL.Hostname())
+	}
+	return nil
+}
+
+// stageWebhookRetryBackoff returns the time to wait after the given number of attempts to call a stage webhook
+// before the next attempt, which doubles with each attempt.
+func stageWebhookRetryBackoff(attempts int32) time.Duration {
+	backoff := stageWebhookRetryInterval
+	for i := int32(1); i < attempts && backoff < stageWebhookMaxRetryInterval; i++ {
+		backoff *= 2
+	}
+	return min(backoff, stageWebhookMaxRetryInterval)
+}
+
+// sendStageWebhookRequest calls a stage webhook once with the given request.
+func sendStageWebhookRequest(ctx context.Context, webhook *placementv1beta1.StageWebhook, request *stageWebhookRequest) (*stageWebhookResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to marshal the webhook request: %w", err))
+	}
+	httpClient, err := newStageWebhookHTTPClient(webhook.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	return callStageWebhook(ctx, httpClient, webhook.URL, body, stageWebhookTimeout(webhook))
+}
+
+// stageWebhookTimeout returns the time to wait for a stage webhook to respond to a single call, which is
+// capped at maxStageWebhookTimeout.
+func stageWebhookTimeout(webhook *placementv1beta1.StageWebhook) time.Duration {
+	if webhook.Timeout == nil || webhook.Timeout.Duration <= 0 {
+		return defaultStageWebhookTimeout
+	}
+	return min(webhook.Timeout.Duration, maxStageWebhookTimeout)
+}
+
+// callStageWebhook calls a stage webhook once.
+func callStageWebhook(ctx context.Context, httpClient *http.Client, url string, body []byte, timeout time.Duration) (*stageWebhookResponse, error) {
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(callCtx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, stageWebhookResponseSizeLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(respBody))
+	}
+	response := &stageWebhookResponse{}
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the response: %w", err)
+	}
+	return response, nil
+}
+
+// newStageWebhookHTTPClient returns the HTTP client to call a stage webhook with, which trusts the given
+// CA bundle (if any) in addition to the system trust roots.
+//
+// The client does not follow redirects, as a redirect could point the call at a host that the webhook is
+// not allowed to target; see validateStageWebhookHost. A redirect response fails the call instead.
+func newStageWebhookHTTPClient(caBundle []byte) (*http.Client, error) {
+	if len(caBundle) == 0 {
+		return &http.Client{CheckRedirect: refuseStageWebhookRedirect}, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, errors.New("the CA bundle does not contain any valid PEM encoded certificate")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	return &http.Client{Transport: transport, CheckRedirect: refuseStageWebhookRedirect}, nil
+}
+
+// refuseStageWebhookRedirect stops the HTTP client from following a redirect, so that the redirect response
+// is returned as is.
+func refuseStageWebhookRedirect(_ *http.Request, _ []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// markStageTaskWebhookApproved marks the Webhook for the before or after stage task as approved in memory.
+func markStageTaskWebhookApproved(stageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, message string) {
+	if message == "" {
+		message = "The webhook has approved the stage"
+	}
+	meta.SetStatusCondition(&stageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionWebhookApproved),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.StageTaskWebhookApprovedReason,
+		Message:            message,
+	})
+}
+
+// markStageTaskWebhookNotApproved marks the Webhook for the before or after stage task as not approved in memory.
+func markStageTaskWebhookNotApproved(stageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, reason, message string) {
+	meta.SetStatusCondition(&stageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionWebhookApproved),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
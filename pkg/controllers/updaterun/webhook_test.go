@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestHandleStageWebhookTask(t *testing.T) {
+	tests := []struct {
+		name            string
+		responses       []func(w http.ResponseWriter)
+		maxRetries      int32
+		taskConditions  []metav1.Condition
+		attempts        int32
+		lastAttemptTime *metav1.Time
+		wantApproved    bool
+		wantAborted     bool
+		wantCalls       int32
+		wantReconciles  int
+		wantCondStatus  metav1.ConditionStatus
+		wantCondReason  string
+		wantCondMessage string
+	}{
+		{
+			name: "webhook approves the stage",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"approved": true, "message": "change CHG-1 approved"}`))
+				},
+			},
+			wantApproved:    true,
+			wantCalls:       1,
+			wantReconciles:  1,
+			wantCondStatus:  metav1.ConditionTrue,
+			wantCondReason:  condition.StageTaskWebhookApprovedReason,
+			wantCondMessage: "change CHG-1 approved",
+		},
+		{
+			name: "webhook rejects the stage",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"approved": false, "message": "change freeze"}`))
+				},
+			},
+			wantAborted:    true,
+			wantCalls:      1,
+			wantReconciles: 1,
+			wantCondStatus: metav1.ConditionFalse,
+			wantCondReason: condition.StageTaskWebhookRejectedReason,
+		},
+		{
+			name: "webhook approves the stage after a failed call",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				},
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"approved": true}`))
+				},
+			},
+			maxRetries:      1,
+			wantApproved:    true,
+			wantCalls:       2,
+			wantReconciles:  2,
+			wantCondStatus:  metav1.ConditionTrue,
+			wantCondReason:  condition.StageTaskWebhookApprovedReason,
+			wantCondMessage: "The webhook has approved the stage",
+		},
+		{
+			name: "all calls to the webhook fail",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`not json`))
+				},
+			},
+			maxRetries:     2,
+			wantAborted:    true,
+			wantCalls:      3,
+			wantReconciles: 3,
+			wantCondStatus: metav1.ConditionFalse,
+			wantCondReason: condition.StageTaskWebhookFailedReason,
+		},
+		{
+			name: "webhook redirect is not followed",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Location", "/redirected")
+					w.WriteHeader(http.StatusTemporaryRedirect)
+				},
+			},
+			wantAborted:    true,
+			wantCalls:      1,
+			wantReconciles: 1,
+			wantCondStatus: metav1.ConditionFalse,
+			wantCondReason: condition.StageTaskWebhookFailedReason,
+		},
+		{
+			name: "webhook has already approved the stage",
+			taskConditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.StageTaskConditionWebhookApproved),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Reason:             condition.StageTaskWebhookApprovedReason,
+					Message:            "approved earlier",
+				},
+			},
+			wantApproved:    true,
+			wantCalls:       0,
+			wantReconciles:  1,
+			wantCondStatus:  metav1.ConditionTrue,
+			wantCondReason:  condition.StageTaskWebhookApprovedReason,
+			wantCondMessage: "approved earlier",
+		},
+		{
+			name: "webhook is not called again before the backoff elapses",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					_, _ = w.Write([]byte(`{"approved": true}`))
+				},
+			},
+			maxRetries:      3,
+			attempts:        2,
+			lastAttemptTime: &metav1.Time{Time: time.Now()},
+			wantCalls:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			var gotRequest stageWebhookRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				if err := json.NewDecoder(r.Body).Decode(&gotRequest); err != nil {
+					t.Errorf("failed to decode the webhook request: %v", err)
+				}
+				tt.responses[min(int(call), len(tt.responses))-1](w)
+			}))
+			defer server.Close()
+
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-update-run",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName: "test-placement",
+				},
+				Status: placementv1beta1.UpdateRunStatus{
+					ResourceSnapshotIndexUsed: "2",
+				},
+			}
+			stageStatus := &placementv1beta1.StageUpdatingStatus{
+				StageName: "test-stage",
+				Clusters:  []placementv1beta1.ClusterUpdatingStatus{{ClusterName: "cluster-1"}, {ClusterName: "cluster-2"}},
+			}
+			taskStatus := &placementv1beta1.StageTaskStatus{
+				Type:                   placementv1beta1.StageTaskTypeWebhook,
+				Conditions:             tt.taskConditions,
+				WebhookAttempts:        tt.attempts,
+				LastWebhookAttemptTime: tt.lastAttemptTime,
+			}
+			task := &placementv1beta1.StageTask{
+				Type: placementv1beta1.StageTaskTypeWebhook,
+				Webhook: &placementv1beta1.StageWebhook{
+					URL:        server.URL,
+					Timeout:    &metav1.Duration{Duration: 5 * time.Second},
+					MaxRetries: ptr.To(tt.maxRetries),
+				},
+			}
+
+			r := &Reconciler{}
+			var gotApproved bool
+			var waitTime time.Duration
+			var err error
+			reconciles := 0
+			for {
+				reconciles++
+				gotApproved, waitTime, err = r.handleStageWebhookTask(context.Background(), taskStatus, task, stageStatus, updateRun, placementv1beta1.AfterStageTaskLabelValue)
+				if err != nil || gotApproved || waitTime < 0 || tt.lastAttemptTime != nil {
+					break
+				}
+				// Wind the clock back to the end of the backoff, instead of waiting it out.
+				if waitTime != stageWebhookRetryBackoff(taskStatus.WebhookAttempts) {
+					t.Fatalf("handleStageWebhookTask() wait time = %v, want %v", waitTime, stageWebhookRetryBackoff(taskStatus.WebhookAttempts))
+				}
+				taskStatus.LastWebhookAttemptTime.Time = taskStatus.LastWebhookAttemptTime.Add(-waitTime)
+			}
+			if tt.wantAborted {
+				if !errors.Is(err, errStagedUpdatedAborted) {
+					t.Fatalf("handleStageWebhookTask() got error %v, want errStagedUpdatedAborted", err)
+				}
+			} else if err != nil {
+				t.Fatalf("handleStageWebhookTask() got error: %v", err)
+			}
+			if gotApproved != tt.wantApproved {
+				t.Errorf("handleStageWebhookTask() approved = %t, want %t", gotApproved, tt.wantApproved)
+			}
+			if gotCalls := atomic.LoadInt32(&calls); gotCalls != tt.wantCalls {
+				t.Errorf("handleStageWebhookTask() called the webhook %d time(s), want %d", gotCalls, tt.wantCalls)
+			}
+			if tt.lastAttemptTime != nil {
+				// The webhook is waiting for the backoff to elapse.
+				if waitTime <= 0 || waitTime > stageWebhookRetryBackoff(tt.attempts) {
+					t.Errorf("handleStageWebhookTask() wait time = %v, want in (0, %v]", waitTime, stageWebhookRetryBackoff(tt.attempts))
+				}
+				if taskStatus.WebhookAttempts != tt.attempts {
+					t.Errorf("handleStageWebhookTask() webhook attempts = %d, want %d", taskStatus.WebhookAttempts, tt.attempts)
+				}
+				return
+			}
+			if reconciles != tt.wantReconciles {
+				t.Errorf("handleStageWebhookTask() took %d reconciliation(s), want %d", reconciles, tt.wantReconciles)
+			}
+			if taskStatus.WebhookAttempts != tt.wantCalls {
+				t.Errorf("handleStageWebhookTask() webhook attempts = %d, want %d", taskStatus.WebhookAttempts, tt.wantCalls)
+			}
+			if tt.wantCalls > 0 {
+				wantRequest := stageWebhookRequest{
+					UpdateRun:             "test-update-run",
+					PlacementName:         "test-placement",
+					StageName:             "test-stage",
+					TaskType:              placementv1beta1.AfterStageTaskLabelValue,
+					Clusters:              []string{"cluster-1", "cluster-2"},
+					ResourceSnapshotIndex: "2",
+				}
+				if diff := cmp.Diff(gotRequest, wantRequest); diff != "" {
+					t.Errorf("handleStageWebhookTask() webhook request mismatch (-got, +want):\n%s", diff)
+				}
+			}
+
+			cond := meta.FindStatusCondition(taskStatus.Conditions, string(placementv1beta1.StageTaskConditionWebhookApproved))
+			if cond == nil {
+				t.Fatalf("handleStageWebhookTask() WebhookApproved condition is not set")
+			}
+			if cond.Status != tt.wantCondStatus || cond.Reason != tt.wantCondReason {
+				t.Errorf("handleStageWebhookTask() WebhookApproved condition = (%s, %s), want (%s, %s)", cond.Status, cond.Reason, tt.wantCondStatus, tt.wantCondReason)
+			}
+			if tt.wantCondMessage != "" && cond.Message != tt.wantCondMessage {
+				t.Errorf("handleStageWebhookTask() WebhookApproved condition message = %q, want %q", cond.Message, tt.wantCondMessage)
+			}
+		})
+	}
+}
+
+func TestStageWebhookTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout *metav1.Duration
+		want    time.Duration
+	}{
+		{
+			name: "timeout is not specified",
+			want: defaultStageWebhookTimeout,
+		},
+		{
+			name:    "timeout is specified",
+			timeout: &metav1.Duration{Duration: 30 * time.Second},
+			want:    30 * time.Second,
+		},
+		{
+			name:    "timeout exceeds the maximum",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+			want:    maxStageWebhookTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stageWebhookTimeout(&placementv1beta1.StageWebhook{Timeout: tt.timeout}); got != tt.want {
+				t.Errorf("stageWebhookTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStageWebhookRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 10, want: stageWebhookMaxRetryInterval},
+	}
+	for _, tt := range tests {
+		if got := stageWebhookRetryBackoff(tt.attempts); got != tt.want {
+			t.Errorf("stageWebhookRetryBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestValidateStageWebhookHost(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespace    string
+		allowedHosts []string
+		url          string
+		wantErr      bool
+	}{
+		{
+			name: "cluster-scoped update run",
+			url:  "http://10.0.0.1/approve",
+		},
+		{
+			name:         "namespaced update run with an allowed host",
+			namespace:    "test-ns",
+			allowedHosts: []string{"change-management.example.com"},
+			url:          "https://Change-Management.example.com:8443/approve",
+		},
+		{
+			name:         "namespaced update run with a host that is not allowed",
+			namespace:    "test-ns",
+			allowedHosts: []string{"change-management.example.com"},
+			url:          "http://kubernetes.default.svc/api",
+			wantErr:      true,
+		},
+		{
+			name:      "namespaced update run with no allowed hosts",
+			namespace: "test-ns",
+			url:       "https://change-management.example.com/approve",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateRun := &placementv1beta1.StagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-update-run", Namespace: tt.namespace},
+			}
+			r := &Reconciler{AllowedStageWebhookHosts: tt.allowedHosts}
+			err := r.validateStageWebhookHost(updateRun, &placementv1beta1.StageWebhook{URL: tt.url})
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Errorf("validateStageWebhookHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
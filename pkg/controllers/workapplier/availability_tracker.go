@@ -65,7 +65,15 @@ func (r *Reconciler) trackInMemberClusterObjAvailability(ctx context.Context, bu
 			return
 		}
 
-		availabilityResTyp, err := trackInMemberClusterObjAvailabilityByGVR(bundle.gvr, bundle.inMemberClusterObj)
+		var availabilityResTyp ManifestProcessingAvailabilityResultType
+		var err error
+		if rule := r.customHealthChecks.ruleFor(bundle.inMemberClusterObj.GroupVersionKind().GroupKind()); rule != nil {
+			// A custom health check rule has been specified for the object; it takes precedence
+			// over the built-in availability check (if any).
+			availabilityResTyp, err = trackAvailabilityWithCustomHealthCheck(rule, bundle.inMemberClusterObj)
+		} else {
+			availabilityResTyp, err = trackInMemberClusterObjAvailabilityByGVR(bundle.gvr, bundle.inMemberClusterObj)
+		}
 		if err != nil {
 			// An unexpected error has occurred during the availability check.
 			bundle.availabilityErr = err
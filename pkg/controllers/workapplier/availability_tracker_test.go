@@ -1032,10 +1032,19 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 
 	untrackableJob := &batchv1.Job{}
 
+	customHealthChecks, err := ParseCustomHealthChecks([]byte(certificateHealthCheckRules))
+	if err != nil {
+		t.Fatalf("ParseCustomHealthChecks() = %v, want no error", err)
+	}
+	certificateGVR := schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	availableCert := certificate(1, 1, map[string]string{"Ready": "True"})
+	unavailableCert := certificate(2, 1, map[string]string{"Ready": "True"})
+
 	testCases := []struct {
-		name        string
-		bundles     []*manifestProcessingBundle
-		wantBundles []*manifestProcessingBundle
+		name               string
+		customHealthChecks *CustomHealthChecks
+		bundles            []*manifestProcessingBundle
+		wantBundles        []*manifestProcessingBundle
 	}{
 		{
 			name: "mixed",
@@ -1118,12 +1127,75 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:               "custom health checks",
+			customHealthChecks: customHealthChecks,
+			bundles: []*manifestProcessingBundle{
+				// An available certificate.
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 0,
+					},
+					gvr:                     &certificateGVR,
+					inMemberClusterObj:      availableCert,
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+				},
+				// A certificate with a stale status.
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 1,
+					},
+					gvr:                     &certificateGVR,
+					inMemberClusterObj:      unavailableCert,
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+				},
+				// An available deployment, which is not covered by the custom health checks.
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 2,
+					},
+					gvr:                     &utils.DeploymentGVR,
+					inMemberClusterObj:      toUnstructured(t, availableDeploy),
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+				},
+			},
+			wantBundles: []*manifestProcessingBundle{
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 0,
+					},
+					gvr:                     &certificateGVR,
+					inMemberClusterObj:      availableCert,
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+					availabilityResTyp:      AvailabilityResultTypeAvailable,
+				},
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 1,
+					},
+					gvr:                     &certificateGVR,
+					inMemberClusterObj:      unavailableCert,
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+					availabilityResTyp:      AvailabilityResultTypeNotYetAvailable,
+				},
+				{
+					id: &fleetv1beta1.WorkResourceIdentifier{
+						Ordinal: 2,
+					},
+					gvr:                     &utils.DeploymentGVR,
+					inMemberClusterObj:      toUnstructured(t, availableDeploy),
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+					availabilityResTyp:      AvailabilityResultTypeAvailable,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			r := &Reconciler{
-				parallelizer: parallelizer.NewParallelizer(2),
+				parallelizer:       parallelizer.NewParallelizer(2),
+				customHealthChecks: tc.customHealthChecks,
 			}
 
 			if err := r.trackInMemberClusterObjAvailability(ctx, tc.bundles, workRef); err != nil {
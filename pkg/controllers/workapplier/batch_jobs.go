@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// jobPhase returns the phase of a job based on its conditions.
+func jobPhase(job *batchv1.Job) fleetv1beta1.BatchJobPhase {
+	for idx := range job.Status.Conditions {
+		cond := &job.Status.Conditions[idx]
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return fleetv1beta1.BatchJobPhaseSucceeded
+		case batchv1.JobFailed:
+			return fleetv1beta1.BatchJobPhaseFailed
+		}
+	}
+	return fleetv1beta1.BatchJobPhaseActive
+}
+
+// buildBatchJobStatus builds the status of a batch workload (a job or a cron job) in the member
+// cluster, which Fleet reports back via the manifest condition. It returns nil if the object is
+// not a batch workload.
+//
+// Note that the availability of batch workloads remains untracked; the progress of such workloads
+// is reported via the batch job status instead, so that a long-running job will not block the rollout.
+func buildBatchJobStatus(gvr *schema.GroupVersionResource, inMemberClusterObj *unstructured.Unstructured) (*fleetv1beta1.BatchJobStatus, error) {
+	if gvr == nil || inMemberClusterObj == nil {
+		return nil, nil
+	}
+
+	switch *gvr {
+	case utils.JobGVR:
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(inMemberClusterObj.Object, &job); err != nil {
+			// Normally this branch should never run.
+			wrappedErr := fmt.Errorf("failed to convert the unstructured object to a job: %w", err)
+			return nil, controller.NewUnexpectedBehaviorError(wrappedErr)
+		}
+		return &fleetv1beta1.BatchJobStatus{
+			Phase:     jobPhase(&job),
+			Active:    job.Status.Active,
+			Succeeded: job.Status.Succeeded,
+			Failed:    job.Status.Failed,
+		}, nil
+	case utils.CronJobGVR:
+		var cronJob batchv1.CronJob
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(inMemberClusterObj.Object, &cronJob); err != nil {
+			// Normally this branch should never run.
+			wrappedErr := fmt.Errorf("failed to convert the unstructured object to a cron job: %w", err)
+			return nil, controller.NewUnexpectedBehaviorError(wrappedErr)
+		}
+		// A cron job never finishes.
+		return &fleetv1beta1.BatchJobStatus{
+			Phase:  fleetv1beta1.BatchJobPhaseActive,
+			Active: int32(len(cronJob.Status.Active)),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+func batchJob(t *testing.T, status batchv1.JobStatus) *unstructured.Unstructured {
+	return toUnstructured(t, &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job",
+			Namespace: nsName,
+		},
+		Status: status,
+	})
+}
+
+// TestBuildBatchJobStatus tests the buildBatchJobStatus function.
+func TestBuildBatchJobStatus(t *testing.T) {
+	cronJob := toUnstructured(t, &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "CronJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cronjob",
+			Namespace: nsName,
+		},
+		Status: batchv1.CronJobStatus{
+			Active: []corev1.ObjectReference{{Name: "job-1"}, {Name: "job-2"}},
+		},
+	})
+
+	testCases := []struct {
+		name               string
+		gvr                *schema.GroupVersionResource
+		inMemberClusterObj *unstructured.Unstructured
+		wantStatus         *fleetv1beta1.BatchJobStatus
+	}{
+		{
+			name: "running job",
+			gvr:  &utils.JobGVR,
+			inMemberClusterObj: batchJob(t, batchv1.JobStatus{
+				Active:    2,
+				Succeeded: 1,
+			}),
+			wantStatus: &fleetv1beta1.BatchJobStatus{
+				Phase:     fleetv1beta1.BatchJobPhaseActive,
+				Active:    2,
+				Succeeded: 1,
+			},
+		},
+		{
+			name: "completed job",
+			gvr:  &utils.JobGVR,
+			inMemberClusterObj: batchJob(t, batchv1.JobStatus{
+				Succeeded: 3,
+				Failed:    1,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+				},
+			}),
+			wantStatus: &fleetv1beta1.BatchJobStatus{
+				Phase:     fleetv1beta1.BatchJobPhaseSucceeded,
+				Succeeded: 3,
+				Failed:    1,
+			},
+		},
+		{
+			name: "failed job",
+			gvr:  &utils.JobGVR,
+			inMemberClusterObj: batchJob(t, batchv1.JobStatus{
+				Failed: 7,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailureTarget, Status: corev1.ConditionTrue},
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+				},
+			}),
+			wantStatus: &fleetv1beta1.BatchJobStatus{
+				Phase:  fleetv1beta1.BatchJobPhaseFailed,
+				Failed: 7,
+			},
+		},
+		{
+			name:               "cron job",
+			gvr:                &utils.CronJobGVR,
+			inMemberClusterObj: cronJob,
+			wantStatus: &fleetv1beta1.BatchJobStatus{
+				Phase:  fleetv1beta1.BatchJobPhaseActive,
+				Active: 2,
+			},
+		},
+		{
+			name:               "not a batch workload",
+			gvr:                &utils.DeploymentGVR,
+			inMemberClusterObj: toUnstructured(t, deploy.DeepCopy()),
+		},
+		{
+			name: "no object",
+			gvr:  ptr.To(utils.JobGVR),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStatus, err := buildBatchJobStatus(tc.gvr, tc.inMemberClusterObj)
+			if err != nil {
+				t.Fatalf("buildBatchJobStatus() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(gotStatus, tc.wantStatus); diff != "" {
+				t.Errorf("buildBatchJobStatus() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -119,6 +119,8 @@ type Reconciler struct {
 	priLinearEqCoeffA int
 	priLinearEqCoeffB int
 	pqSetupOnce       sync.Once
+	// The custom health check rules (if any) for tracking the availability of applied objects.
+	customHealthChecks *CustomHealthChecks
 }
 
 // NewReconciler returns a new Work object reconciler for the work applier.
@@ -134,6 +136,7 @@ func NewReconciler(
 	usePriorityQueue bool,
 	priorityLinearEquationCoeffA *int,
 	priorityLinearEquationCoeffB *int,
+	customHealthChecks *CustomHealthChecks,
 ) *Reconciler {
 	if requeueRateLimiter == nil {
 		klog.V(2).InfoS("requeue rate limiter is not set; using the default rate limiter")
@@ -166,6 +169,7 @@ func NewReconciler(
 		usePriorityQueue:     usePriorityQueue,
 		priLinearEqCoeffA:    *priorityLinearEquationCoeffA,
 		priLinearEqCoeffB:    *priorityLinearEquationCoeffB,
+		customHealthChecks:   customHealthChecks,
 	}
 }
 
@@ -190,6 +194,7 @@ const (
 	ApplyOrReportDiffResTypeFailedToRunDriftDetection      ManifestProcessingApplyOrReportDiffResultType = "FailedToRunDriftDetection"
 	ApplyOrReportDiffResTypeFoundDrifts                    ManifestProcessingApplyOrReportDiffResultType = "FoundDrifts"
 	ApplyOrReportDiffResTypeFoundDriftsInDegradedMode      ManifestProcessingApplyOrReportDiffResultType = "FoundDriftsInDegradedMode"
+	ApplyOrReportDiffResTypeFailedToCreateNamespace        ManifestProcessingApplyOrReportDiffResultType = "FailedToCreateNamespace"
 	// Note that the reason string below uses the same value as kept in the old work applier.
 	ApplyOrReportDiffResTypeFailedToApply ManifestProcessingApplyOrReportDiffResultType = "ManifestApplyFailed"
 
@@ -236,6 +241,7 @@ var (
 		ApplyOrReportDiffResTypeFailedToRunDriftDetection,
 		ApplyOrReportDiffResTypeFoundDrifts,
 		ApplyOrReportDiffResTypeFoundDriftsInDegradedMode,
+		ApplyOrReportDiffResTypeFailedToCreateNamespace,
 		ApplyOrReportDiffResTypeFailedToApply,
 		ApplyOrReportDiffResTypeAppliedWithFailedDriftDetection,
 		ApplyOrReportDiffResTypeApplied,
@@ -437,6 +443,11 @@ func (r *Reconciler) garbageCollectAppliedWork(ctx context.Context, work *fleetv
 	if !controllerutil.ContainsFinalizer(work, fleetv1beta1.WorkFinalizer) {
 		return ctrl.Result{}, nil
 	}
+	if _, ok := work.GetAnnotations()[fleetv1beta1.KeepResourcesOnDeletionAnnotation]; ok {
+		// Orphan the applied resources so that they are left intact on the member cluster.
+		klog.V(2).InfoS("The work is annotated to keep the applied resources, orphaning them", "work", klog.KObj(work))
+		deletePolicy = metav1.DeletePropagationOrphan
+	}
 	appliedWork := &fleetv1beta1.AppliedWork{
 		ObjectMeta: metav1.ObjectMeta{Name: work.Name},
 	}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// healthCheckObjectVarName is the name of the CEL variable that refers to the object
+	// from the member cluster in a custom health check expression.
+	healthCheckObjectVarName = "object"
+
+	// healthCheckCostLimit is the cost limit for evaluating a custom health check expression once;
+	// it helps guard the work applier against expressions that are too expensive to evaluate.
+	healthCheckCostLimit = 1000000
+)
+
+// HealthCheckRule is a declarative health check rule for objects of a specific API group and kind.
+type HealthCheckRule struct {
+	// Group is the API group of the objects; leave it empty for the core API group.
+	Group string `json:"group,omitempty"`
+
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+
+	// AvailableWhen is a CEL expression which evaluates to true when an object is available.
+	// The object from the member cluster can be accessed via the `object` variable, e.g.,
+	// `object.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`.
+	AvailableWhen string `json:"availableWhen"`
+
+	program cel.Program
+}
+
+// CustomHealthChecks is the set of custom health check rules that the work applier uses to
+// track the availability of applied objects.
+//
+// A custom health check rule takes precedence over the built-in availability check (if any)
+// for the same kind of objects.
+type CustomHealthChecks struct {
+	// Rules is the list of custom health check rules.
+	Rules []HealthCheckRule `json:"rules"`
+
+	rulesByGroupKind map[schema.GroupKind]*HealthCheckRule
+}
+
+// LoadCustomHealthChecks loads the custom health check rules from a file (usually a ConfigMap
+// mounted as a volume), in the YAML or JSON format.
+func LoadCustomHealthChecks(path string) (*CustomHealthChecks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the custom health check rules file: %w", err)
+	}
+	return ParseCustomHealthChecks(data)
+}
+
+// ParseCustomHealthChecks parses the custom health check rules and compiles their expressions.
+func ParseCustomHealthChecks(data []byte) (*CustomHealthChecks, error) {
+	checks := &CustomHealthChecks{}
+	if err := yaml.UnmarshalStrict(data, checks); err != nil {
+		return nil, fmt.Errorf("failed to parse the custom health check rules: %w", err)
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable(healthCheckObjectVarName, cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		// Normally this branch should never run.
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+
+	checks.rulesByGroupKind = make(map[schema.GroupKind]*HealthCheckRule, len(checks.Rules))
+	for idx := range checks.Rules {
+		rule := &checks.Rules[idx]
+		gk := schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+		if len(rule.Kind) == 0 {
+			return nil, fmt.Errorf("rule %d is invalid: kind is not specified", idx)
+		}
+		if _, dup := checks.rulesByGroupKind[gk]; dup {
+			return nil, fmt.Errorf("rule %d (%s) is invalid: multiple rules are specified for the same group and kind", idx, gk)
+		}
+		program, err := compileHealthCheckExpression(env, rule.AvailableWhen)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d (%s) is invalid: %w", idx, gk, err)
+		}
+		rule.program = program
+		checks.rulesByGroupKind[gk] = rule
+	}
+	return checks, nil
+}
+
+// compileHealthCheckExpression compiles a custom health check expression into a CEL program.
+func compileHealthCheckExpression(env *cel.Env, expr string) (cel.Program, error) {
+	if len(expr) == 0 {
+		return nil, fmt.Errorf("availableWhen is not specified")
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile the expression %q: %w", expr, iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("the expression %q must evaluate to a bool, got %s", expr, ast.OutputType())
+	}
+	// Note that CEL programs are stateless and can be evaluated concurrently.
+	program, err := env.Program(ast, cel.CostLimit(healthCheckCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build a program for the expression %q: %w", expr, err)
+	}
+	return program, nil
+}
+
+// ruleFor returns the custom health check rule (if any) for a specific group and kind.
+func (c *CustomHealthChecks) ruleFor(gk schema.GroupKind) *HealthCheckRule {
+	if c == nil {
+		return nil
+	}
+	return c.rulesByGroupKind[gk]
+}
+
+// trackAvailabilityWithCustomHealthCheck tracks the availability of an object in the member cluster
+// using a custom health check rule.
+func trackAvailabilityWithCustomHealthCheck(
+	rule *HealthCheckRule,
+	inMemberClusterObj *unstructured.Unstructured,
+) (ManifestProcessingAvailabilityResultType, error) {
+	out, _, err := rule.program.Eval(map[string]interface{}{
+		healthCheckObjectVarName: inMemberClusterObj.Object,
+	})
+	if err != nil {
+		// Evaluation errors are most commonly caused by fields that the controller of the object
+		// has not populated yet (e.g., the status); consider the object to be not yet available.
+		klog.V(2).InfoS("Failed to evaluate the custom health check expression; will check later to see if the object becomes available",
+			"inMemberClusterObj", klog.KObj(inMemberClusterObj), "availableWhen", rule.AvailableWhen, "err", err)
+		return AvailabilityResultTypeNotYetAvailable, nil
+	}
+
+	available, ok := out.(types.Bool)
+	if !ok {
+		return AvailabilityResultTypeFailed, fmt.Errorf("the custom health check expression %q evaluated to a non-bool value of type %s", rule.AvailableWhen, out.Type())
+	}
+	if !available {
+		klog.V(2).InfoS("Object is not available per the custom health check yet, will check later to see if it becomes available",
+			"inMemberClusterObj", klog.KObj(inMemberClusterObj), "availableWhen", rule.AvailableWhen)
+		return AvailabilityResultTypeNotYetAvailable, nil
+	}
+	klog.V(2).InfoS("Object is available per the custom health check", "inMemberClusterObj", klog.KObj(inMemberClusterObj))
+	return AvailabilityResultTypeAvailable, nil
+}
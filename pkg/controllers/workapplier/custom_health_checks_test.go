@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	certificateHealthCheckRules = `
+rules:
+- group: cert-manager.io
+  kind: Certificate
+  availableWhen: >-
+    object.status.observedGeneration == object.metadata.generation &&
+    object.status.conditions.exists(c, c.type == "Ready" && c.status == "True") &&
+    !object.status.conditions.exists(c, c.type == "Issuing" && c.status == "True")
+`
+)
+
+var (
+	certificateGK = schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}
+)
+
+func certificate(generation, observedGeneration int64, conditions map[string]string) *unstructured.Unstructured {
+	cert := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":       "cert",
+				"namespace":  nsName,
+				"generation": generation,
+			},
+		},
+	}
+	if conditions == nil {
+		return cert
+	}
+
+	conds := []interface{}{}
+	for condType, condStatus := range conditions {
+		conds = append(conds, map[string]interface{}{
+			"type":   condType,
+			"status": condStatus,
+		})
+	}
+	cert.Object["status"] = map[string]interface{}{
+		"observedGeneration": observedGeneration,
+		"conditions":         conds,
+	}
+	return cert
+}
+
+// TestParseCustomHealthChecks tests the ParseCustomHealthChecks function.
+func TestParseCustomHealthChecks(t *testing.T) {
+	testCases := []struct {
+		name             string
+		data             string
+		wantRuleGKs      []schema.GroupKind
+		wantErrMsgSubStr string
+	}{
+		{
+			name:        "valid rules",
+			data:        certificateHealthCheckRules,
+			wantRuleGKs: []schema.GroupKind{certificateGK},
+		},
+		{
+			name:        "no rules",
+			data:        `rules: []`,
+			wantRuleGKs: []schema.GroupKind{},
+		},
+		{
+			name:             "unknown field",
+			data:             `rules: [{kind: Certificate, availableWhen: "has(object.status)", unknown: true}]`,
+			wantErrMsgSubStr: "failed to parse the custom health check rules",
+		},
+		{
+			name:             "no kind",
+			data:             `rules: [{group: cert-manager.io, availableWhen: "has(object.status)"}]`,
+			wantErrMsgSubStr: "kind is not specified",
+		},
+		{
+			name:             "no expression",
+			data:             `rules: [{group: cert-manager.io, kind: Certificate}]`,
+			wantErrMsgSubStr: "availableWhen is not specified",
+		},
+		{
+			name:             "invalid expression",
+			data:             `rules: [{kind: Certificate, availableWhen: "object.status.("}]`,
+			wantErrMsgSubStr: "failed to compile the expression",
+		},
+		{
+			name:             "non-bool expression",
+			data:             `rules: [{kind: Certificate, availableWhen: "1 + 1"}]`,
+			wantErrMsgSubStr: "must evaluate to a bool",
+		},
+		{
+			name: "duplicate rules",
+			data: `
+rules:
+- {group: cert-manager.io, kind: Certificate, availableWhen: "has(object.status)"}
+- {group: cert-manager.io, kind: Certificate, availableWhen: "has(object.spec)"}
+`,
+			wantErrMsgSubStr: "multiple rules are specified",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checks, err := ParseCustomHealthChecks([]byte(tc.data))
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("ParseCustomHealthChecks() = %v, want error containing %q", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCustomHealthChecks() = %v, want no error", err)
+			}
+
+			gotRuleGKs := []schema.GroupKind{}
+			for gk := range checks.rulesByGroupKind {
+				gotRuleGKs = append(gotRuleGKs, gk)
+			}
+			if diff := cmp.Diff(gotRuleGKs, tc.wantRuleGKs); diff != "" {
+				t.Errorf("ParseCustomHealthChecks() rules diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestCustomHealthChecksRuleFor tests the ruleFor method.
+func TestCustomHealthChecksRuleFor(t *testing.T) {
+	checks, err := ParseCustomHealthChecks([]byte(certificateHealthCheckRules))
+	if err != nil {
+		t.Fatalf("ParseCustomHealthChecks() = %v, want no error", err)
+	}
+
+	testCases := []struct {
+		name     string
+		checks   *CustomHealthChecks
+		gk       schema.GroupKind
+		wantRule bool
+	}{
+		{
+			name:   "no custom health checks",
+			checks: nil,
+			gk:     certificateGK,
+		},
+		{
+			name:     "matched",
+			checks:   checks,
+			gk:       certificateGK,
+			wantRule: true,
+		},
+		{
+			name:   "not matched",
+			checks: checks,
+			gk:     schema.GroupKind{Group: "cert-manager.io", Kind: "Issuer"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.checks.ruleFor(tc.gk); (got != nil) != tc.wantRule {
+				t.Errorf("ruleFor() = %v, want rule found: %t", got, tc.wantRule)
+			}
+		})
+	}
+}
+
+// TestTrackAvailabilityWithCustomHealthCheck tests the trackAvailabilityWithCustomHealthCheck function.
+func TestTrackAvailabilityWithCustomHealthCheck(t *testing.T) {
+	checks, err := ParseCustomHealthChecks([]byte(certificateHealthCheckRules))
+	if err != nil {
+		t.Fatalf("ParseCustomHealthChecks() = %v, want no error", err)
+	}
+	rule := checks.ruleFor(certificateGK)
+
+	testCases := []struct {
+		name                   string
+		inMemberClusterObj     *unstructured.Unstructured
+		wantAvailabilityResTyp ManifestProcessingAvailabilityResultType
+	}{
+		{
+			name:                   "available",
+			inMemberClusterObj:     certificate(2, 2, map[string]string{"Ready": "True", "Issuing": "False"}),
+			wantAvailabilityResTyp: AvailabilityResultTypeAvailable,
+		},
+		{
+			name:                   "available (no Issuing condition)",
+			inMemberClusterObj:     certificate(2, 2, map[string]string{"Ready": "True"}),
+			wantAvailabilityResTyp: AvailabilityResultTypeAvailable,
+		},
+		{
+			name:                   "no status (evaluation error)",
+			inMemberClusterObj:     certificate(2, 0, nil),
+			wantAvailabilityResTyp: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                   "stale status",
+			inMemberClusterObj:     certificate(2, 1, map[string]string{"Ready": "True"}),
+			wantAvailabilityResTyp: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                   "not ready",
+			inMemberClusterObj:     certificate(2, 2, map[string]string{"Ready": "False"}),
+			wantAvailabilityResTyp: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                   "still issuing",
+			inMemberClusterObj:     certificate(2, 2, map[string]string{"Ready": "True", "Issuing": "True"}),
+			wantAvailabilityResTyp: AvailabilityResultTypeNotYetAvailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResTyp, err := trackAvailabilityWithCustomHealthCheck(rule, tc.inMemberClusterObj)
+			if err != nil {
+				t.Fatalf("trackAvailabilityWithCustomHealthCheck() = %v, want no error", err)
+			}
+			if gotResTyp != tc.wantAvailabilityResTyp {
+				t.Errorf("trackAvailabilityWithCustomHealthCheck() = %v, want %v", gotResTyp, tc.wantAvailabilityResTyp)
+			}
+		})
+	}
+}
+
+// TestTrackAvailabilityWithCustomHealthCheckNonBoolResult tests the trackAvailabilityWithCustomHealthCheck
+// function with an expression that evaluates to a non-bool value at runtime.
+func TestTrackAvailabilityWithCustomHealthCheckNonBoolResult(t *testing.T) {
+	checks, err := ParseCustomHealthChecks([]byte(`rules: [{group: cert-manager.io, kind: Certificate, availableWhen: "object.metadata.name"}]`))
+	if err != nil {
+		t.Fatalf("ParseCustomHealthChecks() = %v, want no error", err)
+	}
+
+	gotResTyp, err := trackAvailabilityWithCustomHealthCheck(checks.ruleFor(certificateGK), certificate(1, 1, nil))
+	if err == nil {
+		t.Fatalf("trackAvailabilityWithCustomHealthCheck() = nil, want error")
+	}
+	if gotResTyp != AvailabilityResultTypeFailed {
+		t.Errorf("trackAvailabilityWithCustomHealthCheck() = %v, want %v", gotResTyp, AvailabilityResultTypeFailed)
+	}
+}
@@ -25,7 +25,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
 )
@@ -164,6 +166,12 @@ func (r *Reconciler) processOneManifest(
 		return
 	}
 
+	// Create the namespace of the manifest object in the member cluster if it does not exist yet and
+	// the Work asks for it.
+	if shouldSkipProcessing := r.createMissingNamespaceIfApplicable(ctx, bundle, work); shouldSkipProcessing {
+		return
+	}
+
 	// Perform the apply op.
 	appliedObj, err := r.apply(ctx, bundle.gvr, bundle.manifestObj, bundle.inMemberClusterObj, work.Spec.ApplyStrategy, expectedAppliedWorkOwnerRef)
 	if err != nil {
@@ -240,6 +248,52 @@ func (r *Reconciler) findInMemberClusterObjectFor(
 	}
 }
 
+// createMissingNamespaceIfApplicable creates the namespace of a namespaced manifest object in the
+// member cluster, if the object has not been created yet and the Work asks for missing namespaces to be
+// created.
+//
+// Note that the namespace is created without any owner reference, i.e., Fleet does not take ownership
+// of the namespace, and the namespace will be left as it is when the Work is deleted.
+func (r *Reconciler) createMissingNamespaceIfApplicable(
+	ctx context.Context,
+	bundle *manifestProcessingBundle,
+	work *fleetv1beta1.Work,
+) (shouldSkipProcessing bool) {
+	namespace := bundle.manifestObj.GetNamespace()
+	if bundle.inMemberClusterObj != nil || namespace == "" || !shouldCreateMissingNamespace(work) {
+		// The object has been created, is cluster-scoped, or the Work does not ask for missing
+		// namespaces to be created; skip the step.
+		return false
+	}
+
+	nsObj := &unstructured.Unstructured{}
+	nsObj.SetAPIVersion("v1")
+	nsObj.SetKind("Namespace")
+	nsObj.SetName(namespace)
+	_, err := r.spokeDynamicClient.Resource(utils.NamespaceGVR).Create(ctx, nsObj, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		klog.V(2).InfoS("Created the missing namespace for the manifest object in the member cluster",
+			"namespace", namespace, "manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", klog.KObj(work))
+		return false
+	case errors.IsAlreadyExists(err):
+		// The namespace exists already.
+		return false
+	default:
+		wrappedErr := controller.NewAPIServerError(false, err)
+		bundle.applyOrReportDiffErr = fmt.Errorf("failed to create the missing namespace %s for the manifest object: %w", namespace, wrappedErr)
+		bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeFailedToCreateNamespace
+		klog.ErrorS(wrappedErr, "Failed to create the missing namespace for the manifest object in the member cluster",
+			"namespace", namespace, "manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", klog.KObj(work))
+		return true
+	}
+}
+
+// shouldCreateMissingNamespace checks if a Work asks for missing namespaces to be created.
+func shouldCreateMissingNamespace(work *fleetv1beta1.Work) bool {
+	return work.GetAnnotations()[kfplacementv1alpha1.WhenNamespaceDoesNotExistAnnotation] == string(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace)
+}
+
 // takeOverInMemberClusterObjectIfApplicable attempts to take over an object in the member cluster
 // as needed.
 func (r *Reconciler) takeOverInMemberClusterObjectIfApplicable(
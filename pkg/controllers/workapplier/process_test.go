@@ -1,14 +1,23 @@
 package workapplier
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
 
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 )
 
 // Note (chenyu1): The fake client Fleet uses for unit tests has trouble processing certain requests
@@ -97,3 +106,163 @@ func TestShouldInitiateTakeOverAttempt(t *testing.T) {
 		})
 	}
 }
+
+// TestCreateMissingNamespaceIfApplicable tests the createMissingNamespaceIfApplicable method.
+func TestCreateMissingNamespaceIfApplicable(t *testing.T) {
+	ctx := context.Background()
+
+	nsUnstructured := toUnstructured(t, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nsName,
+		},
+	})
+	nsUnstructured.SetAPIVersion("v1")
+	nsUnstructured.SetKind("Namespace")
+	deployUnstructured := toUnstructured(t, deploy.DeepCopy())
+
+	workWithOption := func(option kfplacementv1alpha1.WhenNamespaceDoesNotExistOption) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workName,
+				Namespace: memberReservedNSName1,
+				Annotations: map[string]string{
+					kfplacementv1alpha1.WhenNamespaceDoesNotExistAnnotation: string(option),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                  string
+		work                  *fleetv1beta1.Work
+		bundle                *manifestProcessingBundle
+		inMemberClusterObjs   []runtime.Object
+		createErr             error
+		wantShouldSkip        bool
+		wantResTyp            ManifestProcessingApplyOrReportDiffResultType
+		wantNamespaceInMember bool
+	}{
+		{
+			name: "create the missing namespace",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+			wantNamespaceInMember: true,
+		},
+		{
+			name: "namespace exists",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+			inMemberClusterObjs:   []runtime.Object{nsUnstructured},
+			wantNamespaceInMember: true,
+		},
+		{
+			name: "namespace is created concurrently",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+			createErr: errors.NewAlreadyExists(schema.GroupResource{Resource: "namespaces"}, nsName),
+		},
+		{
+			name: "failed to create the missing namespace",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+			createErr:      errors.NewInternalError(fmt.Errorf("etcd is unavailable")),
+			wantShouldSkip: true,
+			wantResTyp:     ApplyOrReportDiffResTypeFailedToCreateNamespace,
+		},
+		{
+			name: "forbidden to create the missing namespace",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+			createErr:      errors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, nsName, fmt.Errorf("no permission")),
+			wantShouldSkip: true,
+			wantResTyp:     ApplyOrReportDiffResTypeFailedToCreateNamespace,
+		},
+		{
+			name: "report error when the namespace does not exist",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionReportError),
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+		},
+		{
+			name: "no option set",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workName,
+					Namespace: memberReservedNSName1,
+				},
+			},
+			bundle: &manifestProcessingBundle{
+				manifestObj: deployUnstructured,
+				gvr:         &utils.DeploymentGVR,
+			},
+		},
+		{
+			name: "object has been created",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj:        deployUnstructured,
+				inMemberClusterObj: deployUnstructured,
+				gvr:                &utils.DeploymentGVR,
+			},
+		},
+		{
+			name: "cluster-scoped object",
+			work: workWithOption(kfplacementv1alpha1.WhenNamespaceDoesNotExistOptionCreateNamespace),
+			bundle: &manifestProcessingBundle{
+				manifestObj: nsUnstructured,
+				gvr:         &nsGVR,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleDynamicClient(scheme.Scheme, tc.inMemberClusterObjs...)
+			if tc.createErr != nil {
+				fakeClient.PrependReactor("create", "namespaces", func(_ clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tc.createErr
+				})
+			}
+			r := &Reconciler{
+				spokeDynamicClient: fakeClient,
+			}
+
+			if gotShouldSkip := r.createMissingNamespaceIfApplicable(ctx, tc.bundle, tc.work); gotShouldSkip != tc.wantShouldSkip {
+				t.Errorf("createMissingNamespaceIfApplicable() = %t, want %t", gotShouldSkip, tc.wantShouldSkip)
+			}
+			if gotErr := tc.bundle.applyOrReportDiffErr != nil; gotErr != tc.wantShouldSkip {
+				t.Errorf("createMissingNamespaceIfApplicable() set error %v, want error %t", tc.bundle.applyOrReportDiffErr, tc.wantShouldSkip)
+			}
+			if tc.bundle.applyOrReportDiffResTyp != tc.wantResTyp {
+				t.Errorf("createMissingNamespaceIfApplicable() result type = %q, want %q", tc.bundle.applyOrReportDiffResTyp, tc.wantResTyp)
+			}
+
+			gotNS, err := fakeClient.Resource(nsGVR).Get(ctx, nsName, metav1.GetOptions{})
+			switch {
+			case tc.wantNamespaceInMember && err != nil:
+				t.Fatalf("Get namespace = %v, want no error", err)
+			case tc.wantNamespaceInMember && len(gotNS.GetOwnerReferences()) != 0:
+				t.Errorf("namespace owner references = %v, want none", gotNS.GetOwnerReferences())
+			case !tc.wantNamespaceInMember && !errors.IsNotFound(err):
+				t.Errorf("Get namespace = %v, want not found error", err)
+			}
+		})
+	}
+}
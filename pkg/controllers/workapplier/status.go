@@ -172,10 +172,20 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 			}
 		}
 
+		// Reset the batch job status (such status needs no port-back).
+		manifestCond.BatchJobStatus = nil
+
 		// Tally the stats, and perform status back-reporting if applicable.
 		if isManifestObjectApplied(bundle.applyOrReportDiffResTyp) {
 			appliedManifestsCount++
 
+			// Report the status of batch workloads (jobs and cron jobs).
+			batchJobStatus, err := buildBatchJobStatus(bundle.gvr, bundle.inMemberClusterObj)
+			if err != nil {
+				klog.ErrorS(err, "Failed to build the batch job status", "work", klog.KObj(work), "resourceIdentifier", manifestCond.Identifier)
+			}
+			manifestCond.BatchJobStatus = batchJobStatus
+
 			if isStatusBackReportingOn {
 				// Back-report the status from the member cluster side, if applicable.
 				//
@@ -327,6 +327,7 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use custom health checks.
 	)
 	Expect(workApplier1.SetupWithManager(hubMgr1)).To(Succeed())
 
@@ -378,6 +379,7 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use custom health checks.
 	)
 	Expect(workApplier2.SetupWithManager(hubMgr2)).To(Succeed())
 
@@ -417,6 +419,7 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use custom health checks.
 	)
 	Expect(workApplier3.SetupWithManager(hubMgr3)).To(Succeed())
 
@@ -454,6 +457,7 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use custom health checks.
 	)
 	// Due to name conflicts, the third work applier must be set up manually.
 	Expect(workApplier4.SetupWithManager(hubMgr4)).To(Succeed())
@@ -331,8 +331,25 @@ func (r *Reconciler) handleDelete(ctx context.Context, resourceBinding fleetv1be
 	// Note: This controller cannot garbage collect all works automatically via background/foreground
 	// cascade deletion as the namespaces of work and resourceBinding are different
 	// and we don't set the ownerReference for the works.
+	_, keepResources := resourceBinding.GetAnnotations()[fleetv1beta1.KeepResourcesOnDeletionAnnotation]
 	for workName := range works {
 		work := works[workName]
+		if keepResources && work.DeletionTimestamp.IsZero() {
+			// Pass the annotation to the work so that the work applier leaves the applied resources intact.
+			if _, ok := work.GetAnnotations()[fleetv1beta1.KeepResourcesOnDeletionAnnotation]; !ok {
+				if work.Annotations == nil {
+					work.Annotations = make(map[string]string)
+				}
+				work.Annotations[fleetv1beta1.KeepResourcesOnDeletionAnnotation] = strconv.FormatBool(true)
+				if err := r.Client.Update(ctx, work); err != nil {
+					if apierrors.IsNotFound(err) {
+						continue
+					}
+					klog.ErrorS(err, "Failed to annotate the work to keep the applied resources", "work", klog.KObj(work))
+					return controllerruntime.Result{}, controller.NewUpdateIgnoreConflictError(err)
+				}
+			}
+		}
 		if err := r.Client.Delete(ctx, work); err != nil && !apierrors.IsNotFound(err) {
 			return controllerruntime.Result{}, controller.NewAPIServerError(false, err)
 		}
@@ -668,6 +685,15 @@ func (r *Reconciler) processOneSelectedResource(
 		newWork = append(newWork, work)
 
 	default:
+		// Divide the replicas of the workload across the selected clusters (if applicable) before
+		// applying the overrides, so that the overrides can still tune the replica count per cluster.
+		if division := resourceBinding.GetBindingSpec().ReplicaDivision; division != nil {
+			if err := divideReplicas(selectedResource, &uResource, division); err != nil {
+				klog.ErrorS(err, "Failed to divide the replicas of the selected resource", "snapshot", klog.KObj(snapshot), "selectedResource", klog.KObj(&uResource))
+				return nil, nil, false, err
+			}
+		}
+
 		resourceDeleted, overrideErr := r.applyOverrides(selectedResource, overrideCtx.cluster, overrideCtx.croMap, overrideCtx.roMap)
 		if overrideErr != nil {
 			return nil, nil, true, overrideErr
@@ -943,6 +969,7 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 	resourceBinding.GetBindingStatus().FailedPlacements = nil
 	resourceBinding.GetBindingStatus().DiffedPlacements = nil
 	resourceBinding.GetBindingStatus().DriftedPlacements = nil
+	resourceBinding.GetBindingStatus().BatchJobSummary = nil
 	// collect and set the failed resource placements to the binding if not all the works are available
 	driftedResourcePlacements := make([]fleetv1beta1.DriftedResourcePlacement, 0, maxDriftedResourcePlacementLimit) // preallocate the memory
 	failedResourcePlacements := make([]fleetv1beta1.FailedResourcePlacement, 0, maxFailedResourcePlacementLimit)    // preallocate the memory
@@ -1062,6 +1089,49 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 		resourceBinding.GetBindingStatus().DriftedPlacements = driftedResourcePlacements
 		klog.V(2).InfoS("Populated drifted manifests", "binding", bindingRef, "numberOfDriftedPlacements", len(driftedResourcePlacements))
 	}
+
+	// Summarize the status of the batch workloads (jobs and cron jobs) only when all the works have been
+	// applied, so that stale status will not leak into the summary.
+	if !isReportDiffModeOn && appliedSummarizedStatus == workConditionSummarizedStatusTrue {
+		if batchJobSummary := summarizeBatchJobStatusFromWorks(works); batchJobSummary != nil {
+			resourceBinding.GetBindingStatus().BatchJobSummary = batchJobSummary
+			klog.V(2).InfoS("Populated batch job summary", "binding", bindingRef, "batchJobSummary", *batchJobSummary)
+		}
+	}
+}
+
+// summarizeBatchJobStatusFromWorks summarizes the status of the batch workloads (jobs and cron jobs)
+// reported in the given works; it returns nil if none of the works has any batch workload, in which
+// case the cluster is considered to have no batch workloads to run once all the works are applied.
+func summarizeBatchJobStatusFromWorks(works map[string]*fleetv1beta1.Work) *fleetv1beta1.BatchJobSummary {
+	var summary *fleetv1beta1.BatchJobSummary
+	for _, w := range works {
+		if w.DeletionTimestamp != nil {
+			continue // ignore the deleting work
+		}
+		for idx := range w.Status.ManifestConditions {
+			batchJobStatus := w.Status.ManifestConditions[idx].BatchJobStatus
+			if batchJobStatus == nil {
+				continue
+			}
+			if summary == nil {
+				summary = &fleetv1beta1.BatchJobSummary{}
+			}
+			summary.Total++
+			switch batchJobStatus.Phase {
+			case fleetv1beta1.BatchJobPhaseSucceeded:
+				summary.Succeeded++
+			case fleetv1beta1.BatchJobPhaseFailed:
+				summary.Failed++
+			default:
+				summary.Active++
+			}
+			summary.ActivePods += batchJobStatus.Active
+			summary.SucceededPods += batchJobStatus.Succeeded
+			summary.FailedPods += batchJobStatus.Failed
+		}
+	}
+	return summary
 }
 
 // setAllWorkAppliedCondition sets the Applied condition on a binding
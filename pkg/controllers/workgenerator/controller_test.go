@@ -3351,6 +3351,101 @@ func TestExtractDiffedResourcePlacementsFromWork(t *testing.T) {
 	}
 }
 
+func TestSummarizeBatchJobStatusFromWorks(t *testing.T) {
+	jobCond := func(phase fleetv1beta1.BatchJobPhase, active, succeeded, failed int32) fleetv1beta1.ManifestCondition {
+		return fleetv1beta1.ManifestCondition{
+			Identifier: fleetv1beta1.WorkResourceIdentifier{Group: "batch", Version: "v1", Kind: "Job", Name: "job"},
+			BatchJobStatus: &fleetv1beta1.BatchJobStatus{
+				Phase:     phase,
+				Active:    active,
+				Succeeded: succeeded,
+				Failed:    failed,
+			},
+		}
+	}
+	deployCond := fleetv1beta1.ManifestCondition{
+		Identifier: fleetv1beta1.WorkResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Name: "deploy"},
+	}
+
+	tests := map[string]struct {
+		works map[string]*fleetv1beta1.Work
+		want  *fleetv1beta1.BatchJobSummary
+	}{
+		"no batch workloads": {
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": {
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{deployCond},
+					},
+				},
+			},
+		},
+		"batch workloads across multiple works": {
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": {
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{
+							deployCond,
+							jobCond(fleetv1beta1.BatchJobPhaseActive, 2, 1, 0),
+						},
+					},
+				},
+				"work-2": {
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{
+							jobCond(fleetv1beta1.BatchJobPhaseSucceeded, 0, 3, 1),
+							jobCond(fleetv1beta1.BatchJobPhaseFailed, 0, 0, 6),
+						},
+					},
+				},
+			},
+			want: &fleetv1beta1.BatchJobSummary{
+				Total:         3,
+				Active:        1,
+				Succeeded:     1,
+				Failed:        1,
+				ActivePods:    2,
+				SucceededPods: 4,
+				FailedPods:    7,
+			},
+		},
+		"deleting works are ignored": {
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": {
+					ObjectMeta: metav1.ObjectMeta{
+						DeletionTimestamp: &metav1.Time{Time: time.Now()},
+					},
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{
+							jobCond(fleetv1beta1.BatchJobPhaseActive, 1, 0, 0),
+						},
+					},
+				},
+				"work-2": {
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{
+							jobCond(fleetv1beta1.BatchJobPhaseSucceeded, 0, 1, 0),
+						},
+					},
+				},
+			},
+			want: &fleetv1beta1.BatchJobSummary{
+				Total:         1,
+				Succeeded:     1,
+				SucceededPods: 1,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := summarizeBatchJobStatusFromWorks(tt.works)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("summarizeBatchJobStatusFromWorks() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdateBindingStatusWithRetry(t *testing.T) {
 	lastTransitionTime := metav1.NewTime(time.Now())
 	tests := []struct {
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// dividableWorkloadGKs are the kinds of the workloads whose replicas can be divided across the
+// selected clusters.
+var dividableWorkloadGKs = map[schema.GroupKind]bool{
+	{Group: appsv1.GroupName, Kind: "Deployment"}:  true,
+	{Group: appsv1.GroupName, Kind: "StatefulSet"}: true,
+	{Group: appsv1.GroupName, Kind: "ReplicaSet"}:  true,
+}
+
+// divideReplicas rewrites the replica count of a selected workload to the share of the replicas
+// that the target cluster runs, as described by the replica division on the binding.
+//
+// The selected resource is left intact if it is not a workload with replicas.
+func divideReplicas(selectedResource *placementv1beta1.ResourceContent, uResource *unstructured.Unstructured, division *placementv1beta1.ReplicaDivision) error {
+	if !dividableWorkloadGKs[uResource.GroupVersionKind().GroupKind()] {
+		return nil
+	}
+
+	replicas, found, err := unstructured.NestedInt64(uResource.Object, "spec", "replicas")
+	if err != nil {
+		return controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to read the replicas of %s %s: %w", uResource.GetKind(), uResource.GetName(), err))
+	}
+	if !found {
+		// The replica count defaults to 1.
+		replicas = 1
+	}
+
+	if err := unstructured.SetNestedField(uResource.Object, replicaShareOf(replicas, division), "spec", "replicas"); err != nil {
+		return controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to set the replicas of %s %s: %w", uResource.GetKind(), uResource.GetName(), err))
+	}
+	raw, err := uResource.MarshalJSON()
+	if err != nil {
+		return controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to marshal %s %s: %w", uResource.GetKind(), uResource.GetName(), err))
+	}
+	selectedResource.Raw = raw
+	return nil
+}
+
+// replicaShareOf returns the number of replicas, out of the given total, that a cluster runs as
+// described by its replica division.
+func replicaShareOf(replicas int64, division *placementv1beta1.ReplicaDivision) int64 {
+	if division.TotalWeight <= 0 {
+		// This should never happen, as the total weight is validated to be positive.
+		return replicas
+	}
+	end := replicas * (division.WeightOffset + division.Weight) / division.TotalWeight
+	start := replicas * division.WeightOffset / division.TotalWeight
+	return end - start
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestReplicaShareOf tests the replicaShareOf function.
+func TestReplicaShareOf(t *testing.T) {
+	testCases := []struct {
+		name       string
+		replicas   int64
+		weights    []int64
+		wantShares []int64
+	}{
+		{
+			name:       "even division",
+			replicas:   9,
+			weights:    []int64{1, 1, 1},
+			wantShares: []int64{3, 3, 3},
+		},
+		{
+			name:       "even division with remainder",
+			replicas:   10,
+			weights:    []int64{1, 1, 1},
+			wantShares: []int64{3, 3, 4},
+		},
+		{
+			name:       "weighted division",
+			replicas:   10,
+			weights:    []int64{75, 25, 0},
+			wantShares: []int64{7, 3, 0},
+		},
+		{
+			name:       "fewer replicas than clusters",
+			replicas:   2,
+			weights:    []int64{1, 1, 1, 1},
+			wantShares: []int64{0, 1, 0, 1},
+		},
+		{
+			name:       "no replicas",
+			replicas:   0,
+			weights:    []int64{2, 3},
+			wantShares: []int64{0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var totalWeight int64
+			for _, w := range tc.weights {
+				totalWeight += w
+			}
+			shares := make([]int64, 0, len(tc.weights))
+			var offset, sum int64
+			for _, w := range tc.weights {
+				share := replicaShareOf(tc.replicas, &placementv1beta1.ReplicaDivision{
+					WeightOffset: offset,
+					Weight:       w,
+					TotalWeight:  totalWeight,
+				})
+				shares = append(shares, share)
+				offset += w
+				sum += share
+			}
+			if diff := cmp.Diff(shares, tc.wantShares); diff != "" {
+				t.Errorf("replicaShareOf() mismatch (-got, +want):\n%s", diff)
+			}
+			if sum != tc.replicas {
+				t.Errorf("replicaShareOf() shares add up to %d, want %d", sum, tc.replicas)
+			}
+		})
+	}
+}
+
+// TestDivideReplicas tests the divideReplicas function.
+func TestDivideReplicas(t *testing.T) {
+	division := &placementv1beta1.ReplicaDivision{WeightOffset: 1, Weight: 2, TotalWeight: 4}
+
+	testCases := []struct {
+		name         string
+		obj          runtime.Object
+		wantReplicas *int64
+	}{
+		{
+			name: "deployment with replicas",
+			obj: &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(8))},
+			},
+			wantReplicas: ptr.To(int64(4)),
+		},
+		{
+			name: "statefulset without replicas",
+			obj: &appsv1.StatefulSet{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "app"},
+			},
+			// The replica count defaults to 1, and floor(1*3/4) - floor(1*1/4) = 0.
+			wantReplicas: ptr.To(int64(0)),
+		},
+		{
+			name: "not a workload",
+			obj: &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "app"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.obj)
+			if err != nil {
+				t.Fatalf("failed to convert object to unstructured: %v", err)
+			}
+			uResource := &unstructured.Unstructured{Object: unstructuredObj}
+			raw, err := uResource.MarshalJSON()
+			if err != nil {
+				t.Fatalf("failed to marshal object: %v", err)
+			}
+			selectedResource := &placementv1beta1.ResourceContent{RawExtension: runtime.RawExtension{Raw: raw}}
+
+			if err := divideReplicas(selectedResource, uResource, division); err != nil {
+				t.Fatalf("divideReplicas() = %v, want no error", err)
+			}
+
+			var got unstructured.Unstructured
+			if err := got.UnmarshalJSON(selectedResource.Raw); err != nil {
+				t.Fatalf("failed to unmarshal the selected resource: %v", err)
+			}
+			replicas, found, err := unstructured.NestedInt64(got.Object, "spec", "replicas")
+			if err != nil {
+				t.Fatalf("failed to read the replicas: %v", err)
+			}
+			var gotReplicas *int64
+			if found {
+				gotReplicas = &replicas
+			}
+			if diff := cmp.Diff(gotReplicas, tc.wantReplicas); diff != "" {
+				t.Errorf("divideReplicas() replicas mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
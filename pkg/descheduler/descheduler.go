@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package descheduler features the Fleet descheduler, which periodically re-evaluates the
+// placements that have been made by the scheduler and evicts the ones that would have been
+// better placed elsewhere, so that the scheduler can re-place them.
+package descheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+)
+
+const (
+	// finishedEvictionRetentionPeriod is the period for which the descheduler keeps the evictions
+	// it has created after they have finished, for auditing purposes.
+	finishedEvictionRetentionPeriod = 24 * time.Hour
+
+	// evictionCreatedEventReason is the reason of the event emitted on a placement when the
+	// descheduler has requested an eviction.
+	evictionCreatedEventReason = "DeschedulingEvictionCreated"
+)
+
+// make sure that our Descheduler implements controller runtime interfaces
+var (
+	_ manager.Runnable               = &Descheduler{}
+	_ manager.LeaderElectionRunnable = &Descheduler{}
+)
+
+// Descheduler periodically re-evaluates the ClusterResourcePlacements of the PickN placement
+// type with a set of pluggable strategies; if a strategy finds that the resources placed on a
+// cluster would be better placed elsewhere, the descheduler creates a ClusterResourcePlacementEviction
+// object for the cluster. The eviction is executed by the eviction controller, subject to the
+// disruption budget of the placement, after which the scheduler will re-place the resources.
+//
+// To avoid disruptions, the descheduler evicts at most one placement per ClusterResourcePlacement
+// at a time, and only evaluates ClusterResourcePlacements whose placements have settled. To keep
+// the descheduler and the scheduler from moving the resources of a placement back and forth, a
+// ClusterResourcePlacement is not evaluated again until a cooldown period has passed since the
+// descheduler last created an eviction for it.
+type Descheduler struct {
+	// Client is used to read the placement related objects (from the cache) and to create evictions.
+	Client client.Client
+
+	// Recorder is used to emit events on the placements.
+	Recorder record.EventRecorder
+
+	// ClusterEligibilityChecker checks whether a cluster is eligible for resource placement.
+	ClusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// Strategies are the descheduling strategies in use, evaluated in order; the first strategy
+	// that selects a cluster to evict wins.
+	Strategies []Strategy
+
+	// Interval is the interval between two descheduling cycles.
+	Interval time.Duration
+
+	// Cooldown is the minimum period between two evictions the descheduler creates for the same
+	// ClusterResourcePlacement; it should not exceed the retention period of finished evictions.
+	Cooldown time.Duration
+}
+
+// Start runs the descheduler until the context is cancelled. This is called by the controller manager.
+func (d *Descheduler) Start(ctx context.Context) error {
+	klog.InfoS("Starting the descheduler", "interval", d.Interval, "strategyCount", len(d.Strategies))
+	defer klog.InfoS("The descheduler is stopped")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := d.runOnce(ctx); err != nil {
+			klog.ErrorS(err, "Failed to run a descheduling cycle")
+		}
+	}, d.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable interface.
+// So that the descheduler only runs on the leader.
+func (d *Descheduler) NeedLeaderElection() bool {
+	return true
+}
+
+// runOnce runs a descheduling cycle.
+func (d *Descheduler) runOnce(ctx context.Context) error {
+	startTime := time.Now()
+	klog.V(2).InfoS("Descheduling cycle starts")
+	defer func() {
+		klog.V(2).InfoS("Descheduling cycle ends", "latency", time.Since(startTime).Milliseconds())
+	}()
+
+	inflightPlacements, coolingDownPlacements, err := d.collectEvictions(ctx)
+	if err != nil {
+		return err
+	}
+
+	crpList := &placementv1beta1.ClusterResourcePlacementList{}
+	if err := d.Client.List(ctx, crpList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourcePlacements")
+		return controller.NewAPIServerError(true, err)
+	}
+	memberClusterList := &clusterv1beta1.MemberClusterList{}
+	if err := d.Client.List(ctx, memberClusterList); err != nil {
+		klog.ErrorS(err, "Failed to list memberClusters")
+		return controller.NewAPIServerError(true, err)
+	}
+
+	var errs []error
+	for idx := range crpList.Items {
+		crp := &crpList.Items[idx]
+		if inflightPlacements.Has(crp.Name) {
+			klog.V(2).InfoS("Skipping the placement as it has an in-flight eviction", "clusterResourcePlacement", klog.KObj(crp))
+			continue
+		}
+		if coolingDownPlacements.Has(crp.Name) {
+			klog.V(2).InfoS("Skipping the placement as it has been descheduled recently", "clusterResourcePlacement", klog.KObj(crp), "cooldown", d.Cooldown)
+			continue
+		}
+		if err := d.deschedule(ctx, crp, memberClusterList.Items); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// collectEvictions returns the names of the placements that have in-flight evictions, and the names
+// of the placements for which the descheduler has created an eviction within the cooldown period; it
+// also cleans up the finished evictions created by the descheduler once the retention period has passed.
+func (d *Descheduler) collectEvictions(ctx context.Context) (sets.Set[string], sets.Set[string], error) {
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	if err := d.Client.List(ctx, evictionList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourcePlacementEvictions")
+		return nil, nil, controller.NewAPIServerError(true, err)
+	}
+
+	inflightPlacements := sets.New[string]()
+	coolingDownPlacements := sets.New[string]()
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		_, createdByDescheduler := eviction.Labels[placementv1beta1.DeschedulerStrategyLabel]
+		age := time.Since(eviction.CreationTimestamp.Time)
+		if createdByDescheduler && age < d.Cooldown {
+			coolingDownPlacements.Insert(eviction.Spec.PlacementName)
+		}
+		if !evictionutils.IsEvictionInTerminalState(eviction) {
+			inflightPlacements.Insert(eviction.Spec.PlacementName)
+			continue
+		}
+		if !createdByDescheduler {
+			// Leave the evictions created by others alone.
+			continue
+		}
+		if age < finishedEvictionRetentionPeriod || age < d.Cooldown {
+			continue
+		}
+		if err := d.Client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete a finished eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+			return nil, nil, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted a finished eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	}
+	return inflightPlacements, coolingDownPlacements, nil
+}
+
+// deschedule evaluates a placement with the descheduling strategies and creates an eviction if needed.
+func (d *Descheduler) deschedule(ctx context.Context, crp *placementv1beta1.ClusterResourcePlacement, memberClusters []clusterv1beta1.MemberCluster) error {
+	crpRef := klog.KObj(crp)
+	if crp.DeletionTimestamp != nil || crp.Spec.Policy == nil || crp.Spec.Policy.PlacementType != placementv1beta1.PickNPlacementType {
+		// Only PickN placements are subject to descheduling; PickAll placements are already on
+		// all the matching clusters, and PickFixed placements cannot be evicted.
+		return nil
+	}
+
+	state, bindings, err := d.buildPlacementState(ctx, crp, memberClusters)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return nil
+	}
+
+	for _, strategy := range d.Strategies {
+		clusterName, reason := strategy.SelectClusterToEvict(ctx, state)
+		if len(clusterName) == 0 {
+			continue
+		}
+		klog.V(2).InfoS("A descheduling strategy has selected a cluster to evict", "clusterResourcePlacement", crpRef, "strategy", strategy.Name(), "memberCluster", clusterName, "reason", reason)
+
+		allowed, err := d.isDisruptionAllowed(ctx, crp, bindings)
+		if err != nil || !allowed {
+			return err
+		}
+		return d.createEviction(ctx, crp, clusterName, strategy.Name(), reason)
+	}
+	return nil
+}
+
+// buildPlacementState builds the scheduling state of a placement for the strategies to evaluate;
+// it returns nil if the placement is not ready for descheduling, i.e., its placements have not settled
+// or there is no other cluster to move the placements to.
+func (d *Descheduler) buildPlacementState(
+	ctx context.Context,
+	crp *placementv1beta1.ClusterResourcePlacement,
+	memberClusters []clusterv1beta1.MemberCluster,
+) (*PlacementState, []placementv1beta1.ClusterResourceBinding, error) {
+	crpRef := klog.KObj(crp)
+	policySnapshot, err := controller.LookupLatestPolicySnapshot(ctx, d.Client, types.NamespacedName{Name: crp.Name})
+	if err != nil {
+		if errors.Is(err, controller.ErrNoLatestPolicySnapshot) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	scheduledCond := policySnapshot.GetCondition(string(placementv1beta1.PolicySnapshotScheduled))
+	if !condition.IsConditionStatusTrue(scheduledCond, policySnapshot.GetGeneration()) {
+		klog.V(2).InfoS("Skipping the placement as the scheduler has not finished scheduling yet", "clusterResourcePlacement", crpRef, "policySnapshot", klog.KObj(policySnapshot))
+		return nil, nil, nil
+	}
+
+	bindingList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := d.Client.List(ctx, bindingList, client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: crp.Name}); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourceBindings", "clusterResourcePlacement", crpRef)
+		return nil, nil, controller.NewAPIServerError(true, err)
+	}
+	boundClusterNames := sets.New[string]()
+	for idx := range bindingList.Items {
+		binding := &bindingList.Items[idx]
+		if binding.DeletionTimestamp != nil || binding.Spec.State != placementv1beta1.BindingStateBound {
+			// Some placements are being changed; wait until they settle.
+			klog.V(2).InfoS("Skipping the placement as not all of its bindings have settled", "clusterResourcePlacement", crpRef, "clusterResourceBinding", klog.KObj(binding))
+			return nil, nil, nil
+		}
+		boundClusterNames.Insert(binding.Spec.TargetCluster)
+	}
+	if boundClusterNames.Len() == 0 {
+		return nil, nil, nil
+	}
+
+	state := &PlacementState{
+		Placement:      crp,
+		PolicySnapshot: policySnapshot,
+	}
+	// Re-use the filter plugins of the scheduler so that a candidate cluster is always one that
+	// the scheduler can pick.
+	affinityPlugin := clusteraffinity.New()
+	skipAffinityFilter := affinityPlugin.PreFilter(ctx, nil, policySnapshot).IsSkip()
+	taintTolerationPlugin := tainttoleration.New()
+	for idx := range memberClusters {
+		cluster := &memberClusters[idx]
+		if boundClusterNames.Has(cluster.Name) {
+			state.BoundClusters = append(state.BoundClusters, cluster)
+			continue
+		}
+		if eligible, _ := d.ClusterEligibilityChecker.IsEligible(cluster); !eligible {
+			continue
+		}
+		if !skipAffinityFilter {
+			if status := affinityPlugin.Filter(ctx, nil, policySnapshot, cluster); !status.IsSuccess() {
+				continue
+			}
+		}
+		if status := taintTolerationPlugin.Filter(ctx, nil, policySnapshot, cluster); !status.IsSuccess() {
+			continue
+		}
+		state.CandidateClusters = append(state.CandidateClusters, cluster)
+	}
+	if len(state.CandidateClusters) == 0 {
+		klog.V(2).InfoS("Skipping the placement as there are no other clusters to move the placements to", "clusterResourcePlacement", crpRef)
+		return nil, nil, nil
+	}
+	return state, bindingList.Items, nil
+}
+
+// isDisruptionAllowed checks if the disruption budget of a placement allows one more voluntary
+// disruption at this moment.
+//
+// The eviction controller always enforces the disruption budget when executing an eviction; the
+// check here only helps avoid creating evictions that are bound to be blocked.
+func (d *Descheduler) isDisruptionAllowed(ctx context.Context, crp *placementv1beta1.ClusterResourcePlacement, bindings []placementv1beta1.ClusterResourceBinding) (bool, error) {
+	var db placementv1beta1.ClusterResourcePlacementDisruptionBudget
+	if err := d.Client.Get(ctx, types.NamespacedName{Name: crp.Name}, &db); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		klog.ErrorS(err, "Failed to get the disruption budget", "clusterResourcePlacement", klog.KObj(crp))
+		return false, controller.NewAPIServerError(true, err)
+	}
+	allowed, availableBindings := evictionutils.IsEvictionAllowed(bindings, *crp, db)
+	if !allowed {
+		klog.V(2).InfoS("Skipping the placement as its disruption budget does not allow more disruptions", "clusterResourcePlacement", klog.KObj(crp), "availableBindings", availableBindings, "totalBindings", len(bindings))
+	}
+	return allowed, nil
+}
+
+// createEviction creates an eviction for the placement on the given cluster.
+func (d *Descheduler) createEviction(ctx context.Context, crp *placementv1beta1.ClusterResourcePlacement, clusterName, strategyName, reason string) error {
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", crp.Name, clusterName),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel:   crp.Name,
+				placementv1beta1.DeschedulerStrategyLabel: strategyName,
+			},
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: crp.Name,
+			ClusterName:   clusterName,
+		},
+	}
+	if err := d.Client.Create(ctx, eviction); err != nil {
+		klog.ErrorS(err, "Failed to create an eviction", "clusterResourcePlacement", klog.KObj(crp), "memberCluster", clusterName)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created an eviction", "clusterResourcePlacement", klog.KObj(crp), "clusterResourcePlacementEviction", klog.KObj(eviction), "strategy", strategyName)
+	d.Recorder.Eventf(crp, corev1.EventTypeNormal, evictionCreatedEventReason,
+		"Requested to evict the placement on cluster %s via eviction %s (strategy %s): %s", clusterName, eviction.Name, strategyName, reason)
+	return nil
+}
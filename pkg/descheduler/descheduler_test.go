@@ -0,0 +1,373 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+)
+
+const (
+	crpName = "test-crp"
+)
+
+var (
+	noScheduleTaint = clusterv1beta1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}
+)
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+// joinedCluster returns a member cluster that is eligible for resource placement.
+func joinedCluster(name string, taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	now := metav1.Now()
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Taints: taints,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			AgentStatus: []clusterv1beta1.AgentStatus{
+				{
+					Type:                  clusterv1beta1.MemberAgent,
+					LastReceivedHeartbeat: now,
+					Conditions: []metav1.Condition{
+						{Type: string(clusterv1beta1.AgentJoined), Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Joined"},
+						{Type: string(clusterv1beta1.AgentHealthy), Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Healthy"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pickNCRP(numberOfClusters int32) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(numberOfClusters),
+			},
+		},
+	}
+}
+
+func scheduledPolicySnapshot(crp *placementv1beta1.ClusterResourcePlacement) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, crp.Name, 0),
+			Generation: 1,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crp.Name,
+				placementv1beta1.IsLatestSnapshotLabel:  strconv.FormatBool(true),
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: crp.Spec.Policy,
+		},
+		Status: placementv1beta1.SchedulingPolicySnapshotStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PolicySnapshotScheduled),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Reason:             "Scheduled",
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+		},
+	}
+}
+
+func boundBinding(clusterName string, available bool) *placementv1beta1.ClusterResourceBinding {
+	binding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("%s-%s", crpName, clusterName),
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: clusterName,
+		},
+	}
+	if available {
+		binding.Status.Conditions = []metav1.Condition{
+			{Type: string(placementv1beta1.ResourceBindingAvailable), Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: metav1.Now()},
+		}
+	}
+	return binding
+}
+
+func finishedEviction(name string, createdAt time.Time, labels map[string]string) *placementv1beta1.ClusterResourcePlacementEviction {
+	return &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            labels,
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: crpName,
+			ClusterName:   "member-1",
+		},
+		Status: placementv1beta1.PlacementEvictionStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeValid), Status: metav1.ConditionTrue, Reason: "Valid", LastTransitionTime: metav1.Now()},
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeExecuted), Status: metav1.ConditionTrue, Reason: "Executed", LastTransitionTime: metav1.Now()},
+			},
+		},
+	}
+}
+
+type evictionSummary struct {
+	ClusterName string
+	Strategy    string
+}
+
+func TestRunOnce(t *testing.T) {
+	crp := pickNCRP(2)
+	descheduledLabels := map[string]string{
+		placementv1beta1.PlacementTrackingLabel:   crpName,
+		placementv1beta1.DeschedulerStrategyLabel: UntoleratedTaintStrategyName,
+	}
+
+	testCases := []struct {
+		name          string
+		objects       []client.Object
+		wantEvictions []evictionSummary
+	}{
+		{
+			name: "evict from the tainted cluster",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				boundBinding("member-2", true),
+			},
+			wantEvictions: []evictionSummary{
+				{ClusterName: "member-1", Strategy: UntoleratedTaintStrategyName},
+			},
+		},
+		{
+			name: "no candidate clusters",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3", noScheduleTaint),
+				boundBinding("member-1", true),
+				boundBinding("member-2", true),
+			},
+		},
+		{
+			name: "placement has not settled yet",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				&placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "scheduled-binding",
+						Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:         placementv1beta1.BindingStateScheduled,
+						TargetCluster: "member-2",
+					},
+				},
+			},
+		},
+		{
+			name: "disruption budget does not allow more disruptions",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				boundBinding("member-2", false),
+				&placementv1beta1.ClusterResourcePlacementDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: crpName},
+					Spec: placementv1beta1.PlacementDisruptionBudgetSpec{
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+		},
+		{
+			name: "placement has an in-flight eviction",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				boundBinding("member-2", true),
+				&placementv1beta1.ClusterResourcePlacementEviction{
+					ObjectMeta: metav1.ObjectMeta{Name: "user-eviction"},
+					Spec: placementv1beta1.PlacementEvictionSpec{
+						PlacementName: crpName,
+						ClusterName:   "member-2",
+					},
+				},
+			},
+			wantEvictions: []evictionSummary{
+				{ClusterName: "member-2"},
+			},
+		},
+		{
+			name: "placement has been descheduled within the cooldown period",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				boundBinding("member-2", true),
+				finishedEviction("recent", time.Now().Add(-time.Minute), descheduledLabels),
+			},
+			wantEvictions: []evictionSummary{
+				{ClusterName: "member-1", Strategy: UntoleratedTaintStrategyName},
+			},
+		},
+		{
+			name: "placement has been descheduled before the cooldown period",
+			objects: []client.Object{
+				crp,
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				joinedCluster("member-3"),
+				boundBinding("member-1", true),
+				boundBinding("member-2", true),
+				finishedEviction("earlier", time.Now().Add(-2*time.Hour), descheduledLabels),
+			},
+			wantEvictions: []evictionSummary{
+				{ClusterName: "member-1", Strategy: UntoleratedTaintStrategyName},
+				{ClusterName: "member-1", Strategy: UntoleratedTaintStrategyName},
+			},
+		},
+		{
+			name: "PickAll placements are not descheduled",
+			objects: []client.Object{
+				&placementv1beta1.ClusterResourcePlacement{
+					ObjectMeta: metav1.ObjectMeta{Name: crpName},
+				},
+				scheduledPolicySnapshot(crp),
+				joinedCluster("member-1", noScheduleTaint),
+				joinedCluster("member-2"),
+				boundBinding("member-1", true),
+			},
+		},
+		{
+			name: "finished evictions created by the descheduler are cleaned up after the retention period",
+			objects: []client.Object{
+				finishedEviction("expired", time.Now().Add(-finishedEvictionRetentionPeriod-time.Minute), descheduledLabels),
+				finishedEviction("not-expired", time.Now(), descheduledLabels),
+				finishedEviction("created-by-others", time.Now().Add(-finishedEvictionRetentionPeriod-time.Minute), nil),
+			},
+			wantEvictions: []evictionSummary{
+				{ClusterName: "member-1"},
+				{ClusterName: "member-1", Strategy: UntoleratedTaintStrategyName},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&placementv1beta1.ClusterResourcePlacementEviction{}).
+				Build()
+			strategies, err := NewStrategies(StrategyNames, StrategyOptions{LowUtilizationThreshold: 50, HighUtilizationThreshold: 80})
+			if err != nil {
+				t.Fatalf("NewStrategies() = %v, want no error", err)
+			}
+			d := &Descheduler{
+				Client:                    fakeClient,
+				Recorder:                  record.NewFakeRecorder(10),
+				ClusterEligibilityChecker: clustereligibilitychecker.New(),
+				Strategies:                strategies,
+				Interval:                  time.Minute,
+				Cooldown:                  time.Hour,
+			}
+
+			if err := d.runOnce(ctx); err != nil {
+				t.Fatalf("runOnce() = %v, want no error", err)
+			}
+
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := fakeClient.List(ctx, evictionList); err != nil {
+				t.Fatalf("failed to list evictions: %v", err)
+			}
+			var gotEvictions []evictionSummary
+			for _, eviction := range evictionList.Items {
+				if eviction.Spec.PlacementName != crpName {
+					t.Errorf("eviction %s targets placement %s, want %s", eviction.Name, eviction.Spec.PlacementName, crpName)
+				}
+				gotEvictions = append(gotEvictions, evictionSummary{
+					ClusterName: eviction.Spec.ClusterName,
+					Strategy:    eviction.Labels[placementv1beta1.DeschedulerStrategyLabel],
+				})
+			}
+			if diff := cmp.Diff(gotEvictions, tc.wantEvictions); diff != "" {
+				t.Errorf("evictions mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
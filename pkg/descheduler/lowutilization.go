@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+var (
+	// utilizationTrackedResources are the resources whose utilization the LowUtilization
+	// strategy tracks.
+	utilizationTrackedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+)
+
+// LowUtilization is a descheduling strategy that moves placements away from over-utilized
+// clusters when there are under-utilized clusters that the placement can be re-placed onto.
+//
+// The utilization of a resource in a cluster is the percentage of its allocatable capacity
+// that is no longer available, as reported in the resource usage of the member cluster.
+// A cluster is over-utilized if the utilization of any tracked resource (CPU and memory) is
+// above the high threshold; it is under-utilized if the utilization of all tracked resources
+// is below the low threshold.
+type LowUtilization struct {
+	// LowThreshold is the utilization percentage below which a cluster is under-utilized.
+	LowThreshold int
+	// HighThreshold is the utilization percentage above which a cluster is over-utilized.
+	HighThreshold int
+}
+
+var _ Strategy = &LowUtilization{}
+
+// Name returns the name of the strategy.
+func (s *LowUtilization) Name() string {
+	return LowUtilizationStrategyName
+}
+
+// SelectClusterToEvict returns the most over-utilized bound cluster if there is at least
+// one under-utilized candidate cluster.
+func (s *LowUtilization) SelectClusterToEvict(_ context.Context, state *PlacementState) (string, string) {
+	hasUnderUtilizedCandidate := false
+	for _, cluster := range state.CandidateClusters {
+		if peak, ok := peakUtilization(cluster); ok && peak < s.LowThreshold {
+			hasUnderUtilizedCandidate = true
+			break
+		}
+	}
+	if !hasUnderUtilizedCandidate {
+		return "", ""
+	}
+
+	var mostUtilized *clusterv1beta1.MemberCluster
+	mostUtilizedPeak := s.HighThreshold
+	for _, cluster := range state.BoundClusters {
+		if peak, ok := peakUtilization(cluster); ok && peak > mostUtilizedPeak {
+			mostUtilized = cluster
+			mostUtilizedPeak = peak
+		}
+	}
+	if mostUtilized == nil {
+		return "", ""
+	}
+	return mostUtilized.Name, fmt.Sprintf("cluster is over-utilized (%d%% > %d%%) while there are under-utilized clusters (< %d%%) available", mostUtilizedPeak, s.HighThreshold, s.LowThreshold)
+}
+
+// peakUtilization returns the highest utilization percentage among all the tracked resources
+// of a cluster; it returns false if the cluster has not reported the usage of all the tracked
+// resources yet.
+func peakUtilization(cluster *clusterv1beta1.MemberCluster) (int, bool) {
+	usage := cluster.Status.ResourceUsage
+	peak := 0
+	for _, name := range utilizationTrackedResources {
+		allocatable, hasAllocatable := usage.Allocatable[name]
+		available, hasAvailable := usage.Available[name]
+		if !hasAllocatable || !hasAvailable || allocatable.IsZero() {
+			return 0, false
+		}
+		used := allocatable.DeepCopy()
+		used.Sub(available)
+		utilization := int(used.AsApproximateFloat64() * 100 / allocatable.AsApproximateFloat64())
+		if utilization > peak {
+			peak = utilization
+		}
+	}
+	return peak, true
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// clusterWithUsage returns a member cluster that has reported the given CPU and memory usage.
+func clusterWithUsage(name string, allocatableCPU, availableCPU, allocatableMemory, availableMemory string) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+					corev1.ResourceMemory: resource.MustParse(allocatableMemory),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(availableCPU),
+					corev1.ResourceMemory: resource.MustParse(availableMemory),
+				},
+			},
+		},
+	}
+}
+
+func TestPeakUtilization(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cluster  *clusterv1beta1.MemberCluster
+		wantPeak int
+		wantOK   bool
+	}{
+		{
+			name:     "cpu is the most utilized",
+			cluster:  clusterWithUsage("member-1", "10", "1", "10Gi", "5Gi"),
+			wantPeak: 90,
+			wantOK:   true,
+		},
+		{
+			name:     "memory is the most utilized",
+			cluster:  clusterWithUsage("member-1", "4", "3", "8Gi", "2Gi"),
+			wantPeak: 75,
+			wantOK:   true,
+		},
+		{
+			name:    "no usage reported",
+			cluster: &clusterv1beta1.MemberCluster{},
+		},
+		{
+			name:    "zero allocatable",
+			cluster: clusterWithUsage("member-1", "0", "0", "8Gi", "2Gi"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPeak, gotOK := peakUtilization(tc.cluster)
+			if gotPeak != tc.wantPeak || gotOK != tc.wantOK {
+				t.Errorf("peakUtilization() = (%d, %t), want (%d, %t)", gotPeak, gotOK, tc.wantPeak, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestLowUtilizationSelectClusterToEvict(t *testing.T) {
+	strategy := &LowUtilization{LowThreshold: 50, HighThreshold: 80}
+	testCases := []struct {
+		name              string
+		boundClusters     []*clusterv1beta1.MemberCluster
+		candidateClusters []*clusterv1beta1.MemberCluster
+		wantClusterName   string
+	}{
+		{
+			name: "evict from the most over-utilized cluster",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-1", "10", "1", "10Gi", "5Gi"),
+				clusterWithUsage("member-2", "10", "5", "10Gi", "1Gi"),
+				clusterWithUsage("member-3", "10", "1", "10Gi", "512Mi"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-4", "10", "9", "10Gi", "9Gi"),
+			},
+			wantClusterName: "member-3",
+		},
+		{
+			name: "no under-utilized candidate clusters",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-1", "10", "1", "10Gi", "5Gi"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-2", "10", "9", "10Gi", "4Gi"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "member-3"}},
+			},
+		},
+		{
+			name: "no over-utilized bound clusters",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-1", "10", "2", "10Gi", "5Gi"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "member-2"}},
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithUsage("member-3", "10", "9", "10Gi", "9Gi"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &PlacementState{
+				BoundClusters:     tc.boundClusters,
+				CandidateClusters: tc.candidateClusters,
+			}
+			gotClusterName, gotReason := strategy.SelectClusterToEvict(context.Background(), state)
+			if gotClusterName != tc.wantClusterName {
+				t.Errorf("SelectClusterToEvict() = %q, want %q", gotClusterName, tc.wantClusterName)
+			}
+			if (len(gotReason) == 0) != (len(tc.wantClusterName) == 0) {
+				t.Errorf("SelectClusterToEvict() reason = %q, want a reason only when a cluster is selected", gotReason)
+			}
+		})
+	}
+}
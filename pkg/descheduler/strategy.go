@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// LowUtilizationStrategyName is the name of the strategy that moves placements away from
+	// over-utilized clusters when under-utilized clusters are available.
+	LowUtilizationStrategyName = "LowUtilization"
+	// TopologySpreadViolationStrategyName is the name of the strategy that moves placements away
+	// from the topology domains that violate the topology spread constraints of the placement.
+	TopologySpreadViolationStrategyName = "TopologySpreadViolation"
+	// UntoleratedTaintStrategyName is the name of the strategy that moves placements away from
+	// clusters that have been tainted (after the placements were made) with taints the placement
+	// does not tolerate.
+	UntoleratedTaintStrategyName = "UntoleratedTaint"
+)
+
+var (
+	// StrategyNames is the list of all the supported descheduling strategies.
+	StrategyNames = []string{
+		LowUtilizationStrategyName,
+		TopologySpreadViolationStrategyName,
+		UntoleratedTaintStrategyName,
+	}
+)
+
+// PlacementState is a snapshot of the scheduling state of a placement which descheduling
+// strategies inspect to decide whether resources should be moved away from a cluster.
+type PlacementState struct {
+	// Placement is the placement being evaluated.
+	Placement *placementv1beta1.ClusterResourcePlacement
+	// PolicySnapshot is the latest scheduling policy snapshot of the placement.
+	PolicySnapshot placementv1beta1.PolicySnapshotObj
+	// BoundClusters are the clusters that the placement has currently been bound to.
+	BoundClusters []*clusterv1beta1.MemberCluster
+	// CandidateClusters are the clusters that are eligible for the placement but have not been
+	// picked by the scheduler; resources evicted by a strategy can be re-placed onto one of them.
+	//
+	// The descheduler guarantees that this list is never empty when a strategy is invoked.
+	CandidateClusters []*clusterv1beta1.MemberCluster
+}
+
+// Strategy is a pluggable descheduling strategy.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// SelectClusterToEvict returns the name of a bound cluster from which the resources of the
+	// placement should be evicted, along with a human-readable reason; it returns an empty
+	// cluster name if no eviction is needed.
+	SelectClusterToEvict(ctx context.Context, state *PlacementState) (clusterName, reason string)
+}
+
+// StrategyOptions are the options for building descheduling strategies.
+type StrategyOptions struct {
+	// LowUtilizationThreshold is the resource utilization percentage below which a cluster is
+	// considered under-utilized.
+	LowUtilizationThreshold int
+	// HighUtilizationThreshold is the resource utilization percentage above which a cluster is
+	// considered over-utilized.
+	HighUtilizationThreshold int
+}
+
+// NewStrategies builds the descheduling strategies with the given names, in the given order.
+func NewStrategies(names []string, opts StrategyOptions) ([]Strategy, error) {
+	strategies := make([]Strategy, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case LowUtilizationStrategyName:
+			strategies = append(strategies, &LowUtilization{
+				LowThreshold:  opts.LowUtilizationThreshold,
+				HighThreshold: opts.HighUtilizationThreshold,
+			})
+		case TopologySpreadViolationStrategyName:
+			strategies = append(strategies, &TopologySpreadViolation{})
+		case UntoleratedTaintStrategyName:
+			strategies = append(strategies, &UntoleratedTaint{})
+		default:
+			return nil, fmt.Errorf("unknown descheduling strategy %q", name)
+		}
+	}
+	return strategies, nil
+}
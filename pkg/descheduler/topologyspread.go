@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+// TopologySpreadViolation is a descheduling strategy that moves placements away from the
+// topology domains that violate the (hard) topology spread constraints of the placement.
+//
+// The scheduler enforces topology spread constraints only when it picks clusters; the
+// placements can become unevenly spread later, e.g., when new clusters join the fleet or
+// when the labels of the clusters change. This strategy evicts a placement from the most
+// crowded domain if the skew exceeds the max skew and a candidate cluster exists in a less
+// crowded domain.
+//
+// Only the constraints with the DoNotSchedule action are enforced.
+type TopologySpreadViolation struct{}
+
+var _ Strategy = &TopologySpreadViolation{}
+
+// Name returns the name of the strategy.
+func (s *TopologySpreadViolation) Name() string {
+	return TopologySpreadViolationStrategyName
+}
+
+// SelectClusterToEvict returns a bound cluster in the most crowded domain of the first
+// violated topology spread constraint.
+func (s *TopologySpreadViolation) SelectClusterToEvict(_ context.Context, state *PlacementState) (string, string) {
+	policy := state.PolicySnapshot.GetPolicySnapshotSpec().Policy
+	if policy == nil {
+		return "", ""
+	}
+
+	for idx := range policy.TopologySpreadConstraints {
+		constraint := &policy.TopologySpreadConstraints[idx]
+		if constraint.WhenUnsatisfiable == placementv1beta1.ScheduleAnyway {
+			continue
+		}
+		maxSkew := int(defaulter.DefaultMaxSkewValue)
+		if constraint.MaxSkew != nil {
+			maxSkew = int(*constraint.MaxSkew)
+		}
+
+		// Count the bound clusters in each domain, and find the domains that have candidate clusters.
+		boundClustersByDomain := make(map[string][]string)
+		for _, cluster := range state.BoundClusters {
+			if domain, ok := cluster.Labels[constraint.TopologyKey]; ok {
+				boundClustersByDomain[domain] = append(boundClustersByDomain[domain], cluster.Name)
+			}
+		}
+		if len(boundClustersByDomain) == 0 {
+			continue
+		}
+		domainsWithCandidates := make(map[string]bool)
+		for _, cluster := range state.CandidateClusters {
+			if domain, ok := cluster.Labels[constraint.TopologyKey]; ok {
+				domainsWithCandidates[domain] = true
+			}
+		}
+
+		// Find the most crowded domain; ties are broken by the domain name for stability.
+		domains := make([]string, 0, len(boundClustersByDomain))
+		for domain := range boundClustersByDomain {
+			domains = append(domains, domain)
+		}
+		sort.Strings(domains)
+		mostCrowded := domains[0]
+		for _, domain := range domains[1:] {
+			if len(boundClustersByDomain[domain]) > len(boundClustersByDomain[mostCrowded]) {
+				mostCrowded = domain
+			}
+		}
+		largest := len(boundClustersByDomain[mostCrowded])
+
+		// Find the least crowded domain that can accept the evicted placement.
+		leastCrowded, smallest := "", largest
+		for domain := range domainsWithCandidates {
+			if count := len(boundClustersByDomain[domain]); count < smallest || (count == smallest && domain < leastCrowded) {
+				leastCrowded, smallest = domain, count
+			}
+		}
+		// Moving a placement from the most crowded domain to the least crowded domain must
+		// reduce the skew; otherwise the eviction would only shuffle placements around.
+		if leastCrowded == "" || largest-smallest <= maxSkew || largest-smallest < 2 {
+			continue
+		}
+
+		clusterNames := boundClustersByDomain[mostCrowded]
+		sort.Strings(clusterNames)
+		return clusterNames[len(clusterNames)-1], fmt.Sprintf("topology spread constraint on key %q is violated: domain %q has %d placement(s) while domain %q has %d (max skew %d)",
+			constraint.TopologyKey, mostCrowded, largest, leastCrowded, smallest, maxSkew)
+	}
+	return "", ""
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	regionLabel = "region"
+)
+
+func clusterInRegion(name, region string) *clusterv1beta1.MemberCluster {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	if len(region) > 0 {
+		cluster.Labels = map[string]string{regionLabel: region}
+	}
+	return cluster
+}
+
+func TestTopologySpreadViolationSelectClusterToEvict(t *testing.T) {
+	testCases := []struct {
+		name              string
+		constraints       []placementv1beta1.TopologySpreadConstraint
+		boundClusters     []*clusterv1beta1.MemberCluster
+		candidateClusters []*clusterv1beta1.MemberCluster
+		wantClusterName   string
+	}{
+		{
+			name: "skew exceeds the default max skew",
+			constraints: []placementv1beta1.TopologySpreadConstraint{
+				{TopologyKey: regionLabel},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+				clusterInRegion("member-3", "east"),
+				clusterInRegion("member-4", "west"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-5", "west"),
+			},
+			wantClusterName: "member-3",
+		},
+		{
+			name: "skew exceeds the max skew with an empty domain",
+			constraints: []placementv1beta1.TopologySpreadConstraint{
+				{TopologyKey: regionLabel, MaxSkew: ptr.To(int32(1)), WhenUnsatisfiable: placementv1beta1.DoNotSchedule},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+				clusterInRegion("member-3", "west"),
+				clusterInRegion("member-4", "west"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-5", "north"),
+			},
+			wantClusterName: "member-2",
+		},
+		{
+			name: "skew within the max skew",
+			constraints: []placementv1beta1.TopologySpreadConstraint{
+				{TopologyKey: regionLabel, MaxSkew: ptr.To(int32(2))},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+				clusterInRegion("member-3", "west"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-4", "north"),
+			},
+		},
+		{
+			name: "no candidate clusters in less crowded domains",
+			constraints: []placementv1beta1.TopologySpreadConstraint{
+				{TopologyKey: regionLabel},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+				clusterInRegion("member-3", "east"),
+				clusterInRegion("member-4", "west"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-5", "east"),
+				clusterInRegion("member-6", ""),
+			},
+		},
+		{
+			name: "soft constraints are ignored",
+			constraints: []placementv1beta1.TopologySpreadConstraint{
+				{TopologyKey: regionLabel, WhenUnsatisfiable: placementv1beta1.ScheduleAnyway},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+				clusterInRegion("member-3", "east"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-4", "west"),
+			},
+		},
+		{
+			name: "no constraints",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-1", "east"),
+				clusterInRegion("member-2", "east"),
+			},
+			candidateClusters: []*clusterv1beta1.MemberCluster{
+				clusterInRegion("member-3", "west"),
+			},
+		},
+	}
+
+	strategy := &TopologySpreadViolation{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &PlacementState{
+				PolicySnapshot: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+					Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+						Policy: &placementv1beta1.PlacementPolicy{
+							PlacementType:             placementv1beta1.PickNPlacementType,
+							TopologySpreadConstraints: tc.constraints,
+						},
+					},
+				},
+				BoundClusters:     tc.boundClusters,
+				CandidateClusters: tc.candidateClusters,
+			}
+			gotClusterName, _ := strategy.SelectClusterToEvict(context.Background(), state)
+			if gotClusterName != tc.wantClusterName {
+				t.Errorf("SelectClusterToEvict() = %q, want %q", gotClusterName, tc.wantClusterName)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
+)
+
+// UntoleratedTaint is a descheduling strategy that moves placements away from clusters which
+// have been tainted with taints that the placement does not tolerate.
+//
+// NoSchedule taints only stop the scheduler from picking a cluster; they have no effect on the
+// placements that have already been made. This strategy evicts such placements so that the scheduler
+// can pick a cluster that is free of untolerated taints instead. NoExecute taints are left to the
+// taint eviction controller, which honors the toleration seconds of the placement.
+type UntoleratedTaint struct{}
+
+var _ Strategy = &UntoleratedTaint{}
+
+// Name returns the name of the strategy.
+func (s *UntoleratedTaint) Name() string {
+	return UntoleratedTaintStrategyName
+}
+
+// SelectClusterToEvict returns the first bound cluster (in the order of the bound clusters)
+// that has a taint the placement does not tolerate.
+func (s *UntoleratedTaint) SelectClusterToEvict(ctx context.Context, state *PlacementState) (string, string) {
+	// Re-use the taint toleration plugin of the scheduler so that the descheduler always
+	// agrees with the scheduler on whether a taint is tolerated.
+	plugin := tainttoleration.New()
+	for _, cluster := range state.BoundClusters {
+		status := plugin.Filter(ctx, nil, state.PolicySnapshot, withoutNoExecuteTaints(cluster))
+		if status.IsSuccess() {
+			continue
+		}
+		if !status.IsClusterUnschedulable() {
+			// Normally this branch should never run.
+			klog.ErrorS(status.AsError(), "Failed to check the taints of a cluster", "clusterResourcePlacement", klog.KObj(state.Placement), "memberCluster", klog.KObj(cluster))
+			continue
+		}
+		return cluster.Name, strings.Join(status.Reasons(), "; ")
+	}
+	return "", ""
+}
+
+// withoutNoExecuteTaints returns a copy of the cluster with all the NoExecute taints removed.
+func withoutNoExecuteTaints(cluster *clusterv1beta1.MemberCluster) *clusterv1beta1.MemberCluster {
+	if !slices.ContainsFunc(cluster.Spec.Taints, isNoExecuteTaint) {
+		return cluster
+	}
+	cluster = cluster.DeepCopy()
+	cluster.Spec.Taints = slices.DeleteFunc(cluster.Spec.Taints, isNoExecuteTaint)
+	return cluster
+}
+
+func isNoExecuteTaint(taint clusterv1beta1.Taint) bool {
+	return taint.Effect == corev1.TaintEffectNoExecute
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func clusterWithTaints(name string, taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Taints: taints,
+		},
+	}
+}
+
+func TestUntoleratedTaintSelectClusterToEvict(t *testing.T) {
+	maintenanceTaint := clusterv1beta1.Taint{Key: "maintenance", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+	gpuTaint := clusterv1beta1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}
+	testCases := []struct {
+		name            string
+		tolerations     []placementv1beta1.Toleration
+		boundClusters   []*clusterv1beta1.MemberCluster
+		wantClusterName string
+	}{
+		{
+			name: "bound cluster has an untolerated taint",
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "gpu", Operator: corev1.TolerationOpExists},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithTaints("member-1", gpuTaint),
+				clusterWithTaints("member-2", gpuTaint, maintenanceTaint),
+				clusterWithTaints("member-3", maintenanceTaint),
+			},
+			wantClusterName: "member-2",
+		},
+		{
+			name: "NoExecute taints are ignored",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithTaints("member-1", clusterv1beta1.Taint{Key: "outage", Effect: corev1.TaintEffectNoExecute}),
+			},
+		},
+		{
+			name: "all taints are tolerated",
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "gpu", Operator: corev1.TolerationOpExists},
+				{Key: "maintenance", Operator: corev1.TolerationOpEqual, Value: "true", Effect: corev1.TaintEffectNoSchedule},
+			},
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithTaints("member-1", gpuTaint),
+				clusterWithTaints("member-2", gpuTaint, maintenanceTaint),
+			},
+		},
+		{
+			name: "no taints",
+			boundClusters: []*clusterv1beta1.MemberCluster{
+				clusterWithTaints("member-1"),
+			},
+		},
+	}
+
+	strategy := &UntoleratedTaint{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &PlacementState{
+				Placement: &placementv1beta1.ClusterResourcePlacement{},
+				PolicySnapshot: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+					Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+						Policy: &placementv1beta1.PlacementPolicy{
+							PlacementType: placementv1beta1.PickNPlacementType,
+							Tolerations:   tc.tolerations,
+						},
+					},
+				},
+				BoundClusters: tc.boundClusters,
+			}
+			gotClusterName, gotReason := strategy.SelectClusterToEvict(context.Background(), state)
+			if gotClusterName != tc.wantClusterName {
+				t.Errorf("SelectClusterToEvict() = %q, want %q", gotClusterName, tc.wantClusterName)
+			}
+			if (len(gotReason) == 0) != (len(tc.wantClusterName) == 0) {
+				t.Errorf("SelectClusterToEvict() reason = %q, want a reason only when a cluster is selected", gotReason)
+			}
+		})
+	}
+}
@@ -27,21 +27,16 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/discovery"
-	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
-	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/controllers"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
 	defaultcontrollers "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/controllers"
 	defaulttrackers "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
@@ -83,7 +78,7 @@ const (
 // PropertyProvider is the Azure property provider for Fleet.
 type PropertyProvider struct {
 	// The trackers.
-	podTracker       *trackers.PodTracker
+	podTracker       *defaulttrackers.PodTracker
 	nodeTracker      *trackers.NodeTracker
 	namespaceTracker *defaulttrackers.NamespaceTracker
 
@@ -127,85 +122,7 @@ var _ propertyprovider.PropertyProvider = &PropertyProvider{}
 func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
 	klog.V(2).Info("Starting Azure property provider")
 
-	podObj := client.Object(&corev1.Pod{})
-	mgr, err := ctrl.NewManager(config, ctrl.Options{
-		Scheme: scheme.Scheme,
-		Cache: cache.Options{
-			ByObject: map[client.Object]cache.ByObject{
-				podObj: {
-					// Set up field selectors so that API server will not send out watch events that
-					// are not relevant to the pod watcher. This is essentially a trade-off between
-					// in-memory check overhead and encoding/transmission overhead; for large clusters
-					// with frequent pod creation/deletion ops, the trade-off seems to be worth it based
-					// on current experimentation results.
-					Field: fields.AndSelectors(
-						fields.OneTermNotEqualSelector("spec.nodeName", ""),
-						fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
-						fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
-					),
-					// Drop irrelevant fields from the pod object; this can significantly reduce the
-					// CPU and memory usage of the pod watcher, as less data is stored in cache.
-					Transform: func(obj interface{}) (interface{}, error) {
-						pod, ok := obj.(*corev1.Pod)
-						if !ok {
-							return nil, fmt.Errorf("failed to cast object to a pod object")
-						}
-
-						// The pod watcher only cares about a very limited set of pod fields,
-						// specifically the pod's current phase, node name, and resource requests.
-
-						// Drop unused metadata fields.
-						pod.ObjectMeta.Labels = nil
-						pod.ObjectMeta.Annotations = nil
-						pod.ObjectMeta.OwnerReferences = nil
-						pod.ObjectMeta.ManagedFields = nil
-
-						// Drop the rest of the pod status as they are irrelevant to the pod watcher.
-						pod.Status = corev1.PodStatus{
-							Phase: pod.Status.Phase,
-						}
-
-						// Drop the unwanted pod spec fields.
-						rebuiltedContainers := make([]corev1.Container, 0, len(pod.Spec.Containers))
-						for idx := range pod.Spec.Containers {
-							c := pod.Spec.Containers[idx]
-							rebuiltedContainers = append(rebuiltedContainers, corev1.Container{
-								Name:         c.Name,
-								Image:        c.Image,
-								Resources:    c.Resources,
-								ResizePolicy: c.ResizePolicy,
-							})
-						}
-						pod.Spec = corev1.PodSpec{
-							NodeName:   pod.Spec.NodeName,
-							Containers: rebuiltedContainers,
-						}
-						return pod, nil
-					},
-				},
-			},
-		},
-		// Disable metric serving for the Azure property provider controller manager.
-		//
-		// Note that this will not stop the metrics from being collected and exported; as they
-		// are registered via a top-level variable as a part of the controller runtime package,
-		// which is also used by the Fleet member agent.
-		Metrics: metricsserver.Options{
-			BindAddress: "0",
-		},
-		// Disable health probe serving for the Azure property provider controller manager.
-		HealthProbeBindAddress: "0",
-		// Disable leader election for the Azure property provider.
-		//
-		// Note that for optimal performance, only the running instance of the Fleet member agent
-		// (if there are multiple ones) should have the Azure property provider enabled; this can
-		// be achieved by starting the Azure property provider only when an instance of the Fleet
-		// member agent wins the leader election. It should be noted that running the Azure property
-		// provider for multiple times will not incur any side effect other than some minor
-		// performance costs, as at this moment the Azure property provider observes data individually
-		// in a passive manner with no need for any centralized state.
-		LeaderElection: false,
-	})
+	mgr, err := defaultcontrollers.NewManager(config)
 	if err != nil {
 		klog.ErrorS(err, "Failed to start Azure property provider")
 		return err
@@ -278,7 +195,7 @@ func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error
 
 	// Set up the node reconciler.
 	klog.V(2).Info("Setting up the node reconciler")
-	nodeReconciler := &controllers.NodeReconciler{
+	nodeReconciler := &defaultcontrollers.NodeReconciler{
 		NT:     p.nodeTracker,
 		Client: mgr.GetClient(),
 	}
@@ -299,10 +216,10 @@ func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error
 		// No pod tracker has been set, and available resources collection is enabled; set up
 		// a pod tracker.
 		klog.V(2).Info("Building a pod tracker")
-		p.podTracker = trackers.NewPodTracker()
+		p.podTracker = defaulttrackers.NewPodTracker()
 
 		klog.V(2).Info("Starting the pod reconciler")
-		podReconciler := &controllers.PodReconciler{
+		podReconciler := &defaultcontrollers.PodReconciler{
 			PT:     p.podTracker,
 			Client: mgr.GetClient(),
 		}
@@ -35,6 +35,7 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
+	defaulttrackers "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
 )
 
 const (
@@ -739,7 +740,7 @@ func TestCollect(t *testing.T) {
 
 			// Build the trackers manually for testing purposes.
 			nodeTracker := trackers.NewNodeTracker(tc.pricingprovider)
-			podTracker := trackers.NewPodTracker()
+			podTracker := defaulttrackers.NewPodTracker()
 			for idx := range tc.nodes {
 				nodeTracker.AddOrUpdate(&tc.nodes[idx])
 			}
@@ -823,7 +824,7 @@ func TestCollectWithDisabledFeatures(t *testing.T) {
 	testCases := []struct {
 		name                                  string
 		nodeTracker                           *trackers.NodeTracker
-		podTracker                            *trackers.PodTracker
+		podTracker                            *defaulttrackers.PodTracker
 		isCostCollectionEnabled               bool
 		isAvailableResourcesCollectionEnabled bool
 		wantPropertyCollectionResponse        propertyprovider.PropertyCollectionResponse
@@ -831,7 +832,7 @@ func TestCollectWithDisabledFeatures(t *testing.T) {
 		{
 			name:                                  "cost collection disabled",
 			nodeTracker:                           trackers.NewNodeTracker(nil),
-			podTracker:                            trackers.NewPodTracker(),
+			podTracker:                            defaulttrackers.NewPodTracker(),
 			isCostCollectionEnabled:               false,
 			isAvailableResourcesCollectionEnabled: true,
 			wantPropertyCollectionResponse: propertyprovider.PropertyCollectionResponse{
@@ -110,9 +110,8 @@ func (d *dummyPricingProviderWithStaleData) LastUpdated() time.Time {
 }
 
 var (
-	ignoreNodeTrackerFields    = cmpopts.IgnoreFields(NodeTracker{}, "mu", "pricingProvider")
-	ignoreCostInfoFields       = cmpopts.IgnoreFields(costInfo{}, "lastUpdated")
-	ignorePodTrackerMutexField = cmpopts.IgnoreFields(PodTracker{}, "mu")
+	ignoreNodeTrackerFields = cmpopts.IgnoreFields(NodeTracker{}, "mu", "pricingProvider")
+	ignoreCostInfoFields    = cmpopts.IgnoreFields(costInfo{}, "lastUpdated")
 
 	// This variable should only work with test cases that do not mutate the tracker.
 	nodeTrackerWith3Nodes = &NodeTracker{
@@ -168,28 +167,6 @@ var (
 		},
 		pricingProvider: &dummyPricingProvider{},
 	}
-
-	// This variable should only work with test cases that do not mutate the tracker.
-	podTrackerWith3Pods = &PodTracker{
-		totalRequested: corev1.ResourceList{
-			corev1.ResourceCPU:    resource.MustParse("8"),
-			corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
-		},
-		requestedByPod: map[string]corev1.ResourceList{
-			fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-				corev1.ResourceCPU:    resource.MustParse("3"),
-				corev1.ResourceMemory: resource.MustParse("250Mi"),
-			},
-			fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-				corev1.ResourceCPU:    resource.MustParse("1"),
-				corev1.ResourceMemory: resource.MustParse("1Gi"),
-			},
-			fmt.Sprintf("%s/%s", namespaceName3, podName3): {
-				corev1.ResourceCPU:    resource.MustParse("4"),
-				corev1.ResourceMemory: resource.MustParse("1000Mi"),
-			},
-		},
-	}
 )
 
 // TestCalculateCosts tests the calculateCosts function.
@@ -2268,586 +2245,3 @@ func TestNodeTrackerNodeCountPerSKU(t *testing.T) {
 		})
 	}
 }
-
-// TestNodeTrackerAddOrUpdate tests the AddOrUpdate method of the PodTracker.
-func TestPodTrackerAddOrUpdate(t *testing.T) {
-	testCases := []struct {
-		name   string
-		pt     *PodTracker
-		pods   []*corev1.Pod
-		wantPT *PodTracker
-	}{
-		{
-			name: "can track a pod with a single container",
-			pt:   NewPodTracker(),
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("200Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("200Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("200Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can track a pod with multiple containers",
-			pt:   NewPodTracker(),
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("200Mi"),
-									},
-								},
-							},
-							{
-								Name: containerName2,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("2"),
-										corev1.ResourceMemory: resource.MustParse("500Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("3"),
-					corev1.ResourceMemory: resource.MustParse("700Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("3"),
-						corev1.ResourceMemory: resource.MustParse("700Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can track multiple pods",
-			pt:   NewPodTracker(),
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("200Mi"),
-									},
-								},
-							},
-							{
-								Name: containerName2,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("2"),
-										corev1.ResourceMemory: resource.MustParse("50Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName2,
-						Namespace: namespaceName2,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName3,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("1Gi"),
-									},
-								},
-							},
-						},
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName3,
-						Namespace: namespaceName3,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1.5"),
-										corev1.ResourceMemory: resource.MustParse("600Mi"),
-									},
-								},
-							},
-							{
-								Name: containerName2,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("2.5"),
-										corev1.ResourceMemory: resource.MustParse("400Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("3"),
-						corev1.ResourceMemory: resource.MustParse("250Mi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
-						corev1.ResourceCPU:    resource.MustParse("4"),
-						corev1.ResourceMemory: resource.MustParse("1000Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can update existing pod with requested capacity change",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("200Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("200Mi"),
-					},
-				},
-			},
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("2"),
-										corev1.ResourceMemory: resource.MustParse("400Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("400Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("2"),
-						corev1.ResourceMemory: resource.MustParse("400Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can update existing pod with no requested capacity change",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("200Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("200Mi"),
-					},
-				},
-			},
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("200Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("200Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("200Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can track a pod with no requested capacity for supported resources",
-			pt:   NewPodTracker(),
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceStorage: resource.MustParse("800Mi"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.Quantity{},
-					corev1.ResourceMemory: resource.Quantity{},
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.Quantity{},
-						corev1.ResourceMemory: resource.Quantity{},
-					},
-				},
-			},
-		},
-		{
-			name: "can track a pod with containers that do not have resource requests",
-			pt:   NewPodTracker(),
-			pods: []*corev1.Pod{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      podName1,
-						Namespace: namespaceName1,
-					},
-					Spec: corev1.PodSpec{
-						Containers: []corev1.Container{
-							{
-								Name: containerName1,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("1"),
-										corev1.ResourceMemory: resource.MustParse("200Mi"),
-									},
-								},
-							},
-							{
-								Name: containerName2,
-							},
-						},
-					},
-				},
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("200Mi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("200Mi"),
-					},
-				},
-			},
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			for _, p := range tc.pods {
-				tc.pt.AddOrUpdate(p)
-			}
-
-			if diff := cmp.Diff(
-				tc.pt, tc.wantPT,
-				cmp.AllowUnexported(PodTracker{}),
-				ignorePodTrackerMutexField,
-			); diff != "" {
-				t.Fatalf("AddOrUpdatePod(), pod tracker diff (-got, +want): \n%s", diff)
-			}
-		})
-	}
-}
-
-// TestPodTrackerRemove tests the Remove method of the PodTracker.
-func TestPodTrackerRemove(t *testing.T) {
-	testCases := []struct {
-		name           string
-		pt             *PodTracker
-		podIdentifiers []string
-		wantPT         *PodTracker
-	}{
-		{
-			name: "can remove a tracked pod",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("3"),
-						corev1.ResourceMemory: resource.MustParse("250Mi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
-						corev1.ResourceCPU:    resource.MustParse("4"),
-						corev1.ResourceMemory: resource.MustParse("1000Mi"),
-					},
-				},
-			},
-			podIdentifiers: []string{
-				fmt.Sprintf("%s/%s", namespaceName2, podName2),
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("7"),
-					corev1.ResourceMemory: *resource.NewQuantity(1310720000, resource.BinarySI),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("3"),
-						corev1.ResourceMemory: resource.MustParse("250Mi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
-						corev1.ResourceCPU:    resource.MustParse("4"),
-						corev1.ResourceMemory: resource.MustParse("1000Mi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can remove multiple tracked pods",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.MustParse("3"),
-						corev1.ResourceMemory: resource.MustParse("250Mi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
-						corev1.ResourceCPU:    resource.MustParse("4"),
-						corev1.ResourceMemory: resource.MustParse("1000Mi"),
-					},
-				},
-			},
-			podIdentifiers: []string{
-				fmt.Sprintf("%s/%s", namespaceName1, podName1),
-				fmt.Sprintf("%s/%s", namespaceName3, podName3),
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("1Gi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can remove an untracked pod",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("1Gi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-				},
-			},
-			podIdentifiers: []string{
-				fmt.Sprintf("%s/%s", namespaceName1, podName1),
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("1"),
-					corev1.ResourceMemory: resource.MustParse("1Gi"),
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
-						corev1.ResourceCPU:    resource.MustParse("1"),
-						corev1.ResourceMemory: resource.MustParse("1Gi"),
-					},
-				},
-			},
-		},
-		{
-			name: "can remove a tracked pod with no requested capacity for supported resources",
-			pt: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.Quantity{},
-					corev1.ResourceMemory: resource.Quantity{},
-				},
-				requestedByPod: map[string]corev1.ResourceList{
-					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
-						corev1.ResourceCPU:    resource.Quantity{},
-						corev1.ResourceMemory: resource.Quantity{},
-					},
-				},
-			},
-			podIdentifiers: []string{
-				fmt.Sprintf("%s/%s", namespaceName1, podName1),
-			},
-			wantPT: &PodTracker{
-				totalRequested: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.Quantity{},
-					corev1.ResourceMemory: resource.Quantity{},
-				},
-				requestedByPod: map[string]corev1.ResourceList{},
-			},
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			for _, identifier := range tc.podIdentifiers {
-				tc.pt.Remove(identifier)
-			}
-
-			if diff := cmp.Diff(
-				tc.pt, tc.wantPT,
-				cmp.AllowUnexported(PodTracker{}),
-				ignorePodTrackerMutexField,
-			); diff != "" {
-				t.Fatalf("RemovePod(), pod tracker diff (-got, +want): \n%s", diff)
-			}
-		})
-	}
-}
-
-// TestPodTotalRequestedFor tests the TotalRequestedFor method of the PodTracker.
-func TestPodTotalRequestedFor(t *testing.T) {
-	testCases := []struct {
-		name string
-		pt   *PodTracker
-		rn   corev1.ResourceName
-		want resource.Quantity
-	}{
-		{
-			name: "can return the total requested CPU capacity",
-			pt:   podTrackerWith3Pods,
-			rn:   corev1.ResourceCPU,
-			want: resource.MustParse("8"),
-		},
-		{
-			name: "can return the total requested memory capacity",
-			pt:   podTrackerWith3Pods,
-			rn:   corev1.ResourceMemory,
-			want: *resource.NewQuantity(2384461824, resource.BinarySI),
-		},
-		{
-			name: "can return the total requested capacity for a non-supported resource",
-			pt:   podTrackerWith3Pods,
-			rn:   corev1.ResourceStorage,
-			want: resource.Quantity{},
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			if got := tc.pt.TotalRequestedFor(tc.rn); !got.Equal(tc.want) {
-				t.Fatalf("TotalRequested() = %s, want %s", got.String(), tc.want.String())
-			}
-		})
-	}
-}
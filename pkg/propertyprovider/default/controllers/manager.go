@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// NewManager returns a controller manager for running the node, pod, and namespace reconcilers
+// of a property provider.
+func NewManager(config *rest.Config) (ctrl.Manager, error) {
+	podObj := client.Object(&corev1.Pod{})
+	return ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme.Scheme,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				podObj: {
+					// Set up field selectors so that API server will not send out watch events that
+					// are not relevant to the pod watcher. This is essentially a trade-off between
+					// in-memory check overhead and encoding/transmission overhead; for large clusters
+					// with frequent pod creation/deletion ops, the trade-off seems to be worth it based
+					// on current experimentation results.
+					Field: fields.AndSelectors(
+						fields.OneTermNotEqualSelector("spec.nodeName", ""),
+						fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+						fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+					),
+					// Drop irrelevant fields from the pod object; this can significantly reduce the
+					// CPU and memory usage of the pod watcher, as less data is stored in cache.
+					Transform: transformPod,
+				},
+			},
+		},
+		// Disable metric serving for the property provider controller manager.
+		//
+		// Note that this will not stop the metrics from being collected and exported; as they
+		// are registered via a top-level variable as a part of the controller runtime package,
+		// which is also used by the Fleet member agent.
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+		// Disable health probe serving for the property provider controller manager.
+		HealthProbeBindAddress: "0",
+		// Disable leader election for the property provider.
+		//
+		// Note that for optimal performance, only the running instance of the Fleet member agent
+		// (if there are multiple ones) should have the property provider enabled; this can
+		// be achieved by starting the property provider only when an instance of the Fleet
+		// member agent wins the leader election. It should be noted that running the property
+		// provider for multiple times will not incur any side effect other than some minor
+		// performance costs, as at this moment the property providers observe data individually
+		// in a passive manner with no need for any centralized state.
+		LeaderElection: false,
+	})
+}
+
+// transformPod drops the pod fields that the pod watcher does not need before the pod
+// object is stored in the cache.
+func transformPod(obj interface{}) (interface{}, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast object to a pod object")
+	}
+
+	// The pod watcher only cares about a very limited set of pod fields,
+	// specifically the pod's current phase, node name, and resource requests.
+
+	// Drop unused metadata fields.
+	pod.ObjectMeta.Labels = nil
+	pod.ObjectMeta.Annotations = nil
+	pod.ObjectMeta.OwnerReferences = nil
+	pod.ObjectMeta.ManagedFields = nil
+
+	// Drop the rest of the pod status as they are irrelevant to the pod watcher.
+	pod.Status = corev1.PodStatus{
+		Phase: pod.Status.Phase,
+	}
+
+	// Drop the unwanted pod spec fields.
+	rebuiltContainers := make([]corev1.Container, 0, len(pod.Spec.Containers))
+	for idx := range pod.Spec.Containers {
+		c := pod.Spec.Containers[idx]
+		rebuiltContainers = append(rebuiltContainers, corev1.Container{
+			Name:         c.Name,
+			Image:        c.Image,
+			Resources:    c.Resources,
+			ResizePolicy: c.ResizePolicy,
+		})
+	}
+	pod.Spec = corev1.PodSpec{
+		NodeName:   pod.Spec.NodeName,
+		Containers: rebuiltContainers,
+	}
+	return pod, nil
+}
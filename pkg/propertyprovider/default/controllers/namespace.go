@@ -14,8 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package controllers feature a number of controllers that are in use
-// by the default property provider.
+// Package controllers feature a number of controllers that are shared by the property providers.
 package controllers
 
 import (
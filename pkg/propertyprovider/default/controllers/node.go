@@ -14,8 +14,6 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package controllers feature a number of controllers that are in use
-// by the Azure property provider.
 package controllers
 
 import (
@@ -27,13 +25,19 @@ import (
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
 )
 
+// NodeTracker is the interface that a node tracker in use by a property provider implements.
+type NodeTracker interface {
+	// AddOrUpdate starts tracking a node or updates the stats about a node that has been tracked.
+	AddOrUpdate(node *corev1.Node)
+	// Remove stops tracking a node.
+	Remove(nodeName string)
+}
+
 // NodeReconciler reconciles Node objects.
 type NodeReconciler struct {
-	NT     *trackers.NodeTracker
+	NT     NodeTracker
 	Client client.Client
 }
 
@@ -41,10 +45,10 @@ type NodeReconciler struct {
 func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	nodeRef := klog.KRef(req.Namespace, req.Name)
 	startTime := time.Now()
-	klog.V(2).InfoS("Reconciliation starts for node objects in the Azure property provider", "node", nodeRef)
+	klog.V(2).InfoS("Reconciliation starts for node objects in the property provider", "node", nodeRef)
 	defer func() {
 		latency := time.Since(startTime).Milliseconds()
-		klog.V(2).InfoS("Reconciliation ends for node objects in the Azure property provider", "node", nodeRef, "latency", latency)
+		klog.V(2).InfoS("Reconciliation ends for node objects in the property provider", "node", nodeRef, "latency", latency)
 	}()
 
 	// Retrieve the node object.
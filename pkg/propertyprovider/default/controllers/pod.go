@@ -14,8 +14,6 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package controllers feature a number of controllers that are in use
-// by the Azure property provider.
 package controllers
 
 import (
@@ -27,8 +25,6 @@ import (
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
 )
 
 // TO-DO (chenyu1): this is a relatively expensive watcher, due to how frequent pods can change
@@ -36,9 +32,17 @@ import (
 // to observe the changes of requested resources in a cluster. The alternative, which is to use
 // Lists, adds too much overhead to the API server.
 
+// PodTracker is the interface that a pod tracker in use by a property provider implements.
+type PodTracker interface {
+	// AddOrUpdate starts tracking a pod or updates the stats about a pod that has been tracked.
+	AddOrUpdate(pod *corev1.Pod)
+	// Remove stops tracking a pod.
+	Remove(podIdentifier string)
+}
+
 // PodReconciler reconciles Pod objects.
 type PodReconciler struct {
-	PT     *trackers.PodTracker
+	PT     PodTracker
 	Client client.Client
 }
 
@@ -46,10 +50,10 @@ type PodReconciler struct {
 func (p *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	podRef := klog.KRef(req.Namespace, req.Name)
 	startTime := time.Now()
-	klog.V(2).InfoS("Reconciliation starts for pod objects in the Azure property provider", "pod", podRef)
+	klog.V(2).InfoS("Reconciliation starts for pod objects in the property provider", "pod", podRef)
 	defer func() {
 		latency := time.Since(startTime).Milliseconds()
-		klog.V(2).InfoS("Reconciliation ends for pod objects in the Azure property provider", "pod", podRef, "latency", latency)
+		klog.V(2).InfoS("Reconciliation ends for pod objects in the property provider", "pod", podRef, "latency", latency)
 	}()
 
 	// Retrieve the pod object from cache.
@@ -15,7 +15,7 @@ limitations under the License.
 */
 
 // Package trackers feature implementations that help track specific stats about
-// Kubernetes resources, e.g., namespaces in the default property provider.
+// Kubernetes resources, e.g., nodes, pods, and namespaces, which are shared by the property providers.
 package trackers
 
 import (
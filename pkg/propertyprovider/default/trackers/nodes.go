@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackers
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ResourceNvidiaGPU is the extended resource name that the NVIDIA device plugin registers
+	// for GPUs.
+	ResourceNvidiaGPU corev1.ResourceName = "nvidia.com/gpu"
+
+	// ReservedNameForUndefinedInstanceType is the instance type reported for nodes that do not
+	// have an instance type label.
+	ReservedNameForUndefinedInstanceType = "undefined"
+	// ReservedNameForUndefinedArchitecture is the architecture reported for nodes that have
+	// neither an architecture label nor an architecture reported in the node info.
+	ReservedNameForUndefinedArchitecture = "undefined"
+)
+
+// SupportedResourceNames is a list of resource names that the node and pod trackers support.
+//
+// Currently the supported resources are CPU, memory, and NVIDIA GPUs.
+var SupportedResourceNames = []corev1.ResourceName{
+	corev1.ResourceCPU,
+	corev1.ResourceMemory,
+	ResourceNvidiaGPU,
+}
+
+// nodeInfo is the information the node tracker keeps about a node.
+type nodeInfo struct {
+	instanceType string
+	architecture string
+	capacity     corev1.ResourceList
+	allocatable  corev1.ResourceList
+}
+
+// NodeTracker helps track specific stats about nodes in a Kubernetes cluster, e.g., its count,
+// the count of nodes per instance type, and the total GPUs.
+//
+// Unlike the node tracker in the Azure property provider, this tracker keeps no running totals;
+// the stats are aggregated over the tracked nodes when they are queried, as there is no need to
+// re-calculate costs upon each node change.
+type NodeTracker struct {
+	nodes map[string]*nodeInfo
+
+	// mu is a RWMutex that protects the tracker against concurrent access.
+	mu sync.RWMutex
+}
+
+// NewNodeTracker returns a node tracker.
+func NewNodeTracker() *NodeTracker {
+	return &NodeTracker{
+		nodes: make(map[string]*nodeInfo),
+	}
+}
+
+// instanceTypeOf returns the instance type of a node, as reported by its well-known labels.
+func instanceTypeOf(node *corev1.Node) string {
+	if it, ok := node.Labels[corev1.LabelInstanceTypeStable]; ok && len(it) > 0 {
+		return it
+	}
+	if it, ok := node.Labels[corev1.LabelInstanceType]; ok && len(it) > 0 {
+		return it
+	}
+	return ReservedNameForUndefinedInstanceType
+}
+
+// architectureOf returns the architecture of a node, as reported by its well-known label or
+// its node info.
+func architectureOf(node *corev1.Node) string {
+	if arch, ok := node.Labels[corev1.LabelArchStable]; ok && len(arch) > 0 {
+		return arch
+	}
+	if len(node.Status.NodeInfo.Architecture) > 0 {
+		return node.Status.NodeInfo.Architecture
+	}
+	return ReservedNameForUndefinedArchitecture
+}
+
+// supportedResourcesIn returns the supported resources in a resource list; absent resources
+// are reported as zero.
+func supportedResourcesIn(rl corev1.ResourceList) corev1.ResourceList {
+	res := make(corev1.ResourceList, len(SupportedResourceNames))
+	for _, rn := range SupportedResourceNames {
+		res[rn] = rl[rn].DeepCopy()
+	}
+	return res
+}
+
+// AddOrUpdate starts tracking a node or updates the stats about a node that has been
+// tracked.
+func (nt *NodeTracker) AddOrUpdate(node *corev1.Node) {
+	nt.mu.Lock()
+	defer nt.mu.Unlock()
+
+	nt.nodes[node.Name] = &nodeInfo{
+		instanceType: instanceTypeOf(node),
+		architecture: architectureOf(node),
+		capacity:     supportedResourcesIn(node.Status.Capacity),
+		allocatable:  supportedResourcesIn(node.Status.Allocatable),
+	}
+	klog.V(2).InfoS("Tracked the node", "node", klog.KObj(node))
+}
+
+// Remove stops tracking a node.
+func (nt *NodeTracker) Remove(nodeName string) {
+	nt.mu.Lock()
+	defer nt.mu.Unlock()
+
+	delete(nt.nodes, nodeName)
+	klog.V(2).InfoS("Untracked the node", "node", nodeName)
+}
+
+// NodeCount returns the node count stat that a node tracker tracks.
+func (nt *NodeTracker) NodeCount() int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return len(nt.nodes)
+}
+
+// NodeCountPerInstanceType returns the count of nodes of each instance type that a node tracker tracks.
+func (nt *NodeTracker) NodeCountPerInstanceType() map[string]int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	res := make(map[string]int)
+	for _, ni := range nt.nodes {
+		res[ni.instanceType]++
+	}
+	return res
+}
+
+// NodeCountPerArchitecture returns the count of nodes of each architecture that a node tracker tracks.
+func (nt *NodeTracker) NodeCountPerArchitecture() map[string]int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	res := make(map[string]int)
+	for _, ni := range nt.nodes {
+		res[ni.architecture]++
+	}
+	return res
+}
+
+// TotalCapacity returns the total capacity of all supported resources across all the tracked nodes.
+func (nt *NodeTracker) TotalCapacity() corev1.ResourceList {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return nt.sumOf(func(ni *nodeInfo) corev1.ResourceList { return ni.capacity })
+}
+
+// TotalAllocatable returns the total allocatable capacity of all supported resources across all
+// the tracked nodes.
+func (nt *NodeTracker) TotalAllocatable() corev1.ResourceList {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return nt.sumOf(func(ni *nodeInfo) corev1.ResourceList { return ni.allocatable })
+}
+
+// MaxAllocatableFor returns the largest allocatable capacity of a specific resource on a single
+// tracked node.
+func (nt *NodeTracker) MaxAllocatableFor(rn corev1.ResourceName) resource.Quantity {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	var maxQ resource.Quantity
+	for _, ni := range nt.nodes {
+		if q := ni.allocatable[rn]; q.Cmp(maxQ) > 0 {
+			maxQ = q.DeepCopy()
+		}
+	}
+	return maxQ
+}
+
+// sumOf sums up the resource lists picked from each tracked node.
+//
+// Note that this method assumes that the access lock has been acquired.
+func (nt *NodeTracker) sumOf(pick func(ni *nodeInfo) corev1.ResourceList) corev1.ResourceList {
+	res := make(corev1.ResourceList, len(SupportedResourceNames))
+	for _, rn := range SupportedResourceNames {
+		res[rn] = resource.Quantity{}
+	}
+	for _, ni := range nt.nodes {
+		rl := pick(ni)
+		for _, rn := range SupportedResourceNames {
+			q := res[rn]
+			q.Add(rl[rn])
+			res[rn] = q
+		}
+	}
+	return res
+}
@@ -42,7 +42,7 @@ func NewPodTracker() *PodTracker {
 		requestedByPod: make(map[string]corev1.ResourceList),
 	}
 
-	for _, rn := range supportedResourceNames {
+	for _, rn := range SupportedResourceNames {
 		pt.totalRequested[rn] = resource.Quantity{}
 	}
 
@@ -57,7 +57,7 @@ func (pt *PodTracker) AddOrUpdate(pod *corev1.Pod) {
 
 	requestsAcrossAllContainers := make(corev1.ResourceList)
 	for _, container := range pod.Spec.Containers {
-		for _, rn := range supportedResourceNames {
+		for _, rn := range SupportedResourceNames {
 			r := requestsAcrossAllContainers[rn]
 			r.Add(container.Resources.Requests[rn])
 			requestsAcrossAllContainers[rn] = r
@@ -72,7 +72,7 @@ func (pt *PodTracker) AddOrUpdate(pod *corev1.Pod) {
 		// At this moment, a pod's requested resources are immutable after the pod
 		// is created; in-place vertical scaling is not yet possible. However, the provider
 		// here still performs a sanity check to avoid any inconsistencies.
-		for _, rn := range supportedResourceNames {
+		for _, rn := range SupportedResourceNames {
 			r1 := rp[rn]
 			r2 := requestsAcrossAllContainers[rn]
 			if !r1.Equal(r2) {
@@ -92,7 +92,7 @@ func (pt *PodTracker) AddOrUpdate(pod *corev1.Pod) {
 		rp = make(corev1.ResourceList)
 
 		// The pod's requested resources have not been tracked.
-		for _, rn := range supportedResourceNames {
+		for _, rn := range SupportedResourceNames {
 			r := requestsAcrossAllContainers[rn]
 			rp[rn] = r
 
@@ -113,7 +113,7 @@ func (pt *PodTracker) Remove(podIdentifier string) {
 	rp, ok := pt.requestedByPod[podIdentifier]
 	if ok {
 		// Untrack the pod's requested resources.
-		for _, rn := range supportedResourceNames {
+		for _, rn := range SupportedResourceNames {
 			r := rp[rn]
 			tr := pt.totalRequested[rn]
 			tr.Sub(r)
@@ -0,0 +1,907 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	nodeName1 = "node-1"
+	nodeName2 = "node-2"
+	nodeName3 = "node-3"
+
+	podName1 = "pod-1"
+	podName2 = "pod-2"
+	podName3 = "pod-3"
+
+	namespaceName1 = "work-1"
+	namespaceName2 = "work-2"
+	namespaceName3 = "work-3"
+
+	containerName1 = "container-1"
+	containerName2 = "container-2"
+	containerName3 = "container-3"
+
+	instanceType1 = "m5.xlarge"
+	instanceType2 = "p3.2xlarge"
+)
+
+var (
+	ignorePodTrackerMutexField = cmpopts.IgnoreFields(PodTracker{}, "mu")
+
+	// This variable should only work with test cases that do not mutate the tracker.
+	podTrackerWith3Pods = &PodTracker{
+		totalRequested: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
+			ResourceNvidiaGPU:     resource.Quantity{},
+		},
+		requestedByPod: map[string]corev1.ResourceList{
+			fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+				corev1.ResourceCPU:    resource.MustParse("3"),
+				corev1.ResourceMemory: resource.MustParse("250Mi"),
+				ResourceNvidiaGPU:     resource.Quantity{},
+			},
+			fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+				ResourceNvidiaGPU:     resource.Quantity{},
+			},
+			fmt.Sprintf("%s/%s", namespaceName3, podName3): {
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("1000Mi"),
+				ResourceNvidiaGPU:     resource.Quantity{},
+			},
+		},
+	}
+)
+
+func nodeWith(name string, labels map[string]string, arch string, capacity, allocatable corev1.ResourceList) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Status: corev1.NodeStatus{
+			Capacity:    capacity,
+			Allocatable: allocatable,
+			NodeInfo: corev1.NodeSystemInfo{
+				Architecture: arch,
+			},
+		},
+	}
+}
+
+func resourceList(cpu, memory, gpu string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+		ResourceNvidiaGPU:     resource.MustParse(gpu),
+	}
+}
+
+// TestNodeTracker tests the node tracker.
+func TestNodeTracker(t *testing.T) {
+	testCases := []struct {
+		name                         string
+		nodes                        []*corev1.Node
+		removedNodeNames             []string
+		wantNodeCount                int
+		wantNodeCountPerInstanceType map[string]int
+		wantNodeCountPerArchitecture map[string]int
+		wantTotalCapacity            corev1.ResourceList
+		wantTotalAllocatable         corev1.ResourceList
+		wantMaxAllocatableMemory     resource.Quantity
+	}{
+		{
+			name:                         "no nodes",
+			wantNodeCountPerInstanceType: map[string]int{},
+			wantNodeCountPerArchitecture: map[string]int{},
+			wantTotalCapacity:            resourceList("0", "0", "0"),
+			wantTotalAllocatable:         resourceList("0", "0", "0"),
+		},
+		{
+			name: "multiple nodes",
+			nodes: []*corev1.Node{
+				nodeWith(nodeName1, map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType1,
+					corev1.LabelArchStable:         "amd64",
+				}, "", corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				}, corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("3800m"),
+					corev1.ResourceMemory: resource.MustParse("14Gi"),
+				}),
+				nodeWith(nodeName2, map[string]string{
+					corev1.LabelInstanceType: instanceType2,
+				}, "amd64", resourceList("8", "64Gi", "1"), resourceList("7800m", "60Gi", "1")),
+				nodeWith(nodeName3, nil, "arm64", resourceList("8", "32Gi", "4"), resourceList("7800m", "30Gi", "4")),
+			},
+			wantNodeCount: 3,
+			wantNodeCountPerInstanceType: map[string]int{
+				instanceType1:                        1,
+				instanceType2:                        1,
+				ReservedNameForUndefinedInstanceType: 1,
+			},
+			wantNodeCountPerArchitecture: map[string]int{
+				"amd64": 2,
+				"arm64": 1,
+			},
+			wantTotalCapacity:        resourceList("20", "112Gi", "5"),
+			wantTotalAllocatable:     resourceList("19400m", "104Gi", "5"),
+			wantMaxAllocatableMemory: resource.MustParse("60Gi"),
+		},
+		{
+			name: "updated and removed nodes",
+			nodes: []*corev1.Node{
+				nodeWith(nodeName1, map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType1,
+				}, "amd64", resourceList("4", "16Gi", "0"), resourceList("4", "16Gi", "0")),
+				nodeWith(nodeName2, map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType2,
+				}, "amd64", resourceList("8", "64Gi", "1"), resourceList("8", "64Gi", "1")),
+				nodeWith(nodeName1, map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType2,
+				}, "", resourceList("8", "64Gi", "1"), resourceList("8", "32Gi", "1")),
+			},
+			removedNodeNames: []string{nodeName2},
+			wantNodeCount:    1,
+			wantNodeCountPerInstanceType: map[string]int{
+				instanceType2: 1,
+			},
+			wantNodeCountPerArchitecture: map[string]int{
+				ReservedNameForUndefinedArchitecture: 1,
+			},
+			wantTotalCapacity:        resourceList("8", "64Gi", "1"),
+			wantTotalAllocatable:     resourceList("8", "32Gi", "1"),
+			wantMaxAllocatableMemory: resource.MustParse("32Gi"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nt := NewNodeTracker()
+			for _, node := range tc.nodes {
+				nt.AddOrUpdate(node)
+			}
+			for _, name := range tc.removedNodeNames {
+				nt.Remove(name)
+			}
+
+			if got := nt.NodeCount(); got != tc.wantNodeCount {
+				t.Errorf("NodeCount() = %d, want %d", got, tc.wantNodeCount)
+			}
+			if diff := cmp.Diff(nt.NodeCountPerInstanceType(), tc.wantNodeCountPerInstanceType); diff != "" {
+				t.Errorf("NodeCountPerInstanceType() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.NodeCountPerArchitecture(), tc.wantNodeCountPerArchitecture); diff != "" {
+				t.Errorf("NodeCountPerArchitecture() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.TotalCapacity(), tc.wantTotalCapacity); diff != "" {
+				t.Errorf("TotalCapacity() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.TotalAllocatable(), tc.wantTotalAllocatable); diff != "" {
+				t.Errorf("TotalAllocatable() diff (-got, +want):\n%s", diff)
+			}
+			if got := nt.MaxAllocatableFor(corev1.ResourceMemory); got.Cmp(tc.wantMaxAllocatableMemory) != 0 {
+				t.Errorf("MaxAllocatableFor(memory) = %s, want %s", got.String(), tc.wantMaxAllocatableMemory.String())
+			}
+		})
+	}
+}
+
+// TestPodTracker tests the pod tracker.
+func TestPodTracker(t *testing.T) {
+	podWith := func(name string, requests ...corev1.ResourceList) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespaceName1,
+			},
+		}
+		for _, r := range requests {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+				Resources: corev1.ResourceRequirements{Requests: r},
+			})
+		}
+		return pod
+	}
+
+	testCases := []struct {
+		name               string
+		pods               []*corev1.Pod
+		removedPodIDs      []string
+		wantTotalRequested corev1.ResourceList
+	}{
+		{
+			name:               "no pods",
+			wantTotalRequested: resourceList("0", "0", "0"),
+		},
+		{
+			name: "multiple pods with multiple containers",
+			pods: []*corev1.Pod{
+				podWith(podName1, resourceList("1", "1Gi", "1"), corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("500m"),
+				}),
+				podWith(podName2, resourceList("2", "2Gi", "2")),
+			},
+			wantTotalRequested: resourceList("3500m", "3Gi", "3"),
+		},
+		{
+			name: "updated and removed pods",
+			pods: []*corev1.Pod{
+				podWith(podName1, resourceList("1", "1Gi", "1")),
+				podWith(podName2, resourceList("2", "2Gi", "2")),
+				podWith(podName1, resourceList("2", "4Gi", "0")),
+			},
+			removedPodIDs:      []string{namespaceName1 + "/" + podName2},
+			wantTotalRequested: resourceList("2", "4Gi", "0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pt := NewPodTracker()
+			for _, pod := range tc.pods {
+				pt.AddOrUpdate(pod)
+			}
+			for _, id := range tc.removedPodIDs {
+				pt.Remove(id)
+			}
+
+			got := pt.TotalRequested()
+			for _, rn := range SupportedResourceNames {
+				gotQ, wantQ := got[rn], tc.wantTotalRequested[rn]
+				if gotQ.Cmp(wantQ) != 0 {
+					t.Errorf("TotalRequested()[%s] = %s, want %s", rn, gotQ.String(), wantQ.String())
+				}
+			}
+		})
+	}
+}
+
+// TestPodTrackerAddOrUpdate tests the AddOrUpdate method of the PodTracker.
+func TestPodTrackerAddOrUpdate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		pt     *PodTracker
+		pods   []*corev1.Pod
+		wantPT *PodTracker
+	}{
+		{
+			name: "can track a pod with a single container",
+			pt:   NewPodTracker(),
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("200Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can track a pod with multiple containers",
+			pt:   NewPodTracker(),
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("200Mi"),
+									},
+								},
+							},
+							{
+								Name: containerName2,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("2"),
+										corev1.ResourceMemory: resource.MustParse("500Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("3"),
+					corev1.ResourceMemory: resource.MustParse("700Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("700Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can track multiple pods",
+			pt:   NewPodTracker(),
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("200Mi"),
+									},
+								},
+							},
+							{
+								Name: containerName2,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("2"),
+										corev1.ResourceMemory: resource.MustParse("50Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName2,
+						Namespace: namespaceName2,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName3,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("1Gi"),
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName3,
+						Namespace: namespaceName3,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1.5"),
+										corev1.ResourceMemory: resource.MustParse("600Mi"),
+									},
+								},
+							},
+							{
+								Name: containerName2,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("2.5"),
+										corev1.ResourceMemory: resource.MustParse("400Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("250Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("1000Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can update existing pod with requested capacity change",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("2"),
+										corev1.ResourceMemory: resource.MustParse("400Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("400Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("400Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can update existing pod with no requested capacity change",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("200Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can track a pod with no requested capacity for supported resources",
+			pt:   NewPodTracker(),
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: resource.MustParse("800Mi"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.Quantity{},
+					corev1.ResourceMemory: resource.Quantity{},
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.Quantity{},
+						corev1.ResourceMemory: resource.Quantity{},
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can track a pod with containers that do not have resource requests",
+			pt:   NewPodTracker(),
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      podName1,
+						Namespace: namespaceName1,
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: containerName1,
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("200Mi"),
+									},
+								},
+							},
+							{
+								Name: containerName2,
+							},
+						},
+					},
+				},
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("200Mi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, p := range tc.pods {
+				tc.pt.AddOrUpdate(p)
+			}
+
+			if diff := cmp.Diff(
+				tc.pt, tc.wantPT,
+				cmp.AllowUnexported(PodTracker{}),
+				ignorePodTrackerMutexField,
+			); diff != "" {
+				t.Fatalf("AddOrUpdatePod(), pod tracker diff (-got, +want): \n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPodTrackerRemove tests the Remove method of the PodTracker.
+func TestPodTrackerRemove(t *testing.T) {
+	testCases := []struct {
+		name           string
+		pt             *PodTracker
+		podIdentifiers []string
+		wantPT         *PodTracker
+	}{
+		{
+			name: "can remove a tracked pod",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("250Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("1000Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			podIdentifiers: []string{
+				fmt.Sprintf("%s/%s", namespaceName2, podName2),
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("7"),
+					corev1.ResourceMemory: *resource.NewQuantity(1310720000, resource.BinarySI),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("250Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("1000Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can remove multiple tracked pods",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: *resource.NewQuantity(2384461824, resource.BinarySI),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("250Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+					fmt.Sprintf("%s/%s", namespaceName3, podName3): {
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("1000Mi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			podIdentifiers: []string{
+				fmt.Sprintf("%s/%s", namespaceName1, podName1),
+				fmt.Sprintf("%s/%s", namespaceName3, podName3),
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can remove an untracked pod",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			podIdentifiers: []string{
+				fmt.Sprintf("%s/%s", namespaceName1, podName1),
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName2, podName2): {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "can remove a tracked pod with no requested capacity for supported resources",
+			pt: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.Quantity{},
+					corev1.ResourceMemory: resource.Quantity{},
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{
+					fmt.Sprintf("%s/%s", namespaceName1, podName1): {
+						corev1.ResourceCPU:    resource.Quantity{},
+						corev1.ResourceMemory: resource.Quantity{},
+						ResourceNvidiaGPU:     resource.Quantity{},
+					},
+				},
+			},
+			podIdentifiers: []string{
+				fmt.Sprintf("%s/%s", namespaceName1, podName1),
+			},
+			wantPT: &PodTracker{
+				totalRequested: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.Quantity{},
+					corev1.ResourceMemory: resource.Quantity{},
+					ResourceNvidiaGPU:     resource.Quantity{},
+				},
+				requestedByPod: map[string]corev1.ResourceList{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, identifier := range tc.podIdentifiers {
+				tc.pt.Remove(identifier)
+			}
+
+			if diff := cmp.Diff(
+				tc.pt, tc.wantPT,
+				cmp.AllowUnexported(PodTracker{}),
+				ignorePodTrackerMutexField,
+			); diff != "" {
+				t.Fatalf("RemovePod(), pod tracker diff (-got, +want): \n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPodTotalRequestedFor tests the TotalRequestedFor method of the PodTracker.
+func TestPodTotalRequestedFor(t *testing.T) {
+	testCases := []struct {
+		name string
+		pt   *PodTracker
+		rn   corev1.ResourceName
+		want resource.Quantity
+	}{
+		{
+			name: "can return the total requested CPU capacity",
+			pt:   podTrackerWith3Pods,
+			rn:   corev1.ResourceCPU,
+			want: resource.MustParse("8"),
+		},
+		{
+			name: "can return the total requested memory capacity",
+			pt:   podTrackerWith3Pods,
+			rn:   corev1.ResourceMemory,
+			want: *resource.NewQuantity(2384461824, resource.BinarySI),
+		},
+		{
+			name: "can return the total requested capacity for a non-supported resource",
+			pt:   podTrackerWith3Pods,
+			rn:   corev1.ResourceStorage,
+			want: resource.Quantity{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.pt.TotalRequestedFor(tc.rn); !got.Equal(tc.want) {
+				t.Fatalf("TotalRequested() = %s, want %s", got.String(), tc.want.String())
+			}
+		})
+	}
+}
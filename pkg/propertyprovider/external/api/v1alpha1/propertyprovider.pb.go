@@ -0,0 +1,559 @@
+// Copyright 2026 The KubeFleet Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: pkg/propertyprovider/external/api/v1alpha1/propertyprovider.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StartRequest is the request for the Start call.
+//
+// The external property provider is expected to connect to the member cluster with its own
+// credentials; the KubeFleet member agent does not share its credentials with the provider.
+type StartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRequest) Reset() {
+	*x = StartRequest{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRequest) ProtoMessage() {}
+
+func (x *StartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
+func (*StartRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{0}
+}
+
+// StartResponse is the response for the Start call.
+type StartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartResponse) Reset() {
+	*x = StartResponse{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResponse) ProtoMessage() {}
+
+func (x *StartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResponse.ProtoReflect.Descriptor instead.
+func (*StartResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{1}
+}
+
+// CollectRequest is the request for the Collect call.
+type CollectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectRequest) Reset() {
+	*x = CollectRequest{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectRequest) ProtoMessage() {}
+
+func (x *CollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
+func (*CollectRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{2}
+}
+
+// CollectResponse is the response for the Collect call.
+type CollectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Properties is a map of non-resource properties and their values. The key should be the
+	// name of the property, which is a Kubernetes label name.
+	Properties map[string]*PropertyValue `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Resources is a group of resources, described by their total, allocatable, and available
+	// capacity.
+	Resources *ResourceUsage `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	// Namespaces is a map of namespace names to their associated work names for namespaces
+	// that are managed by KubeFleet.
+	Namespaces map[string]string `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Conditions is a list of conditions that explains the property collection status.
+	Conditions    []*Condition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectResponse) Reset() {
+	*x = CollectResponse{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectResponse) ProtoMessage() {}
+
+func (x *CollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
+func (*CollectResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollectResponse) GetProperties() map[string]*PropertyValue {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetResources() *ResourceUsage {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetNamespaces() map[string]string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// PropertyValue is the value of a non-resource property.
+type PropertyValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value is the value of the property.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// ObservationTime is when the property is observed.
+	ObservationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observation_time,json=observationTime,proto3" json:"observation_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PropertyValue) Reset() {
+	*x = PropertyValue{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyValue) ProtoMessage() {}
+
+func (x *PropertyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyValue.ProtoReflect.Descriptor instead.
+func (*PropertyValue) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PropertyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PropertyValue) GetObservationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservationTime
+	}
+	return nil
+}
+
+// ResourceUsage describes the resource usage of a cluster.
+//
+// Each map is keyed by the resource name (e.g., cpu, memory); the values are Kubernetes
+// resource quantities in their string forms (e.g., 100m, 2Gi).
+type ResourceUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Capacity is the total capacity of the resources.
+	Capacity map[string]string `protobuf:"bytes,1,rep,name=capacity,proto3" json:"capacity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Allocatable is the allocatable capacity of the resources.
+	Allocatable map[string]string `protobuf:"bytes,2,rep,name=allocatable,proto3" json:"allocatable,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Available is the available capacity of the resources.
+	Available map[string]string `protobuf:"bytes,3,rep,name=available,proto3" json:"available,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ObservationTime is when the resource usage is observed.
+	ObservationTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=observation_time,json=observationTime,proto3" json:"observation_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResourceUsage) GetCapacity() map[string]string {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetAllocatable() map[string]string {
+	if x != nil {
+		return x.Allocatable
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetAvailable() map[string]string {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetObservationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservationTime
+	}
+	return nil
+}
+
+// Condition describes an aspect of the property collection status.
+//
+// It follows the same semantics as the Kubernetes metav1.Condition type; the last transition time
+// and the observed generation are set by the KubeFleet member agent.
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type is the type of the condition.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status is the status of the condition, one of True, False, or Unknown.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Reason is a brief, CamelCase reason for the condition's last transition.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Message is a human-readable message about the condition.
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Condition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto protoreflect.FileDescriptor
+
+const file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDesc = "" +
+	"\n" +
+	"Apkg/propertyprovider/external/api/v1alpha1/propertyprovider.proto\x12#kubefleet.propertyprovider.v1alpha1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x0e\n" +
+	"\fStartRequest\"\x0f\n" +
+	"\rStartResponse\"\x10\n" +
+	"\x0eCollectRequest\"\xb1\x04\n" +
+	"\x0fCollectResponse\x12d\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\v2D.kubefleet.propertyprovider.v1alpha1.CollectResponse.PropertiesEntryR\n" +
+	"properties\x12P\n" +
+	"\tresources\x18\x02 \x01(\v22.kubefleet.propertyprovider.v1alpha1.ResourceUsageR\tresources\x12d\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\v2D.kubefleet.propertyprovider.v1alpha1.CollectResponse.NamespacesEntryR\n" +
+	"namespaces\x12N\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2..kubefleet.propertyprovider.v1alpha1.ConditionR\n" +
+	"conditions\x1aq\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\x05value\x18\x02 \x01(\v22.kubefleet.propertyprovider.v1alpha1.PropertyValueR\x05value:\x028\x01\x1a=\n" +
+	"\x0fNamespacesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\rPropertyValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12E\n" +
+	"\x10observation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0fobservationTime\"\xb7\x04\n" +
+	"\rResourceUsage\x12\\\n" +
+	"\bcapacity\x18\x01 \x03(\v2@.kubefleet.propertyprovider.v1alpha1.ResourceUsage.CapacityEntryR\bcapacity\x12e\n" +
+	"\vallocatable\x18\x02 \x03(\v2C.kubefleet.propertyprovider.v1alpha1.ResourceUsage.AllocatableEntryR\vallocatable\x12_\n" +
+	"\tavailable\x18\x03 \x03(\v2A.kubefleet.propertyprovider.v1alpha1.ResourceUsage.AvailableEntryR\tavailable\x12E\n" +
+	"\x10observation_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fobservationTime\x1a;\n" +
+	"\rCapacityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AllocatableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eAvailableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
+	"\tCondition\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage2\xf8\x01\n" +
+	"\x10PropertyProvider\x12n\n" +
+	"\x05Start\x121.kubefleet.propertyprovider.v1alpha1.StartRequest\x1a2.kubefleet.propertyprovider.v1alpha1.StartResponse\x12t\n" +
+	"\aCollect\x123.kubefleet.propertyprovider.v1alpha1.CollectRequest\x1a4.kubefleet.propertyprovider.v1alpha1.CollectResponseBXZVgithub.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/api/v1alpha1;v1alpha1b\x06proto3"
+
+var (
+	file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescOnce sync.Once
+	file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescData []byte
+)
+
+func file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescGZIP() []byte {
+	file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescOnce.Do(func() {
+		file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDesc), len(file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDesc)))
+	})
+	return file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDescData
+}
+
+var file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_goTypes = []any{
+	(*StartRequest)(nil),          // 0: kubefleet.propertyprovider.v1alpha1.StartRequest
+	(*StartResponse)(nil),         // 1: kubefleet.propertyprovider.v1alpha1.StartResponse
+	(*CollectRequest)(nil),        // 2: kubefleet.propertyprovider.v1alpha1.CollectRequest
+	(*CollectResponse)(nil),       // 3: kubefleet.propertyprovider.v1alpha1.CollectResponse
+	(*PropertyValue)(nil),         // 4: kubefleet.propertyprovider.v1alpha1.PropertyValue
+	(*ResourceUsage)(nil),         // 5: kubefleet.propertyprovider.v1alpha1.ResourceUsage
+	(*Condition)(nil),             // 6: kubefleet.propertyprovider.v1alpha1.Condition
+	nil,                           // 7: kubefleet.propertyprovider.v1alpha1.CollectResponse.PropertiesEntry
+	nil,                           // 8: kubefleet.propertyprovider.v1alpha1.CollectResponse.NamespacesEntry
+	nil,                           // 9: kubefleet.propertyprovider.v1alpha1.ResourceUsage.CapacityEntry
+	nil,                           // 10: kubefleet.propertyprovider.v1alpha1.ResourceUsage.AllocatableEntry
+	nil,                           // 11: kubefleet.propertyprovider.v1alpha1.ResourceUsage.AvailableEntry
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_depIdxs = []int32{
+	7,  // 0: kubefleet.propertyprovider.v1alpha1.CollectResponse.properties:type_name -> kubefleet.propertyprovider.v1alpha1.CollectResponse.PropertiesEntry
+	5,  // 1: kubefleet.propertyprovider.v1alpha1.CollectResponse.resources:type_name -> kubefleet.propertyprovider.v1alpha1.ResourceUsage
+	8,  // 2: kubefleet.propertyprovider.v1alpha1.CollectResponse.namespaces:type_name -> kubefleet.propertyprovider.v1alpha1.CollectResponse.NamespacesEntry
+	6,  // 3: kubefleet.propertyprovider.v1alpha1.CollectResponse.conditions:type_name -> kubefleet.propertyprovider.v1alpha1.Condition
+	12, // 4: kubefleet.propertyprovider.v1alpha1.PropertyValue.observation_time:type_name -> google.protobuf.Timestamp
+	9,  // 5: kubefleet.propertyprovider.v1alpha1.ResourceUsage.capacity:type_name -> kubefleet.propertyprovider.v1alpha1.ResourceUsage.CapacityEntry
+	10, // 6: kubefleet.propertyprovider.v1alpha1.ResourceUsage.allocatable:type_name -> kubefleet.propertyprovider.v1alpha1.ResourceUsage.AllocatableEntry
+	11, // 7: kubefleet.propertyprovider.v1alpha1.ResourceUsage.available:type_name -> kubefleet.propertyprovider.v1alpha1.ResourceUsage.AvailableEntry
+	12, // 8: kubefleet.propertyprovider.v1alpha1.ResourceUsage.observation_time:type_name -> google.protobuf.Timestamp
+	4,  // 9: kubefleet.propertyprovider.v1alpha1.CollectResponse.PropertiesEntry.value:type_name -> kubefleet.propertyprovider.v1alpha1.PropertyValue
+	0,  // 10: kubefleet.propertyprovider.v1alpha1.PropertyProvider.Start:input_type -> kubefleet.propertyprovider.v1alpha1.StartRequest
+	2,  // 11: kubefleet.propertyprovider.v1alpha1.PropertyProvider.Collect:input_type -> kubefleet.propertyprovider.v1alpha1.CollectRequest
+	1,  // 12: kubefleet.propertyprovider.v1alpha1.PropertyProvider.Start:output_type -> kubefleet.propertyprovider.v1alpha1.StartResponse
+	3,  // 13: kubefleet.propertyprovider.v1alpha1.PropertyProvider.Collect:output_type -> kubefleet.propertyprovider.v1alpha1.CollectResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_init() }
+func file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_init() {
+	if File_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDesc), len(file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_goTypes,
+		DependencyIndexes: file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_depIdxs,
+		MessageInfos:      file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_msgTypes,
+	}.Build()
+	File_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto = out.File
+	file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_goTypes = nil
+	file_pkg_propertyprovider_external_api_v1alpha1_propertyprovider_proto_depIdxs = nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	externalv1alpha1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/api/v1alpha1"
+)
+
+// fromCollectResponse converts a Collect call response from an external property provider to
+// a property collection response.
+func fromCollectResponse(resp *externalv1alpha1.CollectResponse) (*propertyprovider.PropertyCollectionResponse, error) {
+	now := time.Now()
+	res := &propertyprovider.PropertyCollectionResponse{}
+
+	if len(resp.GetProperties()) > 0 {
+		res.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(resp.GetProperties()))
+		for name, val := range resp.GetProperties() {
+			if len(name) == 0 {
+				return nil, fmt.Errorf("a property has an empty name")
+			}
+			res.Properties[clusterv1beta1.PropertyName(name)] = clusterv1beta1.PropertyValue{
+				Value:           val.GetValue(),
+				ObservationTime: fromTimestamp(val.GetObservationTime(), now),
+			}
+		}
+	}
+
+	if resources := resp.GetResources(); resources != nil {
+		var err error
+		if res.Resources.Capacity, err = fromResourceList(resources.GetCapacity()); err != nil {
+			return nil, fmt.Errorf("failed to parse the capacity: %w", err)
+		}
+		if res.Resources.Allocatable, err = fromResourceList(resources.GetAllocatable()); err != nil {
+			return nil, fmt.Errorf("failed to parse the allocatable capacity: %w", err)
+		}
+		if res.Resources.Available, err = fromResourceList(resources.GetAvailable()); err != nil {
+			return nil, fmt.Errorf("failed to parse the available capacity: %w", err)
+		}
+		res.Resources.ObservationTime = fromTimestamp(resources.GetObservationTime(), now)
+	}
+
+	if len(resp.GetNamespaces()) > 0 {
+		res.Namespaces = make(map[string]string, len(resp.GetNamespaces()))
+		for ns, work := range resp.GetNamespaces() {
+			res.Namespaces[ns] = work
+		}
+	}
+
+	for _, cond := range resp.GetConditions() {
+		status := metav1.ConditionStatus(cond.GetStatus())
+		switch {
+		case len(cond.GetType()) == 0:
+			return nil, fmt.Errorf("a condition has an empty type")
+		case status != metav1.ConditionTrue && status != metav1.ConditionFalse && status != metav1.ConditionUnknown:
+			return nil, fmt.Errorf("condition %s has an invalid status %q", cond.GetType(), cond.GetStatus())
+		case len(cond.GetReason()) == 0:
+			return nil, fmt.Errorf("condition %s has an empty reason", cond.GetType())
+		}
+		res.Conditions = append(res.Conditions, metav1.Condition{
+			Type:    cond.GetType(),
+			Status:  status,
+			Reason:  cond.GetReason(),
+			Message: cond.GetMessage(),
+		})
+	}
+	return res, nil
+}
+
+// toCollectResponse converts a property collection response to a Collect call response.
+func toCollectResponse(res *propertyprovider.PropertyCollectionResponse) *externalv1alpha1.CollectResponse {
+	resp := &externalv1alpha1.CollectResponse{
+		Resources: &externalv1alpha1.ResourceUsage{
+			Capacity:    toResourceList(res.Resources.Capacity),
+			Allocatable: toResourceList(res.Resources.Allocatable),
+			Available:   toResourceList(res.Resources.Available),
+		},
+		Namespaces: res.Namespaces,
+	}
+	if !res.Resources.ObservationTime.IsZero() {
+		resp.Resources.ObservationTime = timestamppb.New(res.Resources.ObservationTime.Time)
+	}
+
+	if len(res.Properties) > 0 {
+		resp.Properties = make(map[string]*externalv1alpha1.PropertyValue, len(res.Properties))
+		for name, val := range res.Properties {
+			pv := &externalv1alpha1.PropertyValue{
+				Value: val.Value,
+			}
+			if !val.ObservationTime.IsZero() {
+				pv.ObservationTime = timestamppb.New(val.ObservationTime.Time)
+			}
+			resp.Properties[string(name)] = pv
+		}
+	}
+
+	for _, cond := range res.Conditions {
+		resp.Conditions = append(resp.Conditions, &externalv1alpha1.Condition{
+			Type:    cond.Type,
+			Status:  string(cond.Status),
+			Reason:  cond.Reason,
+			Message: cond.Message,
+		})
+	}
+	return resp
+}
+
+// fromResourceList parses resource quantities in their string forms.
+func fromResourceList(rl map[string]string) (corev1.ResourceList, error) {
+	if len(rl) == 0 {
+		return nil, nil
+	}
+	res := make(corev1.ResourceList, len(rl))
+	for rn, q := range rl {
+		parsed, err := resource.ParseQuantity(q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the quantity of resource %s: %w", rn, err)
+		}
+		res[corev1.ResourceName(rn)] = parsed
+	}
+	return res, nil
+}
+
+// toResourceList formats resource quantities in their string forms.
+func toResourceList(rl corev1.ResourceList) map[string]string {
+	if len(rl) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(rl))
+	for rn, q := range rl {
+		res[string(rn)] = q.String()
+	}
+	return res
+}
+
+// fromTimestamp converts a protobuf timestamp to a Kubernetes time; if the timestamp is
+// absent, the given default time is used instead.
+func fromTimestamp(ts *timestamppb.Timestamp, defaultTime time.Time) metav1.Time {
+	if ts == nil {
+		return metav1.NewTime(defaultTime)
+	}
+	return metav1.NewTime(ts.AsTime())
+}
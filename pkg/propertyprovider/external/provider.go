@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external features the external property provider for Fleet, which is an adapter that
+// forwards property provider calls over gRPC to a property provider running out of process
+// (typically as a sidecar of the Fleet member agent).
+//
+// This allows one to report custom cluster properties without forking the Fleet member agent;
+// the external property provider only needs to implement the PropertyProvider gRPC service, as
+// defined in the api/v1alpha1 package. Providers written in Go may simply wrap an implementation of
+// the propertyprovider.PropertyProvider interface with the NewServer function.
+package external
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	externalv1alpha1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/api/v1alpha1"
+)
+
+const (
+	// The condition related values in use by the external property provider.
+	PropertyCollectionSucceededCondType   = "ExternalPropertyCollectionSucceeded"
+	PropertyCollectionSucceededReason     = "PropertiesCollected"
+	PropertyCollectionFailedReason        = "PropertiesCollectionFailed"
+	PropertyCollectionSucceededMsg        = "All properties have been collected successfully from the external property provider"
+	PropertyCollectionFailedMsgTemplate   = "Failed to collect properties from the external property provider; the last collected properties (if any) are reported instead: %v"
+	propertyProviderNotStartedErrTemplate = "the external property provider at %s has not been started yet"
+)
+
+var (
+	// startCallTimeout is the timeout for the Start call to the external property provider.
+	startCallTimeout = 10 * time.Second
+)
+
+// PropertyProvider is the external property provider for Fleet.
+type PropertyProvider struct {
+	// The endpoint at which the external property provider serves, in the gRPC name syntax
+	// (e.g., localhost:50051 or unix:///var/run/kubefleet/property-provider.sock).
+	endpoint string
+	// The dial options in use when connecting to the external property provider; this field is
+	// mostly reserved for testing purposes.
+	dialOpts []grpc.DialOption
+
+	// The gRPC client of the external property provider; set when the provider is started.
+	client externalv1alpha1.PropertyProviderClient
+
+	// mu protects the client and the last successfully collected response.
+	mu sync.Mutex
+	// lastCollected is the last response that has been successfully collected from the external
+	// property provider.
+	lastCollected *externalv1alpha1.CollectResponse
+}
+
+// Verify that the external property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the external property provider.
+//
+// It connects to the external property provider and asks it to start. Note that the external
+// property provider is expected to access the member cluster with its own credentials; the given
+// REST config is not passed on.
+func (p *PropertyProvider) Start(ctx context.Context, _ *rest.Config) error {
+	klog.V(2).InfoS("Starting external property provider", "endpoint", p.endpoint)
+
+	// Note that the connection is established lazily, i.e., no actual connection is made
+	// until the first call.
+	//
+	// The connection is not secured, as the external property provider is expected to serve
+	// within the same pod as the Fleet member agent.
+	dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, p.dialOpts...)
+	conn, err := grpc.NewClient(p.endpoint, dialOpts...)
+	if err != nil {
+		klog.ErrorS(err, "Failed to set up a connection to the external property provider", "endpoint", p.endpoint)
+		return err
+	}
+	client := externalv1alpha1.NewPropertyProviderClient(conn)
+
+	startCtx, cancel := context.WithTimeout(ctx, startCallTimeout)
+	defer cancel()
+	if _, err := client.Start(startCtx, &externalv1alpha1.StartRequest{}); err != nil {
+		klog.ErrorS(err, "Failed to start the external property provider", "endpoint", p.endpoint)
+		_ = conn.Close()
+		return err
+	}
+
+	p.mu.Lock()
+	p.client = client
+	p.mu.Unlock()
+
+	// Close the connection when the Fleet member agent exits.
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			klog.ErrorS(err, "Failed to close the connection to the external property provider", "endpoint", p.endpoint)
+		}
+	}()
+	return nil
+}
+
+// Collect collects the properties of a cluster from the external property provider.
+//
+// If the collection fails, the last successfully collected properties (if any) are reported
+// instead, along with a condition that explains the failure.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	p.mu.Lock()
+	client := p.client
+	p.mu.Unlock()
+
+	if client == nil {
+		return p.collectionFailedResponse(fmt.Errorf(propertyProviderNotStartedErrTemplate, p.endpoint))
+	}
+
+	resp, err := client.Collect(ctx, &externalv1alpha1.CollectRequest{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect properties from the external property provider", "endpoint", p.endpoint)
+		return p.collectionFailedResponse(err)
+	}
+	res, err := fromCollectResponse(resp)
+	if err != nil {
+		klog.ErrorS(err, "The external property provider has returned an invalid response", "endpoint", p.endpoint)
+		return p.collectionFailedResponse(err)
+	}
+
+	p.mu.Lock()
+	p.lastCollected = resp
+	p.mu.Unlock()
+
+	res.Conditions = append(res.Conditions, metav1.Condition{
+		Type:    PropertyCollectionSucceededCondType,
+		Status:  metav1.ConditionTrue,
+		Reason:  PropertyCollectionSucceededReason,
+		Message: PropertyCollectionSucceededMsg,
+	})
+	return *res
+}
+
+// collectionFailedResponse builds a property collection response when the collection fails.
+func (p *PropertyProvider) collectionFailedResponse(err error) propertyprovider.PropertyCollectionResponse {
+	p.mu.Lock()
+	lastCollected := p.lastCollected
+	p.mu.Unlock()
+
+	res := propertyprovider.PropertyCollectionResponse{}
+	if lastCollected != nil {
+		// The last collected response has been converted successfully before; the conversion
+		// always yields a new copy.
+		if converted, convErr := fromCollectResponse(lastCollected); convErr == nil {
+			res = *converted
+		}
+	}
+
+	res.Conditions = append(res.Conditions, metav1.Condition{
+		Type:    PropertyCollectionSucceededCondType,
+		Status:  metav1.ConditionFalse,
+		Reason:  PropertyCollectionFailedReason,
+		Message: fmt.Sprintf(PropertyCollectionFailedMsgTemplate, err),
+	})
+	return res
+}
+
+// New returns a new external property provider that connects to the given endpoint.
+func New(endpoint string) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		endpoint: endpoint,
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	externalv1alpha1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/api/v1alpha1"
+)
+
+const (
+	infinibandProperty = "example.com/infiniband"
+	namespaceName      = "work"
+	workName           = "work-1"
+)
+
+var (
+	observationTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+)
+
+// fakePropertyProvider is a property provider that returns a pre-set response.
+type fakePropertyProvider struct {
+	mu       sync.Mutex
+	startErr error
+	res      propertyprovider.PropertyCollectionResponse
+}
+
+var _ propertyprovider.PropertyProvider = &fakePropertyProvider{}
+
+func (f *fakePropertyProvider) Start(_ context.Context, _ *rest.Config) error {
+	return f.startErr
+}
+
+func (f *fakePropertyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.res
+}
+
+func (f *fakePropertyProvider) setResponse(res propertyprovider.PropertyCollectionResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.res = res
+}
+
+// serveOverBufconn serves a property provider over an in-memory connection and returns an external
+// property provider that connects to it.
+func serveOverBufconn(t *testing.T, pp propertyprovider.PropertyProvider) *PropertyProvider {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	externalv1alpha1.RegisterPropertyProviderServer(s, NewServer(context.Background(), pp, &rest.Config{}))
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	return &PropertyProvider{
+		endpoint: "passthrough:///bufnet",
+		dialOpts: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+		},
+	}
+}
+
+func collectedResponse() propertyprovider.PropertyCollectionResponse {
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+			propertyprovider.NodeCountProperty: {
+				Value:           "3",
+				ObservationTime: observationTime,
+			},
+			infinibandProperty: {
+				Value:           "true",
+				ObservationTime: observationTime,
+			},
+		},
+		Resources: clusterv1beta1.ResourceUsage{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("12"),
+				corev1.ResourceMemory: resource.MustParse("48Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("11500m"),
+				corev1.ResourceMemory: resource.MustParse("40Gi"),
+			},
+			ObservationTime: observationTime,
+		},
+		Namespaces: map[string]string{
+			namespaceName: workName,
+		},
+		Conditions: []metav1.Condition{
+			{
+				Type:    "InfinibandDetected",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Detected",
+				Message: "Infiniband devices have been detected",
+			},
+		},
+	}
+}
+
+func TestStartAndCollect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakePP := &fakePropertyProvider{res: collectedResponse()}
+	p := serveOverBufconn(t, fakePP)
+
+	// Collect before the provider starts.
+	res := p.Collect(ctx)
+	want := propertyprovider.PropertyCollectionResponse{
+		Conditions: []metav1.Condition{
+			{
+				Type:    PropertyCollectionSucceededCondType,
+				Status:  metav1.ConditionFalse,
+				Reason:  PropertyCollectionFailedReason,
+				Message: fmt.Sprintf(PropertyCollectionFailedMsgTemplate, fmt.Errorf(propertyProviderNotStartedErrTemplate, p.endpoint)),
+			},
+		},
+	}
+	if diff := cmp.Diff(res, want); diff != "" {
+		t.Fatalf("Collect() before Start() diff (-got, +want):\n%s", diff)
+	}
+
+	if err := p.Start(ctx, &rest.Config{}); err != nil {
+		t.Fatalf("Start() = %v, want no error", err)
+	}
+
+	// Collect after the provider starts.
+	res = p.Collect(ctx)
+	want = collectedResponse()
+	want.Conditions = append(want.Conditions, metav1.Condition{
+		Type:    PropertyCollectionSucceededCondType,
+		Status:  metav1.ConditionTrue,
+		Reason:  PropertyCollectionSucceededReason,
+		Message: PropertyCollectionSucceededMsg,
+	})
+	if diff := cmp.Diff(res, want); diff != "" {
+		t.Fatalf("Collect() after Start() diff (-got, +want):\n%s", diff)
+	}
+
+	// Collect when the external property provider returns an invalid response; the last
+	// collected properties should be reported instead.
+	invalidRes := collectedResponse()
+	invalidRes.Conditions[0].Status = "Maybe"
+	fakePP.setResponse(invalidRes)
+	res = p.Collect(ctx)
+	want = collectedResponse()
+	want.Conditions = append(want.Conditions, metav1.Condition{
+		Type:   PropertyCollectionSucceededCondType,
+		Status: metav1.ConditionFalse,
+		Reason: PropertyCollectionFailedReason,
+	})
+	// The message of the failure condition includes error details; check only the gist of it.
+	lastCond := &res.Conditions[len(res.Conditions)-1]
+	if !strings.Contains(lastCond.Message, "invalid status") {
+		t.Errorf("Collect() with an invalid response condition message = %q, want one that explains the invalid status", lastCond.Message)
+	}
+	lastCond.Message = ""
+	if diff := cmp.Diff(res, want); diff != "" {
+		t.Fatalf("Collect() with an invalid response diff (-got, +want):\n%s", diff)
+	}
+}
+
+func TestStartFailed(t *testing.T) {
+	p := serveOverBufconn(t, &fakePropertyProvider{startErr: fmt.Errorf("no credentials")})
+	if err := p.Start(context.Background(), &rest.Config{}); err == nil {
+		t.Fatalf("Start() = nil, want error")
+	}
+	if p.client != nil {
+		t.Errorf("Start() set up a client, want no client")
+	}
+}
+
+func TestFromCollectResponse(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name      string
+		resp      *externalv1alpha1.CollectResponse
+		want      *propertyprovider.PropertyCollectionResponse
+		wantErred bool
+	}{
+		{
+			name: "empty response",
+			resp: &externalv1alpha1.CollectResponse{},
+			want: &propertyprovider.PropertyCollectionResponse{},
+		},
+		{
+			name: "absent observation times",
+			resp: &externalv1alpha1.CollectResponse{
+				Properties: map[string]*externalv1alpha1.PropertyValue{
+					infinibandProperty: {Value: "true"},
+				},
+				Resources: &externalv1alpha1.ResourceUsage{
+					Available: map[string]string{
+						string(corev1.ResourceCPU): "1",
+					},
+				},
+			},
+			want: &propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					infinibandProperty: {Value: "true", ObservationTime: metav1.NewTime(now)},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1"),
+					},
+					ObservationTime: metav1.NewTime(now),
+				},
+			},
+		},
+		{
+			name: "invalid resource quantity",
+			resp: &externalv1alpha1.CollectResponse{
+				Resources: &externalv1alpha1.ResourceUsage{
+					Capacity: map[string]string{
+						string(corev1.ResourceCPU): "lots",
+					},
+				},
+			},
+			wantErred: true,
+		},
+		{
+			name: "empty property name",
+			resp: &externalv1alpha1.CollectResponse{
+				Properties: map[string]*externalv1alpha1.PropertyValue{
+					"": {Value: "true"},
+				},
+			},
+			wantErred: true,
+		},
+		{
+			name: "condition without a reason",
+			resp: &externalv1alpha1.CollectResponse{
+				Conditions: []*externalv1alpha1.Condition{
+					{Type: "InfinibandDetected", Status: string(metav1.ConditionTrue)},
+				},
+			},
+			wantErred: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fromCollectResponse(tc.resp)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("fromCollectResponse() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fromCollectResponse() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+				t.Errorf("fromCollectResponse() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
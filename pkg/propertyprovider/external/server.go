@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	externalv1alpha1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/api/v1alpha1"
+)
+
+// server serves a property provider over gRPC.
+type server struct {
+	externalv1alpha1.UnimplementedPropertyProviderServer
+
+	// ctx is the context with which the property provider is started; it should be cancelled
+	// only when the server exits.
+	ctx    context.Context
+	config *rest.Config
+	pp     propertyprovider.PropertyProvider
+
+	startOnce sync.Once
+	startErr  error
+	started   atomic.Bool
+}
+
+// Verify that the server implements the PropertyProviderServer interface at compile time.
+var _ externalv1alpha1.PropertyProviderServer = &server{}
+
+// NewServer returns a gRPC server implementation that serves the given property provider, so that
+// a property provider written in Go can run as an external property provider with ease, e.g.,
+//
+//	s := grpc.NewServer()
+//	v1alpha1.RegisterPropertyProviderServer(s, external.NewServer(ctx, pp, config))
+//
+// The given context is passed on to the property provider when it starts and should be cancelled
+// only when the server exits; the given REST config is what the property provider uses to access the
+// member cluster.
+//
+// The property provider is started only once, i.e., subsequent Start calls (e.g., from a new
+// leader of the Fleet member agent) report the result of the first start attempt.
+func NewServer(ctx context.Context, pp propertyprovider.PropertyProvider, config *rest.Config) externalv1alpha1.PropertyProviderServer {
+	return &server{
+		ctx:    ctx,
+		config: config,
+		pp:     pp,
+	}
+}
+
+// Start starts the property provider.
+func (s *server) Start(_ context.Context, _ *externalv1alpha1.StartRequest) (*externalv1alpha1.StartResponse, error) {
+	s.startOnce.Do(func() {
+		s.startErr = s.pp.Start(s.ctx, s.config)
+		s.started.Store(s.startErr == nil)
+	})
+	if s.startErr != nil {
+		klog.ErrorS(s.startErr, "Failed to start the property provider")
+		return nil, status.Errorf(codes.Internal, "failed to start the property provider: %v", s.startErr)
+	}
+	return &externalv1alpha1.StartResponse{}, nil
+}
+
+// Collect collects properties from the property provider.
+func (s *server) Collect(ctx context.Context, _ *externalv1alpha1.CollectRequest) (*externalv1alpha1.CollectResponse, error) {
+	if !s.started.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "the property provider has not been started yet")
+	}
+	res := s.pp.Collect(ctx)
+	return toCollectResponse(&res), nil
+}
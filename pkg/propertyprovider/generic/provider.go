@@ -0,0 +1,382 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package generic features the generic property provider for Fleet, which derives cluster
+// properties from node labels and node resources only, and as a result works with
+// Kubernetes clusters hosted on any cloud or on premises.
+package generic
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	defaultcontrollers "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/controllers"
+	defaulttrackers "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// A list of properties that the generic property provider collects in addition to the
+	// Fleet required ones.
+
+	// NodeCountPerInstanceTypePropertyTmpl is a property template that describes the count of
+	// nodes of a specific instance type (as reported by the well-known instance type node label)
+	// in a Kubernetes cluster.
+	NodeCountPerInstanceTypePropertyTmpl = "kubernetes-fleet.io/instance-types/%s/count"
+	// NodeCountPerArchitecturePropertyTmpl is a property template that describes the count of
+	// nodes of a specific CPU architecture in a Kubernetes cluster.
+	NodeCountPerArchitecturePropertyTmpl = "kubernetes-fleet.io/architectures/%s/count"
+
+	// TotalNvidiaGPUCountProperty is a property that describes the total number of NVIDIA GPUs
+	// in a Kubernetes cluster.
+	TotalNvidiaGPUCountProperty = "kubernetes-fleet.io/total-nvidia-gpus"
+	// AllocatableNvidiaGPUCountProperty is a property that describes the number of NVIDIA GPUs
+	// in a Kubernetes cluster that are allocatable to workloads.
+	AllocatableNvidiaGPUCountProperty = "kubernetes-fleet.io/allocatable-nvidia-gpus"
+	// AvailableNvidiaGPUCountProperty is a property that describes the number of NVIDIA GPUs
+	// in a Kubernetes cluster that have not been requested by any workload yet.
+	AvailableNvidiaGPUCountProperty = "kubernetes-fleet.io/available-nvidia-gpus"
+
+	// MaxNodeAllocatableMemoryProperty is a property that describes the largest amount of
+	// allocatable memory on a single node in a Kubernetes cluster; it helps tell whether a
+	// workload with large memory requests can fit in the cluster at all.
+	MaxNodeAllocatableMemoryProperty = "kubernetes-fleet.io/max-node-allocatable-memory"
+)
+
+var (
+	// k8sVersionCacheTTL is the TTL for the cached Kubernetes version.
+	k8sVersionCacheTTL = 15 * time.Minute
+)
+
+// PropertyProvider is the generic property provider for Fleet.
+type PropertyProvider struct {
+	// The trackers.
+	podTracker       *defaulttrackers.PodTracker
+	nodeTracker      *defaulttrackers.NodeTracker
+	namespaceTracker *defaulttrackers.NamespaceTracker
+
+	// The discovery client to get k8s cluster version.
+	discoveryClient discovery.ServerVersionInterface
+
+	// The feature flags.
+	isAvailableResourcesCollectionEnabled bool
+	isNamespaceCollectionEnabled          bool
+
+	// The controller manager in use by the generic property provider; this field is mostly reserved for
+	// testing purposes.
+	mgr ctrl.Manager
+	// The names in use by the controllers managed by the property provider; these fields are exposed
+	// to avoid name conflicts, though at this moment are mostly reserved for testing purposes.
+	nodeControllerName      string
+	podControllerName       string
+	namespaceControllerName string
+
+	// Cache for Kubernetes version information with TTL.
+	k8sVersionMutex              sync.Mutex
+	cachedK8sVersion             string
+	cachedK8sVersionObservedTime time.Time
+}
+
+// Verify that the generic property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the generic property provider.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).Info("Starting generic property provider")
+
+	mgr, err := defaultcontrollers.NewManager(config)
+	if err != nil {
+		klog.ErrorS(err, "Failed to start generic property provider")
+		return err
+	}
+	p.mgr = mgr
+
+	if p.nodeTracker == nil {
+		klog.V(2).Info("Building a node tracker")
+		p.nodeTracker = defaulttrackers.NewNodeTracker()
+	}
+
+	p.discoveryClient = discovery.NewDiscoveryClientForConfigOrDie(config)
+	// Fetch the k8s version from the discovery client.
+	klog.V(2).Info("Fetching Kubernetes version from discovery client")
+	serverVersion, err := p.discoveryClient.ServerVersion()
+	if err != nil {
+		klog.ErrorS(err, "Failed to get Kubernetes server version from discovery client")
+		return err
+	}
+	p.cachedK8sVersion = serverVersion.GitVersion
+	p.cachedK8sVersionObservedTime = time.Now()
+
+	// Set up the node reconciler.
+	klog.V(2).Info("Setting up the node reconciler")
+	nodeReconciler := &defaultcontrollers.NodeReconciler{
+		NT:     p.nodeTracker,
+		Client: mgr.GetClient(),
+	}
+	if err := nodeReconciler.SetupWithManager(mgr, p.nodeControllerName); err != nil {
+		klog.ErrorS(err, "Failed to start the node reconciler in the generic property provider")
+		return err
+	}
+
+	switch {
+	case p.podTracker != nil:
+		// A pod tracker has been explicitly set; use it.
+		klog.V(2).Info("A pod tracker has been explicitly set")
+	case !p.isAvailableResourcesCollectionEnabled:
+		// Available resource collection is disabled; there is no need to watch for pods.
+		klog.V(2).Info("Skipping pod tracker setup as available resources collection is disabled")
+	default:
+		klog.V(2).Info("Building a pod tracker")
+		p.podTracker = defaulttrackers.NewPodTracker()
+
+		klog.V(2).Info("Starting the pod reconciler")
+		podReconciler := &defaultcontrollers.PodReconciler{
+			PT:     p.podTracker,
+			Client: mgr.GetClient(),
+		}
+		if err := podReconciler.SetupWithManager(mgr, p.podControllerName); err != nil {
+			klog.ErrorS(err, "Failed to start the pod reconciler in the generic property provider")
+			return err
+		}
+	}
+
+	if p.isNamespaceCollectionEnabled {
+		if p.namespaceTracker == nil {
+			p.namespaceTracker = defaulttrackers.NewNamespaceTracker(mgr.GetClient())
+		}
+
+		// Set up the namespace reconciler.
+		klog.V(2).Info("Setting up the namespace reconciler")
+		namespaceReconciler := &defaultcontrollers.NamespaceReconciler{
+			NamespaceTracker: p.namespaceTracker,
+			Client:           mgr.GetClient(),
+		}
+		if err := namespaceReconciler.SetupWithManager(mgr, p.namespaceControllerName); err != nil {
+			klog.ErrorS(err, "Failed to start the namespace reconciler in the generic property provider")
+			return err
+		}
+	}
+
+	// Start the controller manager in a separate goroutine to avoid blocking the member agent.
+	go func() {
+		// This call will block until the context exits.
+		if err := mgr.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start the generic property provider controller manager")
+		}
+	}()
+
+	// Wait for the cache to sync; see the Azure property provider for the trade-offs made here.
+	mgr.GetCache().WaitForCacheSync(ctx)
+
+	return nil
+}
+
+// Collect collects the properties of a Kubernetes cluster.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	conds := make([]metav1.Condition, 0, 1)
+
+	// Collect the non-resource properties.
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+
+	// Collect node-count related properties.
+	p.collectNodeCountRelatedProperties(ctx, properties)
+
+	// Collect the Kubernetes version.
+	p.collectK8sVersion(ctx, properties)
+
+	// Collect the resource properties.
+	//
+	// Note that the capacity and allocatable totals of GPUs and the largest allocatable memory on a
+	// single node are reported as non-resource properties, as the resource usage fields only
+	// cover the resources that the member cluster status supports.
+	totalCapacity := p.nodeTracker.TotalCapacity()
+	totalAllocatable := p.nodeTracker.TotalAllocatable()
+	resources := clusterv1beta1.ResourceUsage{
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:    totalCapacity[corev1.ResourceCPU],
+			corev1.ResourceMemory: totalCapacity[corev1.ResourceMemory],
+		},
+		Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    totalAllocatable[corev1.ResourceCPU],
+			corev1.ResourceMemory: totalAllocatable[corev1.ResourceMemory],
+		},
+	}
+
+	now := metav1.Now()
+	totalGPUs := totalCapacity[defaulttrackers.ResourceNvidiaGPU]
+	properties[TotalNvidiaGPUCountProperty] = clusterv1beta1.PropertyValue{
+		Value:           totalGPUs.String(),
+		ObservationTime: now,
+	}
+	allocatableGPUs := totalAllocatable[defaulttrackers.ResourceNvidiaGPU]
+	properties[AllocatableNvidiaGPUCountProperty] = clusterv1beta1.PropertyValue{
+		Value:           allocatableGPUs.String(),
+		ObservationTime: now,
+	}
+	maxNodeAllocatableMemory := p.nodeTracker.MaxAllocatableFor(corev1.ResourceMemory)
+	properties[MaxNodeAllocatableMemoryProperty] = clusterv1beta1.PropertyValue{
+		Value:           maxNodeAllocatableMemory.String(),
+		ObservationTime: now,
+	}
+
+	// Collect the available resource properties (if enabled).
+	if p.isAvailableResourcesCollectionEnabled {
+		available := p.collectAvailableResource(ctx, totalAllocatable)
+		resources.Available = corev1.ResourceList{
+			corev1.ResourceCPU:    available[corev1.ResourceCPU],
+			corev1.ResourceMemory: available[corev1.ResourceMemory],
+		}
+		availableGPUs := available[defaulttrackers.ResourceNvidiaGPU]
+		properties[AvailableNvidiaGPUCountProperty] = clusterv1beta1.PropertyValue{
+			Value:           availableGPUs.String(),
+			ObservationTime: now,
+		}
+	}
+
+	var ns map[string]string
+	var nsConds []metav1.Condition
+	if p.isNamespaceCollectionEnabled {
+		ns, nsConds = p.collectNamespaces()
+		conds = append(conds, nsConds...)
+	}
+
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Resources:  resources,
+		Namespaces: ns,
+		Conditions: conds,
+	}
+}
+
+// collectNodeCountRelatedProperties collects the node-count related properties.
+func (p *PropertyProvider) collectNodeCountRelatedProperties(_ context.Context, properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue) {
+	now := metav1.Now()
+
+	// Collect the total node count as a property.
+	properties[propertyprovider.NodeCountProperty] = clusterv1beta1.PropertyValue{
+		Value:           fmt.Sprintf("%d", p.nodeTracker.NodeCount()),
+		ObservationTime: now,
+	}
+
+	// Collect the per-instance-type node counts as properties.
+	for instanceType, count := range p.nodeTracker.NodeCountPerInstanceType() {
+		pName := fmt.Sprintf(NodeCountPerInstanceTypePropertyTmpl, instanceType)
+		properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+			Value:           fmt.Sprintf("%d", count),
+			ObservationTime: now,
+		}
+	}
+
+	// Collect the per-architecture node counts as properties.
+	for arch, count := range p.nodeTracker.NodeCountPerArchitecture() {
+		pName := fmt.Sprintf(NodeCountPerArchitecturePropertyTmpl, arch)
+		properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+			Value:           fmt.Sprintf("%d", count),
+			ObservationTime: now,
+		}
+	}
+}
+
+// collectAvailableResource collects the available resource information.
+func (p *PropertyProvider) collectAvailableResource(_ context.Context, allocatable corev1.ResourceList) corev1.ResourceList {
+	available := make(corev1.ResourceList)
+	if p.podTracker == nil {
+		// No pod tracker has been set; but the property provider has been configured to collect
+		// available resource information. Normally this should never occur.
+		klog.Error(controller.NewUnexpectedBehaviorError(fmt.Errorf("no pod tracker has been set, but the property provider has been configured to collect available resource information")))
+		return available
+	}
+
+	requested := p.podTracker.TotalRequested()
+	for rn := range allocatable {
+		left := allocatable[rn].DeepCopy()
+		// Due to unavoidable inconsistencies in the data collection process, the total value of
+		// a requested resource might exceed that of the allocatable resource; report a zero value
+		// in such cases, which should get fixed in the next (few) property collection iterations.
+		if left.Cmp(requested[rn]) > 0 {
+			left.Sub(requested[rn])
+		} else {
+			left = resource.Quantity{}
+		}
+		available[rn] = left
+	}
+	return available
+}
+
+func (p *PropertyProvider) collectNamespaces() (map[string]string, []metav1.Condition) {
+	if p.namespaceTracker == nil {
+		klog.Error(controller.NewUnexpectedBehaviorError(fmt.Errorf("no namespaceTracker is set")))
+		return nil, nil
+	}
+	result, reachLimit := p.namespaceTracker.ListNamespaces()
+	return result, propertyprovider.BuildNamespaceCollectionConditions(reachLimit)
+}
+
+// collectK8sVersion collects the Kubernetes server version information.
+// It uses a cache with a 15-minute TTL to minimize API calls to the discovery client.
+func (p *PropertyProvider) collectK8sVersion(_ context.Context, properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue) {
+	now := time.Now()
+
+	p.k8sVersionMutex.Lock()
+	defer p.k8sVersionMutex.Unlock()
+	if p.cachedK8sVersion != "" && now.Sub(p.cachedK8sVersionObservedTime) < k8sVersionCacheTTL {
+		// Cache is still valid, use the cached version.
+		properties[propertyprovider.K8sVersionProperty] = clusterv1beta1.PropertyValue{
+			Value:           p.cachedK8sVersion,
+			ObservationTime: metav1.NewTime(p.cachedK8sVersionObservedTime),
+		}
+		return
+	}
+
+	// Cache is expired or empty, fetch the version from the discovery client.
+	serverVersion, err := p.discoveryClient.ServerVersion()
+	if err != nil {
+		klog.ErrorS(err, "Failed to get Kubernetes server version from discovery client")
+		return
+	}
+
+	p.cachedK8sVersion = serverVersion.GitVersion
+	p.cachedK8sVersionObservedTime = now
+	properties[propertyprovider.K8sVersionProperty] = clusterv1beta1.PropertyValue{
+		Value:           p.cachedK8sVersion,
+		ObservationTime: metav1.NewTime(now),
+	}
+	klog.V(2).InfoS("Collected Kubernetes version", "version", p.cachedK8sVersion)
+}
+
+// New returns a new generic property provider.
+func New(isAvailableResourcesCollectionEnabled, isNamespaceCollectionEnabled bool) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		// Use the default names.
+		nodeControllerName:                    "generic-property-provider-node-watcher",
+		podControllerName:                     "generic-property-provider-pod-watcher",
+		namespaceControllerName:               "generic-property-provider-namespace-watcher",
+		isAvailableResourcesCollectionEnabled: isAvailableResourcesCollectionEnabled,
+		isNamespaceCollectionEnabled:          isNamespaceCollectionEnabled,
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+const (
+	nodeName1 = "node-1"
+	nodeName2 = "node-2"
+
+	podName1 = "pod-1"
+	podName2 = "pod-2"
+
+	namespaceName1 = "work-1"
+
+	instanceType1 = "m5.xlarge"
+	instanceType2 = "p3.2xlarge"
+
+	k8sVersion = "v1.35.5"
+)
+
+var (
+	ignoreObservationTimeFieldInPropertyValue = cmpopts.IgnoreFields(clusterv1beta1.PropertyValue{}, "ObservationTime")
+)
+
+func TestCollect(t *testing.T) {
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName1,
+				Labels: map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType1,
+					corev1.LabelArchStable:         "amd64",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("3"),
+					corev1.ResourceMemory: resource.MustParse("12Gi"),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName2,
+				Labels: map[string]string{
+					corev1.LabelInstanceTypeStable: instanceType2,
+					corev1.LabelArchStable:         "arm64",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:         resource.MustParse("8"),
+					corev1.ResourceMemory:      resource.MustParse("64Gi"),
+					trackers.ResourceNvidiaGPU: resource.MustParse("4"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:         resource.MustParse("7"),
+					corev1.ResourceMemory:      resource.MustParse("60Gi"),
+					trackers.ResourceNvidiaGPU: resource.MustParse("4"),
+				},
+			},
+		},
+	}
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podName1,
+				Namespace: namespaceName1,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName1,
+				Containers: []corev1.Container{
+					{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1"),
+								corev1.ResourceMemory: resource.MustParse("2Gi"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podName2,
+				Namespace: namespaceName1,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName2,
+				Containers: []corev1.Container{
+					{
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:         resource.MustParse("2"),
+								corev1.ResourceMemory:      resource.MustParse("8Gi"),
+								trackers.ResourceNvidiaGPU: resource.MustParse("3"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	commonProperties := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		propertyprovider.NodeCountProperty: {
+			Value: "2",
+		},
+		propertyprovider.K8sVersionProperty: {
+			Value: k8sVersion,
+		},
+		clusterv1beta1.PropertyName(fmt.Sprintf(NodeCountPerInstanceTypePropertyTmpl, instanceType1)): {
+			Value: "1",
+		},
+		clusterv1beta1.PropertyName(fmt.Sprintf(NodeCountPerInstanceTypePropertyTmpl, instanceType2)): {
+			Value: "1",
+		},
+		clusterv1beta1.PropertyName(fmt.Sprintf(NodeCountPerArchitecturePropertyTmpl, "amd64")): {
+			Value: "1",
+		},
+		clusterv1beta1.PropertyName(fmt.Sprintf(NodeCountPerArchitecturePropertyTmpl, "arm64")): {
+			Value: "1",
+		},
+		TotalNvidiaGPUCountProperty: {
+			Value: "4",
+		},
+		AllocatableNvidiaGPUCountProperty: {
+			Value: "4",
+		},
+		MaxNodeAllocatableMemoryProperty: {
+			Value: "60Gi",
+		},
+	}
+	withAvailableGPUs := func(properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, available string) map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue {
+		res := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(properties)+1)
+		for k, v := range properties {
+			res[k] = v
+		}
+		res[AvailableNvidiaGPUCountProperty] = clusterv1beta1.PropertyValue{Value: available}
+		return res
+	}
+	wantCapacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("12"),
+		corev1.ResourceMemory: resource.MustParse("80Gi"),
+	}
+	wantAllocatable := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10"),
+		corev1.ResourceMemory: resource.MustParse("72Gi"),
+	}
+
+	testCases := []struct {
+		name                                  string
+		isAvailableResourcesCollectionEnabled bool
+		wantPropertyCollectionResponse        propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name:                                  "available resources collection enabled",
+			isAvailableResourcesCollectionEnabled: true,
+			wantPropertyCollectionResponse: propertyprovider.PropertyCollectionResponse{
+				Properties: withAvailableGPUs(commonProperties, "1"),
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity:    wantCapacity,
+					Allocatable: wantAllocatable,
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("7"),
+						corev1.ResourceMemory: resource.MustParse("62Gi"),
+					},
+				},
+				Conditions: []metav1.Condition{},
+			},
+		},
+		{
+			name: "available resources collection disabled",
+			wantPropertyCollectionResponse: propertyprovider.PropertyCollectionResponse{
+				Properties: commonProperties,
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity:    wantCapacity,
+					Allocatable: wantAllocatable,
+				},
+				Conditions: []metav1.Condition{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Build the trackers manually for testing purposes.
+			nodeTracker := trackers.NewNodeTracker()
+			for idx := range nodes {
+				nodeTracker.AddOrUpdate(&nodes[idx])
+			}
+			podTracker := trackers.NewPodTracker()
+			for idx := range pods {
+				podTracker.AddOrUpdate(&pods[idx])
+			}
+			p := &PropertyProvider{
+				nodeTracker:                           nodeTracker,
+				podTracker:                            podTracker,
+				isAvailableResourcesCollectionEnabled: tc.isAvailableResourcesCollectionEnabled,
+				cachedK8sVersion:                      k8sVersion,
+				cachedK8sVersionObservedTime:          time.Now(),
+			}
+			res := p.Collect(context.Background())
+			if diff := cmp.Diff(res, tc.wantPropertyCollectionResponse, ignoreObservationTimeFieldInPropertyValue); diff != "" {
+				t.Fatalf("Collect() property collection response diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCollectK8sVersion(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		cachedVersion          string
+		cacheAge               time.Duration
+		wantVersion            string
+		wantDiscoveryCallsMade bool
+	}{
+		{
+			name:          "cache is still valid",
+			cachedVersion: "v1.34.0",
+			cacheAge:      time.Minute,
+			wantVersion:   "v1.34.0",
+		},
+		{
+			name:                   "cache has expired",
+			cachedVersion:          "v1.34.0",
+			cacheAge:               k8sVersionCacheTTL + time.Minute,
+			wantVersion:            k8sVersion,
+			wantDiscoveryCallsMade: true,
+		},
+		{
+			name:                   "cache is empty",
+			wantVersion:            k8sVersion,
+			wantDiscoveryCallsMade: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			discoveryClient := &fake.FakeDiscovery{
+				Fake: &k8stesting.Fake{},
+				FakedServerVersion: &version.Info{
+					GitVersion: k8sVersion,
+				},
+			}
+			p := &PropertyProvider{
+				discoveryClient:              discoveryClient,
+				cachedK8sVersion:             tc.cachedVersion,
+				cachedK8sVersionObservedTime: time.Now().Add(-tc.cacheAge),
+			}
+			properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+			p.collectK8sVersion(context.Background(), properties)
+
+			if got := properties[propertyprovider.K8sVersionProperty].Value; got != tc.wantVersion {
+				t.Errorf("collectK8sVersion() version = %q, want %q", got, tc.wantVersion)
+			}
+			if gotCallsMade := len(discoveryClient.Actions()) > 0; gotCallsMade != tc.wantDiscoveryCallsMade {
+				t.Errorf("collectK8sVersion() discovery calls made = %t, want %t", gotCallsMade, tc.wantDiscoveryCallsMade)
+			}
+		})
+	}
+}
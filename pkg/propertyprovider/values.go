@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propertyprovider
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// RetrieveResourceUsageFrom retrieves a resource property value from a member cluster.
+//
+// Note that it will return nil if the property is not available for the cluster;
+// the zero value of resource.Quantity, i.e., resource.Quantity{}, is a valid
+// quantity.
+func RetrieveResourceUsageFrom(cluster *clusterv1beta1.MemberCluster, name string) (*resource.Quantity, error) {
+	// Split the name into two segments, the capacity type, and the resource name.
+	//
+	// As a pre-defined rule, all the resource properties are assigned a label name of the format
+	// `[PREFIX]/[CAPACITY_TYPE]-[RESOURCE_NAME]`; for example, the allocatable CPU capacity of a
+	// a cluster has the label name, `resources.kubernetes-fleet.io/allocatable-cpu`. Note that at
+	// this point of process, the prefix has been removed.
+	segs := strings.Split(name, "-")
+	if len(segs) != 2 || len(segs[0]) == 0 || len(segs[1]) == 0 {
+		return nil, fmt.Errorf("invalid resource property name: %s", name)
+	}
+	cn, tn := segs[0], segs[1]
+
+	// Query the resource usage data.
+	var q resource.Quantity
+	var found bool
+	switch cn {
+	case TotalCapacityName:
+		// The property concerns the total capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Capacity[corev1.ResourceName(tn)]
+	case AllocatableCapacityName:
+		// The property concerns the allocatable capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Allocatable[corev1.ResourceName(tn)]
+	case AvailableCapacityName:
+		// The property concerns the available capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Available[corev1.ResourceName(tn)]
+	default:
+		// The property concerns a capacity type that cannot be recognized.
+		return nil, fmt.Errorf("invalid capacity type %s in resource property name %s", cn, name)
+	}
+
+	if !found {
+		// The property concerns a resource that is not present in the resource usage data.
+		//
+		// It could be that the resource is not available in the cluster; consequently Fleet
+		// does not consider this as an error.
+		return nil, nil
+	}
+	return &q, nil
+}
+
+// RetrievePropertyValueFrom retrieves a property value, resource or non-resource,
+// from a member cluster.
+//
+// Note that it will return nil if the property is not available for the cluster;
+// the zero value of resource.Quantity, i.e., resource.Quantity{}, is a valid
+// quantity.
+func RetrievePropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name string) (*resource.Quantity, error) {
+	// Check if the expression concerns a resource property.
+	var q *resource.Quantity
+	var err error
+	if strings.HasPrefix(name, ResourcePropertyNamePrefix) {
+		name, _ := strings.CutPrefix(name, ResourcePropertyNamePrefix)
+
+		// Retrieve the property value from the cluster resource usage data.
+		q, err = RetrieveResourceUsageFrom(cluster, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve resource property value for %s from cluster %s: %w", name, cluster.Name, err)
+		}
+	} else {
+		v, found := cluster.Status.Properties[clusterv1beta1.PropertyName(name)]
+		if !found {
+			// The property is not available for the cluster.
+			//
+			// Note that this is not considered an error.
+			return nil, nil
+		}
+		qv, err := resource.ParseQuantity(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("value %s of property %s from cluster %s is not a valid quantity: %w", v.Value, name, cluster.Name, err)
+		}
+		q = &qv
+	}
+	return q, nil
+}
@@ -35,6 +35,10 @@ const (
 	// defaultClusterHealthCheckTimeout is the default timeout value this checker uses for checking
 	// if a cluster is still in a healthy state.
 	defaultClusterHealthCheckTimeout = time.Minute * 5
+
+	// defaultClusterUnhealthyThreshold is the default threshold this checker uses for checking
+	// if a cluster has been lost, i.e., it has become unhealthy or disconnected from the fleet.
+	defaultClusterUnhealthyThreshold = time.Second * 60
 )
 
 type ClusterEligibilityChecker struct {
@@ -45,6 +49,10 @@ type ClusterEligibilityChecker struct {
 	// clusterHealthCheckTimeout is the timeout value this checker uses for checking if a cluster is
 	// still in a healthy state.
 	clusterHealthCheckTimeout time.Duration
+
+	// clusterUnhealthyThreshold is the threshold this checker uses for checking if a cluster has
+	// been lost, i.e., it has become unhealthy or disconnected from the fleet.
+	clusterUnhealthyThreshold time.Duration
 }
 
 // checkerOptions is the options for this checker.
@@ -56,6 +64,10 @@ type checkerOptions struct {
 	// clusterHealthCheckTimeout is the timeout value this checker uses for checking if a cluster is
 	// still in a healthy state.
 	clusterHealthCheckTimeout time.Duration
+
+	// clusterUnhealthyThreshold is the threshold this checker uses for checking if a cluster has
+	// been lost, i.e., it has become unhealthy or disconnected from the fleet.
+	clusterUnhealthyThreshold time.Duration
 }
 
 // Option helps set up the plugin.
@@ -77,10 +89,19 @@ func WithClusterHealthCheckTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithClusterUnhealthyThreshold sets the threshold this checker uses for checking if a cluster
+// has been lost, i.e., it has become unhealthy or disconnected from the fleet.
+func WithClusterUnhealthyThreshold(threshold time.Duration) Option {
+	return func(o *checkerOptions) {
+		o.clusterUnhealthyThreshold = threshold
+	}
+}
+
 // defaultPluginOptions is the default options for this plugin.
 var defaultCheckerOptions = checkerOptions{
 	clusterHeartbeatCheckTimeout: defaultClusterHeartbeatCheckTimeout,
 	clusterHealthCheckTimeout:    defaultClusterHealthCheckTimeout,
+	clusterUnhealthyThreshold:    defaultClusterUnhealthyThreshold,
 }
 
 // New returns a new cluster eligibility checker.
@@ -93,6 +114,7 @@ func New(opts ...Option) *ClusterEligibilityChecker {
 	return &ClusterEligibilityChecker{
 		clusterHeartbeatCheckTimeout: options.clusterHeartbeatCheckTimeout,
 		clusterHealthCheckTimeout:    options.clusterHealthCheckTimeout,
+		clusterUnhealthyThreshold:    options.clusterUnhealthyThreshold,
 	}
 }
 
@@ -161,3 +183,44 @@ func (checker *ClusterEligibilityChecker) IsEligible(cluster *clusterv1beta1.Mem
 
 	return true, ""
 }
+
+// LostSince returns the time since which a cluster has been lost, i.e., its member agent has
+// stopped sending heartbeats, or has been reporting the cluster as unhealthy, for longer than
+// the cluster unhealthy threshold; it returns false if the cluster is not lost.
+//
+// Note that a cluster that has left the fleet, or whose member agent has never reported its
+// status, is not considered lost; such clusters are handled separately.
+func (checker *ClusterEligibilityChecker) LostSince(cluster *clusterv1beta1.MemberCluster) (time.Time, bool) {
+	lostAt, ok := checker.LostAt(cluster)
+	if !ok || time.Now().Before(lostAt) {
+		return time.Time{}, false
+	}
+	return lostAt, true
+}
+
+// LostAt returns the time at which a cluster is, or will be, considered lost if its member agent
+// sends no further heartbeats and does not report any health change; it returns false if the cluster
+// cannot be lost, i.e., it has left the fleet, or its member agent has never reported its status.
+//
+// Unlike the other checks, the time is returned even if it is in the future, so that the caller
+// can check the cluster again once it is lost, as nothing is written to the cluster when the
+// member agent goes silent.
+func (checker *ClusterEligibilityChecker) LostAt(cluster *clusterv1beta1.MemberCluster) (time.Time, bool) {
+	if !cluster.GetDeletionTimestamp().IsZero() {
+		return time.Time{}, false
+	}
+	memberAgentStatus := cluster.GetAgentStatus(clusterv1beta1.MemberAgent)
+	if memberAgentStatus == nil {
+		return time.Time{}, false
+	}
+
+	// Note that this checker assumes minimum clock drifts between clusters in the fleet.
+	lostAt := memberAgentStatus.LastReceivedHeartbeat.Add(checker.clusterUnhealthyThreshold)
+	memberAgentHealthyCond := cluster.GetAgentCondition(clusterv1beta1.MemberAgent, clusterv1beta1.AgentHealthy)
+	if memberAgentHealthyCond != nil && memberAgentHealthyCond.Status != metav1.ConditionTrue {
+		if unhealthyAt := memberAgentHealthyCond.LastTransitionTime.Add(checker.clusterUnhealthyThreshold); unhealthyAt.Before(lostAt) {
+			lostAt = unhealthyAt
+		}
+	}
+	return lostAt, true
+}
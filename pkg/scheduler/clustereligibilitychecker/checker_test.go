@@ -249,3 +249,181 @@ func TestIsClusterEligible(t *testing.T) {
 		})
 	}
 }
+
+// TestLostSince tests the LostSince function.
+func TestLostSince(t *testing.T) {
+	clusterUnhealthyThreshold := time.Minute
+	checker := New(WithClusterUnhealthyThreshold(clusterUnhealthyThreshold))
+	deleteTime := metav1.Now()
+	lastHeartbeat := metav1.NewTime(time.Now().Add(-time.Minute * 10).Truncate(time.Second))
+	lastTransition := metav1.NewTime(time.Now().Add(-time.Minute * 20).Truncate(time.Second))
+
+	clusterWithAgentStatus := func(heartbeat metav1.Time, healthyCond *metav1.Condition) *clusterv1beta1.MemberCluster {
+		agentStatus := clusterv1beta1.AgentStatus{
+			Type:                  clusterv1beta1.MemberAgent,
+			LastReceivedHeartbeat: heartbeat,
+		}
+		if healthyCond != nil {
+			agentStatus.Conditions = []metav1.Condition{*healthyCond}
+		}
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				AgentStatus: []clusterv1beta1.AgentStatus{agentStatus},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		cluster       *clusterv1beta1.MemberCluster
+		wantLost      bool
+		wantLostSince time.Time
+	}{
+		{
+			name: "cluster left",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              clusterName,
+					DeletionTimestamp: &deleteTime,
+				},
+			},
+		},
+		{
+			name: "no member agent status",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+			},
+		},
+		{
+			name: "healthy cluster with recent heartbeats",
+			cluster: clusterWithAgentStatus(metav1.Now(), &metav1.Condition{
+				Type:               string(clusterv1beta1.AgentHealthy),
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: lastTransition,
+			}),
+		},
+		{
+			name:          "no recent heartbeats",
+			cluster:       clusterWithAgentStatus(lastHeartbeat, nil),
+			wantLost:      true,
+			wantLostSince: lastHeartbeat.Add(clusterUnhealthyThreshold),
+		},
+		{
+			name: "unhealthy for a prolonged period of time",
+			cluster: clusterWithAgentStatus(metav1.Now(), &metav1.Condition{
+				Type:               string(clusterv1beta1.AgentHealthy),
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: lastTransition,
+			}),
+			wantLost:      true,
+			wantLostSince: lastTransition.Add(clusterUnhealthyThreshold),
+		},
+		{
+			name: "recently unhealthy",
+			cluster: clusterWithAgentStatus(metav1.Now(), &metav1.Condition{
+				Type:               string(clusterv1beta1.AgentHealthy),
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.Now(),
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotLostSince, gotLost := checker.LostSince(tc.cluster)
+			if gotLost != tc.wantLost || !gotLostSince.Equal(tc.wantLostSince) {
+				t.Errorf("LostSince() = (%v, %t), want (%v, %t)", gotLostSince, gotLost, tc.wantLostSince, tc.wantLost)
+			}
+		})
+	}
+}
+
+func TestLostAt(t *testing.T) {
+	clusterUnhealthyThreshold := time.Minute
+	checker := New(WithClusterUnhealthyThreshold(clusterUnhealthyThreshold))
+	deleteTime := metav1.Now()
+	lastHeartbeat := metav1.NewTime(time.Now().Truncate(time.Second))
+	lastTransition := metav1.NewTime(time.Now().Add(-time.Second * 30).Truncate(time.Second))
+
+	testCases := []struct {
+		name       string
+		cluster    *clusterv1beta1.MemberCluster
+		wantOK     bool
+		wantLostAt time.Time
+	}{
+		{
+			name: "cluster left",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              clusterName,
+					DeletionTimestamp: &deleteTime,
+				},
+			},
+		},
+		{
+			name: "no member agent status",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+			},
+		},
+		{
+			name: "healthy cluster with recent heartbeats",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					AgentStatus: []clusterv1beta1.AgentStatus{
+						{
+							Type:                  clusterv1beta1.MemberAgent,
+							LastReceivedHeartbeat: lastHeartbeat,
+						},
+					},
+				},
+			},
+			wantOK:     true,
+			wantLostAt: lastHeartbeat.Add(clusterUnhealthyThreshold),
+		},
+		{
+			name: "recently unhealthy",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					AgentStatus: []clusterv1beta1.AgentStatus{
+						{
+							Type:                  clusterv1beta1.MemberAgent,
+							LastReceivedHeartbeat: lastHeartbeat,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(clusterv1beta1.AgentHealthy),
+									Status:             metav1.ConditionFalse,
+									LastTransitionTime: lastTransition,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantOK:     true,
+			wantLostAt: lastTransition.Add(clusterUnhealthyThreshold),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotLostAt, gotOK := checker.LostAt(tc.cluster)
+			if gotOK != tc.wantOK || !gotLostAt.Equal(tc.wantLostAt) {
+				t.Errorf("LostAt() = (%v, %t), want (%v, %t)", gotLostAt, gotOK, tc.wantLostAt, tc.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterselector features utilities for matching member clusters against the cluster
+// selectors in the placement API.
+package clusterselector
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// Matches checks if the cluster matches a cluster selector, i.e., any of its terms; the terms
+// are OR'd.
+//
+// A selector with no terms matches no cluster.
+func Matches(selector *placementv1beta1.ClusterSelector, cluster *clusterv1beta1.MemberCluster) (bool, error) {
+	for idx := range selector.ClusterSelectorTerms {
+		matched, err := MatchesTerm(&selector.ClusterSelectorTerms[idx], cluster)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MatchesTerm checks if the cluster matches a cluster selector term.
+func MatchesTerm(term *placementv1beta1.ClusterSelectorTerm, cluster *clusterv1beta1.MemberCluster) (bool, error) {
+	// Match the cluster against the label selector.
+	if term.LabelSelector != nil {
+		ls, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse label selector: %w", err)
+		}
+		if !ls.Matches(labels.Set(cluster.Labels)) {
+			// The cluster does not match with the label selector; it is ineligible for resource
+			// placement.
+			return false, nil
+		}
+	}
+
+	// Match the cluster against the property selector.
+	if term.PropertySelector == nil || len(term.PropertySelector.MatchExpressions) == 0 {
+		// The term does not feature a property selector; no check is needed.
+		return true, nil
+	}
+
+	for _, exp := range term.PropertySelector.MatchExpressions {
+		matched, err := MatchesPropertySelectorRequirement(cluster, &exp)
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	// The cluster matches the property selector.
+	return true, nil
+}
+
+// retrieveRawPropertyValueFrom retrieves the raw (string) value of a property, resource or
+// non-resource, from a member cluster.
+//
+// Note that it will return false if the property is not available for the cluster.
+func retrieveRawPropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name string) (string, bool, error) {
+	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
+		q, err := propertyprovider.RetrievePropertyValueFrom(cluster, name)
+		if err != nil || q == nil {
+			return "", false, err
+		}
+		return q.String(), true, nil
+	}
+
+	v, found := cluster.Status.Properties[clusterv1beta1.PropertyName(name)]
+	return v.Value, found, nil
+}
+
+// MatchesPropertySelectorRequirement checks if a cluster matches a property selector requirement.
+//
+// Note that a cluster never matches a requirement on a property that is not available for the
+// cluster, regardless of the operator in use.
+func MatchesPropertySelectorRequirement(cluster *clusterv1beta1.MemberCluster, exp *placementv1beta1.PropertySelectorRequirement) (bool, error) {
+	observed, found, err := retrieveRawPropertyValueFrom(cluster, exp.Name)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		// The property is not available for the cluster.
+		return false, nil
+	}
+
+	switch exp.Operator {
+	case placementv1beta1.PropertySelectorExists:
+		// The property is available for the cluster.
+		return true, nil
+	case placementv1beta1.PropertySelectorIn:
+		return isPropertyValueIn(observed, exp.Values), nil
+	case placementv1beta1.PropertySelectorNotIn:
+		return !isPropertyValueIn(observed, exp.Values), nil
+	}
+
+	// With the comparison operators, only one expected value can be specified.
+	if len(exp.Values) != 1 {
+		// The property selector expression is invalid, as there are too many expected
+		// values.
+		//
+		// Normally this should never happen.
+		return false, fmt.Errorf("more than one value in the property selector expression")
+	}
+	res, err := comparePropertyValues(exp.Name, observed, exp.Values[0])
+	if err != nil {
+		return false, fmt.Errorf("failed to compare the value %s of property %s from cluster %s with %s: %w", observed, exp.Name, cluster.Name, exp.Values[0], err)
+	}
+
+	switch exp.Operator {
+	case placementv1beta1.PropertySelectorEqualTo:
+		// Equality is expected.
+		return res == 0, nil
+	case placementv1beta1.PropertySelectorNotEqualTo:
+		// Inequality is expected.
+		return res != 0, nil
+	case placementv1beta1.PropertySelectorGreaterThan:
+		// The observed value is expected to be greater than the value.
+		return res > 0, nil
+	case placementv1beta1.PropertySelectorGreaterThanOrEqualTo:
+		// The observed value is expected to be greater than or equal to the value.
+		return res >= 0, nil
+	case placementv1beta1.PropertySelectorLessThan:
+		// The observed value is expected to be less than the value.
+		return res < 0, nil
+	case placementv1beta1.PropertySelectorLessThanOrEqualTo:
+		// The observed value is expected to be less than or equal to the value.
+		return res <= 0, nil
+	default:
+		// The operator is not recognized; normally this should never happen.
+		return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+	}
+}
+
+// isPropertyValueIn checks if an observed property value is equal to any of the given values;
+// version-like values are compared as versions (e.g., `v1.30` is equal to `1.30.0`), and all
+// other values are compared as strings.
+func isPropertyValueIn(observed string, values []string) bool {
+	observedV, observedVErr := version.ParseGeneric(observed)
+	for _, v := range values {
+		if v == observed {
+			return true
+		}
+		if observedVErr != nil {
+			continue
+		}
+		if res, err := observedV.Compare(v); err == nil && res == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// comparePropertyValues compares an observed property value with an expected one. It returns
+// -1, 0, or 1 if the observed value is less than, equal to, or greater than the expected one
+// respectively.
+//
+// The values are compared as versions first if the property is known to hold versions (e.g.,
+// the Kubernetes version of a cluster) or if either value is explicitly version-like (e.g.,
+// `v1.30` or `1.29.3`); this keeps values such as `1.30` and `1.4`, which are also valid
+// quantities, from being compared numerically. Otherwise the values are compared as Kubernetes
+// quantities if both of them are valid quantities, and as versions if both of them are valid
+// versions.
+func comparePropertyValues(name, observed, expected string) (int, error) {
+	if isVersionProperty(name) || isVersionLike(observed) || isVersionLike(expected) {
+		if res, ok := compareAsVersions(observed, expected); ok {
+			return res, nil
+		}
+	}
+
+	observedQ, observedQErr := resource.ParseQuantity(observed)
+	expectedQ, expectedQErr := resource.ParseQuantity(expected)
+	if observedQErr == nil && expectedQErr == nil {
+		return observedQ.Cmp(expectedQ), nil
+	}
+
+	if _, err := version.ParseGeneric(observed); err != nil && observedQErr != nil {
+		return 0, fmt.Errorf("value %s is neither a valid quantity nor a valid version", observed)
+	}
+	res, ok := compareAsVersions(observed, expected)
+	if !ok {
+		return 0, fmt.Errorf("value %s is neither a valid quantity nor a valid version", expected)
+	}
+	return res, nil
+}
+
+// isVersionProperty checks if a property is known to hold version values.
+func isVersionProperty(name string) bool {
+	return name == propertyprovider.K8sVersionProperty
+}
+
+// isVersionLike checks if a value can only be reasonably read as a version, i.e., it has a `v`
+// prefix or features at least three dot-separated components.
+func isVersionLike(value string) bool {
+	return strings.HasPrefix(value, "v") || strings.Count(value, ".") >= 2
+}
+
+// compareAsVersions compares two values as versions; it returns false if either of them is
+// not a valid version.
+func compareAsVersions(observed, expected string) (int, bool) {
+	observedV, err := version.ParseGeneric(observed)
+	if err != nil {
+		return 0, false
+	}
+	res, err := observedV.Compare(expected)
+	if err != nil {
+		return 0, false
+	}
+	return res, true
+}
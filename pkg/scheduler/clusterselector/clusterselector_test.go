@@ -0,0 +1,760 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterselector
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+const (
+	clusterName1 = "cluster-1"
+
+	regionLabelName   = "region"
+	regionLabelValue1 = "eastus"
+	regionLabelValue2 = "westus"
+
+	envLabelName   = "env"
+	envLabelValue1 = "prod"
+	envLabelValue2 = "canary"
+
+	nonExistentNonResourcePropertyName = "non-existent-non-resource-property"
+	invalidNonResourcePropertyName     = "invalid-non-resource-property"
+)
+
+// TestMatches tests the Matches function.
+func TestMatches(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName1,
+			Labels: map[string]string{
+				envLabelName:    envLabelValue1,
+				regionLabelName: regionLabelValue1,
+			},
+		},
+	}
+	termWithLabel := func(name, value string) placementv1beta1.ClusterSelectorTerm {
+		return placementv1beta1.ClusterSelectorTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					name: value,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		selector       *placementv1beta1.ClusterSelector
+		want           bool
+		expectedToFail bool
+	}{
+		{
+			name:     "no terms",
+			selector: &placementv1beta1.ClusterSelector{},
+			want:     false,
+		},
+		{
+			name: "single term, matches",
+			selector: &placementv1beta1.ClusterSelector{
+				ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+					termWithLabel(envLabelName, envLabelValue1),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "multiple terms, none matches",
+			selector: &placementv1beta1.ClusterSelector{
+				ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+					termWithLabel(envLabelName, envLabelValue2),
+					termWithLabel(regionLabelName, regionLabelValue2),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "multiple terms, one matches",
+			selector: &placementv1beta1.ClusterSelector{
+				ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+					termWithLabel(envLabelName, envLabelValue2),
+					termWithLabel(regionLabelName, regionLabelValue1),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "invalid term",
+			selector: &placementv1beta1.ClusterSelector{
+				ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+					{
+						LabelSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      regionLabelName,
+									Operator: metav1.LabelSelectorOperator("invalid"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := Matches(tc.selector, cluster)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("Matches(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil || matches != tc.want {
+				t.Errorf("Matches() = %v, %v, want %v, nil", matches, err, tc.want)
+			}
+		})
+	}
+}
+
+// TestMatchesTerm tests the MatchesTerm function.
+func TestMatchesTerm(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName1,
+			Labels: map[string]string{
+				envLabelName:    envLabelValue1,
+				regionLabelName: regionLabelValue1,
+			},
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("40Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("36Gi"),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {
+					Value: "4",
+				},
+				invalidNonResourcePropertyName: {
+					Value: "invalid",
+				},
+				propertyprovider.K8sVersionProperty: {
+					Value: "v1.29.3",
+				},
+			},
+		},
+	}
+	requirementWith := func(name string, op placementv1beta1.PropertySelectorOperator, values ...string) *placementv1beta1.ClusterSelectorTerm {
+		return &placementv1beta1.ClusterSelectorTerm{
+			PropertySelector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     name,
+						Operator: op,
+						Values:   values,
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		term           *placementv1beta1.ClusterSelectorTerm
+		cluster        *clusterv1beta1.MemberCluster
+		want           bool
+		expectedToFail bool
+	}{
+		{
+			name: "invalid label selector",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      regionLabelName,
+							Operator: metav1.LabelSelectorOperator("invalid"),
+							Values: []string{
+								regionLabelValue1,
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "label selector mismatches",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						envLabelName: envLabelValue2,
+					},
+				},
+			},
+			cluster: cluster,
+			want:    false,
+		},
+		{
+			name: "label selector matches, no property selector",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						envLabelName: envLabelValue1,
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "label selector matches, no expressions in the property selector",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						envLabelName: envLabelValue1,
+					},
+				},
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "invalid resource property name",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     "resources.kubernetes-fleet.io/cpu",
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"2",
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "property not found",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     nonExistentNonResourcePropertyName,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"0",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "multiple value options",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.NodeCountProperty,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"1",
+								"2",
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "invalid property value",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     invalidNonResourcePropertyName,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"1",
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "invalid value option",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.NodeCountProperty,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"invalid",
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "invalid operator",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.NodeCountProperty,
+							Operator: "invalid",
+							Values: []string{
+								"1",
+							},
+						},
+					},
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "op =, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.NodeCountProperty,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"4",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op =, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.NodeCountProperty,
+							Operator: placementv1beta1.PropertySelectorEqualTo,
+							Values: []string{
+								"8",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "op !=, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.TotalCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorNotEqualTo,
+							Values: []string{
+								"11",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op !=, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.TotalCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorNotEqualTo,
+							Values: []string{
+								"10",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "op >, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AllocatableMemoryCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorGreaterThan,
+							Values: []string{
+								"30Gi",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op >, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AllocatableMemoryCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorGreaterThan,
+							Values: []string{
+								"40Gi",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "op <, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AvailableCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorLessThan,
+							Values: []string{
+								"4",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op <, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AvailableCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorLessThan,
+							Values: []string{
+								"1",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "op >=, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.TotalMemoryCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+							Values: []string{
+								"40Gi",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op >=, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.TotalMemoryCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+							Values: []string{
+								"41Gi",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name: "op <=, matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AllocatableCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorLessThanOrEqualTo,
+							Values: []string{
+								"8",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "op <=, not matched",
+			term: &placementv1beta1.ClusterSelectorTerm{
+				PropertySelector: &placementv1beta1.PropertySelector{
+					MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+						{
+							Name:     propertyprovider.AllocatableCPUCapacityProperty,
+							Operator: placementv1beta1.PropertySelectorLessThanOrEqualTo,
+							Values: []string{
+								"7",
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+		},
+		{
+			name:    "op In, matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorIn, "v1.28.0", "v1.29.3"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op In, matched (version-aware)",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorIn, "1.29.3"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op In, not matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorIn, "v1.28.0", "v1.30.0"),
+			cluster: cluster,
+		},
+		{
+			name:    "op In, resource property, matched",
+			term:    requirementWith(propertyprovider.AllocatableCPUCapacityProperty, placementv1beta1.PropertySelectorIn, "8"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op NotIn, matched",
+			term:    requirementWith(invalidNonResourcePropertyName, placementv1beta1.PropertySelectorNotIn, "valid"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op NotIn, not matched",
+			term:    requirementWith(invalidNonResourcePropertyName, placementv1beta1.PropertySelectorNotIn, "valid", "invalid"),
+			cluster: cluster,
+		},
+		{
+			name:    "op NotIn, property not found",
+			term:    requirementWith(nonExistentNonResourcePropertyName, placementv1beta1.PropertySelectorNotIn, "valid"),
+			cluster: cluster,
+		},
+		{
+			name:    "op Exists, matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorExists),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op Exists, not matched",
+			term:    requirementWith(nonExistentNonResourcePropertyName, placementv1beta1.PropertySelectorExists),
+			cluster: cluster,
+		},
+		{
+			name:    "op >=, version, matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorGreaterThanOrEqualTo, "1.29"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:    "op >, version, not matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorGreaterThan, "v1.29.3"),
+			cluster: cluster,
+		},
+		{
+			name:    "op <, version, matched",
+			term:    requirementWith(propertyprovider.K8sVersionProperty, placementv1beta1.PropertySelectorLessThan, "v1.30.0"),
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name:           "op <, version against a non-version value",
+			term:           requirementWith(invalidNonResourcePropertyName, placementv1beta1.PropertySelectorLessThan, "v1.30.0"),
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := MatchesTerm(tc.term, tc.cluster)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("MatchesTerm(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil || matches != tc.want {
+				t.Errorf("MatchesTerm() = %v, %v, want %v, nil", matches, err, tc.want)
+			}
+		})
+	}
+}
+
+// TestComparePropertyValues tests the comparePropertyValues function.
+func TestComparePropertyValues(t *testing.T) {
+	testCases := []struct {
+		name           string
+		propertyName   string
+		observed       string
+		expected       string
+		want           int
+		expectedToFail bool
+	}{
+		{
+			name:         "quantities",
+			propertyName: propertyprovider.AllocatableCPUCapacityProperty,
+			observed:     "1.5",
+			expected:     "1.25",
+			want:         1,
+		},
+		{
+			name:         "quantities with units",
+			propertyName: propertyprovider.AllocatableMemoryCapacityProperty,
+			observed:     "1Gi",
+			expected:     "1024Mi",
+			want:         0,
+		},
+		{
+			name:         "version property, quantity-like values",
+			propertyName: propertyprovider.K8sVersionProperty,
+			observed:     "1.30",
+			expected:     "1.4",
+			want:         1,
+		},
+		{
+			name:         "version-like observed value",
+			propertyName: "custom-property",
+			observed:     "v1.4",
+			expected:     "1.30",
+			want:         -1,
+		},
+		{
+			name:         "version-like expected value",
+			propertyName: "custom-property",
+			observed:     "1.30",
+			expected:     "1.4.0",
+			want:         1,
+		},
+		{
+			name:         "version-like values, equal",
+			propertyName: "custom-property",
+			observed:     "v1.30",
+			expected:     "1.30.0",
+			want:         0,
+		},
+		{
+			name:         "version property, non-version values",
+			propertyName: propertyprovider.K8sVersionProperty,
+			observed:     "8",
+			expected:     "10",
+			want:         -1,
+		},
+		{
+			name:           "invalid observed value",
+			propertyName:   "custom-property",
+			observed:       "invalid",
+			expected:       "1.30",
+			expectedToFail: true,
+		},
+		{
+			name:           "invalid expected value",
+			propertyName:   "custom-property",
+			observed:       "v1.30",
+			expected:       "invalid",
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := comparePropertyValues(tc.propertyName, tc.observed, tc.expected)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("comparePropertyValues(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil || res != tc.want {
+				t.Errorf("comparePropertyValues() = %v, %v, want %v, nil", res, err, tc.want)
+			}
+		})
+	}
+}
@@ -82,6 +82,10 @@ type CycleState struct {
 	//
 	// This is set when scheduling policies of the PickN placement type.
 	batchSizeLimit int
+
+	// trace keeps track of the results of the plugins for each cluster in the current scheduling cycle;
+	// it is only set when the scheduling trace support is enabled.
+	trace *cycleTrace
 }
 
 // Read retrieves a value from CycleState by a key.
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -41,10 +42,12 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clusterselector"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
 )
 
@@ -100,6 +103,11 @@ type Framework interface {
 	// RunSchedulingCycleFor performs scheduling for a resource placement, specifically
 	// its associated latest scheduling policy snapshot.
 	RunSchedulingCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj) (result ctrl.Result, err error)
+
+	// SimulateSchedulingFor runs the scheduler plugins for a scheduling policy snapshot that is not
+	// persisted, and returns the scheduling decisions the scheduler would make, along with the results
+	// of the plugins for each cluster; no binding is created or updated.
+	SimulateSchedulingFor(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (decisions []placementv1beta1.ClusterDecision, results []placementv1beta1.ClusterSimulationResult, err error)
 }
 
 // framework implements the Framework interface.
@@ -131,6 +139,10 @@ type framework struct {
 	//
 	// Note that all picked clusters will always have their associated decisions written to the status.
 	maxUnselectedClusterDecisionCount int
+
+	// enableSchedulingTrace controls whether the scheduler framework records the results of the plugins
+	// for every evaluated cluster in a scheduling trace object.
+	enableSchedulingTrace bool
 }
 
 var (
@@ -151,6 +163,10 @@ type frameworkOptions struct {
 	// checker is the cluster eligibility checker the scheduler framework will use to check
 	// if a cluster is eligibile for resource placement.
 	clusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// enableSchedulingTrace controls whether the scheduler framework records the results of the plugins
+	// for every evaluated cluster in a scheduling trace object.
+	enableSchedulingTrace bool
 }
 
 // Option is the function for configuring a scheduler framework.
@@ -184,6 +200,14 @@ func WithClusterEligibilityChecker(checker *clustereligibilitychecker.ClusterEli
 	}
 }
 
+// WithSchedulingTrace sets whether a scheduler framework records the results of the plugins for every
+// evaluated cluster in a scheduling trace object.
+func WithSchedulingTrace(enabled bool) Option {
+	return func(fo *frameworkOptions) {
+		fo.enableSchedulingTrace = enabled
+	}
+}
+
 // NewFramework returns a new scheduler framework.
 func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framework {
 	options := defaultFrameworkOptions
@@ -213,6 +237,7 @@ func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framew
 		parallelizer:                      parallelizer.NewParallelizer(options.numOfWorkers),
 		maxUnselectedClusterDecisionCount: options.maxUnselectedClusterDecisionCount,
 		clusterEligibilityChecker:         options.clusterEligibilityChecker,
+		enableSchedulingTrace:             options.enableSchedulingTrace,
 	}
 	// initialize all the plugins
 	for _, plugin := range f.profile.registeredPlugins {
@@ -335,6 +360,9 @@ func (f *framework) RunSchedulingCycleFor(ctx context.Context, placementKey queu
 	// the framework). These reserved fields are never accessed concurrently, as each scheduling run has its own cycle and a run
 	// is always executed in one single goroutine; plugin access to the state is guarded by sync.Map.
 	state := NewCycleState(clusters, obsolete, bound, scheduled)
+	if f.enableSchedulingTrace {
+		state.trace = newCycleTrace()
+	}
 
 	switch {
 	case policy.GetPolicySnapshotSpec().Policy == nil:
@@ -383,6 +411,29 @@ var markUnscheduledForAndUpdate = func(ctx context.Context, hubClient client.Cli
 	return err
 }
 
+// markFailedOverAndUpdate returns a function that marks a binding on a lost cluster as unscheduled and
+// updates it; if keepResources is true, the binding is also annotated so that the resources placed on
+// the cluster are left intact when the binding is removed.
+func markFailedOverAndUpdate(keepResources bool) func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
+	return func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
+		annotations := binding.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[placementv1beta1.PreviousBindingStateAnnotation] = string(binding.GetBindingSpec().State)
+		if keepResources {
+			annotations[placementv1beta1.KeepResourcesOnDeletionAnnotation] = strconv.FormatBool(true)
+		}
+		binding.SetAnnotations(annotations)
+		binding.GetBindingSpec().State = placementv1beta1.BindingStateUnscheduled
+		err := hubClient.Update(ctx, binding, &client.UpdateOptions{})
+		if err == nil {
+			klog.V(2).InfoS("Marked binding on a lost cluster as unscheduled", "binding", klog.KObj(binding), "keepResources", keepResources)
+		}
+		return err
+	}
+}
+
 // removeFinalizerAndUpdate removes scheduler binding cleanup finalizer from binding and updates it.
 var removeFinalizerAndUpdate = func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
 	controllerutil.RemoveFinalizer(binding, placementv1beta1.SchedulerBindingCleanupFinalizer)
@@ -432,6 +483,14 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 ) (result ctrl.Result, err error) {
 	policyRef := klog.KObj(policy)
 
+	// Unschedule the scheduled/bound bindings on clusters that no longer match the cluster affinity
+	// terms required during execution, if any.
+	scheduled, bound, err = f.unscheduleOnIneligibleClustersDuringExecution(ctx, policy, clusters, scheduled, bound)
+	if err != nil {
+		klog.ErrorS(err, "Failed to unschedule bindings on clusters that no longer meet the required cluster affinity terms", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// The scheduler always needs to take action when processing scheduling policies of the PickAll
 	// placement type; enter the actual scheduling stages right away.
 	klog.V(2).InfoS("Scheduling is always needed for placements of the PickAll placement type; entering scheduling stages", "policySnapshot", policyRef)
@@ -479,6 +538,9 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Record the results of the plugins for every evaluated cluster in the scheduling trace, if enabled.
+	f.updateSchedulingTrace(ctx, state, policy, scored, nil)
+
 	// Extract the patched bindings.
 	patched := make([]placementv1beta1.BindingObj, 0, len(toPatch))
 	for _, p := range toPatch {
@@ -646,6 +708,9 @@ func (f *framework) runFilterPlugins(ctx context.Context, state *CycleState, pol
 	doWork := func(pieces int) {
 		cluster := clusters[pieces]
 		status := f.runFilterPluginsFor(childCtx, state, policy, &cluster)
+		if status.IsClusterUnschedulable() || status.IsClusterAlreadySelected() {
+			state.trace.recordFilterStatus(cluster.Name, status)
+		}
 		switch {
 		case status.IsSuccess():
 			// Use atomic add to avoid races with minimum overhead.
@@ -855,6 +920,30 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	// Fail over the scheduled/bound bindings on clusters that have been lost for longer than the grace
+	// period, if the policy has a failover policy; the scheduler will pick replacement clusters for them
+	// in the steps below.
+	scheduled, bound, failoverRequeueAfter, err := f.failover(ctx, policy, clusters, scheduled, bound)
+	if err != nil {
+		klog.ErrorS(err, "Failed to fail over bindings on lost clusters", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		// Check again later if some bindings are on lost clusters that are still in their grace period.
+		if err == nil && failoverRequeueAfter > 0 && result.IsZero() {
+			result = ctrl.Result{Requeue: true, RequeueAfter: failoverRequeueAfter}
+		}
+	}()
+
+	// Unschedule the scheduled/bound bindings on clusters that no longer match the cluster affinity
+	// terms required during execution, if any; similarly, the scheduler will pick replacement clusters
+	// for them in the steps below.
+	scheduled, bound, err = f.unscheduleOnIneligibleClustersDuringExecution(ctx, policy, clusters, scheduled, bound)
+	if err != nil {
+		klog.ErrorS(err, "Failed to unschedule bindings on clusters that no longer meet the required cluster affinity terms", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// Check if the scheduler should downscale, i.e., mark some scheduled/bound bindings as unscheduled and/or
 	// clean up all obsolete bindings right away.
 	//
@@ -985,6 +1074,9 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Record the results of the plugins for every evaluated cluster in the scheduling trace, if enabled.
+	f.updateSchedulingTrace(ctx, state, policy, picked, notPicked)
+
 	// Requeue if needed.
 	//
 	// The scheduler will requeue to pick more clusters for the current policy snapshot if and only if
@@ -1018,6 +1110,96 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 	return ctrl.Result{}, nil
 }
 
+// failover marks the scheduled and bound bindings on clusters that have been lost for longer than the
+// grace period of the failover policy as unscheduled; it returns the remaining scheduled and bound
+// bindings, and the period of time after which the scheduler should check again for bindings on lost
+// clusters that are still in their grace period (zero if there are none).
+//
+// No binding is failed over if the policy does not have a failover policy.
+func (f *framework) failover(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	scheduled, bound []placementv1beta1.BindingObj,
+) (remainingScheduled, remainingBound []placementv1beta1.BindingObj, requeueAfter time.Duration, err error) {
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	if placementPolicy == nil || placementPolicy.FailoverPolicy == nil {
+		return scheduled, bound, 0, nil
+	}
+	failoverPolicy := placementPolicy.FailoverPolicy
+	gracePeriod := time.Duration(defaulter.DefaultFailoverGracePeriodSeconds) * time.Second
+	if failoverPolicy.GracePeriodSeconds != nil {
+		gracePeriod = time.Duration(*failoverPolicy.GracePeriodSeconds) * time.Second
+	}
+
+	remainingScheduled, remainingBound, toFailover, err := partitionBindingsByCluster(clusters, scheduled, bound, func(cluster *clusterv1beta1.MemberCluster) (bool, error) {
+		lostSince, lost := f.clusterEligibilityChecker.LostSince(cluster)
+		if !lost {
+			return false, nil
+		}
+		wait := time.Until(lostSince.Add(gracePeriod))
+		if wait <= 0 {
+			return true, nil
+		}
+		if requeueAfter == 0 || wait < requeueAfter {
+			requeueAfter = wait
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if len(toFailover) > 0 {
+		keepResources := failoverPolicy.ResourceRetentionPolicy == placementv1beta1.FailoverResourceRetentionPolicyKeep
+		klog.V(2).InfoS("Failing over bindings on lost clusters", "policySnapshot", klog.KObj(policy), "bindingCount", len(toFailover), "keepResources", keepResources)
+		if err := f.updateBindings(ctx, toFailover, markFailedOverAndUpdate(keepResources)); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	return remainingScheduled, remainingBound, requeueAfter, nil
+}
+
+// unscheduleOnIneligibleClustersDuringExecution marks the scheduled and bound bindings on clusters that
+// no longer match the cluster affinity terms required during execution as unscheduled; it returns the
+// remaining scheduled and bound bindings.
+//
+// No binding is unscheduled if the policy does not have cluster affinity terms required during execution.
+func (f *framework) unscheduleOnIneligibleClustersDuringExecution(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	scheduled, bound []placementv1beta1.BindingObj,
+) (remainingScheduled, remainingBound []placementv1beta1.BindingObj, err error) {
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	if placementPolicy == nil || placementPolicy.Affinity == nil || placementPolicy.Affinity.ClusterAffinity == nil {
+		return scheduled, bound, nil
+	}
+	selector := placementPolicy.Affinity.ClusterAffinity.RequiredDuringSchedulingRequiredDuringExecution
+	if selector == nil || len(selector.ClusterSelectorTerms) == 0 {
+		return scheduled, bound, nil
+	}
+
+	remainingScheduled, remainingBound, toUnschedule, err := partitionBindingsByCluster(clusters, scheduled, bound, func(cluster *clusterv1beta1.MemberCluster) (bool, error) {
+		matched, err := clusterselector.Matches(selector, cluster)
+		if err != nil {
+			return false, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to match cluster %s against the required cluster affinity terms: %w", cluster.Name, err))
+		}
+		return !matched, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(toUnschedule) > 0 {
+		klog.V(2).InfoS("Unscheduling bindings on clusters that no longer meet the required cluster affinity terms", "policySnapshot", klog.KObj(policy), "bindingCount", len(toUnschedule))
+		if err := f.updateBindings(ctx, toUnschedule, markUnscheduledForAndUpdate); err != nil {
+			return nil, nil, err
+		}
+	}
+	return remainingScheduled, remainingBound, nil
+}
+
 // downscale performs downscaling on scheduled and bound bindings, i.e., marks some of them as unscheduled.
 //
 // To minimize interruptions, the scheduler picks scheduled bindings first (in any order); if there
@@ -1272,6 +1454,7 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 		scoreList, status := f.runScorePluginsFor(childCtx, state, policy, cluster)
 		switch {
 		case status.IsSuccess():
+			state.trace.recordPluginScores(cluster.Name, scoreList)
 			totalScore := &ClusterScore{}
 			for _, score := range scoreList {
 				totalScore.Add(score)
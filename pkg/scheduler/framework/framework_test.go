@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -5051,6 +5052,325 @@ func TestDownscale(t *testing.T) {
 	}
 }
 
+// TestFailover tests the failover method.
+func TestFailover(t *testing.T) {
+	now := time.Now()
+	clusterWithHeartbeat := func(name string, heartbeat time.Time) clusterv1beta1.MemberCluster {
+		return clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				AgentStatus: []clusterv1beta1.AgentStatus{
+					{
+						Type: clusterv1beta1.MemberAgent,
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(clusterv1beta1.AgentJoined),
+								Status: metav1.ConditionTrue,
+							},
+							{
+								Type:   string(clusterv1beta1.AgentHealthy),
+								Status: metav1.ConditionTrue,
+							},
+						},
+						LastReceivedHeartbeat: metav1.NewTime(heartbeat),
+					},
+				},
+			},
+		}
+	}
+	clusters := []clusterv1beta1.MemberCluster{
+		// Lost for about 19 minutes, beyond the grace period.
+		clusterWithHeartbeat(clusterName, now.Add(-time.Minute*20)),
+		// Lost for about 2 minutes, within the grace period.
+		clusterWithHeartbeat(altClusterName, now.Add(-time.Minute*3)),
+		// Healthy.
+		clusterWithHeartbeat(anotherClusterName, now),
+	}
+	newBinding := func(name, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: cluster,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                   string
+		failoverPolicy         *placementv1beta1.FailoverPolicy
+		wantFailedOver         bool
+		wantKeepResources      bool
+		wantRequeueAfterMin    time.Duration
+		wantRequeueAfterMax    time.Duration
+		wantRemainingBound     []string
+		wantRemainingScheduled []string
+	}{
+		{
+			name:                   "no failover policy",
+			wantRemainingBound:     []string{bindingName, anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "failover policy with the Delete resource retention policy",
+			failoverPolicy: &placementv1beta1.FailoverPolicy{
+				GracePeriodSeconds:      ptr.To(int32(300)),
+				ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyDelete,
+			},
+			wantFailedOver:         true,
+			wantRequeueAfterMin:    time.Minute * 2,
+			wantRequeueAfterMax:    time.Minute * 3,
+			wantRemainingBound:     []string{anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "failover policy with the Keep resource retention policy",
+			failoverPolicy: &placementv1beta1.FailoverPolicy{
+				GracePeriodSeconds:      ptr.To(int32(300)),
+				ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyKeep,
+			},
+			wantFailedOver:         true,
+			wantKeepResources:      true,
+			wantRequeueAfterMin:    time.Minute * 2,
+			wantRequeueAfterMax:    time.Minute * 3,
+			wantRemainingBound:     []string{anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "failover policy with no grace period",
+			failoverPolicy: &placementv1beta1.FailoverPolicy{
+				GracePeriodSeconds:      ptr.To(int32(0)),
+				ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyDelete,
+			},
+			wantFailedOver:         true,
+			wantRemainingBound:     []string{anotherBindingName},
+			wantRemainingScheduled: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lostBinding := newBinding(bindingName, clusterName, placementv1beta1.BindingStateBound)
+			scheduledBinding := newBinding(altBindingName, altClusterName, placementv1beta1.BindingStateScheduled)
+			healthyBinding := newBinding(anotherBindingName, anotherClusterName, placementv1beta1.BindingStateBound)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(lostBinding, scheduledBinding, healthyBinding).
+				Build()
+			// Construct framework manually instead of using NewFramework to avoid mocking the
+			// controller manager.
+			f := &framework{
+				client:                    fakeClient,
+				clusterEligibilityChecker: clustereligibilitychecker.New(),
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:  placementv1beta1.PickNPlacementType,
+						FailoverPolicy: tc.failoverPolicy,
+					},
+				},
+			}
+
+			ctx := context.Background()
+			scheduled, bound, requeueAfter, err := f.failover(ctx, policy, clusters,
+				controller.ConvertCRBArrayToBindingObjs([]*placementv1beta1.ClusterResourceBinding{scheduledBinding}),
+				controller.ConvertCRBArrayToBindingObjs([]*placementv1beta1.ClusterResourceBinding{lostBinding, healthyBinding}))
+			if err != nil {
+				t.Fatalf("failover() = %v, want no error", err)
+			}
+
+			bindingNames := func(bindings []placementv1beta1.BindingObj) []string {
+				names := make([]string, 0, len(bindings))
+				for _, binding := range bindings {
+					names = append(names, binding.GetName())
+				}
+				return names
+			}
+			if diff := cmp.Diff(bindingNames(bound), tc.wantRemainingBound); diff != "" {
+				t.Errorf("failover() remaining bound bindings diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(bindingNames(scheduled), tc.wantRemainingScheduled); diff != "" {
+				t.Errorf("failover() remaining scheduled bindings diff (-got, +want): %s", diff)
+			}
+			if requeueAfter < tc.wantRequeueAfterMin || requeueAfter > tc.wantRequeueAfterMax {
+				t.Errorf("failover() requeueAfter = %v, want in the range [%v, %v]", requeueAfter, tc.wantRequeueAfterMin, tc.wantRequeueAfterMax)
+			}
+
+			gotBinding := &placementv1beta1.ClusterResourceBinding{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: bindingName}, gotBinding); err != nil {
+				t.Fatalf("Get() binding %s = %v, want no error", bindingName, err)
+			}
+			wantState := placementv1beta1.BindingStateBound
+			if tc.wantFailedOver {
+				wantState = placementv1beta1.BindingStateUnscheduled
+			}
+			if gotBinding.Spec.State != wantState {
+				t.Errorf("binding %s state = %s, want %s", bindingName, gotBinding.Spec.State, wantState)
+			}
+			if _, gotKeepResources := gotBinding.Annotations[placementv1beta1.KeepResourcesOnDeletionAnnotation]; gotKeepResources != tc.wantKeepResources {
+				t.Errorf("binding %s has the keep resources annotation = %t, want %t", bindingName, gotKeepResources, tc.wantKeepResources)
+			}
+		})
+	}
+}
+
+// TestUnscheduleOnIneligibleClustersDuringExecution tests the unscheduleOnIneligibleClustersDuringExecution method.
+func TestUnscheduleOnIneligibleClustersDuringExecution(t *testing.T) {
+	regionLabel := "region"
+	clusterWithLabels := func(name string, labels map[string]string) clusterv1beta1.MemberCluster {
+		return clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+		}
+	}
+	clusters := []clusterv1beta1.MemberCluster{
+		clusterWithLabels(clusterName, map[string]string{regionLabel: "west"}),
+		clusterWithLabels(altClusterName, map[string]string{regionLabel: "east"}),
+		clusterWithLabels(anotherClusterName, map[string]string{regionLabel: "east"}),
+	}
+	newBinding := func(name, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: cluster,
+			},
+		}
+	}
+	selectorFor := func(region string) *placementv1beta1.ClusterSelector {
+		return &placementv1beta1.ClusterSelector{
+			ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+				{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							regionLabel: region,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name                   string
+		clusterAffinity        *placementv1beta1.ClusterAffinity
+		wantUnscheduled        []string
+		wantRemainingBound     []string
+		wantRemainingScheduled []string
+	}{
+		{
+			name:                   "no cluster affinity",
+			wantRemainingBound:     []string{bindingName, anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "required during scheduling ignored during execution terms only",
+			clusterAffinity: &placementv1beta1.ClusterAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: selectorFor("east"),
+			},
+			wantRemainingBound:     []string{bindingName, anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "required during execution terms, some clusters no longer match",
+			clusterAffinity: &placementv1beta1.ClusterAffinity{
+				RequiredDuringSchedulingRequiredDuringExecution: selectorFor("east"),
+			},
+			wantUnscheduled:        []string{bindingName},
+			wantRemainingBound:     []string{anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+		{
+			name: "required during execution terms, all clusters still match",
+			clusterAffinity: &placementv1beta1.ClusterAffinity{
+				RequiredDuringSchedulingRequiredDuringExecution: &placementv1beta1.ClusterSelector{
+					ClusterSelectorTerms: append(selectorFor("east").ClusterSelectorTerms, selectorFor("west").ClusterSelectorTerms...),
+				},
+			},
+			wantRemainingBound:     []string{bindingName, anotherBindingName},
+			wantRemainingScheduled: []string{altBindingName},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			westBinding := newBinding(bindingName, clusterName, placementv1beta1.BindingStateBound)
+			scheduledBinding := newBinding(altBindingName, altClusterName, placementv1beta1.BindingStateScheduled)
+			eastBinding := newBinding(anotherBindingName, anotherClusterName, placementv1beta1.BindingStateBound)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(westBinding, scheduledBinding, eastBinding).
+				Build()
+			// Construct framework manually instead of using NewFramework to avoid mocking the
+			// controller manager.
+			f := &framework{
+				client: fakeClient,
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickNPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: tc.clusterAffinity,
+						},
+					},
+				},
+			}
+
+			ctx := context.Background()
+			scheduled, bound, err := f.unscheduleOnIneligibleClustersDuringExecution(ctx, policy, clusters,
+				controller.ConvertCRBArrayToBindingObjs([]*placementv1beta1.ClusterResourceBinding{scheduledBinding}),
+				controller.ConvertCRBArrayToBindingObjs([]*placementv1beta1.ClusterResourceBinding{westBinding, eastBinding}))
+			if err != nil {
+				t.Fatalf("unscheduleOnIneligibleClustersDuringExecution() = %v, want no error", err)
+			}
+
+			bindingNames := func(bindings []placementv1beta1.BindingObj) []string {
+				names := make([]string, 0, len(bindings))
+				for _, binding := range bindings {
+					names = append(names, binding.GetName())
+				}
+				return names
+			}
+			if diff := cmp.Diff(bindingNames(bound), tc.wantRemainingBound); diff != "" {
+				t.Errorf("unscheduleOnIneligibleClustersDuringExecution() remaining bound bindings diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(bindingNames(scheduled), tc.wantRemainingScheduled); diff != "" {
+				t.Errorf("unscheduleOnIneligibleClustersDuringExecution() remaining scheduled bindings diff (-got, +want): %s", diff)
+			}
+
+			bindingList := &placementv1beta1.ClusterResourceBindingList{}
+			if err := fakeClient.List(ctx, bindingList); err != nil {
+				t.Fatalf("List() bindings = %v, want no error", err)
+			}
+			gotUnscheduled := make([]string, 0)
+			for _, binding := range bindingList.Items {
+				if binding.Spec.State == placementv1beta1.BindingStateUnscheduled {
+					gotUnscheduled = append(gotUnscheduled, binding.Name)
+				}
+			}
+			if diff := cmp.Diff(gotUnscheduled, tc.wantUnscheduled, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unscheduled bindings diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
 // TestRunScorePluginsFor tests the runScorePluginsFor method.
 func TestRunScorePluginsFor(t *testing.T) {
 	dummyScorePluginA := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
@@ -107,6 +107,52 @@ type bindingWithPatch struct {
 	patch client.Patch
 }
 
+// partitionBindingsByCluster splits scheduled and bound bindings into the ones to keep and the ones
+// to remove, as decided by the shouldRemove func on the target cluster of each binding.
+//
+// Bindings whose target clusters are not found are always kept, as they are handled as dangling bindings.
+func partitionBindingsByCluster(
+	clusters []clusterv1beta1.MemberCluster,
+	scheduled, bound []placementv1beta1.BindingObj,
+	shouldRemove func(cluster *clusterv1beta1.MemberCluster) (bool, error),
+) (remainingScheduled, remainingBound, toRemove []placementv1beta1.BindingObj, err error) {
+	// Build a map for clusters for quick lookup.
+	clusterMap := make(map[string]*clusterv1beta1.MemberCluster, len(clusters))
+	for idx := range clusters {
+		clusterMap[clusters[idx].Name] = &clusters[idx]
+	}
+
+	toRemove = make([]placementv1beta1.BindingObj, 0)
+	partition := func(bindings []placementv1beta1.BindingObj) ([]placementv1beta1.BindingObj, error) {
+		remaining := make([]placementv1beta1.BindingObj, 0, len(bindings))
+		for _, binding := range bindings {
+			cluster, ok := clusterMap[binding.GetBindingSpec().TargetCluster]
+			if !ok {
+				// Normally this should never happen, as bindings on missing clusters are dangling ones.
+				remaining = append(remaining, binding)
+				continue
+			}
+			remove, err := shouldRemove(cluster)
+			if err != nil {
+				return nil, err
+			}
+			if remove {
+				toRemove = append(toRemove, binding)
+				continue
+			}
+			remaining = append(remaining, binding)
+		}
+		return remaining, nil
+	}
+	if remainingScheduled, err = partition(scheduled); err != nil {
+		return nil, nil, nil, err
+	}
+	if remainingBound, err = partition(bound); err != nil {
+		return nil, nil, nil, err
+	}
+	return remainingScheduled, remainingBound, toRemove, nil
+}
+
 // crossReferencePickedClustersAndDeDupBindings cross references picked clusters in the current scheduling
 // run and existing bindings to find out:
 //
@@ -175,8 +221,9 @@ func crossReferencePickedClustersAndDeDupBindings(
 		currentAnnotation := binding.GetAnnotations()
 		if previousState, exist := currentAnnotation[placementv1beta1.PreviousBindingStateAnnotation]; exist {
 			desiredState = placementv1beta1.BindingState(previousState)
-			// remove the annotation just to avoid confusion.
+			// remove the annotations just to avoid confusion.
 			delete(currentAnnotation, placementv1beta1.PreviousBindingStateAnnotation)
+			delete(currentAnnotation, placementv1beta1.KeepResourcesOnDeletionAnnotation)
 			binding.SetAnnotations(currentAnnotation)
 		} else {
 			return nil, nil, nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to find the previous state of an unscheduled binding: %+v", binding))
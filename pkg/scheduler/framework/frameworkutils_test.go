@@ -17,9 +17,14 @@ limitations under the License.
 package framework
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 )
@@ -165,3 +170,80 @@ func TestGenerateBinding(t *testing.T) {
 		})
 	}
 }
+
+func TestPartitionBindingsByCluster(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-2"}},
+	}
+	newBinding := func(name, clusterName string) placementv1beta1.BindingObj {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+			},
+		}
+	}
+	scheduled := []placementv1beta1.BindingObj{newBinding("scheduled-1", "cluster-1"), newBinding("scheduled-2", "cluster-2")}
+	bound := []placementv1beta1.BindingObj{newBinding("bound-1", "cluster-1"), newBinding("bound-3", "cluster-3")}
+
+	tests := []struct {
+		name          string
+		shouldRemove  func(cluster *clusterv1beta1.MemberCluster) (bool, error)
+		wantScheduled []placementv1beta1.BindingObj
+		wantBound     []placementv1beta1.BindingObj
+		wantToRemove  []placementv1beta1.BindingObj
+		expectedError bool
+	}{
+		{
+			name: "remove bindings on one cluster",
+			shouldRemove: func(cluster *clusterv1beta1.MemberCluster) (bool, error) {
+				return cluster.Name == "cluster-1", nil
+			},
+			wantScheduled: []placementv1beta1.BindingObj{newBinding("scheduled-2", "cluster-2")},
+			// Bindings on missing clusters are always kept.
+			wantBound:    []placementv1beta1.BindingObj{newBinding("bound-3", "cluster-3")},
+			wantToRemove: []placementv1beta1.BindingObj{newBinding("scheduled-1", "cluster-1"), newBinding("bound-1", "cluster-1")},
+		},
+		{
+			name: "remove no binding",
+			shouldRemove: func(_ *clusterv1beta1.MemberCluster) (bool, error) {
+				return false, nil
+			},
+			wantScheduled: scheduled,
+			wantBound:     bound,
+			wantToRemove:  []placementv1beta1.BindingObj{},
+		},
+		{
+			name: "error",
+			shouldRemove: func(_ *clusterv1beta1.MemberCluster) (bool, error) {
+				return false, errors.New("failed")
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remainingScheduled, remainingBound, toRemove, err := partitionBindingsByCluster(clusters, scheduled, bound, tt.shouldRemove)
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("partitionBindingsByCluster() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("partitionBindingsByCluster() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(remainingScheduled, tt.wantScheduled); diff != "" {
+				t.Errorf("partitionBindingsByCluster() remainingScheduled diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(remainingBound, tt.wantBound); diff != "" {
+				t.Errorf("partitionBindingsByCluster() remainingBound diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(toRemove, tt.wantToRemove); diff != "" {
+				t.Errorf("partitionBindingsByCluster() toRemove diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// SchedulerProfileNotFoundReason is the reason string of placement condition when the scheduling
+	// policy specifies a scheduler name that matches no scheduling profile.
+	SchedulerProfileNotFoundReason = "SchedulerProfileNotFound"
+
+	schedulerProfileNotFoundMessage = "no scheduling profile is named %s; the placement will not be scheduled until the scheduling policy specifies the name of a configured scheduling profile"
+)
+
+// multiProfileFramework implements the Framework interface; it runs each scheduling cycle with one
+// of multiple frameworks, each of which uses its own scheduling profile, per the scheduler name
+// specified in the scheduling policy.
+type multiProfileFramework struct {
+	// Framework is the framework that uses the default profile; it schedules the policies that do
+	// not specify a scheduler name, and serves the Handle methods.
+	Framework
+
+	// frameworks are the frameworks in use, keyed by the names of their profiles.
+	frameworks map[string]Framework
+}
+
+// NewMultiProfileFramework returns a Framework that schedules each policy with the profile whose name
+// matches the scheduler name specified in the policy; policies that do not specify a scheduler name
+// are scheduled with the profile of the given default name.
+func NewMultiProfileFramework(profiles []*Profile, defaultProfileName string, manager ctrl.Manager, opts ...Option) (Framework, error) {
+	f := &multiProfileFramework{
+		frameworks: make(map[string]Framework, len(profiles)),
+	}
+	for _, profile := range profiles {
+		if _, ok := f.frameworks[profile.Name()]; ok {
+			return nil, fmt.Errorf("scheduling profile %s is specified more than once", profile.Name())
+		}
+		f.frameworks[profile.Name()] = NewFramework(profile, manager, opts...)
+	}
+
+	defaultFramework, ok := f.frameworks[defaultProfileName]
+	if !ok {
+		return nil, fmt.Errorf("default scheduling profile %s is not found", defaultProfileName)
+	}
+	f.Framework = defaultFramework
+	return f, nil
+}
+
+// frameworkFor returns the framework that schedules the given policy, and whether such a framework
+// is found.
+func (f *multiProfileFramework) frameworkFor(policy placementv1beta1.PolicySnapshotObj) (fw Framework, schedulerName string, found bool) {
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || len(spec.Policy.SchedulerName) == 0 {
+		return f.Framework, "", true
+	}
+
+	fw, found = f.frameworks[spec.Policy.SchedulerName]
+	return fw, spec.Policy.SchedulerName, found
+}
+
+// RunSchedulingCycleFor performs scheduling for a resource placement with the profile that
+// the scheduling policy selects.
+//
+// If no profile is named as the scheduling policy specifies, the policy snapshot is marked as not
+// scheduled and the placement is not requeued; the scheduler will process it again when the policy
+// changes, or when the scheduler restarts with a new set of profiles.
+func (f *multiProfileFramework) RunSchedulingCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj) (result ctrl.Result, err error) {
+	fw, schedulerName, found := f.frameworkFor(policy)
+	if !found {
+		klog.ErrorS(fmt.Errorf("no scheduling profile is named %s", schedulerName), "Failed to find the scheduling profile", "placement", placementKey, "policySnapshot", klog.KObj(policy))
+		return ctrl.Result{}, f.markSchedulerProfileNotFound(ctx, policy, schedulerName)
+	}
+	return fw.RunSchedulingCycleFor(ctx, placementKey, policy)
+}
+
+// markSchedulerProfileNotFound sets the scheduled condition of a policy snapshot to false, as the
+// scheduler name the policy specifies matches no scheduling profile.
+func (f *multiProfileFramework) markSchedulerProfileNotFound(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, schedulerName string) error {
+	policyRef := klog.KObj(policy)
+
+	// Retrieve the corresponding placement generation.
+	observedCRPGeneration, err := annotations.ExtractObservedPlacementGenerationFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to retrieve placement generation from annotation", "policySnapshot", policyRef)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+
+	newCondition := newScheduledCondition(policy, metav1.ConditionFalse, SchedulerProfileNotFoundReason, fmt.Sprintf(schedulerProfileNotFoundMessage, schedulerName))
+	policyStatus := policy.GetPolicySnapshotStatus()
+	currentCondition := meta.FindStatusCondition(policyStatus.Conditions, string(placementv1beta1.PolicySnapshotScheduled))
+	if observedCRPGeneration == policyStatus.ObservedCRPGeneration && condition.EqualCondition(currentCondition, &newCondition) {
+		// Skip if there is no change in the condition.
+		return nil
+	}
+
+	policyStatus.ObservedCRPGeneration = observedCRPGeneration
+	meta.SetStatusCondition(&policyStatus.Conditions, newCondition)
+	if err := f.Client().Status().Update(ctx, policy); err != nil {
+		klog.ErrorS(err, "Failed to update policy snapshot status", "policySnapshot", policyRef)
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
+
+// SimulateSchedulingFor runs the scheduler plugins of the profile that the scheduling policy selects
+// for a scheduling policy snapshot that is not persisted.
+func (f *multiProfileFramework) SimulateSchedulingFor(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (decisions []placementv1beta1.ClusterDecision, results []placementv1beta1.ClusterSimulationResult, err error) {
+	fw, schedulerName, found := f.frameworkFor(policy)
+	if !found {
+		return nil, nil, controller.NewUserError(fmt.Errorf("no scheduling profile is named %s", schedulerName))
+	}
+	return fw.SimulateSchedulingFor(ctx, policy)
+}
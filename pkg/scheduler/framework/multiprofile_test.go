@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+// namedFramework is a Framework with a name, for identifying which framework is picked.
+type namedFramework struct {
+	Framework
+
+	name string
+}
+
+// TestMultiProfileFrameworkFor tests that the multi-profile framework picks the framework per the
+// scheduler name in the scheduling policy.
+func TestMultiProfileFrameworkFor(t *testing.T) {
+	defaultFramework := &namedFramework{name: "DefaultProfile"}
+	customFramework := &namedFramework{name: "custom"}
+	f := &multiProfileFramework{
+		Framework: defaultFramework,
+		frameworks: map[string]Framework{
+			"DefaultProfile": defaultFramework,
+			"custom":         customFramework,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		policy        *placementv1beta1.PlacementPolicy
+		wantFramework string
+		wantNotFound  bool
+	}{
+		{
+			name:          "nil policy",
+			wantFramework: "DefaultProfile",
+		},
+		{
+			name:          "no scheduler name",
+			policy:        &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType},
+			wantFramework: "DefaultProfile",
+		},
+		{
+			name:          "custom scheduler name",
+			policy:        &placementv1beta1.PlacementPolicy{SchedulerName: "custom"},
+			wantFramework: "custom",
+		},
+		{
+			name:         "unknown scheduler name",
+			policy:       &placementv1beta1.PlacementPolicy{SchedulerName: "unknown"},
+			wantNotFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: tc.policy,
+				},
+			}
+			fw, _, found := f.frameworkFor(policy)
+			if found == tc.wantNotFound {
+				t.Fatalf("frameworkFor() found = %t, want %t", found, !tc.wantNotFound)
+			}
+			if !found {
+				return
+			}
+			if got := fw.(*namedFramework).name; got != tc.wantFramework {
+				t.Errorf("frameworkFor() = %s, want %s", got, tc.wantFramework)
+			}
+		})
+	}
+}
+
+// TestMultiProfileFrameworkRunSchedulingCycleForUnknownProfile tests that the multi-profile framework
+// marks a policy snapshot that specifies an unknown scheduler name as not scheduled, without requeuing.
+func TestMultiProfileFrameworkRunSchedulingCycleForUnknownProfile(t *testing.T) {
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       policyName,
+			Generation: 1,
+			Annotations: map[string]string{
+				placementv1beta1.CRPGenerationAnnotation: "2",
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{SchedulerName: "unknown"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(policy).
+		WithStatusSubresource(policy).
+		Build()
+	// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+	defaultFramework := &framework{client: fakeClient}
+	f := &multiProfileFramework{
+		Framework:  defaultFramework,
+		frameworks: map[string]Framework{"DefaultProfile": defaultFramework},
+	}
+
+	ctx := context.Background()
+	res, err := f.RunSchedulingCycleFor(ctx, queue.PlacementKey(crpName), policy)
+	if err != nil {
+		t.Fatalf("RunSchedulingCycleFor() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(res, ctrl.Result{}); diff != "" {
+		t.Errorf("RunSchedulingCycleFor() result diff (-got, +want):\n%s", diff)
+	}
+
+	updatedPolicy := &placementv1beta1.ClusterSchedulingPolicySnapshot{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: policyName}, updatedPolicy); err != nil {
+		t.Fatalf("Get() policy snapshot = %v, want no error", err)
+	}
+	wantStatus := placementv1beta1.SchedulingPolicySnapshotStatus{
+		ObservedCRPGeneration: 2,
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.PolicySnapshotScheduled),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             SchedulerProfileNotFoundReason,
+				Message:            fmt.Sprintf(schedulerProfileNotFoundMessage, "unknown"),
+			},
+		},
+	}
+	if diff := cmp.Diff(updatedPolicy.Status, wantStatus, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("policy snapshot status diff (-got, +want):\n%s", diff)
+	}
+}
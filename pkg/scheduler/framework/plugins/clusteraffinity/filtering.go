@@ -21,6 +21,7 @@ import (
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clusterselector"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 )
 
@@ -30,12 +31,7 @@ func (p *Plugin) PreFilter(
 	_ framework.CycleStatePluginReadWriter,
 	ps placementv1beta1.PolicySnapshotObj,
 ) (status *framework.Status) {
-	noRequiredClusterAffinityTerms := ps.GetPolicySnapshotSpec().Policy == nil ||
-		ps.GetPolicySnapshotSpec().Policy.Affinity == nil ||
-		ps.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity == nil ||
-		ps.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil ||
-		len(ps.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution.ClusterSelectorTerms) == 0
-	if noRequiredClusterAffinityTerms {
+	if len(requiredClusterSelectorsOf(ps)) == 0 {
 		// There are no required cluster affinity terms to enforce; consider all clusters
 		// eligible for resource placement in the scope of this plugin.
 		//
@@ -56,27 +52,44 @@ func (p *Plugin) Filter(
 	// Note that this extension point assumes that previous extension point (PreFilter) has
 	// guaranteed that if scheduling policy reaches this stage, it must have at least one
 	// required cluster affinity term to enforce.
-
-	for idx := range ps.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution.ClusterSelectorTerms {
-		t := &ps.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution.ClusterSelectorTerms[idx]
-		r := clusterRequirement{
-			ClusterSelectorTerm: *t,
-		}
-		isMatched, err := r.Matches(cluster)
+	//
+	// Both the terms required during scheduling only and the terms required during execution
+	// as well must be met at scheduling time; the results are AND'd.
+	for _, selector := range requiredClusterSelectorsOf(ps) {
+		isMatched, err := clusterselector.Matches(selector, cluster)
 		if err != nil {
 			// An error has occurred when matching the cluster against a required affinity term.
 			return framework.FromError(err, p.Name(), "failed to match the cluster against a required affinity term")
 		}
-		if isMatched {
-			// The cluster matches with the required affinity term; mark it as eligible for
-			// resource placement.
+		if !isMatched {
+			// The cluster does not match any of the required affinity terms; consider it ineligible
+			// for resource placement in the scope of this plugin.
 			//
 			// Note that when there are multiple cluster selector terms, the results are OR'd.
-			return nil
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster does not match with any of the required cluster affinity terms")
 		}
 	}
 
-	// The cluster does not match any of the required affinity terms; consider it ineligible for resource
-	// placement in the scope of this plugin.
-	return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster does not match with any of the required cluster affinity terms")
+	// The cluster matches with the required affinity terms; mark it as eligible for resource placement.
+	return nil
+}
+
+// requiredClusterSelectorsOf returns the required cluster affinity selectors, with at least one
+// term, in a scheduling policy.
+func requiredClusterSelectorsOf(ps placementv1beta1.PolicySnapshotObj) []*placementv1beta1.ClusterSelector {
+	policy := ps.GetPolicySnapshotSpec().Policy
+	if policy == nil || policy.Affinity == nil || policy.Affinity.ClusterAffinity == nil {
+		return nil
+	}
+
+	selectors := make([]*placementv1beta1.ClusterSelector, 0, 2)
+	for _, selector := range []*placementv1beta1.ClusterSelector{
+		policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		policy.Affinity.ClusterAffinity.RequiredDuringSchedulingRequiredDuringExecution,
+	} {
+		if selector != nil && len(selector.ClusterSelectorTerms) > 0 {
+			selectors = append(selectors, selector)
+		}
+	}
+	return selectors
 }
@@ -21,6 +21,7 @@ import (
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 )
 
@@ -59,7 +60,7 @@ func preparePluginState(state framework.CycleStatePluginReadWriter, policy place
 
 			for cidx := range cs {
 				c := &cs[cidx]
-				q, err := retrievePropertyValueFrom(c, n)
+				q, err := propertyprovider.RetrievePropertyValueFrom(c, n)
 				if err != nil {
 					// An error has occurred when retrieving the property value from the cluster.
 					//
@@ -21,7 +21,6 @@ import (
 	"math"
 	"testing"
 
-	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,673 +36,6 @@ const (
 	invalidNonResourcePropertyName     = "invalid-non-resource-property"
 )
 
-// TestRetrieveResourceUsageFrom tests the retrieveResourceUsageFrom function.
-func TestRetrieveResourceUsageFrom(t *testing.T) {
-	cluster := &clusterv1beta1.MemberCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterName1,
-		},
-		Status: clusterv1beta1.MemberClusterStatus{
-			ResourceUsage: clusterv1beta1.ResourceUsage{
-				Capacity: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("10"),
-					corev1.ResourceMemory: resource.MustParse("40Gi"),
-				},
-				Allocatable: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: resource.MustParse("36Gi"),
-				},
-				Available: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("4Gi"),
-				},
-			},
-		},
-	}
-
-	testCases := []struct {
-		name           string
-		cluster        *clusterv1beta1.MemberCluster
-		propertyName   string
-		wantQuantity   *resource.Quantity
-		expectedToFail bool
-	}{
-		{
-			name:           "invalid property name (multiple segments)",
-			propertyName:   "resources.kubernetes-fleet.io/allocatable-cpu",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (no capacity type)",
-			propertyName:   "-cpu",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (no resource name)",
-			propertyName:   "allocatable-",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (not a known capacity type)",
-			propertyName:   "additional-",
-			expectedToFail: true,
-		},
-		{
-			name:         "resource not available",
-			propertyName: "allocatable-gpu",
-			cluster:      cluster,
-		},
-		{
-			name:         "total capacity usage",
-			propertyName: "total-cpu",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("10")),
-		},
-		{
-			name:         "allocatable capacity usage",
-			propertyName: "allocatable-memory",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("36Gi")),
-		},
-		{
-			name:         "available capacity usage",
-			propertyName: "available-cpu",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("2")),
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			q, err := retrieveResourceUsageFrom(tc.cluster, tc.propertyName)
-			if tc.expectedToFail {
-				if err == nil {
-					t.Errorf("retrieveResourceUsageFrom(), want error, got nil")
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("retrieveResourceUsageFrom() = %v, want nil", err)
-			}
-			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
-				t.Errorf("retrieveResourceUsageFrom() quantity diff (-got, +want): %s\n", diff)
-			}
-		})
-	}
-}
-
-// TestRetrievePropertyValueFrom tests the retrievePropertyValueFrom function.
-func TestRetrievePropertyValueFrom(t *testing.T) {
-	cluster := &clusterv1beta1.MemberCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterName1,
-		},
-		Status: clusterv1beta1.MemberClusterStatus{
-			ResourceUsage: clusterv1beta1.ResourceUsage{
-				Capacity: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("10"),
-					corev1.ResourceMemory: resource.MustParse("40Gi"),
-				},
-				Allocatable: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: resource.MustParse("36Gi"),
-				},
-				Available: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("4Gi"),
-				},
-			},
-			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
-				propertyprovider.NodeCountProperty: {
-					Value: "4",
-				},
-				invalidNonResourcePropertyName: {
-					Value: "invalid",
-				},
-			},
-		},
-	}
-
-	testCases := []struct {
-		name           string
-		cluster        *clusterv1beta1.MemberCluster
-		propertyName   string
-		wantQuantity   *resource.Quantity
-		expectedToFail bool
-	}{
-		{
-			name:           "invalid resource property (name format error)",
-			propertyName:   "resources.kubernetes-fleet.io/allocatable",
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name:         "resource property retrieval",
-			propertyName: propertyprovider.AvailableMemoryCapacityProperty,
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("4Gi")),
-		},
-		{
-			name:         "absent non-resource property",
-			propertyName: nonExistentNonResourcePropertyName,
-			cluster:      cluster,
-		},
-		{
-			name:           "invalid non-resource property (value format error)",
-			propertyName:   invalidNonResourcePropertyName,
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name:         "non-resource property retrieval",
-			propertyName: propertyprovider.NodeCountProperty,
-			wantQuantity: ptr.To(resource.MustParse("4")),
-			cluster:      cluster,
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			q, err := retrievePropertyValueFrom(tc.cluster, tc.propertyName)
-			if tc.expectedToFail {
-				if err == nil {
-					t.Errorf("retrievePropertyValueFrom(), want error, got nil")
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("retrievePropertyValueFrom() = %v, want nil", err)
-			}
-			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
-				t.Errorf("retrievePropertyValueFrom() quantity diff (-got, +want): %s\n", diff)
-			}
-		})
-	}
-}
-
-// TestClusterRequirementMatches tests the Matches method on clusterRequirement pointers.
-func TestClusterRequirementMatches(t *testing.T) {
-	cluster := &clusterv1beta1.MemberCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterName1,
-			Labels: map[string]string{
-				envLabelName:    envLabelValue1,
-				regionLabelName: regionLabelValue1,
-			},
-		},
-		Status: clusterv1beta1.MemberClusterStatus{
-			ResourceUsage: clusterv1beta1.ResourceUsage{
-				Capacity: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("10"),
-					corev1.ResourceMemory: resource.MustParse("40Gi"),
-				},
-				Allocatable: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: resource.MustParse("36Gi"),
-				},
-				Available: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("4Gi"),
-				},
-			},
-			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
-				propertyprovider.NodeCountProperty: {
-					Value: "4",
-				},
-				invalidNonResourcePropertyName: {
-					Value: "invalid",
-				},
-			},
-		},
-	}
-
-	testCases := []struct {
-		name               string
-		clusterRequirement *clusterRequirement
-		cluster            *clusterv1beta1.MemberCluster
-		want               bool
-		expectedToFail     bool
-	}{
-		{
-			name: "invalid label selector",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					LabelSelector: &metav1.LabelSelector{
-						MatchExpressions: []metav1.LabelSelectorRequirement{
-							{
-								Key:      regionLabelName,
-								Operator: metav1.LabelSelectorOperator("invalid"),
-								Values: []string{
-									regionLabelValue1,
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "label selector mismatches",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					LabelSelector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							envLabelName: envLabelValue2,
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    false,
-		},
-		{
-			name: "label selector matches, no property selector",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					LabelSelector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							envLabelName: envLabelValue1,
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "label selector matches, no expressions in the property selector",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					LabelSelector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							envLabelName: envLabelValue1,
-						},
-					},
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "invalid resource property name",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     "resources.kubernetes-fleet.io/cpu",
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"2",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "property not found",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     nonExistentNonResourcePropertyName,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"0",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "multiple value options",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.NodeCountProperty,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"1",
-									"2",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "invalid property value",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     invalidNonResourcePropertyName,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"1",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "invalid value option",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.NodeCountProperty,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"invalid",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "invalid operator",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.NodeCountProperty,
-								Operator: "invalid",
-								Values: []string{
-									"1",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name: "op =, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.NodeCountProperty,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"4",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op =, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.NodeCountProperty,
-								Operator: placementv1beta1.PropertySelectorEqualTo,
-								Values: []string{
-									"8",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "op !=, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.TotalCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorNotEqualTo,
-								Values: []string{
-									"11",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op !=, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.TotalCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorNotEqualTo,
-								Values: []string{
-									"10",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "op >, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AllocatableMemoryCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorGreaterThan,
-								Values: []string{
-									"30Gi",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op >, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AllocatableMemoryCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorGreaterThan,
-								Values: []string{
-									"40Gi",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "op <, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AvailableCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorLessThan,
-								Values: []string{
-									"4",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op <, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AvailableCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorLessThan,
-								Values: []string{
-									"1",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "op >=, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.TotalMemoryCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-								Values: []string{
-									"40Gi",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op >=, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.TotalMemoryCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-								Values: []string{
-									"41Gi",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-		{
-			name: "op <=, matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AllocatableCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorLessThanOrEqualTo,
-								Values: []string{
-									"8",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-			want:    true,
-		},
-		{
-			name: "op <=, not matched",
-			clusterRequirement: &clusterRequirement{
-				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
-					PropertySelector: &placementv1beta1.PropertySelector{
-						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-							{
-								Name:     propertyprovider.AllocatableCPUCapacityProperty,
-								Operator: placementv1beta1.PropertySelectorLessThanOrEqualTo,
-								Values: []string{
-									"7",
-								},
-							},
-						},
-					},
-				},
-			},
-			cluster: cluster,
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			matches, err := tc.clusterRequirement.Matches(tc.cluster)
-			if tc.expectedToFail {
-				if err == nil {
-					t.Errorf("clusterRequirement.Matches(), want error, got nil")
-				}
-				return
-			}
-
-			if err != nil || matches != tc.want {
-				t.Errorf("clusterRequirement.Matches() = %v, %v, want %v, nil", matches, err, tc.want)
-			}
-		})
-	}
-}
-
 // TestClusterPreferenceScores tests the Scores method on clusterPreference pointers.
 func TestClusterPreferenceScores(t *testing.T) {
 	cluster := &clusterv1beta1.MemberCluster{
@@ -20,6 +20,8 @@ package clustereligibility
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -29,6 +31,9 @@ import (
 const (
 	// defaultPluginName is the default name of the plugin.
 	defaultPluginName = "ClusterEligibility"
+
+	// lostClusterReasonFmt is the reason format for filtering out a lost cluster.
+	lostClusterReasonFmt = "cluster has been unhealthy or disconnected from the fleet since %s"
 )
 
 // Plugin is the scheduler plugin that performs the cluster eligibility check.
@@ -101,12 +106,20 @@ func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
 func (p *Plugin) Filter(
 	_ context.Context,
 	_ framework.CycleStatePluginReadWriter,
-	_ placementv1beta1.PolicySnapshotObj,
+	policy placementv1beta1.PolicySnapshotObj,
 	cluster *clusterv1beta1.MemberCluster,
 ) (status *framework.Status) {
 	if eligible, reason := p.handle.ClusterEligibilityChecker().IsEligible(cluster); !eligible {
 		return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
 	}
 
+	// For placements with a failover policy, filter out the clusters that have been lost, so that
+	// the scheduler will not pick a cluster it would fail over from shortly.
+	if placementPolicy := policy.GetPolicySnapshotSpec().Policy; placementPolicy != nil && placementPolicy.FailoverPolicy != nil {
+		if lostSince, lost := p.handle.ClusterEligibilityChecker().LostSince(cluster); lost {
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), fmt.Sprintf(lostClusterReasonFmt, lostSince.Format(time.RFC3339)))
+		}
+	}
+
 	return nil
 }
@@ -281,3 +281,94 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+// TestFilterWithFailoverPolicy tests the Filter method with policies that have a failover policy.
+func TestFilterWithFailoverPolicy(t *testing.T) {
+	p := New()
+	p.SetUpWithFramework(&MockHandle{
+		clusterEligibilityChecker: clustereligibilitychecker.New(clustereligibilitychecker.WithClusterUnhealthyThreshold(time.Minute)),
+	})
+
+	clusterWithHealthyCondition := func(status metav1.ConditionStatus, lastTransitionTime time.Time) *clusterv1beta1.MemberCluster {
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				AgentStatus: []clusterv1beta1.AgentStatus{
+					{
+						Type: clusterv1beta1.MemberAgent,
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(clusterv1beta1.AgentJoined),
+								Status: metav1.ConditionTrue,
+							},
+							{
+								Type:               string(clusterv1beta1.AgentHealthy),
+								Status:             status,
+								LastTransitionTime: metav1.NewTime(lastTransitionTime),
+							},
+						},
+						LastReceivedHeartbeat: metav1.NewTime(time.Now()),
+					},
+				},
+			},
+		}
+	}
+	policyWithFailover := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:  placementv1beta1.PickNPlacementType,
+				FailoverPolicy: &placementv1beta1.FailoverPolicy{},
+			},
+		},
+	}
+	policyWithoutFailover := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+		},
+	}
+	testCases := []struct {
+		name    string
+		policy  placementv1beta1.PolicySnapshotObj
+		cluster *clusterv1beta1.MemberCluster
+		want    *framework.Status
+	}{
+		{
+			name:    "lost cluster, with failover policy",
+			policy:  policyWithFailover,
+			cluster: clusterWithHealthyCondition(metav1.ConditionFalse, time.Now().Add(-time.Minute*2)),
+			want:    framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName, ""),
+		},
+		{
+			name:    "lost cluster, without failover policy",
+			policy:  policyWithoutFailover,
+			cluster: clusterWithHealthyCondition(metav1.ConditionFalse, time.Now().Add(-time.Minute*2)),
+		},
+		{
+			name:    "healthy cluster, with failover policy",
+			policy:  policyWithFailover,
+			cluster: clusterWithHealthyCondition(metav1.ConditionTrue, time.Now().Add(-time.Minute*2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil)
+
+			status := p.Filter(ctx, state, tc.policy, tc.cluster)
+			if diff := cmp.Diff(status, tc.want, cmp.AllowUnexported(framework.Status{}), ignoredStatusFields); diff != "" {
+				t.Errorf("p.Filter() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
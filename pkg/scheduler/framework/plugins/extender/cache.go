@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheEntry is an extender response cached by the plugin.
+type cacheEntry struct {
+	val       interface{}
+	expiresAt time.Time
+}
+
+// responseCache caches extender responses for a fixed period of time, so that the plugin does
+// not call the extender repeatedly when the same placement is scheduled again and again.
+//
+// All methods are safe to call on a nil cache, which caches nothing.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+// newResponseCache returns a new responseCache.
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get returns the cached value under the given key, if it has not expired yet.
+func (c *responseCache) get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.val, true
+}
+
+// set caches a value under the given key; it also drops all expired entries.
+func (c *responseCache) set(key string, val interface{}) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{
+		val:       val,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// maxResponseBytes is the maximum size of an extender response the plugin reads.
+	maxResponseBytes = 10 << 20
+)
+
+// filter asks the extender which of the candidate clusters it rejects, and returns the reasons of
+// the rejections, keyed by cluster names.
+func (p *Plugin) filter(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) (map[string]string, error) {
+	key := cacheKey(filterVerb, policy, clusters)
+	if val, ok := p.cache.get(key); ok {
+		if failedClusters, ok := val.(map[string]string); ok {
+			return failedClusters, nil
+		}
+	}
+
+	res := &ExtenderFilterResult{}
+	if err := p.post(ctx, filterVerb, policy, clusters, res); err != nil {
+		return nil, err
+	}
+	if len(res.Error) != 0 {
+		return nil, fmt.Errorf("the extender failed to process the %s request: %s", filterVerb, res.Error)
+	}
+	p.cache.set(key, res.FailedClusters)
+	return res.FailedClusters, nil
+}
+
+// score asks the extender to score the candidate clusters, and returns the scores, keyed by
+// cluster names.
+func (p *Plugin) score(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) (map[string]int32, error) {
+	key := cacheKey(scoreVerb, policy, clusters)
+	if val, ok := p.cache.get(key); ok {
+		if scores, ok := val.(map[string]int32); ok {
+			return scores, nil
+		}
+	}
+
+	res := &ExtenderScoreResult{}
+	if err := p.post(ctx, scoreVerb, policy, clusters, res); err != nil {
+		return nil, err
+	}
+	if len(res.Error) != 0 {
+		return nil, fmt.Errorf("the extender failed to process the %s request: %s", scoreVerb, res.Error)
+	}
+	for clusterName, score := range res.Scores {
+		if score < 0 || score > MaxExtenderScore {
+			return nil, fmt.Errorf("the extender returned score %d for cluster %s, which is not in the range [0, %d]", score, clusterName, MaxExtenderScore)
+		}
+	}
+	p.cache.set(key, res.Scores)
+	return res.Scores, nil
+}
+
+// post sends a request to the extender and decodes the response into the given result.
+func (p *Plugin) post(ctx context.Context, verb string, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster, result interface{}) error {
+	body, err := json.Marshal(&ExtenderArgs{
+		PolicySnapshot: policy,
+		Clusters:       clusters,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode the %s request: %w", verb, err)
+	}
+
+	url := strings.TrimSuffix(p.url, "/") + "/" + verb
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build the %s request: %w", verb, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the %s request to the extender: %w", verb, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("the extender returned status code %d for the %s request", resp.StatusCode, verb)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode the extender response for the %s request: %w", verb, err)
+	}
+	return nil
+}
+
+// cacheKey returns the key under which the response to a request is cached.
+//
+// The key identifies the policy snapshot being scheduled and the set of candidate clusters, but not
+// the details of the clusters (e.g., their properties), which change frequently; as a result, changes
+// of the clusters are picked up by the extender only after the cached responses expire.
+func cacheKey(verb string, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) string {
+	names := make([]string, 0, len(clusters))
+	for idx := range clusters {
+		names = append(names, clusters[idx].Name)
+	}
+	slices.Sort(names)
+	hash := sha256.Sum256([]byte(strings.Join(names, ",")))
+	return fmt.Sprintf("%s/%s/%s/%d/%s/%s", verb, policy.GetNamespace(), policy.GetName(), policy.GetGeneration(), policy.GetResourceVersion(), hex.EncodeToString(hash[:]))
+}
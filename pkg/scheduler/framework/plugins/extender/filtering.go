@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling
+// framework.
+//
+// The plugin sends all the candidate clusters to the extender in one filter request, and saves the
+// response in the cycle state for the Filter stage.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	if len(p.url) == 0 {
+		// No extender is configured; skip the step.
+		//
+		// Note that this will also skip the Filter() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no extender configured")
+	}
+
+	failedClusters, err := p.filter(ctx, policy, state.ListClusters())
+	if err != nil {
+		return p.handleExtenderError(err, policy)
+	}
+	p.readOrInitPluginState(state).failedClusters = failedClusters
+
+	// All done.
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	if reason, rejected := ps.failedClusters[cluster.Name]; rejected {
+		return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), fmt.Sprintf("the extender rejected the cluster: %s", reason))
+	}
+	return nil
+}
+
+// handleExtenderError handles an error that occurs when calling the extender per the failure policy
+// of the plugin.
+func (p *Plugin) handleExtenderError(err error, policy placementv1beta1.PolicySnapshotObj) *framework.Status {
+	if p.failurePolicy == FailurePolicyIgnore {
+		// Skip the extender, which will also skip the Filter() (or Score()) extension point
+		// for the plugin.
+		klog.ErrorS(err, "Failed to call the scheduler extender; ignoring it per the failure policy", "plugin", p.Name(), "policySnapshot", klog.KObj(policy))
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), fmt.Sprintf("the extender is ignored as it failed: %v", err))
+	}
+	return framework.FromError(err, p.Name(), "failed to call the extender")
+}
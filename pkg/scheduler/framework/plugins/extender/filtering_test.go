@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	policyName   = "app-1"
+	clusterName1 = "member-1"
+	clusterName2 = "member-2"
+	clusterName3 = "member-3"
+)
+
+var (
+	cmpStatusOptions = cmp.Options{
+		cmpopts.IgnoreFields(framework.Status{}, "reasons", "err"),
+		cmp.AllowUnexported(framework.Status{}),
+	}
+	defaultPluginName = defaultPluginOptions.name
+
+	policy = &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       policyName,
+			Generation: 1,
+		},
+	}
+	clusters = []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName2}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName3}},
+	}
+)
+
+// testExtenderArgs is the request body a test extender receives.
+type testExtenderArgs struct {
+	PolicySnapshot placementv1beta1.ClusterSchedulingPolicySnapshot `json:"policySnapshot"`
+	Clusters       []clusterv1beta1.MemberCluster                   `json:"clusters"`
+}
+
+// newTestExtender returns a test extender that replies to requests with the given verb using the
+// given status code and response body; it also records the number of requests it receives and
+// the names of the clusters in the last request.
+func newTestExtender(t *testing.T, verb string, statusCode int, resp interface{}, calls *atomic.Int32, gotClusterNames *[]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/"+verb {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls.Add(1)
+
+		args := testExtenderArgs{}
+		if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if args.PolicySnapshot.Name != policyName {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		names := make([]string, 0, len(args.Clusters))
+		for idx := range args.Clusters {
+			names = append(names, args.Clusters[idx].Name)
+		}
+		*gotClusterNames = names
+
+		w.WriteHeader(statusCode)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("failed to encode the extender response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestPreFilterAndFilter tests the PreFilter and Filter extension points of the plugin.
+func TestPreFilterAndFilter(t *testing.T) {
+	testCases := []struct {
+		name               string
+		statusCode         int
+		resp               interface{}
+		failurePolicy      FailurePolicy
+		wantPreFilter      *framework.Status
+		wantFilterStatuses map[string]*framework.Status
+	}{
+		{
+			name:       "extender rejects a cluster",
+			statusCode: http.StatusOK,
+			resp: &ExtenderFilterResult{
+				FailedClusters: map[string]string{clusterName2: "insufficient capacity"},
+			},
+			failurePolicy: FailurePolicyFail,
+			wantFilterStatuses: map[string]*framework.Status{
+				clusterName1: nil,
+				clusterName2: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+				clusterName3: nil,
+			},
+		},
+		{
+			name:          "extender accepts all clusters",
+			statusCode:    http.StatusOK,
+			resp:          &ExtenderFilterResult{},
+			failurePolicy: FailurePolicyFail,
+			wantFilterStatuses: map[string]*framework.Status{
+				clusterName1: nil,
+				clusterName2: nil,
+				clusterName3: nil,
+			},
+		},
+		{
+			name:          "extender returns an error, failure policy Fail",
+			statusCode:    http.StatusOK,
+			resp:          &ExtenderFilterResult{Error: "planner unavailable"},
+			failurePolicy: FailurePolicyFail,
+			wantPreFilter: framework.FromError(context.DeadlineExceeded, defaultPluginName),
+		},
+		{
+			name:          "extender returns a non-OK status code, failure policy Fail",
+			statusCode:    http.StatusInternalServerError,
+			resp:          &ExtenderFilterResult{},
+			failurePolicy: FailurePolicyFail,
+			wantPreFilter: framework.FromError(context.DeadlineExceeded, defaultPluginName),
+		},
+		{
+			name:          "extender returns a non-OK status code, failure policy Ignore",
+			statusCode:    http.StatusInternalServerError,
+			resp:          &ExtenderFilterResult{},
+			failurePolicy: FailurePolicyIgnore,
+			wantPreFilter: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := atomic.Int32{}
+			var gotClusterNames []string
+			server := newTestExtender(t, filterVerb, tc.statusCode, tc.resp, &calls, &gotClusterNames)
+			p := New(WithURL(server.URL), WithFailurePolicy(tc.failurePolicy), WithCacheTTL(0))
+			state := framework.NewCycleState(clusters, nil, nil)
+
+			status := p.PreFilter(context.Background(), state, policy)
+			if diff := cmp.Diff(status, tc.wantPreFilter, cmpStatusOptions); diff != "" {
+				t.Fatalf("PreFilter() status diff (-got, +want): %s", diff)
+			}
+			wantClusterNames := []string{clusterName1, clusterName2, clusterName3}
+			if diff := cmp.Diff(gotClusterNames, wantClusterNames); diff != "" {
+				t.Errorf("extender request clusters diff (-got, +want): %s", diff)
+			}
+			if tc.wantPreFilter != nil {
+				return
+			}
+
+			for idx := range clusters {
+				status := p.Filter(context.Background(), state, policy, &clusters[idx])
+				if diff := cmp.Diff(status, tc.wantFilterStatuses[clusters[idx].Name], cmpStatusOptions); diff != "" {
+					t.Errorf("Filter(%s) status diff (-got, +want): %s", clusters[idx].Name, diff)
+				}
+			}
+		})
+	}
+}
+
+// TestPreFilterNoURL tests that the plugin skips filtering when no extender is configured.
+func TestPreFilterNoURL(t *testing.T) {
+	p := New()
+	state := framework.NewCycleState(clusters, nil, nil)
+	status := p.PreFilter(context.Background(), state, policy)
+	wantStatus := framework.NewNonErrorStatus(framework.Skip, defaultPluginName)
+	if diff := cmp.Diff(status, wantStatus, cmpStatusOptions); diff != "" {
+		t.Errorf("PreFilter() status diff (-got, +want): %s", diff)
+	}
+}
+
+// TestPreFilterTimeout tests that the plugin stops waiting for the extender after the timeout.
+func TestPreFilterTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	p := New(WithURL(server.URL), WithTimeout(100*time.Millisecond))
+	state := framework.NewCycleState(clusters, nil, nil)
+	status := p.PreFilter(context.Background(), state, policy)
+	wantStatus := framework.FromError(context.DeadlineExceeded, defaultPluginName)
+	if diff := cmp.Diff(status, wantStatus, cmpStatusOptions); diff != "" {
+		t.Errorf("PreFilter() status diff (-got, +want): %s", diff)
+	}
+}
+
+// TestPreFilterCache tests that the plugin caches the extender responses.
+func TestPreFilterCache(t *testing.T) {
+	calls := atomic.Int32{}
+	var gotClusterNames []string
+	resp := &ExtenderFilterResult{
+		FailedClusters: map[string]string{clusterName1: "insufficient capacity"},
+	}
+	server := newTestExtender(t, filterVerb, http.StatusOK, resp, &calls, &gotClusterNames)
+	p := New(WithURL(server.URL), WithCacheTTL(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		state := framework.NewCycleState(clusters, nil, nil)
+		if status := p.PreFilter(context.Background(), state, policy); !status.IsSuccess() {
+			t.Fatalf("PreFilter() = %v, want success", status)
+		}
+		if status := p.Filter(context.Background(), state, policy, &clusters[0]); !status.IsClusterUnschedulable() {
+			t.Fatalf("Filter(%s) = %v, want cluster unschedulable", clusterName1, status)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("extender calls = %d, want 1", got)
+	}
+
+	// A different set of candidate clusters invalidates the cache.
+	state := framework.NewCycleState(clusters[:2], nil, nil)
+	if status := p.PreFilter(context.Background(), state, policy); !status.IsSuccess() {
+		t.Fatalf("PreFilter() = %v, want success", status)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("extender calls = %d, want 2", got)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package extender features a scheduler plugin that delegates filtering and scoring decisions to
+// an external HTTP(S) endpoint, so that out-of-tree services (e.g., a capacity planning service) can
+// veto or rank clusters without changes to the scheduler itself.
+package extender
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// FailurePolicy specifies how the plugin handles the errors that occur when calling the extender.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail fails the scheduling cycle (which will be retried later) when the
+	// extender cannot be reached or returns an invalid response.
+	FailurePolicyFail FailurePolicy = "Fail"
+
+	// FailurePolicyIgnore ignores the extender when it cannot be reached or returns an invalid
+	// response, i.e., all clusters pass the extender's filter and receive no extender score.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// Plugin is the scheduler plugin that calls an external HTTP(S) endpoint, i.e., the extender,
+// for filtering and scoring decisions.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// The framework handle.
+	handle framework.Handle
+
+	// The URL prefix of the extender; the plugin sends filter requests to {url}/filter and score
+	// requests to {url}/score.
+	url string
+	// The HTTP client for calling the extender.
+	httpClient *http.Client
+	// How the plugin handles the errors that occur when calling the extender.
+	failurePolicy FailurePolicy
+	// The weight of the extender score; a weight of 0 disables scoring.
+	scoreWeight int32
+	// The cache of extender responses; it is nil if caching is disabled.
+	cache *responseCache
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * PreFilter
+	// * Filter
+	// * PreScore
+	// * Score
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.PreFilterPlugin = &Plugin{}
+	_ framework.FilterPlugin    = &Plugin{}
+	_ framework.PreScorePlugin  = &Plugin{}
+	_ framework.ScorePlugin     = &Plugin{}
+)
+
+type extenderPluginOptions struct {
+	// The name of the plugin.
+	name string
+	// The URL prefix of the extender.
+	url string
+	// The timeout for each call to the extender.
+	timeout time.Duration
+	// How the plugin handles the errors that occur when calling the extender.
+	failurePolicy FailurePolicy
+	// The weight of the extender score.
+	scoreWeight int32
+	// For how long an extender response is cached; a TTL of 0 disables caching.
+	cacheTTL time.Duration
+}
+
+type Option func(*extenderPluginOptions)
+
+var defaultPluginOptions = extenderPluginOptions{
+	name:          "Extender",
+	timeout:       5 * time.Second,
+	failurePolicy: FailurePolicyFail,
+	scoreWeight:   1,
+	cacheTTL:      30 * time.Second,
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *extenderPluginOptions) {
+		o.name = name
+	}
+}
+
+// WithURL sets the URL prefix of the extender.
+func WithURL(url string) Option {
+	return func(o *extenderPluginOptions) {
+		o.url = url
+	}
+}
+
+// WithTimeout sets the timeout for each call to the extender.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *extenderPluginOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithFailurePolicy sets how the plugin handles the errors that occur when calling the extender.
+func WithFailurePolicy(policy FailurePolicy) Option {
+	return func(o *extenderPluginOptions) {
+		o.failurePolicy = policy
+	}
+}
+
+// WithScoreWeight sets the weight of the extender score; a weight of 0 disables scoring.
+func WithScoreWeight(weight int32) Option {
+	return func(o *extenderPluginOptions) {
+		o.scoreWeight = weight
+	}
+}
+
+// WithCacheTTL sets for how long an extender response is cached; a TTL of 0 disables caching.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(o *extenderPluginOptions) {
+		o.cacheTTL = ttl
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	p := Plugin{
+		name:          options.name,
+		url:           options.url,
+		httpClient:    &http.Client{Timeout: options.timeout},
+		failurePolicy: options.failurePolicy,
+		scoreWeight:   options.scoreWeight,
+	}
+	if options.cacheTTL > 0 {
+		p.cache = newResponseCache(options.cacheTTL)
+	}
+	return p
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"testing"
+	"time"
+)
+
+// TestNew tests the New function.
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name              string
+		opts              []Option
+		wantName          string
+		wantTimeout       time.Duration
+		wantFailurePolicy FailurePolicy
+		wantScoreWeight   int32
+		wantCache         bool
+	}{
+		{
+			name:              "default options",
+			opts:              nil,
+			wantName:          "Extender",
+			wantTimeout:       5 * time.Second,
+			wantFailurePolicy: FailurePolicyFail,
+			wantScoreWeight:   1,
+			wantCache:         true,
+		},
+		{
+			name: "custom options",
+			opts: []Option{
+				WithName("CapacityPlanner"),
+				WithURL("https://capacity-planner.example.com"),
+				WithTimeout(time.Second),
+				WithFailurePolicy(FailurePolicyIgnore),
+				WithScoreWeight(5),
+				WithCacheTTL(0),
+			},
+			wantName:          "CapacityPlanner",
+			wantTimeout:       time.Second,
+			wantFailurePolicy: FailurePolicyIgnore,
+			wantScoreWeight:   5,
+			wantCache:         false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New(tc.opts...)
+			if got := p.Name(); got != tc.wantName {
+				t.Errorf("New() name = %v, want %v", got, tc.wantName)
+			}
+			if got := p.httpClient.Timeout; got != tc.wantTimeout {
+				t.Errorf("New() timeout = %v, want %v", got, tc.wantTimeout)
+			}
+			if p.failurePolicy != tc.wantFailurePolicy {
+				t.Errorf("New() failure policy = %v, want %v", p.failurePolicy, tc.wantFailurePolicy)
+			}
+			if p.scoreWeight != tc.wantScoreWeight {
+				t.Errorf("New() score weight = %v, want %v", p.scoreWeight, tc.wantScoreWeight)
+			}
+			if gotCache := p.cache != nil; gotCache != tc.wantCache {
+				t.Errorf("New() cache enabled = %v, want %v", gotCache, tc.wantCache)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreScore allows the plugin to connect to the PreScore extension point in the scheduling
+// framework.
+//
+// The plugin sends all the candidate clusters that have not been rejected by the extender itself
+// to the extender in one score request, and saves the response in the cycle state for the Score stage.
+func (p *Plugin) PreScore(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	if len(p.url) == 0 || p.scoreWeight == 0 {
+		// No extender is configured, or the extender scores carry no weight; skip the step.
+		//
+		// Note that this will also skip the Score() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no extender configured for scoring")
+	}
+
+	ps := p.readOrInitPluginState(state)
+	clusters := state.ListClusters()
+	candidates := make([]clusterv1beta1.MemberCluster, 0, len(clusters))
+	for idx := range clusters {
+		if _, rejected := ps.failedClusters[clusters[idx].Name]; !rejected {
+			candidates = append(candidates, clusters[idx])
+		}
+	}
+
+	scores, err := p.score(ctx, policy, candidates)
+	if err != nil {
+		return p.handleExtenderError(err, policy)
+	}
+	ps.scores = scores
+
+	// All done.
+	return nil
+}
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+func (p *Plugin) Score(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreScore stage.
+		return nil, framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	// Clusters that the extender does not score receive a score of 0.
+	return &framework.ClusterScore{
+		AffinityScore: p.scoreWeight * ps.scores[cluster.Name],
+	}, nil
+}
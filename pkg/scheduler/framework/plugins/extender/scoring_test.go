@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// TestPreScoreAndScore tests the PreScore and Score extension points of the plugin.
+func TestPreScoreAndScore(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		statusCode           int
+		resp                 interface{}
+		failurePolicy        FailurePolicy
+		scoreWeight          int32
+		failedClusters       map[string]string
+		wantPreScore         *framework.Status
+		wantClusterNames     []string
+		wantScores           map[string]*framework.ClusterScore
+		wantNoExtenderCalled bool
+	}{
+		{
+			name:       "extender scores clusters",
+			statusCode: http.StatusOK,
+			resp: &ExtenderScoreResult{
+				Scores: map[string]int32{clusterName1: 10, clusterName2: 100},
+			},
+			failurePolicy:    FailurePolicyFail,
+			scoreWeight:      2,
+			wantClusterNames: []string{clusterName1, clusterName2, clusterName3},
+			wantScores: map[string]*framework.ClusterScore{
+				clusterName1: {AffinityScore: 20},
+				clusterName2: {AffinityScore: 200},
+				clusterName3: {AffinityScore: 0},
+			},
+		},
+		{
+			name:       "clusters rejected by the extender are not sent for scoring",
+			statusCode: http.StatusOK,
+			resp: &ExtenderScoreResult{
+				Scores: map[string]int32{clusterName1: 10},
+			},
+			failurePolicy:    FailurePolicyFail,
+			scoreWeight:      1,
+			failedClusters:   map[string]string{clusterName2: "insufficient capacity"},
+			wantClusterNames: []string{clusterName1, clusterName3},
+			wantScores: map[string]*framework.ClusterScore{
+				clusterName1: {AffinityScore: 10},
+				clusterName3: {AffinityScore: 0},
+			},
+		},
+		{
+			name:       "extender returns an out-of-range score, failure policy Fail",
+			statusCode: http.StatusOK,
+			resp: &ExtenderScoreResult{
+				Scores: map[string]int32{clusterName1: 101},
+			},
+			failurePolicy:    FailurePolicyFail,
+			scoreWeight:      1,
+			wantPreScore:     framework.FromError(context.DeadlineExceeded, defaultPluginName),
+			wantClusterNames: []string{clusterName1, clusterName2, clusterName3},
+		},
+		{
+			name:             "extender returns an error, failure policy Ignore",
+			statusCode:       http.StatusOK,
+			resp:             &ExtenderScoreResult{Error: "planner unavailable"},
+			failurePolicy:    FailurePolicyIgnore,
+			scoreWeight:      1,
+			wantPreScore:     framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+			wantClusterNames: []string{clusterName1, clusterName2, clusterName3},
+		},
+		{
+			name:                 "score weight of 0",
+			statusCode:           http.StatusOK,
+			resp:                 &ExtenderScoreResult{},
+			failurePolicy:        FailurePolicyFail,
+			scoreWeight:          0,
+			wantPreScore:         framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+			wantNoExtenderCalled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := atomic.Int32{}
+			var gotClusterNames []string
+			server := newTestExtender(t, scoreVerb, tc.statusCode, tc.resp, &calls, &gotClusterNames)
+			p := New(WithURL(server.URL), WithFailurePolicy(tc.failurePolicy), WithScoreWeight(tc.scoreWeight), WithCacheTTL(0))
+			state := framework.NewCycleState(clusters, nil, nil)
+			if tc.failedClusters != nil {
+				state.Write(framework.StateKey(p.Name()), &pluginState{failedClusters: tc.failedClusters})
+			}
+
+			status := p.PreScore(context.Background(), state, policy)
+			if diff := cmp.Diff(status, tc.wantPreScore, cmpStatusOptions); diff != "" {
+				t.Fatalf("PreScore() status diff (-got, +want): %s", diff)
+			}
+			if tc.wantNoExtenderCalled {
+				if got := calls.Load(); got != 0 {
+					t.Errorf("extender calls = %d, want 0", got)
+				}
+				return
+			}
+			if diff := cmp.Diff(gotClusterNames, tc.wantClusterNames); diff != "" {
+				t.Errorf("extender request clusters diff (-got, +want): %s", diff)
+			}
+			if tc.wantPreScore != nil {
+				return
+			}
+
+			for idx := range clusters {
+				wantScore, ok := tc.wantScores[clusters[idx].Name]
+				if !ok {
+					continue
+				}
+				score, status := p.Score(context.Background(), state, policy, &clusters[idx])
+				if !status.IsSuccess() {
+					t.Fatalf("Score(%s) = %v, want success", clusters[idx].Name, status)
+				}
+				if diff := cmp.Diff(score, wantScore); diff != "" {
+					t.Errorf("Score(%s) diff (-got, +want): %s", clusters[idx].Name, diff)
+				}
+			}
+		})
+	}
+}
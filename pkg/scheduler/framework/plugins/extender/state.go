@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"fmt"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+type pluginState struct {
+	// failedClusters maps the names of the clusters that the extender rejects to the reasons of
+	// the rejections.
+	failedClusters map[string]string
+	// scores maps the names of clusters to the scores that the extender gives.
+	scores map[string]int32
+}
+
+// readPluginState reads the plugin state from the cycle state.
+func (p *Plugin) readPluginState(state framework.CycleStatePluginReadWriter) (*pluginState, error) {
+	val, err := state.Read(framework.StateKey(p.Name()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read value from the cycle state: %w", err)
+	}
+
+	ps, ok := val.(*pluginState)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast value %v to the right type", val)
+	}
+	return ps, nil
+}
+
+// readOrInitPluginState reads the plugin state from the cycle state, or initializes (and saves)
+// an empty one if the state has not been set yet in the current scheduling cycle.
+func (p *Plugin) readOrInitPluginState(state framework.CycleStatePluginReadWriter) *pluginState {
+	if ps, err := p.readPluginState(state); err == nil {
+		return ps
+	}
+	ps := &pluginState{}
+	state.Write(framework.StateKey(p.Name()), ps)
+	return ps
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// MaxExtenderScore is the maximum score an extender may give to a cluster.
+	MaxExtenderScore = 100
+
+	// filterVerb and scoreVerb are the paths (relative to the extender URL) the plugin sends
+	// filter and score requests to.
+	filterVerb = "filter"
+	scoreVerb  = "score"
+)
+
+// ExtenderArgs is the body of the requests the plugin sends to the extender.
+type ExtenderArgs struct {
+	// PolicySnapshot is the scheduling policy snapshot being scheduled; it is a
+	// ClusterSchedulingPolicySnapshot for ClusterResourcePlacements, or a
+	// SchedulingPolicySnapshot for ResourcePlacements.
+	PolicySnapshot placementv1beta1.PolicySnapshotObj `json:"policySnapshot"`
+
+	// Clusters is the list of candidate member clusters.
+	Clusters []clusterv1beta1.MemberCluster `json:"clusters"`
+}
+
+// ExtenderFilterResult is the body of the responses the extender returns for filter requests.
+type ExtenderFilterResult struct {
+	// FailedClusters maps the names of the clusters that the extender rejects to the reasons of
+	// the rejection. All other candidate clusters pass the extender's filter.
+	// +optional
+	FailedClusters map[string]string `json:"failedClusters,omitempty"`
+
+	// Error is the error (if any) that the extender encounters; a non-empty error is handled
+	// per the failure policy of the plugin.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ExtenderScoreResult is the body of the responses the extender returns for score requests.
+type ExtenderScoreResult struct {
+	// Scores maps the names of clusters to their scores, which must be in the range of
+	// [0, MaxExtenderScore]. Candidate clusters that are absent from the map receive a score of 0.
+	// +optional
+	Scores map[string]int32 `json:"scores,omitempty"`
+
+	// Error is the error (if any) that the extender encounters; a non-empty error is handled
+	// per the failure policy of the plugin.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling
+// framework.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	affinity, antiAffinity := placementAffinityOf(policy)
+	noRequiredTerms := (affinity == nil || len(affinity.RequiredDuringSchedulingIgnoredDuringExecution) == 0) &&
+		(antiAffinity == nil || len(antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) == 0)
+	if noRequiredTerms {
+		// There are no required placement affinity or anti-affinity terms specified in the
+		// scheduling policy; skip the step.
+		//
+		// Note that this will also skip the Filter() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no required placement affinity or anti-affinity terms specified")
+	}
+
+	// Prepare the plugin state, i.e., find out the clusters that satisfy each term.
+	if _, err := p.readOrPreparePluginState(ctx, state, policy); err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	// All done.
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	// Required placement affinity terms are ANDed.
+	for idx, clusters := range ps.requiredAffinityClusters {
+		if !clusters.Has(cluster.Name) {
+			reason := fmt.Sprintf("none of the placements selected by required placement affinity term %d has been scheduled on the cluster", idx)
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+	}
+	for idx, clusters := range ps.requiredAntiAffinityClusters {
+		if clusters.Has(cluster.Name) {
+			reason := fmt.Sprintf("a placement selected by required placement anti-affinity term %d has been scheduled on the cluster", idx)
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+	}
+
+	// All done.
+	return nil
+}
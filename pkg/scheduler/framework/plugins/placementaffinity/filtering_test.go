@@ -0,0 +1,459 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	crpName        = "app"
+	policyName     = "app-1"
+	testNamespace  = "work"
+	clusterName1   = "member-1"
+	clusterName2   = "member-2"
+	clusterName3   = "member-3"
+	appLabelKey    = "app"
+	databaseApp    = "database"
+	statefulApp    = "stateful"
+	dbCRPName      = "db"
+	otherDBCRPName = "db-replica"
+)
+
+var (
+	cmpStatusOptions = cmp.Options{
+		cmpopts.IgnoreFields(framework.Status{}, "reasons", "err"),
+		cmp.AllowUnexported(framework.Status{}),
+	}
+	defaultPluginName = defaultPluginOptions.name
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func crp(name string, labels map[string]string) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+func crb(name, placementName, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			TargetCluster: cluster,
+			State:         state,
+		},
+	}
+}
+
+func policySnapshot(affinity *placementv1beta1.Affinity) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+				Affinity:      affinity,
+			},
+		},
+	}
+}
+
+func termFor(app string) placementv1beta1.PlacementAffinityTerm {
+	return placementv1beta1.PlacementAffinityTerm{
+		PlacementSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{appLabelKey: app},
+		},
+	}
+}
+
+// TestPreFilter tests the PreFilter extension point of the plugin.
+func TestPreFilter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     placementv1beta1.PolicySnapshotObj
+		wantStatus *framework.Status
+	}{
+		{
+			name: "no policy",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+		{
+			name: "no affinity",
+			policy: policySnapshot(&placementv1beta1.Affinity{
+				ClusterAffinity: &placementv1beta1.ClusterAffinity{},
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+		{
+			name: "preferred terms only",
+			policy: policySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+						{Weight: 10, PlacementAffinityTerm: termFor(databaseApp)},
+					},
+				},
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+		{
+			name: "required anti-affinity terms",
+			policy: policySnapshot(&placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(statefulApp),
+					},
+				},
+			}),
+		},
+		{
+			name: "invalid placement selector",
+			policy: policySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						{
+							PlacementSelector: metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{Key: appLabelKey, Operator: metav1.LabelSelectorOpIn},
+								},
+							},
+						},
+					},
+				},
+			}),
+			wantStatus: framework.FromError(nil, defaultPluginName),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+			state := framework.NewCycleState(nil, nil, nil)
+			status := p.PreFilter(context.Background(), state, tc.policy)
+			if diff := cmp.Diff(status, tc.wantStatus, cmpStatusOptions); diff != "" {
+				t.Errorf("PreFilter() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestFilter tests the Filter extension point of the plugin.
+func TestFilter(t *testing.T) {
+	now := metav1.NewTime(time.Now())
+	deletingCRP := crp(otherDBCRPName, map[string]string{appLabelKey: databaseApp})
+	deletingCRP.DeletionTimestamp = &now
+	deletingCRP.Finalizers = []string{"kubernetes-fleet.io/test"}
+
+	testCases := []struct {
+		name       string
+		affinity   *placementv1beta1.Affinity
+		objects    []client.Object
+		cluster    string
+		wantStatus *framework.Status
+	}{
+		{
+			name: "required affinity, selected placement has been bound on the cluster",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+				crb("db-1", dbCRPName, clusterName1, placementv1beta1.BindingStateBound),
+			},
+			cluster: clusterName1,
+		},
+		{
+			name: "required affinity, selected placement has been scheduled on the cluster",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+				crb("db-1", dbCRPName, clusterName1, placementv1beta1.BindingStateScheduled),
+			},
+			cluster: clusterName1,
+		},
+		{
+			name: "required affinity, selected placement has been bound on another cluster",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+				crb("db-1", dbCRPName, clusterName2, placementv1beta1.BindingStateBound),
+			},
+			cluster:    clusterName1,
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name: "required affinity, binding of the selected placement is unscheduled",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+				crb("db-1", dbCRPName, clusterName1, placementv1beta1.BindingStateUnscheduled),
+			},
+			cluster:    clusterName1,
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name: "required affinity, selected placement is being deleted",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				deletingCRP,
+				crb("db-replica-1", otherDBCRPName, clusterName1, placementv1beta1.BindingStateBound),
+			},
+			cluster:    clusterName1,
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name: "required affinity, multiple terms are ANDed",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+						termFor(statefulApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+				crb("db-1", dbCRPName, clusterName1, placementv1beta1.BindingStateBound),
+			},
+			cluster:    clusterName1,
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name: "required anti-affinity, selected placement has been bound on the cluster",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(statefulApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp("stateful-1", map[string]string{appLabelKey: statefulApp}),
+				crb("stateful-1-1", "stateful-1", clusterName1, placementv1beta1.BindingStateBound),
+			},
+			cluster:    clusterName1,
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name: "required anti-affinity, selected placement has been bound on other clusters",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(statefulApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp("stateful-1", map[string]string{appLabelKey: statefulApp}),
+				crb("stateful-1-2", "stateful-1", clusterName2, placementv1beta1.BindingStateBound),
+				crb("stateful-1-3", "stateful-1", clusterName3, placementv1beta1.BindingStateScheduled),
+			},
+			cluster: clusterName1,
+		},
+		{
+			name: "required anti-affinity, the placement never selects itself",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(statefulApp),
+					},
+				},
+			},
+			objects: []client.Object{
+				crp(crpName, map[string]string{appLabelKey: statefulApp}),
+				crb("app-1", crpName, clusterName1, placementv1beta1.BindingStateBound),
+			},
+			cluster: clusterName1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(tc.objects...).Build()
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil, nil)
+			policy := policySnapshot(tc.affinity)
+			if status := p.PreFilter(ctx, state, policy); !status.IsSuccess() {
+				t.Fatalf("PreFilter() = %v, want success", status)
+			}
+
+			cluster := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: tc.cluster,
+				},
+			}
+			status := p.Filter(ctx, state, policy, cluster)
+			if diff := cmp.Diff(status, tc.wantStatus, cmpStatusOptions); diff != "" {
+				t.Errorf("Filter() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestFilterResourcePlacement tests the Filter extension point of the plugin with namespaced placements.
+func TestFilterResourcePlacement(t *testing.T) {
+	objects := []client.Object{
+		&placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dbCRPName,
+				Namespace: testNamespace,
+				Labels:    map[string]string{appLabelKey: databaseApp},
+			},
+		},
+		&placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db-1",
+				Namespace: testNamespace,
+				Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: dbCRPName},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName1,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+		// Placements in other namespaces are never selected.
+		&placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dbCRPName,
+				Namespace: "other",
+				Labels:    map[string]string{appLabelKey: databaseApp},
+			},
+		},
+		&placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db-2",
+				Namespace: "other",
+				Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: dbCRPName},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName2,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+	}
+	policy := &placementv1beta1.SchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policyName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							termFor(databaseApp),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	p := New()
+	p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(objects...).Build()
+	ctx := context.Background()
+	state := framework.NewCycleState(nil, nil, nil)
+	if status := p.PreFilter(ctx, state, policy); !status.IsSuccess() {
+		t.Fatalf("PreFilter() = %v, want success", status)
+	}
+
+	wantStatuses := map[string]*framework.Status{
+		clusterName1: nil,
+		clusterName2: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+	}
+	for name, wantStatus := range wantStatuses {
+		cluster := &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+		status := p.Filter(ctx, state, policy, cluster)
+		if diff := cmp.Diff(status, wantStatus, cmpStatusOptions); diff != "" {
+			t.Errorf("Filter(%s) status mismatch (-got, +want):\n%s", name, diff)
+		}
+	}
+}
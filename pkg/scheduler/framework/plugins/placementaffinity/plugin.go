@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementaffinity features a scheduler plugin that enforces inter-placement affinity and anti-affinity,
+// i.e., it requires or prefers clusters where some other placements have (or have not) been scheduled.
+package placementaffinity
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// Plugin is the scheduler plugin that enforces inter-placement affinity and anti-affinity.
+// "Affinity" means a scheduler requires or prefers the clusters where some other placements (selected by labels)
+// have been scheduled, e.g., to place an application next to its database.
+// "Anti-Affinity" means a scheduler avoids the clusters where some other placements have been scheduled,
+// e.g., to keep the replicas of a stateful service on disjoint clusters.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// The framework handle.
+	handle framework.Handle
+
+	// The client for reading placements and bindings; it is set up with the framework handle.
+	client client.Reader
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * PreFilter
+	// * Filter
+	// * PreScore
+	// * Score
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.PreFilterPlugin = &Plugin{}
+	_ framework.FilterPlugin    = &Plugin{}
+	_ framework.PreScorePlugin  = &Plugin{}
+	_ framework.ScorePlugin     = &Plugin{}
+)
+
+type placementAffinityPluginOptions struct {
+	// The name of the plugin.
+	name string
+}
+
+type Option func(*placementAffinityPluginOptions)
+
+var defaultPluginOptions = placementAffinityPluginOptions{
+	name: "PlacementAffinity",
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *placementAffinityPluginOptions) {
+		o.name = name
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name: options.name,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+	p.client = handle.Client()
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// TestPreScore tests the PreScore extension point of the plugin.
+func TestPreScore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		affinity   *placementv1beta1.Affinity
+		wantStatus *framework.Status
+	}{
+		{
+			name: "required terms only",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						termFor(databaseApp),
+					},
+				},
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+		{
+			name: "preferred anti-affinity terms",
+			affinity: &placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+						{Weight: 10, PlacementAffinityTerm: termFor(statefulApp)},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+			state := framework.NewCycleState(nil, nil, nil)
+			status := p.PreScore(context.Background(), state, policySnapshot(tc.affinity))
+			if diff := cmp.Diff(status, tc.wantStatus, cmpStatusOptions); diff != "" {
+				t.Errorf("PreScore() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestScore tests the Score extension point of the plugin.
+func TestScore(t *testing.T) {
+	objects := []client.Object{
+		crp(dbCRPName, map[string]string{appLabelKey: databaseApp}),
+		crb("db-1", dbCRPName, clusterName1, placementv1beta1.BindingStateBound),
+		crb("db-2", dbCRPName, clusterName2, placementv1beta1.BindingStateBound),
+		crp("stateful-1", map[string]string{appLabelKey: statefulApp}),
+		crb("stateful-1-2", "stateful-1", clusterName2, placementv1beta1.BindingStateScheduled),
+		crb("stateful-1-3", "stateful-1", clusterName3, placementv1beta1.BindingStateBound),
+	}
+	affinity := &placementv1beta1.Affinity{
+		PlacementAffinity: &placementv1beta1.PlacementAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+				{Weight: 20, PlacementAffinityTerm: termFor(databaseApp)},
+			},
+		},
+		PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+				{Weight: 50, PlacementAffinityTerm: termFor(statefulApp)},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		cluster   string
+		wantScore *framework.ClusterScore
+	}{
+		{
+			name:      "cluster satisfies the preferred affinity term only",
+			cluster:   clusterName1,
+			wantScore: &framework.ClusterScore{AffinityScore: 20},
+		},
+		{
+			name:      "cluster satisfies both the preferred affinity and anti-affinity terms",
+			cluster:   clusterName2,
+			wantScore: &framework.ClusterScore{AffinityScore: -30},
+		},
+		{
+			name:      "cluster satisfies the preferred anti-affinity term only",
+			cluster:   clusterName3,
+			wantScore: &framework.ClusterScore{AffinityScore: -50},
+		},
+		{
+			name:      "cluster satisfies no terms",
+			cluster:   "member-4",
+			wantScore: &framework.ClusterScore{},
+		},
+	}
+
+	p := New()
+	p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(objects...).Build()
+	ctx := context.Background()
+	state := framework.NewCycleState(nil, nil, nil)
+	policy := policySnapshot(affinity)
+	if status := p.PreScore(ctx, state, policy); !status.IsSuccess() {
+		t.Fatalf("PreScore() = %v, want success", status)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: tc.cluster,
+				},
+			}
+			score, status := p.Score(ctx, state, policy, cluster)
+			if !status.IsSuccess() {
+				t.Fatalf("Score() = %v, want success", status)
+			}
+			if diff := cmp.Diff(score, tc.wantScore); diff != "" {
+				t.Errorf("Score() score mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+type pluginState struct {
+	// requiredAffinityClusters[i] is the set of clusters that satisfy the i-th required
+	// placement affinity term.
+	requiredAffinityClusters []sets.Set[string]
+	// requiredAntiAffinityClusters[i] is the set of clusters that satisfy the i-th required
+	// placement anti-affinity term.
+	requiredAntiAffinityClusters []sets.Set[string]
+	// preferredAffinityClusters[i] is the set of clusters that satisfy the i-th preferred
+	// placement affinity term.
+	preferredAffinityClusters []sets.Set[string]
+	// preferredAntiAffinityClusters[i] is the set of clusters that satisfy the i-th preferred
+	// placement anti-affinity term.
+	preferredAntiAffinityClusters []sets.Set[string]
+}
+
+// placementAffinityOf returns the placement affinity and anti-affinity specified in a scheduling policy (if any).
+func placementAffinityOf(policy placementv1beta1.PolicySnapshotObj) (*placementv1beta1.PlacementAffinity, *placementv1beta1.PlacementAntiAffinity) {
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || spec.Policy.Affinity == nil {
+		return nil, nil
+	}
+	return spec.Policy.Affinity.PlacementAffinity, spec.Policy.Affinity.PlacementAntiAffinity
+}
+
+// preparePluginState prepares a common state for easier queries of the clusters that satisfy
+// each of the placement affinity and anti-affinity terms.
+func (p *Plugin) preparePluginState(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	clustersByPlacement, err := p.scheduledClustersByPlacement(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := &pluginState{}
+	affinity, antiAffinity := placementAffinityOf(policy)
+	if affinity != nil {
+		if ps.requiredAffinityClusters, err = clustersSatisfying(clustersByPlacement, affinity.RequiredDuringSchedulingIgnoredDuringExecution); err != nil {
+			return nil, err
+		}
+		if ps.preferredAffinityClusters, err = clustersSatisfying(clustersByPlacement, termsOf(affinity.PreferredDuringSchedulingIgnoredDuringExecution)); err != nil {
+			return nil, err
+		}
+	}
+	if antiAffinity != nil {
+		if ps.requiredAntiAffinityClusters, err = clustersSatisfying(clustersByPlacement, antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution); err != nil {
+			return nil, err
+		}
+		if ps.preferredAntiAffinityClusters, err = clustersSatisfying(clustersByPlacement, termsOf(antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)); err != nil {
+			return nil, err
+		}
+	}
+	return ps, nil
+}
+
+// placementWithClusters is a placement, in the same scope as the one being scheduled, along with
+// the clusters where it has been scheduled.
+type placementWithClusters struct {
+	labels   labels.Set
+	clusters sets.Set[string]
+}
+
+// scheduledClustersByPlacement returns, for each placement other than the one being scheduled in the
+// same scope (cluster-scoped placements, or namespaced placements in the same namespace), the clusters
+// where the placement has been scheduled or bound.
+func (p *Plugin) scheduledClustersByPlacement(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (map[string]*placementWithClusters, error) {
+	namespace := policy.GetNamespace()
+	self := policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+
+	var placementList placementv1beta1.PlacementObjList
+	var bindingList placementv1beta1.BindingObjList
+	var listOptions []client.ListOption
+	if namespace == "" {
+		placementList = &placementv1beta1.ClusterResourcePlacementList{}
+		bindingList = &placementv1beta1.ClusterResourceBindingList{}
+	} else {
+		placementList = &placementv1beta1.ResourcePlacementList{}
+		bindingList = &placementv1beta1.ResourceBindingList{}
+		listOptions = append(listOptions, client.InNamespace(namespace))
+	}
+	if err := p.client.List(ctx, placementList, listOptions...); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	if err := p.client.List(ctx, bindingList, listOptions...); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	placements := make(map[string]*placementWithClusters)
+	for _, placement := range placementList.GetPlacementObjs() {
+		if placement.GetName() == self || placement.GetDeletionTimestamp() != nil {
+			// A placement never selects itself; placements that are being deleted are ignored.
+			continue
+		}
+		placements[placement.GetName()] = &placementWithClusters{
+			labels:   placement.GetLabels(),
+			clusters: sets.New[string](),
+		}
+	}
+
+	for _, binding := range bindingList.GetBindingObjs() {
+		placement, ok := placements[binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]]
+		if !ok || binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+		spec := binding.GetBindingSpec()
+		if spec.State != placementv1beta1.BindingStateScheduled && spec.State != placementv1beta1.BindingStateBound {
+			// Unscheduled bindings are on their way out.
+			continue
+		}
+		placement.clusters.Insert(spec.TargetCluster)
+	}
+	return placements, nil
+}
+
+// clustersSatisfying returns, for each placement affinity term, the set of clusters where any of
+// the selected placements has been scheduled.
+func clustersSatisfying(placements map[string]*placementWithClusters, terms []placementv1beta1.PlacementAffinityTerm) ([]sets.Set[string], error) {
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	res := make([]sets.Set[string], len(terms))
+	for idx := range terms {
+		selector, err := metav1.LabelSelectorAsSelector(&terms[idx].PlacementSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the placement selector of term %d: %w", idx, err)
+		}
+		res[idx] = sets.New[string]()
+		for _, placement := range placements {
+			if selector.Matches(placement.labels) {
+				res[idx] = res[idx].Union(placement.clusters)
+			}
+		}
+	}
+	return res, nil
+}
+
+// termsOf returns the placement affinity terms in a list of weighted terms.
+func termsOf(weightedTerms []placementv1beta1.WeightedPlacementAffinityTerm) []placementv1beta1.PlacementAffinityTerm {
+	if len(weightedTerms) == 0 {
+		return nil
+	}
+	terms := make([]placementv1beta1.PlacementAffinityTerm, len(weightedTerms))
+	for idx := range weightedTerms {
+		terms[idx] = weightedTerms[idx].PlacementAffinityTerm
+	}
+	return terms
+}
+
+// readOrPreparePluginState reads the plugin state from the cycle state, or prepares (and saves) one
+// if the state has not been prepared yet in the current scheduling cycle.
+func (p *Plugin) readOrPreparePluginState(ctx context.Context, state framework.CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	if ps, err := p.readPluginState(state); err == nil {
+		return ps, nil
+	}
+
+	ps, err := p.preparePluginState(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	state.Write(framework.StateKey(p.Name()), ps)
+	return ps, nil
+}
+
+// readPluginState reads the plugin state from the cycle state.
+func (p *Plugin) readPluginState(state framework.CycleStatePluginReadWriter) (*pluginState, error) {
+	val, err := state.Read(framework.StateKey(p.Name()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read value from the cycle state: %w", err)
+	}
+
+	ps, ok := val.(*pluginState)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast value %v to the right type", val)
+	}
+	return ps, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling
+// framework.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	// Prepare the plugin state, i.e., sum up the resource requests of the selected workloads.
+	ps, err := p.readOrPreparePluginState(ctx, state, policy)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	if len(ps.requests) == 0 {
+		// The placement does not select any workload with resource requests; skip the step.
+		//
+		// Note that this will also skip the Filter() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no workload resource requests")
+	}
+
+	// All done.
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	if state.HasObsoleteBindingFor(cluster.Name) {
+		// The cluster already runs the selected workloads, which its available resources have
+		// accounted for; checking the requests again would count them twice.
+		return nil
+	}
+
+	requests := ps.requestsOn(cluster.Name)
+	available := cluster.Status.ResourceUsage.Available
+	for _, name := range sortedResourceNames(requests) {
+		availableQuantity, ok := available[name]
+		if !ok {
+			// The cluster does not report the available amount of the resource; it cannot be checked.
+			continue
+		}
+		requested := requests[name]
+		if requested.AsApproximateFloat64() > p.allowedAmountOf(availableQuantity.AsApproximateFloat64()) {
+			klog.V(2).InfoS("Cluster is unschedulable, because it does not have enough available resources",
+				"policySnapshot", klog.KObj(policy), "cluster", klog.KObj(cluster), "resource", name, "requested", requested.String(), "available", availableQuantity.String())
+			reason := fmt.Sprintf("cluster does not have enough available %s for the selected workloads: requested %s, available %s (with %d%% headroom)",
+				name, requested.String(), availableQuantity.String(), p.headroomPercentage)
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+	}
+
+	// All done.
+	return nil
+}
+
+// allowedAmountOf returns the amount of a resource that the placement can request on a cluster, given
+// the available amount of the resource on the cluster, i.e., the available amount less the headroom.
+func (p *Plugin) allowedAmountOf(available float64) float64 {
+	return available * float64(100-p.headroomPercentage) / 100
+}
+
+// sortedResourceNames returns the names of the resources in a resource list in order, so that the
+// results of the plugin are deterministic.
+func sortedResourceNames(resources corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	crpName     = "test-placement"
+	policyName  = "test-placement-1"
+	clusterName = "bravelion"
+)
+
+var (
+	cmpStatusOptions = cmp.Options{
+		cmpopts.IgnoreFields(framework.Status{}, "reasons", "err"),
+		cmp.AllowUnexported(framework.Status{}),
+	}
+	cmpResourceListOption = cmp.Comparer(func(a, b resource.Quantity) bool {
+		return a.Cmp(b) == 0
+	})
+	defaultPluginName = defaultPluginOptions.name
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func policySnapshot() *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+	}
+}
+
+func masterResourceSnapshot(t *testing.T, objs ...interface{}) *placementv1beta1.ClusterResourceSnapshot {
+	selectedResources := make([]placementv1beta1.ResourceContent, 0, len(objs))
+	for _, obj := range objs {
+		selectedResources = append(selectedResources, placementv1beta1.ResourceContent{
+			RawExtension: runtime.RawExtension{Raw: rawOf(t, obj)},
+		})
+	}
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName + "-0-snapshot",
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+				placementv1beta1.IsLatestSnapshotLabel:  "true",
+				placementv1beta1.ResourceIndexLabel:     "0",
+			},
+			Annotations: map[string]string{
+				placementv1beta1.ResourceGroupHashAnnotation:         "hash",
+				placementv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+			},
+		},
+		Spec: placementv1beta1.ResourceSnapshotSpec{
+			SelectedResources: selectedResources,
+		},
+	}
+}
+
+func clusterWithAvailable(available corev1.ResourceList) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Available: available,
+			},
+		},
+	}
+}
+
+// TestPreFilter tests the PreFilter extension point of the plugin.
+func TestPreFilter(t *testing.T) {
+	testCases := []struct {
+		name         string
+		objects      []client.Object
+		wantStatus   *framework.Status
+		wantRequests corev1.ResourceList
+	}{
+		{
+			name:       "no resource snapshot",
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+		},
+		{
+			name: "no workload selected",
+			objects: []client.Object{
+				masterResourceSnapshot(t, &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}}),
+			},
+			wantStatus:   framework.NewNonErrorStatus(framework.Skip, defaultPluginName),
+			wantRequests: corev1.ResourceList{},
+		},
+		{
+			name: "workload selected",
+			objects: []client.Object{
+				masterResourceSnapshot(t, deployment(ptr.To(int32(2)), containerWith(cpuAndMemory("1", "1Gi"), nil))),
+			},
+			wantRequests: cpuAndMemory("2", "2Gi"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(tc.objects...).Build()
+			state := framework.NewCycleState(nil, nil, nil)
+			status := p.PreFilter(context.Background(), state, policySnapshot())
+			if diff := cmp.Diff(status, tc.wantStatus, cmpStatusOptions); diff != "" {
+				t.Errorf("PreFilter() status mismatch (-got, +want):\n%s", diff)
+			}
+
+			ps, err := p.readPluginState(state)
+			if err != nil {
+				t.Fatalf("readPluginState() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(ps.requests, tc.wantRequests, cmpResourceListOption); diff != "" {
+				t.Errorf("plugin state requests mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestFilter tests the Filter extension point of the plugin.
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name               string
+		headroomPercentage int
+		requests           corev1.ResourceList
+		workloads          []workload
+		replicaShares      *replicaShareEstimate
+		hasObsoleteBinding bool
+		available          corev1.ResourceList
+		wantStatus         *framework.Status
+	}{
+		{
+			name:               "enough resources",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("2", "2Gi"),
+			available:          cpuAndMemory("4", "8Gi"),
+		},
+		{
+			name:               "not enough cpu",
+			headroomPercentage: 0,
+			requests:           cpuAndMemory("5", "2Gi"),
+			available:          cpuAndMemory("4", "8Gi"),
+			wantStatus:         framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:               "not enough memory with headroom",
+			headroomPercentage: 20,
+			requests:           cpuAndMemory("1", "7Gi"),
+			available:          cpuAndMemory("4", "8Gi"),
+			wantStatus:         framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:               "exactly at the headroom",
+			headroomPercentage: 50,
+			requests:           cpuAndMemory("2", "4Gi"),
+			available:          cpuAndMemory("4", "8Gi"),
+		},
+		{
+			name:               "resource not reported by the cluster",
+			headroomPercentage: 10,
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:      resource.MustParse("1"),
+				"nvidia.com/gpu":        resource.MustParse("2"),
+				corev1.ResourceMemory:   resource.MustParse("1Gi"),
+				corev1.ResourceStorage:  resource.MustParse("10Gi"),
+				corev1.ResourcePods:     resource.MustParse("2"),
+				corev1.ResourceServices: resource.MustParse("1"),
+			},
+			available: cpuAndMemory("4", "8Gi"),
+		},
+		{
+			name:               "cluster reports no available resources",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("2", "2Gi"),
+		},
+		{
+			name:               "not enough resources, but the cluster has an obsolete binding",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("5", "2Gi"),
+			hasObsoleteBinding: true,
+			available:          cpuAndMemory("4", "8Gi"),
+		},
+		{
+			name:               "enough resources for the divided share of the replicas",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("6", "6Gi"),
+			workloads: []workload{
+				{podRequests: cpuAndMemory("1", "1Gi"), replicas: 6, divisible: true},
+			},
+			replicaShares: &replicaShareEstimate{
+				weights:     map[string]float64{clusterName: 1},
+				totalWeight: 2,
+			},
+			available: cpuAndMemory("4", "8Gi"),
+		},
+		{
+			name:               "not enough resources for the divided share of the replicas",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("6", "6Gi"),
+			workloads: []workload{
+				{podRequests: cpuAndMemory("1", "1Gi"), replicas: 6, divisible: true},
+			},
+			replicaShares: &replicaShareEstimate{
+				weights:     map[string]float64{clusterName: 3},
+				totalWeight: 4,
+			},
+			available:  cpuAndMemory("4", "8Gi"),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+		{
+			name:               "replicas of an indivisible workload are not divided",
+			headroomPercentage: 10,
+			requests:           cpuAndMemory("6", "6Gi"),
+			workloads: []workload{
+				{podRequests: cpuAndMemory("1", "1Gi"), replicas: 6},
+			},
+			replicaShares: &replicaShareEstimate{
+				weights:     map[string]float64{clusterName: 1},
+				totalWeight: 2,
+			},
+			available:  cpuAndMemory("4", "8Gi"),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, defaultPluginName),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New(WithHeadroomPercentage(tc.headroomPercentage))
+			var obsoleteBindings []placementv1beta1.BindingObj
+			if tc.hasObsoleteBinding {
+				obsoleteBindings = append(obsoleteBindings, &placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "obsolete-binding"},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName,
+					},
+				})
+			}
+			state := framework.NewCycleState(nil, obsoleteBindings, nil)
+			state.Write(framework.StateKey(p.Name()), &pluginState{
+				requests:      tc.requests,
+				workloads:     tc.workloads,
+				replicaShares: tc.replicaShares,
+			})
+			status := p.Filter(context.Background(), state, policySnapshot(), clusterWithAvailable(tc.available))
+			if diff := cmp.Diff(status, tc.wantStatus, cmpStatusOptions); diff != "" {
+				t.Errorf("Filter() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcecapacity features a scheduler plugin that checks whether the workloads selected by
+// a placement fit into the available resources of a cluster, and prefers the clusters with more
+// resources left after the placement.
+package resourcecapacity
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	// MaxResourceCapacityScore is the score the plugin assigns to a cluster that would have all of
+	// its available resources left after the placement.
+	MaxResourceCapacityScore = 100
+)
+
+// Plugin is the scheduler plugin that checks the resource requests of the workloads (Deployments,
+// StatefulSets, and Jobs) selected by a placement against the available resources reported by each
+// cluster.
+//
+// A cluster that does not report its available amount of a resource is not checked against that
+// resource.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// The framework handle.
+	handle framework.Handle
+
+	// The client for reading resource snapshots; it is set up with the framework handle.
+	client client.Reader
+
+	// headroomPercentage is the percentage of the available resources of a cluster that must remain
+	// unrequested after the placement.
+	headroomPercentage int
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * PreFilter
+	// * Filter
+	// * PreScore
+	// * Score
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.PreFilterPlugin = &Plugin{}
+	_ framework.FilterPlugin    = &Plugin{}
+	_ framework.PreScorePlugin  = &Plugin{}
+	_ framework.ScorePlugin     = &Plugin{}
+)
+
+type resourceCapacityPluginOptions struct {
+	// The name of the plugin.
+	name string
+
+	// The percentage of the available resources of a cluster that must remain unrequested after
+	// the placement.
+	headroomPercentage int
+}
+
+type Option func(*resourceCapacityPluginOptions)
+
+var defaultPluginOptions = resourceCapacityPluginOptions{
+	name:               "ResourceCapacity",
+	headroomPercentage: 10,
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *resourceCapacityPluginOptions) {
+		o.name = name
+	}
+}
+
+// WithHeadroomPercentage sets the percentage of the available resources of a cluster that must remain
+// unrequested after the placement.
+func WithHeadroomPercentage(percentage int) Option {
+	return func(o *resourceCapacityPluginOptions) {
+		o.headroomPercentage = percentage
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name:               options.name,
+		headroomPercentage: options.headroomPercentage,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+	p.client = handle.Client()
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"math"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// replicaShareEstimate estimates the share of the replicas of the divisible workloads that each
+// cluster runs when a placement divides the replicas across the selected clusters.
+//
+// The clusters that the placement selects are not known until the scheduling cycle completes; the
+// estimate assumes that the placement selects the expected number of clusters, which weigh as much
+// as the candidate clusters do on average, unless the clusters are weighed statically.
+type replicaShareEstimate struct {
+	// weights are the weights of the candidate clusters, keyed by the cluster name.
+	weights map[string]float64
+	// totalWeight is the expected total weight of the selected clusters.
+	totalWeight float64
+}
+
+// shareOf returns the estimated share, in the range [0, 1], of the replicas that a cluster runs.
+func (e *replicaShareEstimate) shareOf(clusterName string) float64 {
+	if e.totalWeight <= 0 {
+		return 1
+	}
+	return math.Min(e.weights[clusterName]/e.totalWeight, 1)
+}
+
+// estimateReplicaShares estimates the share of the replicas that each of the candidate clusters runs,
+// as instructed by a placement policy; it returns nil if the policy does not divide replicas.
+//
+// The weights of the clusters mirror the ones the rollout controller uses when it divides the
+// replicas, including the fallback to an even division when no cluster has a positive weight.
+func estimateReplicaShares(policy *placementv1beta1.PlacementPolicy, clusters []clusterv1beta1.MemberCluster) *replicaShareEstimate {
+	if policy == nil || policy.ReplicaScheduling == nil || policy.ReplicaScheduling.Type != placementv1beta1.ReplicaSchedulingTypeDivided {
+		return nil
+	}
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	// Find out the expected number of selected clusters.
+	selected := len(clusters)
+	switch policy.PlacementType {
+	case placementv1beta1.PickNPlacementType:
+		if policy.NumberOfClusters != nil && int(*policy.NumberOfClusters) < selected {
+			selected = int(*policy.NumberOfClusters)
+		}
+	case placementv1beta1.PickFixedPlacementType:
+		selected = len(policy.ClusterNames)
+	}
+	if selected <= 0 {
+		return nil
+	}
+
+	replicaScheduling := policy.ReplicaScheduling
+	weights := make(map[string]float64, len(clusters))
+	var totalWeight float64
+	switch replicaScheduling.WeightType {
+	case placementv1beta1.ReplicaDivisionWeightTypeStatic:
+		staticWeights := make(map[string]float64, len(replicaScheduling.StaticWeights))
+		for _, w := range replicaScheduling.StaticWeights {
+			staticWeights[w.ClusterName] = float64(w.Weight)
+		}
+		for idx := range clusters {
+			weight := staticWeights[clusters[idx].Name]
+			weights[clusters[idx].Name] = weight
+			totalWeight += weight
+		}
+	case placementv1beta1.ReplicaDivisionWeightTypeClusterProperty:
+		var sum float64
+		for idx := range clusters {
+			value := positivePropertyValueOf(&clusters[idx], replicaScheduling.WeightPropertyName)
+			weights[clusters[idx].Name] = value
+			sum += value
+		}
+		// Assume that the selected clusters report the average value of the candidate clusters.
+		totalWeight = sum / float64(len(clusters)) * float64(selected)
+	}
+	if totalWeight <= 0 {
+		// Divide the replicas evenly.
+		for idx := range clusters {
+			weights[clusters[idx].Name] = 1
+		}
+		totalWeight = float64(selected)
+	}
+	return &replicaShareEstimate{weights: weights, totalWeight: totalWeight}
+}
+
+// positivePropertyValueOf returns the value of a property on a member cluster; it returns 0 if the
+// cluster does not report a valid, positive value for the property.
+func positivePropertyValueOf(cluster *clusterv1beta1.MemberCluster, propertyName string) float64 {
+	q, err := propertyprovider.RetrievePropertyValueFrom(cluster, propertyName)
+	if err != nil || q == nil || q.Sign() <= 0 {
+		return 0
+	}
+	return q.AsApproximateFloat64()
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	weightPropertyName = "example.com/capacity"
+)
+
+func clusterWithProperty(name, value string) clusterv1beta1.MemberCluster {
+	cluster := clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	if len(value) > 0 {
+		cluster.Status.Properties = map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+			weightPropertyName: {Value: value},
+		}
+	}
+	return cluster
+}
+
+// TestEstimateReplicaShares tests the estimateReplicaShares function.
+func TestEstimateReplicaShares(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		clusterWithProperty("cluster-1", "30"),
+		clusterWithProperty("cluster-2", "10"),
+		clusterWithProperty("cluster-3", "20"),
+		clusterWithProperty("cluster-4", ""),
+	}
+
+	testCases := []struct {
+		name       string
+		policy     *placementv1beta1.PlacementPolicy
+		wantShares map[string]float64
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+		},
+		{
+			name: "replicas duplicated",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type: placementv1beta1.ReplicaSchedulingTypeDuplicated,
+				},
+			},
+		},
+		{
+			name: "pick all, even weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+				},
+			},
+			wantShares: map[string]float64{
+				"cluster-1": 0.25,
+				"cluster-2": 0.25,
+				"cluster-3": 0.25,
+				"cluster-4": 0.25,
+			},
+		},
+		{
+			name: "pick N, even weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(2)),
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type: placementv1beta1.ReplicaSchedulingTypeDivided,
+				},
+			},
+			wantShares: map[string]float64{
+				"cluster-1": 0.5,
+				"cluster-2": 0.5,
+				"cluster-3": 0.5,
+				"cluster-4": 0.5,
+			},
+		},
+		{
+			name: "pick fixed, static weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"cluster-1", "cluster-2"},
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "cluster-1", Weight: 3},
+						{ClusterName: "cluster-2", Weight: 1},
+					},
+				},
+			},
+			wantShares: map[string]float64{
+				"cluster-1": 0.75,
+				"cluster-2": 0.25,
+				"cluster-3": 0,
+				"cluster-4": 0,
+			},
+		},
+		{
+			name: "pick N, cluster property weights",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(2)),
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType:         placementv1beta1.ReplicaDivisionWeightTypeClusterProperty,
+					WeightPropertyName: weightPropertyName,
+				},
+			},
+			// The average value is 15, and the expected total weight of the 2 selected clusters is 30.
+			wantShares: map[string]float64{
+				"cluster-1": 1,
+				"cluster-2": 10.0 / 30,
+				"cluster-3": 20.0 / 30,
+				"cluster-4": 0,
+			},
+		},
+		{
+			name: "no cluster has a positive weight",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeClusterProperty,
+					// No cluster reports this property.
+					WeightPropertyName: "example.com/unknown",
+				},
+			},
+			wantShares: map[string]float64{
+				"cluster-1": 0.25,
+				"cluster-2": 0.25,
+				"cluster-3": 0.25,
+				"cluster-4": 0.25,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := estimateReplicaShares(tc.policy, clusters)
+			if tc.wantShares == nil {
+				if estimate != nil {
+					t.Fatalf("estimateReplicaShares() = %v, want nil", estimate)
+				}
+				return
+			}
+			if estimate == nil {
+				t.Fatalf("estimateReplicaShares() = nil, want an estimate")
+			}
+			gotShares := make(map[string]float64, len(clusters))
+			for idx := range clusters {
+				gotShares[clusters[idx].Name] = estimate.shareOf(clusters[idx].Name)
+			}
+			if diff := cmp.Diff(gotShares, tc.wantShares); diff != "" {
+				t.Errorf("estimateReplicaShares() shares mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+var (
+	deploymentGVK  = appsv1.SchemeGroupVersion.WithKind("Deployment")
+	statefulSetGVK = appsv1.SchemeGroupVersion.WithKind("StatefulSet")
+	jobGVK         = batchv1.SchemeGroupVersion.WithKind("Job")
+)
+
+// workload is a workload selected by a placement, along with the resource requests of its pods.
+type workload struct {
+	// podRequests is the effective resource requests of each pod of the workload.
+	podRequests corev1.ResourceList
+	// replicas is the number of pods that the workload runs at the same time.
+	replicas int32
+	// divisible is whether the replicas of the workload are divided across the selected clusters
+	// when the placement divides replicas, i.e., whether the workload is a Deployment or a StatefulSet.
+	divisible bool
+}
+
+// workloadsOf returns the workloads selected in the given resource snapshots, i.e., the Deployments,
+// StatefulSets, and Jobs.
+//
+// Other resources, including the workloads wrapped in envelopes, are not counted.
+func workloadsOf(resourceSnapshots []placementv1beta1.ResourceSnapshotObj) ([]workload, error) {
+	workloads := make([]workload, 0)
+	for _, resourceSnapshot := range resourceSnapshots {
+		for idx, content := range resourceSnapshot.GetResourceSnapshotSpec().SelectedResources {
+			w, err := workloadFromRaw(content.Raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the workload at index %d of resource snapshot %s: %w", idx, resourceSnapshot.GetName(), err)
+			}
+			if w != nil {
+				workloads = append(workloads, *w)
+			}
+		}
+	}
+	return workloads, nil
+}
+
+// workloadFromRaw returns the workload of the given object; it returns nil if the object is not a
+// workload.
+func workloadFromRaw(raw []byte) (*workload, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	var podSpec *corev1.PodSpec
+	var replicas int32
+	var divisible bool
+	switch typeMeta.GroupVersionKind() {
+	case deploymentGVK:
+		var deploy appsv1.Deployment
+		if err := json.Unmarshal(raw, &deploy); err != nil {
+			return nil, err
+		}
+		podSpec, replicas, divisible = &deploy.Spec.Template.Spec, ptr.Deref(deploy.Spec.Replicas, 1), true
+	case statefulSetGVK:
+		var sts appsv1.StatefulSet
+		if err := json.Unmarshal(raw, &sts); err != nil {
+			return nil, err
+		}
+		podSpec, replicas, divisible = &sts.Spec.Template.Spec, ptr.Deref(sts.Spec.Replicas, 1), true
+	case jobGVK:
+		var job batchv1.Job
+		if err := json.Unmarshal(raw, &job); err != nil {
+			return nil, err
+		}
+		// A Job runs no more pods at the same time than its parallelism, or its completions if
+		// the latter is smaller.
+		replicas = ptr.Deref(job.Spec.Parallelism, 1)
+		if job.Spec.Completions != nil && *job.Spec.Completions < replicas {
+			replicas = *job.Spec.Completions
+		}
+		podSpec = &job.Spec.Template.Spec
+	default:
+		return nil, nil
+	}
+
+	return &workload{podRequests: podRequests(podSpec), replicas: replicas, divisible: divisible}, nil
+}
+
+// totalRequestsOf returns the total resource requests of the given workloads on a cluster that runs
+// the given share, in the range [0, 1], of the replicas of each divisible workload; the share of
+// replicas is rounded up.
+func totalRequestsOf(workloads []workload, share float64) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, w := range workloads {
+		replicas := int64(w.replicas)
+		if w.divisible && share < 1 {
+			replicas = int64(math.Ceil(float64(w.replicas) * share))
+		}
+		for name, quantity := range w.podRequests {
+			quantity.Mul(replicas)
+			sum := total[name]
+			sum.Add(quantity)
+			total[name] = sum
+		}
+	}
+	return total
+}
+
+// podRequests returns the effective resource requests of a pod with the given spec, taking init
+// containers, sidecar containers, and pod overhead into account.
+//
+// The limit of a resource is used as its request if the request is not set, which matches the
+// defaulting behavior of the API server for pods.
+func podRequests(podSpec *corev1.PodSpec) corev1.ResourceList {
+	pod := &corev1.Pod{Spec: *podSpec.DeepCopy()}
+	for _, containers := range [][]corev1.Container{pod.Spec.Containers, pod.Spec.InitContainers} {
+		for idx := range containers {
+			resources := &containers[idx].Resources
+			for name, limit := range resources.Limits {
+				if _, ok := resources.Requests[name]; ok {
+					continue
+				}
+				if resources.Requests == nil {
+					resources.Requests = corev1.ResourceList{}
+				}
+				resources.Requests[name] = limit
+			}
+		}
+	}
+	return resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func containerWith(requests, limits corev1.ResourceList) corev1.Container {
+	return corev1.Container{
+		Name:  "app",
+		Image: "nginx",
+		Resources: corev1.ResourceRequirements{
+			Requests: requests,
+			Limits:   limits,
+		},
+	}
+}
+
+func podTemplateWith(containers ...corev1.Container) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: containers,
+		},
+	}
+}
+
+func deployment(replicas *int32, containers ...corev1.Container) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "app",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+			Template: podTemplateWith(containers...),
+		},
+	}
+}
+
+func rawOf(t *testing.T, obj interface{}) []byte {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	return raw
+}
+
+func cpuAndMemory(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+// TestWorkloadFromRaw tests the workloadFromRaw function.
+func TestWorkloadFromRaw(t *testing.T) {
+	testCases := []struct {
+		name          string
+		obj           interface{}
+		wantRequests  corev1.ResourceList
+		wantDivisible bool
+	}{
+		{
+			name:          "deployment with replicas",
+			obj:           deployment(ptr.To(int32(3)), containerWith(cpuAndMemory("500m", "256Mi"), nil)),
+			wantRequests:  cpuAndMemory("1500m", "768Mi"),
+			wantDivisible: true,
+		},
+		{
+			name:          "deployment without replicas",
+			obj:           deployment(nil, containerWith(cpuAndMemory("500m", "256Mi"), nil)),
+			wantRequests:  cpuAndMemory("500m", "256Mi"),
+			wantDivisible: true,
+		},
+		{
+			name:          "deployment with limits only",
+			obj:           deployment(ptr.To(int32(2)), containerWith(nil, cpuAndMemory("1", "1Gi"))),
+			wantRequests:  cpuAndMemory("2", "2Gi"),
+			wantDivisible: true,
+		},
+		{
+			name: "deployment with an init container",
+			obj: func() *appsv1.Deployment {
+				deploy := deployment(ptr.To(int32(2)), containerWith(cpuAndMemory("500m", "256Mi"), nil))
+				deploy.Spec.Template.Spec.InitContainers = []corev1.Container{
+					containerWith(cpuAndMemory("1", "128Mi"), nil),
+				}
+				return deploy
+			}(),
+			wantRequests:  cpuAndMemory("2", "512Mi"),
+			wantDivisible: true,
+		},
+		{
+			name: "statefulset",
+			obj: &appsv1.StatefulSet{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(2)),
+					Template: podTemplateWith(containerWith(cpuAndMemory("250m", "1Gi"), nil)),
+				},
+			},
+			wantRequests:  cpuAndMemory("500m", "2Gi"),
+			wantDivisible: true,
+		},
+		{
+			name: "job with parallelism smaller than completions",
+			obj: &batchv1.Job{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "batch/v1",
+					Kind:       "Job",
+				},
+				Spec: batchv1.JobSpec{
+					Parallelism: ptr.To(int32(4)),
+					Completions: ptr.To(int32(10)),
+					Template:    podTemplateWith(containerWith(cpuAndMemory("1", "1Gi"), nil)),
+				},
+			},
+			wantRequests: cpuAndMemory("4", "4Gi"),
+		},
+		{
+			name: "job with completions smaller than parallelism",
+			obj: &batchv1.Job{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "batch/v1",
+					Kind:       "Job",
+				},
+				Spec: batchv1.JobSpec{
+					Parallelism: ptr.To(int32(4)),
+					Completions: ptr.To(int32(2)),
+					Template:    podTemplateWith(containerWith(cpuAndMemory("1", "1Gi"), nil)),
+				},
+			},
+			wantRequests: cpuAndMemory("2", "2Gi"),
+		},
+		{
+			name: "not a workload",
+			obj: &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := workloadFromRaw(rawOf(t, tc.obj))
+			if err != nil {
+				t.Fatalf("workloadFromRaw() = %v, want no error", err)
+			}
+			if tc.wantRequests == nil {
+				if w != nil {
+					t.Fatalf("workloadFromRaw() = %v, want nil", w)
+				}
+				return
+			}
+			if w == nil {
+				t.Fatalf("workloadFromRaw() = nil, want a workload")
+			}
+			if diff := cmp.Diff(totalRequestsOf([]workload{*w}, 1), tc.wantRequests, cmpResourceListOption); diff != "" {
+				t.Errorf("workloadFromRaw() requests mismatch (-got, +want):\n%s", diff)
+			}
+			if w.divisible != tc.wantDivisible {
+				t.Errorf("workloadFromRaw() divisible = %t, want %t", w.divisible, tc.wantDivisible)
+			}
+		})
+	}
+}
+
+// TestWorkloadsOf tests the workloadsOf function.
+func TestWorkloadsOf(t *testing.T) {
+	resourceSnapshots := []placementv1beta1.ResourceSnapshotObj{
+		&placementv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot-0"},
+			Spec: placementv1beta1.ResourceSnapshotSpec{
+				SelectedResources: []placementv1beta1.ResourceContent{
+					{RawExtension: runtime.RawExtension{Raw: rawOf(t, deployment(ptr.To(int32(2)), containerWith(cpuAndMemory("1", "1Gi"), nil)))}},
+					{RawExtension: runtime.RawExtension{Raw: rawOf(t, &corev1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}})}},
+				},
+			},
+		},
+		&placementv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot-1"},
+			Spec: placementv1beta1.ResourceSnapshotSpec{
+				SelectedResources: []placementv1beta1.ResourceContent{
+					{RawExtension: runtime.RawExtension{Raw: rawOf(t, deployment(nil, containerWith(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}, nil)))}},
+				},
+			},
+		},
+	}
+
+	workloads, err := workloadsOf(resourceSnapshots)
+	if err != nil {
+		t.Fatalf("workloadsOf() = %v, want no error", err)
+	}
+	if len(workloads) != 2 {
+		t.Fatalf("workloadsOf() returned %d workloads, want 2", len(workloads))
+	}
+	if diff := cmp.Diff(totalRequestsOf(workloads, 1), cpuAndMemory("2500m", "2Gi"), cmpResourceListOption); diff != "" {
+		t.Errorf("workloadsOf() requests mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestTotalRequestsOf tests the totalRequestsOf function.
+func TestTotalRequestsOf(t *testing.T) {
+	workloads := []workload{
+		{podRequests: cpuAndMemory("1", "1Gi"), replicas: 3, divisible: true},
+		{podRequests: cpuAndMemory("500m", "512Mi"), replicas: 2},
+	}
+
+	testCases := []struct {
+		name         string
+		share        float64
+		wantRequests corev1.ResourceList
+	}{
+		{
+			name:         "all replicas",
+			share:        1,
+			wantRequests: cpuAndMemory("4", "4Gi"),
+		},
+		{
+			name:         "half of the replicas, rounded up",
+			share:        0.5,
+			wantRequests: cpuAndMemory("3", "3Gi"),
+		},
+		{
+			name:         "no replicas",
+			share:        0,
+			wantRequests: cpuAndMemory("1", "1Gi"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(totalRequestsOf(workloads, tc.share), tc.wantRequests, cmpResourceListOption); diff != "" {
+				t.Errorf("totalRequestsOf() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"context"
+	"math"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreScore allows the plugin to connect to the PreScore extension point in the scheduling
+// framework.
+func (p *Plugin) PreScore(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	// Prepare the plugin state if it has not been prepared in the PreFilter stage.
+	ps, err := p.readOrPreparePluginState(ctx, state, policy)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	if len(ps.requests) == 0 {
+		// The placement does not select any workload with resource requests; skip the step.
+		//
+		// Note that this will also skip the Score() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no workload resource requests")
+	}
+
+	// All done.
+	return nil
+}
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+//
+// A cluster is scored by the fraction of its available resources left after the placement, for the
+// scarcest resource the selected workloads request; a cluster that does not report the available
+// amount of any of the requested resources scores zero.
+func (p *Plugin) Score(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreScore stage.
+		return nil, framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	available := cluster.Status.ResourceUsage.Available
+	minLeftFraction := math.Inf(1)
+	for name, requested := range ps.requestsOn(cluster.Name) {
+		availableQuantity, ok := available[name]
+		if !ok || requested.IsZero() {
+			continue
+		}
+		availableAmount := availableQuantity.AsApproximateFloat64()
+		leftFraction := 0.0
+		if availableAmount > 0 {
+			leftFraction = (availableAmount - requested.AsApproximateFloat64()) / availableAmount
+		}
+		minLeftFraction = math.Min(minLeftFraction, leftFraction)
+	}
+
+	score = &framework.ClusterScore{}
+	if !math.IsInf(minLeftFraction, 1) && minLeftFraction > 0 {
+		score.AffinityScore = int32(math.Floor(minLeftFraction * MaxResourceCapacityScore))
+	}
+
+	// All done.
+	return score, nil
+}
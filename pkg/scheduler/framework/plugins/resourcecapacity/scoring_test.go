@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// TestPreScore tests the PreScore extension point of the plugin.
+func TestPreScore(t *testing.T) {
+	p := New()
+	p.client = fake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+	state := framework.NewCycleState(nil, nil, nil)
+	status := p.PreScore(context.Background(), state, policySnapshot())
+	wantStatus := framework.NewNonErrorStatus(framework.Skip, defaultPluginName)
+	if diff := cmp.Diff(status, wantStatus, cmpStatusOptions); diff != "" {
+		t.Errorf("PreScore() status mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestScore tests the Score extension point of the plugin.
+func TestScore(t *testing.T) {
+	testCases := []struct {
+		name      string
+		requests  corev1.ResourceList
+		available corev1.ResourceList
+		wantScore *framework.ClusterScore
+	}{
+		{
+			name:      "scarcest resource decides the score",
+			requests:  cpuAndMemory("1", "6Gi"),
+			available: cpuAndMemory("4", "8Gi"),
+			wantScore: &framework.ClusterScore{AffinityScore: 25},
+		},
+		{
+			name:      "fraction is rounded down",
+			requests:  cpuAndMemory("1", "1Gi"),
+			available: cpuAndMemory("3", "8Gi"),
+			wantScore: &framework.ClusterScore{AffinityScore: 66},
+		},
+		{
+			name:      "requests exceed available resources",
+			requests:  cpuAndMemory("5", "1Gi"),
+			available: cpuAndMemory("4", "8Gi"),
+			wantScore: &framework.ClusterScore{},
+		},
+		{
+			name: "only reported resources are scored",
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+				"nvidia.com/gpu":   resource.MustParse("2"),
+			},
+			available: cpuAndMemory("2", "8Gi"),
+			wantScore: &framework.ClusterScore{AffinityScore: 50},
+		},
+		{
+			name:      "cluster reports no available resources",
+			requests:  cpuAndMemory("1", "1Gi"),
+			wantScore: &framework.ClusterScore{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			state := framework.NewCycleState(nil, nil, nil)
+			state.Write(framework.StateKey(p.Name()), &pluginState{requests: tc.requests})
+			score, status := p.Score(context.Background(), state, policySnapshot(), clusterWithAvailable(tc.available))
+			if !status.IsSuccess() {
+				t.Fatalf("Score() status = %v, want success", status)
+			}
+			if diff := cmp.Diff(score, tc.wantScore); diff != "" {
+				t.Errorf("Score() score mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcecapacity
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+type pluginState struct {
+	// requests is the total resource requests of the workloads selected by the placement, with all
+	// of their replicas.
+	requests corev1.ResourceList
+	// workloads are the workloads selected by the placement.
+	workloads []workload
+	// replicaShares estimates the share of the replicas that each cluster runs; it is nil if the
+	// placement does not divide the replicas.
+	replicaShares *replicaShareEstimate
+}
+
+// requestsOn returns the total resource requests of the workloads selected by the placement on a
+// cluster, i.e., with the estimated share of the replicas that the cluster runs.
+func (ps *pluginState) requestsOn(clusterName string) corev1.ResourceList {
+	if ps.replicaShares == nil {
+		return ps.requests
+	}
+	return totalRequestsOf(ps.workloads, ps.replicaShares.shareOf(clusterName))
+}
+
+// preparePluginState prepares a common state with the total resource requests of the workloads in
+// the latest resource snapshots of the placement being scheduled.
+func (p *Plugin) preparePluginState(ctx context.Context, state framework.CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	placementName := policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if len(placementName) == 0 {
+		// The policy snapshot is not associated with a placement, e.g., in a simulation; there is no
+		// resource to check.
+		return &pluginState{}, nil
+	}
+
+	placementKey := types.NamespacedName{Namespace: policy.GetNamespace(), Name: placementName}
+	masterResourceSnapshot, err := controller.FetchLatestMasterResourceSnapshot(ctx, p.client, placementKey)
+	if err != nil {
+		return nil, err
+	}
+	if masterResourceSnapshot == nil {
+		// No resource has been selected yet.
+		return &pluginState{}, nil
+	}
+	resourceSnapshotsByName, err := controller.FetchAllResourceSnapshotsAlongWithMaster(ctx, p.client,
+		controller.GetObjectKeyFromNamespaceName(placementKey.Namespace, placementKey.Name), masterResourceSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	resourceSnapshots := make([]placementv1beta1.ResourceSnapshotObj, 0, len(resourceSnapshotsByName))
+	for _, resourceSnapshot := range resourceSnapshotsByName {
+		resourceSnapshots = append(resourceSnapshots, resourceSnapshot)
+	}
+
+	workloads, err := workloadsOf(resourceSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginState{
+		requests:      totalRequestsOf(workloads, 1),
+		workloads:     workloads,
+		replicaShares: estimateReplicaShares(policy.GetPolicySnapshotSpec().Policy, state.ListClusters()),
+	}, nil
+}
+
+// readOrPreparePluginState reads the plugin state from the cycle state, or prepares (and saves) one
+// if the state has not been prepared yet in the current scheduling cycle.
+func (p *Plugin) readOrPreparePluginState(ctx context.Context, state framework.CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	if ps, err := p.readPluginState(state); err == nil {
+		return ps, nil
+	}
+
+	ps, err := p.preparePluginState(ctx, state, policy)
+	if err != nil {
+		return nil, err
+	}
+	state.Write(framework.StateKey(p.Name()), ps)
+	return ps, nil
+}
+
+// readPluginState reads the plugin state from the cycle state.
+func (p *Plugin) readPluginState(state framework.CycleStatePluginReadWriter) (*pluginState, error) {
+	val, err := state.Read(framework.StateKey(p.Name()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read value from the cycle state: %w", err)
+	}
+
+	ps, ok := val.(*pluginState)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast value %v to the right type", val)
+	}
+	return ps, nil
+}
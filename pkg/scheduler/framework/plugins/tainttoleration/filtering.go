@@ -43,14 +43,21 @@ func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placement
 
 func tolerationsTolerateTaint(taint clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) bool {
 	for _, toleration := range tolerations {
-		if canTolerationTolerateTaint(taint, toleration) {
+		// A NoExecute taint that is only tolerated for a limited period of time would have the
+		// placement evicted from the cluster later; to avoid placing resources on the cluster only
+		// to evict them shortly after, the scheduler does not consider such taints as tolerated.
+		if taint.Effect == corev1.TaintEffectNoExecute && toleration.TolerationSeconds != nil {
+			continue
+		}
+		if CanTolerationTolerateTaint(taint, toleration) {
 			return true
 		}
 	}
 	return false
 }
 
-func canTolerationTolerateTaint(taint clusterv1beta1.Taint, toleration placementv1beta1.Toleration) bool {
+// CanTolerationTolerateTaint returns if a toleration tolerates a taint, regardless of the toleration seconds.
+func CanTolerationTolerateTaint(taint clusterv1beta1.Taint, toleration placementv1beta1.Toleration) bool {
 	if toleration.Operator == corev1.TolerationOpExists {
 		if toleration.Key == "" || toleration.Key == taint.Key {
 			return toleration.Effect == taint.Effect || toleration.Effect == ""
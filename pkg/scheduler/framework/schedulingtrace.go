@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// cycleTrace keeps track of the results of the Filter and Score plugins for each cluster in a
+// scheduling cycle, so that the scheduler can report them in a scheduling trace object.
+//
+// It is safe for concurrent use, as plugins run for clusters in parallel.
+type cycleTrace struct {
+	mu sync.Mutex
+	// filterStatuses is the status of the Filter plugin that has filtered out a cluster (or has
+	// found that a cluster is already selected), keyed by cluster names.
+	filterStatuses map[string]*Status
+	// pluginScores is the list of scores from each Score plugin for a cluster, keyed by cluster names.
+	pluginScores map[string]map[string]*ClusterScore
+}
+
+// newCycleTrace returns a new cycleTrace.
+func newCycleTrace() *cycleTrace {
+	return &cycleTrace{
+		filterStatuses: make(map[string]*Status),
+		pluginScores:   make(map[string]map[string]*ClusterScore),
+	}
+}
+
+// recordFilterStatus records the status of the Filter plugin that has filtered out a cluster.
+//
+// It is a no-op on a nil cycleTrace, i.e., when the scheduling trace support is disabled.
+func (t *cycleTrace) recordFilterStatus(clusterName string, status *Status) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.filterStatuses[clusterName] = status
+}
+
+// recordPluginScores records the scores from each Score plugin for a cluster.
+//
+// It is a no-op on a nil cycleTrace, i.e., when the scheduling trace support is disabled.
+func (t *cycleTrace) recordPluginScores(clusterName string, scoreList map[string]*ClusterScore) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pluginScores[clusterName] = scoreList
+}
+
+// pluginScoresFrom converts the scores from each Score plugin for a cluster into their API
+// representation, sorted by the plugin name.
+func pluginScoresFrom(scoreList map[string]*ClusterScore) []placementv1beta1.PluginScore {
+	pluginScores := make([]placementv1beta1.PluginScore, 0, len(scoreList))
+	for pluginName, score := range scoreList {
+		affinityScore := score.AffinityScore
+		topologySpreadScore := score.TopologySpreadScore
+		pluginScores = append(pluginScores, placementv1beta1.PluginScore{
+			PluginName: pluginName,
+			Score: placementv1beta1.ClusterScore{
+				AffinityScore:       &affinityScore,
+				TopologySpreadScore: &topologySpreadScore,
+			},
+		})
+	}
+	sort.Slice(pluginScores, func(i, j int) bool {
+		return pluginScores[i].PluginName < pluginScores[j].PluginName
+	})
+	return pluginScores
+}
+
+// build returns the trace of the scheduling cycle for all the clusters evaluated, given the
+// clusters picked and not picked in the cycle.
+func (t *cycleTrace) build(policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster, picked, notPicked ScoredClusters) placementv1beta1.SchedulingCycleTrace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pickedClusters := make(map[string]*ScoredCluster, len(picked))
+	for _, sc := range picked {
+		pickedClusters[sc.Cluster.Name] = sc
+	}
+	notPickedClusters := make(map[string]*ScoredCluster, len(notPicked))
+	for _, sc := range notPicked {
+		notPickedClusters[sc.Cluster.Name] = sc
+	}
+
+	entries := make([]placementv1beta1.ClusterSchedulingTraceEntry, 0, len(clusters))
+	for idx := range clusters {
+		clusterName := clusters[idx].Name
+		entry := placementv1beta1.ClusterSchedulingTraceEntry{
+			ClusterName: clusterName,
+		}
+
+		scoreList, isScored := t.pluginScores[clusterName]
+		if isScored {
+			entry.PluginScores = pluginScoresFrom(scoreList)
+		}
+
+		status := t.filterStatuses[clusterName]
+		switch {
+		case status.IsClusterAlreadySelected():
+			entry.Selected = true
+			entry.Reason = status.String()
+		case status.IsClusterUnschedulable():
+			entry.Reason = status.String()
+			entry.FilterResult = &placementv1beta1.PluginFilterResult{
+				PluginName: status.SourcePlugin(),
+				Reason:     status.String(),
+			}
+		case pickedClusters[clusterName] != nil:
+			entry.Selected = true
+			sc := pickedClusters[clusterName]
+			if isScored {
+				entry.Reason = fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, sc.Score.AffinityScore, sc.Score.TopologySpreadScore)
+			} else {
+				entry.Reason = fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName)
+			}
+		case notPickedClusters[clusterName] != nil:
+			sc := notPickedClusters[clusterName]
+			entry.Reason = fmt.Sprintf(notPickedByScoreReasonTemplate, clusterName, sc.Score.AffinityScore, sc.Score.TopologySpreadScore)
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ClusterName < entries[j].ClusterName
+	})
+
+	if len(entries) > clustersDecisionArrayLengthLimitInAPI {
+		klog.V(2).InfoS("Reached API limit of scheduling trace cluster count; clusters off the limit will be discarded", "policySnapshot", klog.KObj(policy))
+		entries = entries[:clustersDecisionArrayLengthLimitInAPI]
+	}
+	return placementv1beta1.SchedulingCycleTrace{
+		PolicySnapshotName: policy.GetName(),
+		Clusters:           entries,
+	}
+}
+
+// updateSchedulingTrace creates or updates the scheduling trace object of the placement which owns
+// the given policy snapshot, with the results of the plugins recorded in the cycle state.
+//
+// This is a no-op if the scheduling trace support is disabled. Any error is logged only, as the
+// trace is for informational purposes and should not block the scheduling cycle.
+func (f *framework) updateSchedulingTrace(
+	ctx context.Context,
+	state *CycleState,
+	policy placementv1beta1.PolicySnapshotObj,
+	picked, notPicked ScoredClusters,
+) {
+	if state.trace == nil {
+		return
+	}
+	policyRef := klog.KObj(policy)
+
+	placementName := policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if len(placementName) == 0 {
+		klog.V(2).InfoS("Policy snapshot does not have a placement tracking label; skip updating the scheduling trace", "policySnapshot", policyRef)
+		return
+	}
+
+	var trace placementv1beta1.SchedulingTraceObj
+	if len(policy.GetNamespace()) == 0 {
+		trace = &placementv1beta1.ClusterSchedulingTrace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: placementName,
+			},
+		}
+	} else {
+		trace = &placementv1beta1.SchedulingTrace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      placementName,
+				Namespace: policy.GetNamespace(),
+			},
+		}
+	}
+
+	cycleTrace := state.trace.build(policy, state.clusters, picked, notPicked)
+	op, err := controllerutil.CreateOrUpdate(ctx, f.client, trace, func() error {
+		// Only refresh the trace (and its update time) when the results have changed, so that no
+		// update is issued in scheduling cycles with the same outcome.
+		if !equality.Semantic.DeepEqual(*trace.GetSchedulingCycleTrace(), cycleTrace) {
+			trace.SetSchedulingCycleTrace(cycleTrace)
+			trace.SetLastUpdatedTime(metav1.Now())
+		}
+
+		// Set the placement as the owner, so that the trace is deleted along with the placement.
+		if owner := metav1.GetControllerOf(policy); owner != nil {
+			trace.SetOwnerReferences([]metav1.OwnerReference{*owner})
+		}
+		return nil
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create or update the scheduling trace", "policySnapshot", policyRef, "schedulingTrace", klog.KObj(trace))
+		return
+	}
+	klog.V(2).InfoS("Scheduling trace is processed", "policySnapshot", policyRef, "schedulingTrace", klog.KObj(trace), "operation", op)
+}
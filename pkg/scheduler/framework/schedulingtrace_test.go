@@ -0,0 +1,435 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	traceNamespace = "test-namespace"
+)
+
+var (
+	ignoreTraceResourceVersionField = cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion")
+)
+
+// TestCycleTraceBuild tests the build method of cycleTrace.
+func TestCycleTraceBuild(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	clusterName3 := fmt.Sprintf(clusterNameTemplate, 3)
+	clusterName4 := fmt.Sprintf(clusterNameTemplate, 4)
+	clusterName5 := fmt.Sprintf(clusterNameTemplate, 5)
+	// The clusters are deliberately out of order.
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName4}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName2}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName3}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName5}},
+	}
+	filterPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	scorePluginNameA := fmt.Sprintf(dummyAllPurposePluginNameFormat, 1)
+	scorePluginNameB := fmt.Sprintf(dummyAllPurposePluginNameFormat, 2)
+
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+	}
+	alreadySelectedStatus := NewNonErrorStatus(ClusterAlreadySelected, filterPluginName, "already selected")
+	unschedulableStatus := NewNonErrorStatus(ClusterUnschedulable, filterPluginName, "cluster does not match")
+
+	testCases := []struct {
+		name      string
+		trace     func() *cycleTrace
+		picked    ScoredClusters
+		notPicked ScoredClusters
+		want      placementv1beta1.SchedulingCycleTrace
+	}{
+		{
+			name: "pickAll placement type",
+			trace: func() *cycleTrace {
+				ct := newCycleTrace()
+				ct.recordFilterStatus(clusterName1, alreadySelectedStatus)
+				ct.recordFilterStatus(clusterName2, unschedulableStatus)
+				return ct
+			},
+			picked: ScoredClusters{
+				{Cluster: &clusters[3], Score: &ClusterScore{}},
+				{Cluster: &clusters[0], Score: &ClusterScore{}},
+				{Cluster: &clusters[4], Score: &ClusterScore{}},
+			},
+			want: placementv1beta1.SchedulingCycleTrace{
+				PolicySnapshotName: policyName,
+				Clusters: []placementv1beta1.ClusterSchedulingTraceEntry{
+					{
+						ClusterName: clusterName1,
+						Selected:    true,
+						Reason:      alreadySelectedStatus.String(),
+					},
+					{
+						ClusterName: clusterName2,
+						Reason:      unschedulableStatus.String(),
+						FilterResult: &placementv1beta1.PluginFilterResult{
+							PluginName: filterPluginName,
+							Reason:     unschedulableStatus.String(),
+						},
+					},
+					{
+						ClusterName: clusterName3,
+						Selected:    true,
+						Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName3),
+					},
+					{
+						ClusterName: clusterName4,
+						Selected:    true,
+						Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName4),
+					},
+					{
+						ClusterName: clusterName5,
+						Selected:    true,
+						Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName5),
+					},
+				},
+			},
+		},
+		{
+			name: "pickN placement type",
+			trace: func() *cycleTrace {
+				ct := newCycleTrace()
+				ct.recordFilterStatus(clusterName1, alreadySelectedStatus)
+				ct.recordFilterStatus(clusterName2, unschedulableStatus)
+				ct.recordPluginScores(clusterName3, map[string]*ClusterScore{
+					scorePluginNameB: {TopologySpreadScore: 1},
+					scorePluginNameA: {AffinityScore: 10},
+				})
+				ct.recordPluginScores(clusterName4, map[string]*ClusterScore{
+					scorePluginNameA: {AffinityScore: 5},
+					scorePluginNameB: {TopologySpreadScore: -1},
+				})
+				ct.recordPluginScores(clusterName5, map[string]*ClusterScore{})
+				return ct
+			},
+			picked: ScoredClusters{
+				{Cluster: &clusters[3], Score: &ClusterScore{AffinityScore: 10, TopologySpreadScore: 1}},
+			},
+			notPicked: ScoredClusters{
+				{Cluster: &clusters[0], Score: &ClusterScore{AffinityScore: 5, TopologySpreadScore: -1}},
+				{Cluster: &clusters[4], Score: &ClusterScore{}},
+			},
+			want: placementv1beta1.SchedulingCycleTrace{
+				PolicySnapshotName: policyName,
+				Clusters: []placementv1beta1.ClusterSchedulingTraceEntry{
+					{
+						ClusterName: clusterName1,
+						Selected:    true,
+						Reason:      alreadySelectedStatus.String(),
+					},
+					{
+						ClusterName: clusterName2,
+						Reason:      unschedulableStatus.String(),
+						FilterResult: &placementv1beta1.PluginFilterResult{
+							PluginName: filterPluginName,
+							Reason:     unschedulableStatus.String(),
+						},
+					},
+					{
+						ClusterName: clusterName3,
+						Selected:    true,
+						Reason:      fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, 10, 1),
+						PluginScores: []placementv1beta1.PluginScore{
+							{
+								PluginName: scorePluginNameA,
+								Score: placementv1beta1.ClusterScore{
+									AffinityScore:       ptr.To(int32(10)),
+									TopologySpreadScore: ptr.To(int32(0)),
+								},
+							},
+							{
+								PluginName: scorePluginNameB,
+								Score: placementv1beta1.ClusterScore{
+									AffinityScore:       ptr.To(int32(0)),
+									TopologySpreadScore: ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					{
+						ClusterName: clusterName4,
+						Reason:      fmt.Sprintf(notPickedByScoreReasonTemplate, clusterName4, 5, -1),
+						PluginScores: []placementv1beta1.PluginScore{
+							{
+								PluginName: scorePluginNameA,
+								Score: placementv1beta1.ClusterScore{
+									AffinityScore:       ptr.To(int32(5)),
+									TopologySpreadScore: ptr.To(int32(0)),
+								},
+							},
+							{
+								PluginName: scorePluginNameB,
+								Score: placementv1beta1.ClusterScore{
+									AffinityScore:       ptr.To(int32(0)),
+									TopologySpreadScore: ptr.To(int32(-1)),
+								},
+							},
+						},
+					},
+					{
+						ClusterName:  clusterName5,
+						Reason:       fmt.Sprintf(notPickedByScoreReasonTemplate, clusterName5, 0, 0),
+						PluginScores: []placementv1beta1.PluginScore{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.trace().build(policy, clusters, tc.picked, tc.notPicked)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("build() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestUpdateSchedulingTrace tests the updateSchedulingTrace method.
+func TestUpdateSchedulingTrace(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName2}},
+	}
+	filterPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	unschedulableStatus := NewNonErrorStatus(ClusterUnschedulable, filterPluginName, "cluster does not match")
+	wantCycleTrace := placementv1beta1.SchedulingCycleTrace{
+		PolicySnapshotName: policyName,
+		Clusters: []placementv1beta1.ClusterSchedulingTraceEntry{
+			{
+				ClusterName: clusterName1,
+				Selected:    true,
+				Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName1),
+			},
+			{
+				ClusterName: clusterName2,
+				Reason:      unschedulableStatus.String(),
+				FilterResult: &placementv1beta1.PluginFilterResult{
+					PluginName: filterPluginName,
+					Reason:     unschedulableStatus.String(),
+				},
+			},
+		},
+	}
+	crpOwner := metav1.OwnerReference{
+		APIVersion: placementv1beta1.GroupVersion.String(),
+		Kind:       placementv1beta1.ClusterResourcePlacementKind,
+		Name:       crpName,
+		UID:        "crp-uid",
+		Controller: ptr.To(true),
+	}
+	rpOwner := metav1.OwnerReference{
+		APIVersion: placementv1beta1.GroupVersion.String(),
+		Kind:       placementv1beta1.ResourcePlacementKind,
+		Name:       crpName,
+		UID:        "rp-uid",
+		Controller: ptr.To(true),
+	}
+	lastUpdatedTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	testCases := []struct {
+		name          string
+		policy        placementv1beta1.PolicySnapshotObj
+		existing      []client.Object
+		disabled      bool
+		wantTrace     placementv1beta1.SchedulingTraceObj
+		wantNoTrace   bool
+		wantRefreshed bool
+	}{
+		{
+			name: "disabled",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            policyName,
+					Labels:          map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					OwnerReferences: []metav1.OwnerReference{crpOwner},
+				},
+			},
+			disabled:    true,
+			wantTrace:   &placementv1beta1.ClusterSchedulingTrace{},
+			wantNoTrace: true,
+		},
+		{
+			name: "no placement tracking label",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			},
+			wantTrace:   &placementv1beta1.ClusterSchedulingTrace{},
+			wantNoTrace: true,
+		},
+		{
+			name: "create cluster scheduling trace",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            policyName,
+					Labels:          map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					OwnerReferences: []metav1.OwnerReference{crpOwner},
+				},
+			},
+			wantTrace: &placementv1beta1.ClusterSchedulingTrace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            crpName,
+					OwnerReferences: []metav1.OwnerReference{crpOwner},
+				},
+				Trace: wantCycleTrace,
+			},
+			wantRefreshed: true,
+		},
+		{
+			name: "update scheduling trace",
+			policy: &placementv1beta1.SchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            policyName,
+					Namespace:       traceNamespace,
+					Labels:          map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					OwnerReferences: []metav1.OwnerReference{rpOwner},
+				},
+			},
+			existing: []client.Object{
+				&placementv1beta1.SchedulingTrace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      crpName,
+						Namespace: traceNamespace,
+					},
+					Trace: placementv1beta1.SchedulingCycleTrace{
+						PolicySnapshotName: "old-policy",
+					},
+					LastUpdatedTime: lastUpdatedTime,
+				},
+			},
+			wantTrace: &placementv1beta1.SchedulingTrace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            crpName,
+					Namespace:       traceNamespace,
+					OwnerReferences: []metav1.OwnerReference{rpOwner},
+				},
+				Trace: wantCycleTrace,
+			},
+			wantRefreshed: true,
+		},
+		{
+			name: "trace unchanged",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            policyName,
+					Labels:          map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					OwnerReferences: []metav1.OwnerReference{crpOwner},
+				},
+			},
+			existing: []client.Object{
+				&placementv1beta1.ClusterSchedulingTrace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            crpName,
+						OwnerReferences: []metav1.OwnerReference{crpOwner},
+					},
+					Trace:           wantCycleTrace,
+					LastUpdatedTime: lastUpdatedTime,
+				},
+			},
+			wantTrace: &placementv1beta1.ClusterSchedulingTrace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            crpName,
+					OwnerReferences: []metav1.OwnerReference{crpOwner},
+				},
+				Trace:           wantCycleTrace,
+				LastUpdatedTime: lastUpdatedTime,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(tc.existing...).
+				Build()
+			f := &framework{
+				client: fakeClient,
+			}
+
+			state := NewCycleState(clusters, nil)
+			if !tc.disabled {
+				state.trace = newCycleTrace()
+			}
+			state.trace.recordFilterStatus(clusterName2, unschedulableStatus)
+			picked := ScoredClusters{{Cluster: &clusters[0], Score: &ClusterScore{}}}
+
+			startTime := metav1.Now().Rfc3339Copy()
+			f.updateSchedulingTrace(context.Background(), state, tc.policy, picked, nil)
+
+			got := tc.wantTrace.DeepCopyObject().(placementv1beta1.SchedulingTraceObj)
+			key := types.NamespacedName{Name: crpName, Namespace: tc.policy.GetNamespace()}
+			err := fakeClient.Get(context.Background(), key, got)
+			if tc.wantNoTrace {
+				if err == nil {
+					t.Fatalf("Get() = %v, want not found error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get() = %v, want no error", err)
+			}
+
+			gotLastUpdatedTime := metav1.Time{}
+			switch trace := got.(type) {
+			case *placementv1beta1.ClusterSchedulingTrace:
+				gotLastUpdatedTime = trace.LastUpdatedTime
+			case *placementv1beta1.SchedulingTrace:
+				gotLastUpdatedTime = trace.LastUpdatedTime
+			}
+			if tc.wantRefreshed {
+				if gotLastUpdatedTime.Before(&startTime) {
+					t.Errorf("LastUpdatedTime = %v, want no earlier than %v", gotLastUpdatedTime, startTime)
+				}
+				tc.wantTrace.SetLastUpdatedTime(gotLastUpdatedTime)
+			}
+			if diff := cmp.Diff(got, tc.wantTrace, ignoreTraceResourceVersionField, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
+				t.Errorf("updateSchedulingTrace() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// SimulateSchedulingFor runs the scheduler plugins for a scheduling policy snapshot that is not
+// persisted, as if it were the latest policy snapshot of a new placement; it returns the scheduling
+// decisions the scheduler would make, along with the results of the plugins for each cluster.
+//
+// Unlike RunSchedulingCycleFor, this method does not read or write any binding, nor does it update
+// the status of the policy snapshot.
+func (f *framework) SimulateSchedulingFor(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+) (decisions []placementv1beta1.ClusterDecision, results []placementv1beta1.ClusterSimulationResult, err error) {
+	policyRef := klog.KObj(policy)
+	klog.V(2).InfoS("Scheduling simulation starts", "policySnapshot", policyRef)
+	defer klog.V(2).InfoS("Scheduling simulation ends", "policySnapshot", policyRef)
+
+	clusters, err := f.collectClusters(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect clusters", "policySnapshot", policyRef)
+		return nil, nil, err
+	}
+
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	switch {
+	case placementPolicy == nil || placementPolicy.PlacementType == placementv1beta1.PickAllPlacementType:
+		// The placement policy is not set; in such cases the policy is considered to be of
+		// the PickAll placement type.
+		return f.simulateSchedulingCycles(ctx, policy, clusters, false, 0)
+	case placementPolicy.PlacementType == placementv1beta1.PickFixedPlacementType:
+		// No plugin runs for policies of the PickFixed placement type.
+		valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(clusters, placementPolicy.ClusterNames)
+		return newSchedulingDecisionsForPickFixedPlacementType(valid, invalid, notFound), nil, nil
+	case placementPolicy.PlacementType == placementv1beta1.PickNPlacementType:
+		numOfClusters, err := annotations.ExtractNumOfClustersFromPolicySnapshot(policy)
+		if err != nil {
+			klog.ErrorS(err, "Failed to extract number of clusters required from policy snapshot", "policySnapshot", policyRef)
+			return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		}
+		return f.simulateSchedulingCycles(ctx, policy, clusters, true, numOfClusters)
+	default:
+		// This normally should never occur.
+		err := fmt.Errorf("the placement type %s is unknown", placementPolicy.PlacementType)
+		klog.ErrorS(err, "Failed to simulate scheduling", "policySnapshot", policyRef)
+		return nil, nil, controller.NewUnexpectedBehaviorError(err)
+	}
+}
+
+// simulateSchedulingCycles runs simulated scheduling cycles for a policy of the PickAll or the PickN
+// placement type.
+//
+// For the PickN placement type, a real scheduler might need multiple cycles to pick all the clusters
+// it needs, as post-batch plugins might limit the number of clusters to pick in one cycle; the
+// simulation repeats the cycles in the same manner, treating the clusters picked in earlier cycles
+// as scheduled ones.
+func (f *framework) simulateSchedulingCycles(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	isPickN bool,
+	numOfClusters int,
+) ([]placementv1beta1.ClusterDecision, []placementv1beta1.ClusterSimulationResult, error) {
+	policyRef := klog.KObj(policy)
+
+	// resultsByCluster keeps the latest results of the plugins for each cluster; for a cluster picked
+	// in an earlier cycle, the results are the ones from the cycle in which it is picked.
+	resultsByCluster := make(map[string]*placementv1beta1.ClusterSimulationResult, len(clusters))
+	picked := make(ScoredClusters, 0)
+	scheduled := make([]placementv1beta1.BindingObj, 0)
+	var notPicked ScoredClusters
+	var filtered filteredClusterWithStatusList
+	for {
+		state := NewCycleState(clusters, nil, scheduled)
+
+		desiredBatchSize := numOfClusters - len(picked)
+		batchSizeLimit := desiredBatchSize
+		if isPickN {
+			state.desiredBatchSize = desiredBatchSize
+			limit, status := f.runPostBatchPlugins(ctx, state, policy)
+			if status.IsInteralError() {
+				klog.ErrorS(status.AsError(), "Failed to run post batch plugins", "policySnapshot", policyRef)
+				return nil, nil, controller.NewUnexpectedBehaviorError(status.AsError())
+			}
+			batchSizeLimit = limit
+			state.batchSizeLimit = limit
+		}
+
+		if status := f.runPreFilterPlugins(ctx, state, policy); status.IsInteralError() {
+			klog.ErrorS(status.AsError(), "Failed to run pre filter plugins", "policySnapshot", policyRef)
+			return nil, nil, controller.NewUnexpectedBehaviorError(status.AsError())
+		}
+
+		passed := make([]*clusterv1beta1.MemberCluster, 0, len(clusters))
+		filtered = make(filteredClusterWithStatusList, 0)
+		for idx := range clusters {
+			cluster := &clusters[idx]
+			if state.HasScheduledOrBoundBindingFor(cluster.Name) {
+				// The cluster has been picked in an earlier cycle.
+				continue
+			}
+			status, filterResults, err := f.simulateFilterPluginsFor(ctx, state, policy, cluster)
+			if err != nil {
+				klog.ErrorS(err, "Failed to run filter plugins", "policySnapshot", policyRef)
+				return nil, nil, controller.NewUnexpectedBehaviorError(err)
+			}
+			resultsByCluster[cluster.Name] = &placementv1beta1.ClusterSimulationResult{
+				ClusterName:   cluster.Name,
+				Feasible:      status == nil,
+				FilterResults: filterResults,
+			}
+			if status != nil {
+				filtered = append(filtered, &filteredClusterWithStatus{cluster: cluster, status: status})
+				continue
+			}
+			passed = append(passed, cluster)
+		}
+		sort.Sort(filtered)
+
+		if !isPickN {
+			// Policies of the PickAll placement type pick all clusters that have passed the filter plugins.
+			for _, cluster := range passed {
+				picked = append(picked, &ScoredCluster{Cluster: cluster, Score: &ClusterScore{}})
+			}
+			sort.Sort(picked)
+			return newSimulatedSchedulingDecisions(picked, nil, filtered), collectSimulationResults(resultsByCluster), nil
+		}
+
+		if status := f.runPreScorePlugins(ctx, state, policy); status.IsInteralError() {
+			klog.ErrorS(status.AsError(), "Failed to run pre-score plugins", "policySnapshot", policyRef)
+			return nil, nil, controller.NewUnexpectedBehaviorError(status.AsError())
+		}
+		scored := make(ScoredClusters, 0, len(passed))
+		for _, cluster := range passed {
+			scoreList, status := f.runScorePluginsFor(ctx, state, policy, cluster)
+			if !status.IsSuccess() {
+				klog.ErrorS(status.AsError(), "Failed to run score plugins", "policySnapshot", policyRef)
+				return nil, nil, controller.NewUnexpectedBehaviorError(status.AsError())
+			}
+			totalScore := &ClusterScore{}
+			for _, score := range scoreList {
+				totalScore.Add(score)
+			}
+			resultsByCluster[cluster.Name].PluginScores = pluginScoresFrom(scoreList)
+			scored = append(scored, &ScoredCluster{Cluster: cluster, Score: totalScore})
+		}
+
+		numOfClustersToPick := calcNumOfClustersToSelect(desiredBatchSize, batchSizeLimit, len(scored))
+		var pickedInCycle ScoredClusters
+		pickedInCycle, notPicked = pickTopNScoredClusters(scored, numOfClustersToPick)
+		for _, sc := range pickedInCycle {
+			picked = append(picked, sc)
+			// Keep track of the picked clusters as scheduled bindings so that the plugins
+			// can take them into account in later cycles.
+			scheduled = append(scheduled, &placementv1beta1.ClusterResourceBinding{
+				Spec: placementv1beta1.ResourceBindingSpec{
+					State:                        placementv1beta1.BindingStateScheduled,
+					SchedulingPolicySnapshotName: policy.GetName(),
+					TargetCluster:                sc.Cluster.Name,
+				},
+			})
+		}
+
+		if len(pickedInCycle) == 0 || !shouldRequeue(desiredBatchSize, batchSizeLimit, len(pickedInCycle)) {
+			break
+		}
+	}
+
+	return newSimulatedSchedulingDecisions(picked, notPicked, filtered), collectSimulationResults(resultsByCluster), nil
+}
+
+// simulateFilterPluginsFor runs all filter plugins for a single cluster, even if the cluster has been
+// filtered out by an earlier plugin; it returns the status from the first plugin that filters out the
+// cluster (nil if the cluster has passed all the plugins), along with the reasons from all the plugins
+// that filter out the cluster.
+func (f *framework) simulateFilterPluginsFor(
+	ctx context.Context,
+	state *CycleState,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (firstFiltered *Status, filterResults []placementv1beta1.PluginFilterResult, err error) {
+	for _, pl := range f.profile.filterPlugins {
+		// Skip the plugin if it is not needed.
+		if state.skippedFilterPlugins.Has(pl.Name()) {
+			continue
+		}
+		status := pl.Filter(ctx, state, policy, cluster)
+		switch {
+		case status.IsSuccess(): // Do nothing.
+		case status.IsClusterUnschedulable():
+			if firstFiltered == nil {
+				firstFiltered = status
+			}
+			filterResults = append(filterResults, placementv1beta1.PluginFilterResult{
+				PluginName: pl.Name(),
+				Reason:     status.String(),
+			})
+		case status.IsInteralError():
+			return nil, nil, status.AsError()
+		default:
+			// Any status that is not Success, InternalError, or ClusterUnschedulable is considered an error.
+			return nil, nil, fmt.Errorf("filter plugin %s returned an unknown status %s", pl.Name(), status)
+		}
+	}
+	return firstFiltered, filterResults, nil
+}
+
+// newSimulatedSchedulingDecisions returns a list of scheduling decisions from the clusters picked,
+// not picked, and filtered out in a simulation.
+func newSimulatedSchedulingDecisions(picked, notPicked ScoredClusters, filtered filteredClusterWithStatusList) []placementv1beta1.ClusterDecision {
+	decisions := make([]placementv1beta1.ClusterDecision, 0, len(picked)+len(notPicked)+len(filtered))
+	for _, sc := range picked {
+		affinityScore := sc.Score.AffinityScore
+		topologySpreadScore := sc.Score.TopologySpreadScore
+		decisions = append(decisions, placementv1beta1.ClusterDecision{
+			ClusterName: sc.Cluster.Name,
+			Selected:    true,
+			ClusterScore: &placementv1beta1.ClusterScore{
+				AffinityScore:       &affinityScore,
+				TopologySpreadScore: &topologySpreadScore,
+			},
+			Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, sc.Cluster.Name, affinityScore, topologySpreadScore),
+		})
+	}
+	for _, sc := range notPicked {
+		affinityScore := sc.Score.AffinityScore
+		topologySpreadScore := sc.Score.TopologySpreadScore
+		decisions = append(decisions, placementv1beta1.ClusterDecision{
+			ClusterName: sc.Cluster.Name,
+			Selected:    false,
+			ClusterScore: &placementv1beta1.ClusterScore{
+				AffinityScore:       &affinityScore,
+				TopologySpreadScore: &topologySpreadScore,
+			},
+			Reason: fmt.Sprintf(notPickedByScoreReasonTemplate, sc.Cluster.Name, affinityScore, topologySpreadScore),
+		})
+	}
+	for _, fc := range filtered {
+		decisions = append(decisions, placementv1beta1.ClusterDecision{
+			ClusterName: fc.cluster.Name,
+			Selected:    false,
+			Reason:      fc.status.String(),
+		})
+	}
+
+	if len(decisions) > clustersDecisionArrayLengthLimitInAPI {
+		klog.V(2).InfoS("Reached API limit of cluster decision count; decisions off the limit will be discarded")
+		decisions = decisions[:clustersDecisionArrayLengthLimitInAPI]
+	}
+	return decisions
+}
+
+// collectSimulationResults returns the results of the plugins for each cluster, sorted by cluster name.
+func collectSimulationResults(resultsByCluster map[string]*placementv1beta1.ClusterSimulationResult) []placementv1beta1.ClusterSimulationResult {
+	results := make([]placementv1beta1.ClusterSimulationResult, 0, len(resultsByCluster))
+	for _, result := range resultsByCluster {
+		results = append(results, *result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ClusterName < results[j].ClusterName
+	})
+
+	if len(results) > clustersDecisionArrayLengthLimitInAPI {
+		klog.V(2).InfoS("Reached API limit of cluster simulation result count; results off the limit will be discarded")
+		results = results[:clustersDecisionArrayLengthLimitInAPI]
+	}
+	return results
+}
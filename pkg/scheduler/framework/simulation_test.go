@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// TestSimulateSchedulingFor tests the SimulateSchedulingFor method.
+func TestSimulateSchedulingFor(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	clusterName3 := fmt.Sprintf(clusterNameTemplate, 3)
+	clusterName4 := fmt.Sprintf(clusterNameTemplate, 4)
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName2}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName3}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName4}},
+	}
+
+	filterPluginNameA := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	filterPluginNameB := fmt.Sprintf(dummyAllPurposePluginNameFormat, 1)
+	scorePluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 2)
+	postBatchPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 3)
+	filterPluginA := &DummyAllPurposePlugin{
+		name: filterPluginNameA,
+		filterRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) *Status {
+			if cluster.Name == clusterName3 {
+				return NewNonErrorStatus(ClusterUnschedulable, filterPluginNameA, "reason A")
+			}
+			return nil
+		},
+	}
+	filterPluginB := &DummyAllPurposePlugin{
+		name: filterPluginNameB,
+		filterRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) *Status {
+			if cluster.Name == clusterName3 || cluster.Name == clusterName4 {
+				return NewNonErrorStatus(ClusterUnschedulable, filterPluginNameB, "reason B")
+			}
+			return nil
+		},
+	}
+	affinityScores := map[string]int32{
+		clusterName1: 10,
+		clusterName2: 20,
+	}
+	scorePlugin := &DummyAllPurposePlugin{
+		name: scorePluginName,
+		preScoreRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj) *Status {
+			return nil
+		},
+		scoreRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+			return &ClusterScore{AffinityScore: affinityScores[cluster.Name]}, nil
+		},
+	}
+	// The post batch plugin allows only one cluster to be picked per cycle.
+	postBatchPlugin := &DummyAllPurposePlugin{
+		name: postBatchPluginName,
+		postBatchRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj) (int, *Status) {
+			return 1, nil
+		},
+	}
+
+	filteredDecisions := []placementv1beta1.ClusterDecision{
+		{
+			ClusterName: clusterName3,
+			Selected:    false,
+			Reason:      NewNonErrorStatus(ClusterUnschedulable, filterPluginNameA, "reason A").String(),
+		},
+		{
+			ClusterName: clusterName4,
+			Selected:    false,
+			Reason:      NewNonErrorStatus(ClusterUnschedulable, filterPluginNameB, "reason B").String(),
+		},
+	}
+	filteredResults := []placementv1beta1.ClusterSimulationResult{
+		{
+			ClusterName: clusterName3,
+			Feasible:    false,
+			FilterResults: []placementv1beta1.PluginFilterResult{
+				{
+					PluginName: filterPluginNameA,
+					Reason:     NewNonErrorStatus(ClusterUnschedulable, filterPluginNameA, "reason A").String(),
+				},
+				{
+					PluginName: filterPluginNameB,
+					Reason:     NewNonErrorStatus(ClusterUnschedulable, filterPluginNameB, "reason B").String(),
+				},
+			},
+		},
+		{
+			ClusterName: clusterName4,
+			Feasible:    false,
+			FilterResults: []placementv1beta1.PluginFilterResult{
+				{
+					PluginName: filterPluginNameB,
+					Reason:     NewNonErrorStatus(ClusterUnschedulable, filterPluginNameB, "reason B").String(),
+				},
+			},
+		},
+	}
+	scoredResult := func(clusterName string) placementv1beta1.ClusterSimulationResult {
+		return placementv1beta1.ClusterSimulationResult{
+			ClusterName: clusterName,
+			Feasible:    true,
+			PluginScores: []placementv1beta1.PluginScore{
+				{
+					PluginName: scorePluginName,
+					Score: placementv1beta1.ClusterScore{
+						AffinityScore:       ptr.To(affinityScores[clusterName]),
+						TopologySpreadScore: ptr.To(int32(0)),
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		policy        *placementv1beta1.PlacementPolicy
+		numOfClusters string
+		wantDecisions []placementv1beta1.ClusterDecision
+		wantResults   []placementv1beta1.ClusterSimulationResult
+	}{
+		{
+			name:   "PickAll placement type",
+			policy: nil,
+			wantDecisions: append([]placementv1beta1.ClusterDecision{
+				{
+					ClusterName:  clusterName1,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(0)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, 0, 0),
+				},
+				{
+					ClusterName:  clusterName2,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(0)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, 0, 0),
+				},
+			}, filteredDecisions...),
+			wantResults: append([]placementv1beta1.ClusterSimulationResult{
+				{ClusterName: clusterName1, Feasible: true},
+				{ClusterName: clusterName2, Feasible: true},
+			}, filteredResults...),
+		},
+		{
+			name: "PickN placement type, single cycle",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+			numOfClusters: "1",
+			wantDecisions: append([]placementv1beta1.ClusterDecision{
+				{
+					ClusterName:  clusterName2,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(20)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, 20, 0),
+				},
+				{
+					ClusterName:  clusterName1,
+					Selected:     false,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(10)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(notPickedByScoreReasonTemplate, clusterName1, 10, 0),
+				},
+			}, filteredDecisions...),
+			wantResults: append([]placementv1beta1.ClusterSimulationResult{
+				scoredResult(clusterName1),
+				scoredResult(clusterName2),
+			}, filteredResults...),
+		},
+		{
+			name: "PickN placement type, multiple cycles",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+			numOfClusters: "3",
+			wantDecisions: append([]placementv1beta1.ClusterDecision{
+				{
+					ClusterName:  clusterName2,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(20)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, 20, 0),
+				},
+				{
+					ClusterName:  clusterName1,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(10)), TopologySpreadScore: ptr.To(int32(0))},
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, 10, 0),
+				},
+			}, filteredDecisions...),
+			wantResults: append([]placementv1beta1.ClusterSimulationResult{
+				scoredResult(clusterName1),
+				scoredResult(clusterName2),
+			}, filteredResults...),
+		},
+		{
+			name: "PickFixed placement type",
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{anotherClusterName},
+			},
+			wantDecisions: []placementv1beta1.ClusterDecision{
+				{
+					ClusterName: anotherClusterName,
+					Selected:    false,
+					Reason:      fmt.Sprintf(pickFixedNotFoundClusterReasonTemplate, anotherClusterName),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			for idx := range clusters {
+				fakeClientBuilder.WithObjects(&clusters[idx])
+			}
+			profile := NewProfile(dummyProfileName).
+				WithFilterPlugin(filterPluginA).
+				WithFilterPlugin(filterPluginB).
+				WithPreScorePlugin(scorePlugin).
+				WithScorePlugin(scorePlugin).
+				WithPostBatchPlugin(postBatchPlugin)
+			// Construct framework manually instead of using NewFramework to avoid mocking the
+			// controller manager.
+			f := &framework{
+				profile:                   profile,
+				client:                    fakeClientBuilder.Build(),
+				parallelizer:              parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+				clusterEligibilityChecker: clustereligibilitychecker.New(),
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: tc.policy,
+				},
+			}
+			if len(tc.numOfClusters) > 0 {
+				policy.Annotations = map[string]string{placementv1beta1.NumberOfClustersAnnotation: tc.numOfClusters}
+			}
+
+			decisions, results, err := f.SimulateSchedulingFor(context.Background(), policy)
+			if err != nil {
+				t.Fatalf("SimulateSchedulingFor() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(decisions, tc.wantDecisions); diff != "" {
+				t.Errorf("SimulateSchedulingFor() decisions diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(results, tc.wantResults); diff != "" {
+				t.Errorf("SimulateSchedulingFor() results diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/extender"
+)
+
+const (
+	// ConfigurationAPIVersion is the API version of the scheduler configuration file.
+	ConfigurationAPIVersion = "scheduler.kubernetes-fleet.io/v1alpha1"
+	// ConfigurationKind is the kind of the scheduler configuration file.
+	ConfigurationKind = "SchedulerConfiguration"
+
+	// AllPlugins, when listed as a disabled plugin, disables all the plugins enabled by default
+	// at an extension point.
+	AllPlugins = "*"
+
+	// MaxScoreWeight is the maximum weight of the scores of a plugin.
+	MaxScoreWeight = 100
+)
+
+// ExtensionPoint is an extension point in the scheduling framework.
+type ExtensionPoint string
+
+const (
+	PostBatch ExtensionPoint = "PostBatch"
+	PreFilter ExtensionPoint = "PreFilter"
+	Filter    ExtensionPoint = "Filter"
+	PreScore  ExtensionPoint = "PreScore"
+	Score     ExtensionPoint = "Score"
+)
+
+// extensionPoints are all the extension points, in the order they run.
+var extensionPoints = []ExtensionPoint{PostBatch, PreFilter, Filter, PreScore, Score}
+
+// Configuration is the scheduler configuration, which declares the scheduling profiles in use by the
+// scheduler. It is loaded from a file (usually mounted from a ConfigMap) in the YAML or JSON format.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Profiles are the scheduling profiles; a placement selects a profile by specifying its name as
+	// the scheduler name in the placement policy.
+	//
+	// A profile named DefaultProfile, if present, overrides the default profile, which schedules the
+	// placements that do not specify a scheduler name.
+	Profiles []ProfileConfiguration `json:"profiles"`
+}
+
+// ProfileConfiguration is the configuration of a scheduling profile.
+type ProfileConfiguration struct {
+	// SchedulerName is the name of the profile.
+	SchedulerName string `json:"schedulerName"`
+
+	// Plugins specifies the plugins to enable or disable at each extension point, on top of the
+	// plugins enabled by default.
+	// +optional
+	Plugins PluginsConfiguration `json:"plugins,omitempty"`
+
+	// ScoreWeights are the weights of the scores of the plugins at the Score extension point, keyed
+	// by plugin names; each weight must be in the range [1, MaxScoreWeight].
+	//
+	// The topology spread and affinity scores of a plugin with a weight are multiplied by the weight;
+	// the preference for the clusters where a placement has been scheduled before is kept separate
+	// and unweighted, i.e., it only breaks ties between clusters with the same topology spread and
+	// affinity scores. The scores of plugins without a weight are used as is.
+	// +optional
+	ScoreWeights map[string]int32 `json:"scoreWeights,omitempty"`
+
+	// Extender configures the scheduler extender of the profile, i.e., an external HTTP(S) endpoint
+	// that the profile calls for filtering and scoring decisions. If set, the Extender plugin runs at
+	// the PreFilter, Filter, PreScore, and Score extension points after the plugins enabled by default
+	// (and can be disabled like them); otherwise, the profile does not call any extender.
+	// +optional
+	Extender *ExtenderConfiguration `json:"extender,omitempty"`
+}
+
+// ExtenderConfiguration is the configuration of a scheduler extender.
+type ExtenderConfiguration struct {
+	// URL is the URL prefix of the extender; filter requests are sent to {URL}/filter and score
+	// requests to {URL}/score. Must be an absolute HTTP or HTTPS URL.
+	URL string `json:"url"`
+
+	// Timeout is the timeout for each call to the extender. Defaults to 5 seconds. Must be in the
+	// range [100ms, 1m].
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy is how the profile handles the errors that occur when calling the extender; Fail
+	// fails the scheduling cycle, which will be retried later, while Ignore proceeds as if no extender
+	// is configured. Defaults to Fail.
+	// +optional
+	FailurePolicy extender.FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// ScoreWeight is the weight of the scores given by the extender; a weight of 0 disables scoring
+	// by the extender. Defaults to 1. Must be in the range [0, MaxScoreWeight].
+	// +optional
+	ScoreWeight *int32 `json:"scoreWeight,omitempty"`
+
+	// CacheTTL is for how long the responses of the extender are cached; a TTL of 0 disables caching.
+	// Defaults to 30 seconds. Must be in the range [0, 1h].
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+}
+
+// newPlugin validates the extender configuration and creates an Extender plugin per it.
+func (c *ExtenderConfiguration) newPlugin() (*extender.Plugin, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the extender URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return nil, fmt.Errorf("extender URL %q must be an absolute HTTP or HTTPS URL", c.URL)
+	}
+	opts := []extender.Option{extender.WithURL(c.URL)}
+
+	if c.Timeout != nil {
+		if c.Timeout.Duration < 100*time.Millisecond || c.Timeout.Duration > time.Minute {
+			return nil, fmt.Errorf("extender timeout %s is not in the range [100ms, 1m]", c.Timeout.Duration)
+		}
+		opts = append(opts, extender.WithTimeout(c.Timeout.Duration))
+	}
+	switch c.FailurePolicy {
+	case "":
+	case extender.FailurePolicyFail, extender.FailurePolicyIgnore:
+		opts = append(opts, extender.WithFailurePolicy(c.FailurePolicy))
+	default:
+		return nil, fmt.Errorf("extender failure policy %s is not %s or %s", c.FailurePolicy, extender.FailurePolicyFail, extender.FailurePolicyIgnore)
+	}
+	if c.ScoreWeight != nil {
+		if *c.ScoreWeight < 0 || *c.ScoreWeight > MaxScoreWeight {
+			return nil, fmt.Errorf("extender score weight %d is not in the range [0, %d]", *c.ScoreWeight, MaxScoreWeight)
+		}
+		opts = append(opts, extender.WithScoreWeight(*c.ScoreWeight))
+	}
+	if c.CacheTTL != nil {
+		if c.CacheTTL.Duration < 0 || c.CacheTTL.Duration > time.Hour {
+			return nil, fmt.Errorf("extender cache TTL %s is not in the range [0, 1h]", c.CacheTTL.Duration)
+		}
+		opts = append(opts, extender.WithCacheTTL(c.CacheTTL.Duration))
+	}
+
+	p := extender.New(opts...)
+	return &p, nil
+}
+
+// PluginsConfiguration specifies the plugins to enable or disable at each extension point.
+type PluginsConfiguration struct {
+	// +optional
+	PostBatch PluginSet `json:"postBatch,omitempty"`
+	// +optional
+	PreFilter PluginSet `json:"preFilter,omitempty"`
+	// +optional
+	Filter PluginSet `json:"filter,omitempty"`
+	// +optional
+	PreScore PluginSet `json:"preScore,omitempty"`
+	// +optional
+	Score PluginSet `json:"score,omitempty"`
+}
+
+// PluginSet specifies the plugins to enable or disable at an extension point.
+type PluginSet struct {
+	// Enabled are the plugins to run at the extension point in addition to the plugins enabled by
+	// default; they run after the default plugins, in the order as specified.
+	// +optional
+	Enabled []string `json:"enabled,omitempty"`
+
+	// Disabled are the plugins enabled by default to skip at the extension point; AllPlugins (*)
+	// disables all of them.
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// pluginSetFor returns the plugin set for an extension point.
+func (c *PluginsConfiguration) pluginSetFor(point ExtensionPoint) PluginSet {
+	switch point {
+	case PostBatch:
+		return c.PostBatch
+	case PreFilter:
+		return c.PreFilter
+	case Filter:
+		return c.Filter
+	case PreScore:
+		return c.PreScore
+	default:
+		return c.Score
+	}
+}
+
+// LoadConfiguration parses and validates a scheduler configuration file.
+func LoadConfiguration(data []byte) (*Configuration, error) {
+	config := &Configuration{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the scheduler configuration: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate validates a scheduler configuration.
+func (c *Configuration) Validate() error {
+	_, err := NewProfiles(c, Options{})
+	return err
+}
+
+// NewProfiles creates the scheduling profiles declared in a scheduler configuration with the given
+// options; the default profile is always included. A nil configuration yields the default profile only.
+func NewProfiles(config *Configuration, opts Options) ([]*framework.Profile, error) {
+	if config == nil {
+		return []*framework.Profile{NewProfile(opts)}, nil
+	}
+	if config.APIVersion != ConfigurationAPIVersion || config.Kind != ConfigurationKind {
+		return nil, fmt.Errorf("unsupported scheduler configuration %s/%s; want %s/%s", config.APIVersion, config.Kind, ConfigurationAPIVersion, ConfigurationKind)
+	}
+
+	profiles := make([]*framework.Profile, 0, len(config.Profiles)+1)
+	names := sets.New[string]()
+	for idx := range config.Profiles {
+		pc := &config.Profiles[idx]
+		if len(pc.SchedulerName) == 0 {
+			return nil, fmt.Errorf("profile %d has no scheduler name", idx)
+		}
+		if names.Has(pc.SchedulerName) {
+			return nil, fmt.Errorf("profile %s is specified more than once", pc.SchedulerName)
+		}
+		names.Insert(pc.SchedulerName)
+
+		p, err := newConfiguredProfile(pc, opts)
+		if err != nil {
+			return nil, fmt.Errorf("profile %s is invalid: %w", pc.SchedulerName, err)
+		}
+		profiles = append(profiles, p)
+	}
+	if !names.Has(DefaultProfileName) {
+		profiles = append([]*framework.Profile{NewProfile(opts)}, profiles...)
+	}
+	return profiles, nil
+}
+
+// newConfiguredProfile creates a scheduling profile per its configuration.
+func newConfiguredProfile(pc *ProfileConfiguration, opts Options) (*framework.Profile, error) {
+	var extenderPlugin *extender.Plugin
+	if pc.Extender != nil {
+		var err error
+		if extenderPlugin, err = pc.Extender.newPlugin(); err != nil {
+			return nil, err
+		}
+	}
+	plugins, defaults := newPlugins(opts, extenderPlugin)
+
+	enabled := make(map[ExtensionPoint][]string, len(extensionPoints))
+	for _, point := range extensionPoints {
+		set := pc.Plugins.pluginSetFor(point)
+		for _, name := range set.Disabled {
+			if _, ok := plugins[name]; !ok && name != AllPlugins {
+				return nil, fmt.Errorf("plugin %s disabled at the %s extension point is not found", name, point)
+			}
+		}
+		if !slices.Contains(set.Disabled, AllPlugins) {
+			for _, name := range defaults[point] {
+				if !slices.Contains(set.Disabled, name) {
+					enabled[point] = append(enabled[point], name)
+				}
+			}
+		}
+		for _, name := range set.Enabled {
+			if slices.Contains(enabled[point], name) {
+				return nil, fmt.Errorf("plugin %s is enabled more than once at the %s extension point", name, point)
+			}
+			enabled[point] = append(enabled[point], name)
+		}
+	}
+
+	// Plugins that prepare their states at the PreFilter (PreScore) extension point by default
+	// must also run at that extension point if they run at the Filter (Score) extension point.
+	for point, prePoint := range map[ExtensionPoint]ExtensionPoint{Filter: PreFilter, Score: PreScore} {
+		for _, name := range enabled[point] {
+			if slices.Contains(defaults[prePoint], name) && !slices.Contains(enabled[prePoint], name) {
+				return nil, fmt.Errorf("plugin %s runs at the %s extension point and thus must also run at the %s extension point", name, point, prePoint)
+			}
+		}
+	}
+
+	for name, weight := range pc.ScoreWeights {
+		if !slices.Contains(enabled[Score], name) {
+			return nil, fmt.Errorf("plugin %s has a score weight but does not run at the %s extension point", name, Score)
+		}
+		if weight < 1 || weight > MaxScoreWeight {
+			return nil, fmt.Errorf("score weight %d of plugin %s is not in the range [1, %d]", weight, name, MaxScoreWeight)
+		}
+	}
+
+	return buildProfile(pc.SchedulerName, plugins, enabled, pc.ScoreWeights)
+}
+
+// weightedScorePlugin is a score plugin whose scores are weighted.
+type weightedScorePlugin struct {
+	framework.ScorePlugin
+
+	// weight is the weight of the scores.
+	weight int32
+}
+
+// Score scores a cluster with the wrapped plugin, and weighs the topology spread and affinity scores.
+func (p *weightedScorePlugin) Score(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	score, status = p.ScorePlugin.Score(ctx, state, policy, cluster)
+	if score == nil {
+		return score, status
+	}
+	return &framework.ClusterScore{
+		TopologySpreadScore:            p.weight * score.TopologySpreadScore,
+		AffinityScore:                  p.weight * score.AffinityScore,
+		ObsoletePlacementAffinityScore: score.ObsoletePlacementAffinityScore,
+	}, status
+}
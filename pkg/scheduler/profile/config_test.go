@@ -0,0 +1,396 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/extender"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
+)
+
+const (
+	configHeader = `apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: SchedulerConfiguration
+`
+)
+
+// TestLoadConfiguration tests the LoadConfiguration function.
+func TestLoadConfiguration(t *testing.T) {
+	testCases := []struct {
+		name             string
+		data             string
+		wantProfileNames []string
+		wantErrMsgSubStr string
+	}{
+		{
+			name: "valid configuration",
+			data: configHeader + `profiles:
+- schedulerName: no-spread
+  plugins:
+    postBatch:
+      disabled: ["*"]
+    preFilter:
+      disabled: ["TopologySpreadConstraints"]
+    filter:
+      disabled: ["TopologySpreadConstraints"]
+    preScore:
+      disabled: ["TopologySpreadConstraints"]
+    score:
+      disabled: ["TopologySpreadConstraints"]
+  scoreWeights:
+    ClusterAffinity: 2
+    SamePlacementAntiAffinity: 10
+`,
+			wantProfileNames: []string{"no-spread"},
+		},
+		{
+			name: "profile with an extender",
+			data: configHeader + `profiles:
+- schedulerName: capacity-aware
+  extender:
+    url: https://capacity-planner.example.com/fleet
+    timeout: 2s
+    failurePolicy: Ignore
+    scoreWeight: 10
+    cacheTTL: 0s
+`,
+			wantProfileNames: []string{"capacity-aware"},
+		},
+		{
+			name: "profile with the extender disabled",
+			data: configHeader + `profiles:
+- schedulerName: filter-only
+  extender:
+    url: https://capacity-planner.example.com/fleet
+  plugins:
+    preScore:
+      disabled: ["Extender"]
+    score:
+      disabled: ["Extender"]
+`,
+			wantProfileNames: []string{"filter-only"},
+		},
+		{
+			name:             "unsupported version",
+			data:             "apiVersion: scheduler.kubernetes-fleet.io/v1\nkind: SchedulerConfiguration\nprofiles: []\n",
+			wantErrMsgSubStr: "unsupported scheduler configuration",
+		},
+		{
+			name:             "unknown field",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  weights: {}\n",
+			wantErrMsgSubStr: "failed to unmarshal the scheduler configuration",
+		},
+		{
+			name:             "profile without a name",
+			data:             configHeader + "profiles:\n- plugins: {}\n",
+			wantErrMsgSubStr: "profile 0 has no scheduler name",
+		},
+		{
+			name:             "duplicate profiles",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n- schedulerName: custom\n",
+			wantErrMsgSubStr: "profile custom is specified more than once",
+		},
+		{
+			name:             "unknown plugin",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  plugins:\n    filter:\n      disabled: [\"Unknown\"]\n",
+			wantErrMsgSubStr: "plugin Unknown disabled at the Filter extension point is not found",
+		},
+		{
+			name:             "plugin that does not support the extension point",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  plugins:\n    score:\n      enabled: [\"TaintToleration\"]\n",
+			wantErrMsgSubStr: "plugin TaintToleration does not support the Score extension point",
+		},
+		{
+			name:             "plugin enabled twice",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  plugins:\n    filter:\n      enabled: [\"TaintToleration\"]\n",
+			wantErrMsgSubStr: "plugin TaintToleration is enabled more than once at the Filter extension point",
+		},
+		{
+			name:             "plugin runs at Filter but not at PreFilter",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  plugins:\n    preFilter:\n      disabled: [\"PlacementAffinity\"]\n",
+			wantErrMsgSubStr: "plugin PlacementAffinity runs at the Filter extension point and thus must also run at the PreFilter extension point",
+		},
+		{
+			name:             "extender plugin enabled without an extender",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  plugins:\n    filter:\n      enabled: [\"Extender\"]\n",
+			wantErrMsgSubStr: "plugin Extender is not found",
+		},
+		{
+			name:             "extender with a relative URL",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: /fleet\n",
+			wantErrMsgSubStr: "must be an absolute HTTP or HTTPS URL",
+		},
+		{
+			name:             "extender with an unsupported URL scheme",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: grpc://capacity-planner.example.com\n",
+			wantErrMsgSubStr: "must be an absolute HTTP or HTTPS URL",
+		},
+		{
+			name:             "extender timeout out of range",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: https://capacity-planner.example.com\n    timeout: 2m\n",
+			wantErrMsgSubStr: "extender timeout 2m0s is not in the range [100ms, 1m]",
+		},
+		{
+			name:             "extender failure policy unknown",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: https://capacity-planner.example.com\n    failurePolicy: Retry\n",
+			wantErrMsgSubStr: "extender failure policy Retry is not Fail or Ignore",
+		},
+		{
+			name:             "extender score weight out of range",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: https://capacity-planner.example.com\n    scoreWeight: -1\n",
+			wantErrMsgSubStr: "extender score weight -1 is not in the range [0, 100]",
+		},
+		{
+			name:             "extender cache TTL out of range",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  extender:\n    url: https://capacity-planner.example.com\n    cacheTTL: 2h\n",
+			wantErrMsgSubStr: "extender cache TTL 2h0m0s is not in the range [0, 1h]",
+		},
+		{
+			name:             "score weight out of range",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  scoreWeights:\n    ClusterAffinity: 101\n",
+			wantErrMsgSubStr: "score weight 101 of plugin ClusterAffinity is not in the range [1, 100]",
+		},
+		{
+			name:             "score weight for a plugin that does not run at Score",
+			data:             configHeader + "profiles:\n- schedulerName: custom\n  scoreWeights:\n    TaintToleration: 2\n",
+			wantErrMsgSubStr: "plugin TaintToleration has a score weight but does not run at the Score extension point",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := LoadConfiguration([]byte(tc.data))
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("LoadConfiguration() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfiguration() = %v, want no error", err)
+			}
+			gotProfileNames := make([]string, 0, len(config.Profiles))
+			for _, pc := range config.Profiles {
+				gotProfileNames = append(gotProfileNames, pc.SchedulerName)
+			}
+			if diff := cmp.Diff(gotProfileNames, tc.wantProfileNames); diff != "" {
+				t.Errorf("LoadConfiguration() profile names diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNewProfiles tests the NewProfiles function.
+func TestNewProfiles(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           *Configuration
+		wantProfileNames []string
+	}{
+		{
+			name:             "no configuration",
+			wantProfileNames: []string{DefaultProfileName},
+		},
+		{
+			name: "additional profile",
+			config: &Configuration{
+				Profiles: []ProfileConfiguration{{SchedulerName: "custom"}},
+			},
+			wantProfileNames: []string{DefaultProfileName, "custom"},
+		},
+		{
+			name: "default profile overridden",
+			config: &Configuration{
+				Profiles: []ProfileConfiguration{{SchedulerName: "custom"}, {SchedulerName: DefaultProfileName}},
+			},
+			wantProfileNames: []string{"custom", DefaultProfileName},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.config != nil {
+				tc.config.APIVersion = ConfigurationAPIVersion
+				tc.config.Kind = ConfigurationKind
+			}
+			profiles, err := NewProfiles(tc.config, Options{})
+			if err != nil {
+				t.Fatalf("NewProfiles() = %v, want no error", err)
+			}
+			gotProfileNames := make([]string, 0, len(profiles))
+			for _, p := range profiles {
+				gotProfileNames = append(gotProfileNames, p.Name())
+			}
+			if diff := cmp.Diff(gotProfileNames, tc.wantProfileNames); diff != "" {
+				t.Errorf("NewProfiles() profile names diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNewConfiguredProfile tests that a configured profile enables the right plugins.
+func TestNewConfiguredProfile(t *testing.T) {
+	pc := &ProfileConfiguration{
+		SchedulerName: "no-spread",
+		Plugins: PluginsConfiguration{
+			PostBatch: PluginSet{Disabled: []string{AllPlugins}},
+			PreFilter: PluginSet{Disabled: []string{"TopologySpreadConstraints"}},
+			Filter:    PluginSet{Disabled: []string{"TopologySpreadConstraints", "TaintToleration"}},
+			PreScore:  PluginSet{Disabled: []string{"TopologySpreadConstraints"}},
+			Score:     PluginSet{Disabled: []string{"TopologySpreadConstraints"}},
+		},
+		ScoreWeights: map[string]int32{"SamePlacementAntiAffinity": 10},
+	}
+	profile, err := newConfiguredProfile(pc, Options{})
+	if err != nil {
+		t.Fatalf("newConfiguredProfile() = %v, want no error", err)
+	}
+
+	wantProfile := framework.NewProfile("no-spread")
+	testClusterAffinityPlugin := clusteraffinity.New()
+	testClusterEligibilityPlugin := clustereligibility.New()
+	testNamespaceAffinityPlugin := namespaceaffinity.New()
+	testPlacementAffinityPlugin := placementaffinity.New()
+	testSamePlacementAffinityPlugin := sameplacementaffinity.New()
+	wantProfile.WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testPlacementAffinityPlugin).
+		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testPlacementAffinityPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).
+		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testPlacementAffinityPlugin).
+		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testPlacementAffinityPlugin).WithScorePlugin(&weightedScorePlugin{ScorePlugin: &testSamePlacementAffinityPlugin, weight: 10})
+
+	if diff := cmp.Diff(profile, wantProfile,
+		cmp.AllowUnexported(framework.Profile{},
+			weightedScorePlugin{},
+			clusteraffinity.Plugin{},
+			clustereligibility.Plugin{},
+			namespaceaffinity.Plugin{},
+			placementaffinity.Plugin{},
+			sameplacementaffinity.Plugin{},
+			topologyspreadconstraints.Plugin{},
+			tainttoleration.Plugin{})); diff != "" {
+		t.Errorf("newConfiguredProfile() mismatch (-got +want):\n%s", diff)
+	}
+}
+
+// TestNewConfiguredProfileWithExtender tests that a configured profile with an extender runs the
+// extender after the plugins enabled by default.
+func TestNewConfiguredProfileWithExtender(t *testing.T) {
+	pc := &ProfileConfiguration{
+		SchedulerName: "capacity-aware",
+		Plugins: PluginsConfiguration{
+			PostBatch: PluginSet{Disabled: []string{AllPlugins}},
+			PreFilter: PluginSet{Disabled: []string{AllPlugins}},
+			Filter:    PluginSet{Disabled: []string{AllPlugins}},
+			PreScore:  PluginSet{Disabled: []string{AllPlugins}},
+			Score:     PluginSet{Disabled: []string{AllPlugins}},
+		},
+		Extender: &ExtenderConfiguration{
+			URL:           "https://capacity-planner.example.com/fleet",
+			FailurePolicy: extender.FailurePolicyIgnore,
+		},
+	}
+	// Disabling all the plugins enabled by default also disables the extender.
+	profile, err := newConfiguredProfile(pc, Options{})
+	if err != nil {
+		t.Fatalf("newConfiguredProfile() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(profile, framework.NewProfile("capacity-aware"), cmp.AllowUnexported(framework.Profile{})); diff != "" {
+		t.Errorf("newConfiguredProfile() mismatch (-got +want):\n%s", diff)
+	}
+
+	// Re-enable the extender at the Filter and PreFilter extension points only.
+	pc.Plugins.PreFilter.Enabled = []string{"Extender"}
+	pc.Plugins.Filter.Enabled = []string{"Extender"}
+	profile, err = newConfiguredProfile(pc, Options{})
+	if err != nil {
+		t.Fatalf("newConfiguredProfile() = %v, want no error", err)
+	}
+	testExtenderPlugin := extender.New(extender.WithURL("https://capacity-planner.example.com/fleet"), extender.WithFailurePolicy(extender.FailurePolicyIgnore))
+	wantProfile := framework.NewProfile("capacity-aware").WithPreFilterPlugin(&testExtenderPlugin).WithFilterPlugin(&testExtenderPlugin)
+	if diff := cmp.Diff(profile, wantProfile,
+		cmp.AllowUnexported(framework.Profile{}, extender.Plugin{}),
+		cmpopts.IgnoreFields(extender.Plugin{}, "httpClient", "cache")); diff != "" {
+		t.Errorf("newConfiguredProfile() mismatch (-got +want):\n%s", diff)
+	}
+}
+
+// fixedScorePlugin is a score plugin that gives every cluster the same score.
+type fixedScorePlugin struct {
+	score *framework.ClusterScore
+}
+
+func (p *fixedScorePlugin) Name() string { return "FixedScore" }
+
+func (p *fixedScorePlugin) SetUpWithFramework(_ framework.Handle) {}
+
+func (p *fixedScorePlugin) Score(_ context.Context, _ framework.CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, _ *clusterv1beta1.MemberCluster) (*framework.ClusterScore, *framework.Status) {
+	return p.score, nil
+}
+
+// TestWeightedScorePlugin tests the Score method of weightedScorePlugin.
+func TestWeightedScorePlugin(t *testing.T) {
+	testCases := []struct {
+		name      string
+		score     *framework.ClusterScore
+		weight    int32
+		wantScore *framework.ClusterScore
+	}{
+		{
+			name:      "affinity and topology spread scores",
+			score:     &framework.ClusterScore{TopologySpreadScore: -1, AffinityScore: 20},
+			weight:    3,
+			wantScore: &framework.ClusterScore{TopologySpreadScore: -3, AffinityScore: 60},
+		},
+		{
+			name:      "obsolete placement affinity score stays separate",
+			score:     &framework.ClusterScore{ObsoletePlacementAffinityScore: 1},
+			weight:    10,
+			wantScore: &framework.ClusterScore{ObsoletePlacementAffinityScore: 1},
+		},
+		{
+			name:   "nil score",
+			weight: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &weightedScorePlugin{ScorePlugin: &fixedScorePlugin{score: tc.score}, weight: tc.weight}
+			gotScore, status := p.Score(context.Background(), nil, nil, &clusterv1beta1.MemberCluster{})
+			if !status.IsSuccess() {
+				t.Fatalf("Score() = %v, want success", status)
+			}
+			if diff := cmp.Diff(gotScore, tc.wantScore); diff != "" {
+				t.Errorf("Score() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,23 +18,33 @@ limitations under the License.
 package profile
 
 import (
+	"fmt"
+
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/extender"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/resourcecapacity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
 )
 
 const (
-	// defaultProfileName is the default name for the scheduling profile.
-	defaultProfileName = "DefaultProfile"
+	// DefaultProfileName is the name of the default scheduling profile, which schedules the placements
+	// that do not specify a scheduler name.
+	DefaultProfileName = "DefaultProfile"
 )
 
 // Options holds the configuration options for creating a scheduling profile.
 type Options struct {
 	ClusterAffinityPlugin *clusteraffinity.Plugin
+
+	// ResourceCapacityPlugin, if set, is added to the profile so that clusters without enough available
+	// resources for the selected workloads are filtered out.
+	ResourceCapacityPlugin *resourcecapacity.Plugin
 }
 
 // NewDefaultProfile creates a default scheduling profile.
@@ -44,23 +54,118 @@ func NewDefaultProfile() *framework.Profile {
 
 // NewProfile creates a scheduling profile with the given options.
 func NewProfile(opts Options) *framework.Profile {
-	p := framework.NewProfile(defaultProfileName)
+	plugins, enabled := newPlugins(opts, nil)
+	// The default plugin list is always valid.
+	p, _ := buildProfile(DefaultProfileName, plugins, enabled, nil)
+	return p
+}
 
-	// default plugin list
+// newPlugins returns fresh instances of all the plugins available to a scheduling profile, keyed by
+// their names, along with the names of the plugins enabled by default at each extension point, in
+// the order they run.
+//
+// The Extender plugin is available (and enabled by default) only if the profile configures an
+// extender, in which case extenderPlugin is set.
+func newPlugins(opts Options, extenderPlugin *extender.Plugin) (map[string]framework.Plugin, map[ExtensionPoint][]string) {
 	clusterAffinityPlugin := clusteraffinity.New()
 	if opts.ClusterAffinityPlugin != nil {
 		clusterAffinityPlugin = *opts.ClusterAffinityPlugin
 	}
 	clusterEligibilityPlugin := clustereligibility.New()
 	namespaceAffinityPlugin := namespaceaffinity.New()
+	placementAffinityPlugin := placementaffinity.New()
 	samePlacementAffinityPlugin := sameplacementaffinity.New()
 	topologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 	taintTolerationPlugin := tainttoleration.New()
+	resourceCapacityPlugin := resourcecapacity.New()
+	if opts.ResourceCapacityPlugin != nil {
+		resourceCapacityPlugin = *opts.ResourceCapacityPlugin
+	}
 
-	p.WithPostBatchPlugin(&topologySpreadConstraintsPlugin).
-		WithPreFilterPlugin(&clusterAffinityPlugin).WithPreFilterPlugin(&namespaceAffinityPlugin).WithPreFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&namespaceAffinityPlugin).WithFilterPlugin(&taintTolerationPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithPreScorePlugin(&clusterAffinityPlugin).WithPreScorePlugin(&topologySpreadConstraintsPlugin).
-		WithScorePlugin(&clusterAffinityPlugin).WithScorePlugin(&samePlacementAffinityPlugin).WithScorePlugin(&topologySpreadConstraintsPlugin)
-	return p
+	plugins := make(map[string]framework.Plugin)
+	for _, pl := range []framework.Plugin{
+		&clusterAffinityPlugin, &clusterEligibilityPlugin, &namespaceAffinityPlugin, &placementAffinityPlugin,
+		&samePlacementAffinityPlugin, &topologySpreadConstraintsPlugin, &taintTolerationPlugin, &resourceCapacityPlugin,
+	} {
+		plugins[pl.Name()] = pl
+	}
+
+	// default plugin list
+	enabled := map[ExtensionPoint][]string{
+		PostBatch: {topologySpreadConstraintsPlugin.Name()},
+		PreFilter: {clusterAffinityPlugin.Name(), namespaceAffinityPlugin.Name(), placementAffinityPlugin.Name(), topologySpreadConstraintsPlugin.Name()},
+		Filter: {clusterAffinityPlugin.Name(), clusterEligibilityPlugin.Name(), namespaceAffinityPlugin.Name(), taintTolerationPlugin.Name(),
+			placementAffinityPlugin.Name(), samePlacementAffinityPlugin.Name(), topologySpreadConstraintsPlugin.Name()},
+		PreScore: {clusterAffinityPlugin.Name(), placementAffinityPlugin.Name(), topologySpreadConstraintsPlugin.Name()},
+		Score:    {clusterAffinityPlugin.Name(), placementAffinityPlugin.Name(), samePlacementAffinityPlugin.Name(), topologySpreadConstraintsPlugin.Name()},
+	}
+
+	if opts.ResourceCapacityPlugin != nil {
+		for _, point := range []ExtensionPoint{PreFilter, Filter, PreScore, Score} {
+			enabled[point] = append(enabled[point], resourceCapacityPlugin.Name())
+		}
+	}
+
+	// The extender runs after the built-in plugins at each extension point. Note that this does not
+	// spare the extender from clusters the built-in plugins filter out: filtering runs per cluster
+	// (in parallel), so the extender receives all the candidate clusters in one request at the
+	// PreFilter extension point, before any built-in Filter plugin runs; the ordering only ensures
+	// that a cluster which has failed a built-in Filter plugin reports that plugin as the reason.
+	if extenderPlugin != nil {
+		plugins[extenderPlugin.Name()] = extenderPlugin
+		for _, point := range []ExtensionPoint{PreFilter, Filter, PreScore, Score} {
+			enabled[point] = append(enabled[point], extenderPlugin.Name())
+		}
+	}
+	return plugins, enabled
+}
+
+// buildProfile builds a scheduling profile with the given plugins enabled at each extension point;
+// the scores of the plugins with a weight are weighted.
+func buildProfile(name string, plugins map[string]framework.Plugin, enabled map[ExtensionPoint][]string, scoreWeights map[string]int32) (*framework.Profile, error) {
+	p := framework.NewProfile(name)
+	for _, point := range extensionPoints {
+		for _, pluginName := range enabled[point] {
+			pl, ok := plugins[pluginName]
+			if !ok {
+				return nil, fmt.Errorf("plugin %s is not found", pluginName)
+			}
+
+			var supported bool
+			switch point {
+			case PostBatch:
+				var postBatchPlugin framework.PostBatchPlugin
+				if postBatchPlugin, supported = pl.(framework.PostBatchPlugin); supported {
+					p.WithPostBatchPlugin(postBatchPlugin)
+				}
+			case PreFilter:
+				var preFilterPlugin framework.PreFilterPlugin
+				if preFilterPlugin, supported = pl.(framework.PreFilterPlugin); supported {
+					p.WithPreFilterPlugin(preFilterPlugin)
+				}
+			case Filter:
+				var filterPlugin framework.FilterPlugin
+				if filterPlugin, supported = pl.(framework.FilterPlugin); supported {
+					p.WithFilterPlugin(filterPlugin)
+				}
+			case PreScore:
+				var preScorePlugin framework.PreScorePlugin
+				if preScorePlugin, supported = pl.(framework.PreScorePlugin); supported {
+					p.WithPreScorePlugin(preScorePlugin)
+				}
+			case Score:
+				var scorePlugin framework.ScorePlugin
+				if scorePlugin, supported = pl.(framework.ScorePlugin); supported {
+					if weight, ok := scoreWeights[pluginName]; ok {
+						scorePlugin = &weightedScorePlugin{ScorePlugin: scorePlugin, weight: weight}
+					}
+					p.WithScorePlugin(scorePlugin)
+				}
+			}
+			if !supported {
+				return nil, fmt.Errorf("plugin %s does not support the %s extension point", pluginName, point)
+			}
+		}
+	}
+	return p, nil
 }
@@ -25,6 +25,8 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/resourcecapacity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
@@ -39,27 +41,28 @@ func TestNewDefaultProfile(t *testing.T) {
 	}
 
 	// Verify profile name
-	if profile.Name() != defaultProfileName {
-		t.Errorf("NewDefaultProfile() profile name = %q, want %q", profile.Name(), defaultProfileName)
+	if profile.Name() != DefaultProfileName {
+		t.Errorf("NewDefaultProfile() profile name = %q, want %q", profile.Name(), DefaultProfileName)
 	}
 
 	// Use reflection to check the internal structure since the fields are unexported
 	// Create a comparable profile to verify the structure
-	wantProfile := framework.NewProfile(defaultProfileName)
+	wantProfile := framework.NewProfile(DefaultProfileName)
 
 	// Configure the expected profile with the same plugins
 	testClusterAffinityPlugin := clusteraffinity.New()
 	testClusterEligibilityPlugin := clustereligibility.New()
 	testNamespaceAffinityPlugin := namespaceaffinity.New()
+	testPlacementAffinityPlugin := placementaffinity.New()
 	testSamePlacementAffinityPlugin := sameplacementaffinity.New()
 	testTopologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 	testTaintTolerationPlugin := tainttoleration.New()
 
 	wantProfile.WithPostBatchPlugin(&testTopologySpreadConstraintsPlugin).
-		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
-		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
-		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
-		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
+		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testPlacementAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testPlacementAffinityPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testPlacementAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
+		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testPlacementAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
 
 	// Compare the profiles using cmp.Equal with AllowUnexported to access private fields
 	if diff := cmp.Diff(profile, wantProfile,
@@ -67,6 +70,7 @@ func TestNewDefaultProfile(t *testing.T) {
 			clusteraffinity.Plugin{},
 			clustereligibility.Plugin{},
 			namespaceaffinity.Plugin{},
+			placementaffinity.Plugin{},
 			sameplacementaffinity.Plugin{},
 			topologyspreadconstraints.Plugin{},
 			tainttoleration.Plugin{})); diff != "" {
@@ -78,8 +82,9 @@ func TestNewDefaultProfile(t *testing.T) {
 // It verifies that:
 // 1. Profile is created successfully with both empty and custom options
 // 2. Profile name is set to the default value regardless of options
-// 3. Custom ClusterAffinityPlugin option is accepted
+// 3. Custom ClusterAffinityPlugin and ResourceCapacityPlugin options are accepted
 func TestNewProfileWithOptions(t *testing.T) {
+	resourceCapacityPlugin := resourcecapacity.New(resourcecapacity.WithHeadroomPercentage(20))
 	testCases := []struct {
 		name     string
 		opts     Options
@@ -88,14 +93,21 @@ func TestNewProfileWithOptions(t *testing.T) {
 		{
 			name:     "EmptyOptions",
 			opts:     Options{},
-			wantName: defaultProfileName,
+			wantName: DefaultProfileName,
 		},
 		{
 			name: "CustomClusterAffinityPlugin",
 			opts: Options{
 				ClusterAffinityPlugin: &clusteraffinity.Plugin{},
 			},
-			wantName: defaultProfileName,
+			wantName: DefaultProfileName,
+		},
+		{
+			name: "ResourceCapacityPlugin",
+			opts: Options{
+				ResourceCapacityPlugin: &resourceCapacityPlugin,
+			},
+			wantName: DefaultProfileName,
 		},
 	}
 
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// isScheduledOrBound returns whether a binding counts towards the clusters where its placement has been
+// scheduled, as seen by the placement affinity and anti-affinity terms of other placements.
+func isScheduledOrBound(binding fleetv1beta1.BindingObj) bool {
+	state := binding.GetBindingSpec().State
+	return state == fleetv1beta1.BindingStateScheduled || state == fleetv1beta1.BindingStateBound
+}
+
+// placementAffinityTermsOf returns all the placement affinity and anti-affinity terms of a placement.
+func placementAffinityTermsOf(placement fleetv1beta1.PlacementObj) []fleetv1beta1.PlacementAffinityTerm {
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.Affinity == nil {
+		return nil
+	}
+
+	var terms []fleetv1beta1.PlacementAffinityTerm
+	if affinity := policy.Affinity.PlacementAffinity; affinity != nil {
+		terms = append(terms, affinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for idx := range affinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, affinity.PreferredDuringSchedulingIgnoredDuringExecution[idx].PlacementAffinityTerm)
+		}
+	}
+	if antiAffinity := policy.Affinity.PlacementAntiAffinity; antiAffinity != nil {
+		terms = append(terms, antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for idx := range antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[idx].PlacementAffinityTerm)
+		}
+	}
+	return terms
+}
+
+// hasPlacementAffinityTermsSelecting returns whether a placement has any placement affinity or anti-affinity
+// term that selects a placement with the given labels; if the labels are unknown (nil), any term counts.
+func hasPlacementAffinityTermsSelecting(placement fleetv1beta1.PlacementObj, placementLabels labels.Set) bool {
+	terms := placementAffinityTermsOf(placement)
+	if placementLabels == nil {
+		return len(terms) > 0
+	}
+	for idx := range terms {
+		selector, err := metav1.LabelSelectorAsSelector(&terms[idx].PlacementSelector)
+		if err != nil {
+			// This should never happen as the placement selectors have been validated.
+			klog.ErrorS(err, "Failed to parse the placement selector of a placement affinity term", "placement", klog.KObj(placement))
+			continue
+		}
+		if selector.Matches(placementLabels) {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,7 +34,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
-// Reconciler reconciles the deletion of a binding.
+// Reconciler reconciles the creation, the state changes, and the deletion of a binding.
 type Reconciler struct {
 	// Client is the client the controller uses to access the hub cluster.
 	client.Client
@@ -85,20 +86,79 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.SchedulerWorkQueue.AddRateLimited(queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), placementName)))
 	}
 
+	// The clusters where the placement of the binding has been scheduled might have changed; re-evaluate the
+	// placements with placement affinity or anti-affinity terms that select the placement.
+	if err := r.enqueuePlacementsWithAffinityTo(ctx, binding); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// No action is needed for the scheduler to take in other cases.
 	return ctrl.Result{}, nil
 }
 
-// buildCustomPredicate creates a predicate that only triggers on deletion timestamp changes.
+// enqueuePlacementsWithAffinityTo enqueues the placements, in the same scope as the placement of the given binding,
+// that have placement affinity or anti-affinity terms selecting the placement of the binding.
+func (r *Reconciler) enqueuePlacementsWithAffinityTo(ctx context.Context, binding fleetv1beta1.BindingObj) error {
+	bindingRef := klog.KObj(binding)
+	placementName, exist := binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	if !exist {
+		// The error has been reported above if the binding is being deleted.
+		return nil
+	}
+
+	var placementList fleetv1beta1.PlacementObjList
+	var listOptions []client.ListOption
+	if binding.GetNamespace() == "" {
+		placementList = &fleetv1beta1.ClusterResourcePlacementList{}
+	} else {
+		placementList = &fleetv1beta1.ResourcePlacementList{}
+		listOptions = append(listOptions, client.InNamespace(binding.GetNamespace()))
+	}
+	if err := r.Client.List(ctx, placementList, listOptions...); err != nil {
+		klog.ErrorS(err, "Failed to list placements", "binding", bindingRef)
+		return controller.NewAPIServerError(true, err)
+	}
+	placements := placementList.GetPlacementObjs()
+
+	// If the placement of the binding is gone, its labels are unknown; all the placements with placement
+	// affinity or anti-affinity terms are re-evaluated.
+	var placementLabels labels.Set
+	for idx := range placements {
+		if placements[idx].GetName() == placementName {
+			placementLabels = labels.Set(placements[idx].GetLabels())
+			if placementLabels == nil {
+				placementLabels = labels.Set{}
+			}
+			break
+		}
+	}
+
+	for idx := range placements {
+		placement := placements[idx]
+		if placement.GetName() == placementName || placement.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if !hasPlacementAffinityTermsSelecting(placement, placementLabels) {
+			continue
+		}
+		klog.V(2).InfoS("Enqueueing placement with placement affinity terms for scheduler processing", "binding", bindingRef, "placement", klog.KObj(placement))
+		r.SchedulerWorkQueue.AddBatched(controller.GetObjectKeyFromObj(placement))
+	}
+	return nil
+}
+
+// buildCustomPredicate creates a predicate that only triggers on binding creations, deletion timestamp changes,
+// and the state changes that add or remove the target cluster from the clusters where the placement has been scheduled.
 func buildCustomPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
-			// Ignore creation events.
-			return false
+			// A new binding might satisfy the placement affinity terms of other placements.
+			return true
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
 			// Ignore deletion events (events emitted when the object is actually removed
-			// from storage).
+			// from storage); bindings carry the scheduler binding cleanup finalizer, and the
+			// deletion is processed when the deletion timestamp is set.
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
@@ -116,7 +176,16 @@ func buildCustomPredicate() predicate.Predicate {
 				return true
 			}
 
-			return false
+			// Check if the binding has started or stopped counting towards the clusters where the placement
+			// has been scheduled.
+			oldBinding, oldOK := e.ObjectOld.(fleetv1beta1.BindingObj)
+			newBinding, newOK := e.ObjectNew.(fleetv1beta1.BindingObj)
+			if !oldOK || !newOK {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to cast runtime objects in update event to binding objects"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+			return isScheduledOrBound(oldBinding) != isScheduledOrBound(newBinding)
 		},
 	}
 }
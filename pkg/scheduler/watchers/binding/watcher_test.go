@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+const (
+	affinityLabelKey   = "app"
+	affinityLabelValue = "db"
+)
+
+// fakeSchedulingQueueWriter records the keys added to the scheduling queue.
+type fakeSchedulingQueueWriter struct {
+	keys []queue.PlacementKey
+}
+
+func (q *fakeSchedulingQueueWriter) Add(placementKey queue.PlacementKey) {
+	q.keys = append(q.keys, placementKey)
+}
+
+func (q *fakeSchedulingQueueWriter) AddRateLimited(placementKey queue.PlacementKey) {
+	q.keys = append(q.keys, placementKey)
+}
+
+func (q *fakeSchedulingQueueWriter) AddAfter(placementKey queue.PlacementKey, _ time.Duration) {
+	q.keys = append(q.keys, placementKey)
+}
+
+func (q *fakeSchedulingQueueWriter) AddBatched(placementKey queue.PlacementKey) {
+	q.keys = append(q.keys, placementKey)
+}
+
+func clusterResourcePlacementWithAffinity(name string, affinity *fleetv1beta1.Affinity) *fleetv1beta1.ClusterResourcePlacement {
+	return &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: fleetv1beta1.PlacementSpec{
+			Policy: &fleetv1beta1.PlacementPolicy{
+				PlacementType: fleetv1beta1.PickAllPlacementType,
+				Affinity:      affinity,
+			},
+		},
+	}
+}
+
+func placementAffinityTerm(value string) fleetv1beta1.PlacementAffinityTerm {
+	return fleetv1beta1.PlacementAffinityTerm{
+		PlacementSelector: metav1.LabelSelector{MatchLabels: map[string]string{affinityLabelKey: value}},
+	}
+}
+
+// TestReconcile tests the Reconcile method.
+func TestReconcile(t *testing.T) {
+	selectedCRP := &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   crpName,
+			Labels: map[string]string{affinityLabelKey: affinityLabelValue},
+		},
+	}
+	affinityCRP := clusterResourcePlacementWithAffinity("affinity-crp", &fleetv1beta1.Affinity{
+		PlacementAffinity: &fleetv1beta1.PlacementAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []fleetv1beta1.PlacementAffinityTerm{placementAffinityTerm(affinityLabelValue)},
+		},
+	})
+	antiAffinityCRP := clusterResourcePlacementWithAffinity("anti-affinity-crp", &fleetv1beta1.Affinity{
+		PlacementAntiAffinity: &fleetv1beta1.PlacementAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []fleetv1beta1.WeightedPlacementAffinityTerm{
+				{Weight: 10, PlacementAffinityTerm: placementAffinityTerm(affinityLabelValue)},
+			},
+		},
+	})
+	unrelatedCRP := clusterResourcePlacementWithAffinity("unrelated-crp", &fleetv1beta1.Affinity{
+		PlacementAffinity: &fleetv1beta1.PlacementAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []fleetv1beta1.PlacementAffinityTerm{placementAffinityTerm("web")},
+		},
+	})
+	noAffinityCRP := clusterResourcePlacementWithAffinity("no-affinity-crp", nil)
+	otherNamespaceRP := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: "affinity-rp", Namespace: "other-ns"},
+		Spec:       affinityCRP.Spec,
+	}
+
+	binding := func(deleting bool) *fleetv1beta1.ClusterResourceBinding {
+		b := &fleetv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       crbName,
+				Labels:     map[string]string{fleetv1beta1.PlacementTrackingLabel: crpName},
+				Finalizers: []string{fleetv1beta1.SchedulerBindingCleanupFinalizer},
+			},
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				State:         fleetv1beta1.BindingStateScheduled,
+				TargetCluster: clusterName,
+			},
+		}
+		if deleting {
+			b.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		return b
+	}
+
+	testCases := []struct {
+		name     string
+		objects  []client.Object
+		wantKeys []queue.PlacementKey
+	}{
+		{
+			name:    "binding is created",
+			objects: []client.Object{binding(false), selectedCRP, affinityCRP, antiAffinityCRP, unrelatedCRP, noAffinityCRP, otherNamespaceRP},
+			wantKeys: []queue.PlacementKey{
+				queue.PlacementKey(affinityCRP.Name),
+				queue.PlacementKey(antiAffinityCRP.Name),
+			},
+		},
+		{
+			name:    "binding is deleted",
+			objects: []client.Object{binding(true), selectedCRP, affinityCRP, unrelatedCRP},
+			wantKeys: []queue.PlacementKey{
+				queue.PlacementKey(crpName),
+				queue.PlacementKey(affinityCRP.Name),
+			},
+		},
+		{
+			name:    "placement of the binding is gone",
+			objects: []client.Object{binding(true), affinityCRP, unrelatedCRP, noAffinityCRP},
+			wantKeys: []queue.PlacementKey{
+				queue.PlacementKey(crpName),
+				queue.PlacementKey(affinityCRP.Name),
+				queue.PlacementKey(unrelatedCRP.Name),
+			},
+		},
+		{
+			name:    "no placement has placement affinity terms",
+			objects: []client.Object{binding(false), selectedCRP, noAffinityCRP},
+		},
+		{
+			name: "binding is not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			q := &fakeSchedulingQueueWriter{}
+			r := &Reconciler{
+				Client:             fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build(),
+				SchedulerWorkQueue: q,
+			}
+
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: crbName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(q.keys, tc.wantKeys, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b queue.PlacementKey) bool { return a < b })); diff != "" {
+				t.Errorf("Reconcile() enqueued keys mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestBuildCustomPredicate tests the update events that the custom predicate triggers on.
+func TestBuildCustomPredicate(t *testing.T) {
+	bindingWithState := func(state fleetv1beta1.BindingState) *fleetv1beta1.ClusterResourceBinding {
+		return &fleetv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: crbName},
+			Spec:       fleetv1beta1.ResourceBindingSpec{State: state},
+		}
+	}
+	deletingBinding := bindingWithState(fleetv1beta1.BindingStateBound)
+	deletingBinding.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	testCases := []struct {
+		name   string
+		oldObj client.Object
+		newObj client.Object
+		want   bool
+	}{
+		{
+			name:   "binding is scheduled",
+			oldObj: bindingWithState(fleetv1beta1.BindingStateUnscheduled),
+			newObj: bindingWithState(fleetv1beta1.BindingStateScheduled),
+			want:   true,
+		},
+		{
+			name:   "binding is unscheduled",
+			oldObj: bindingWithState(fleetv1beta1.BindingStateBound),
+			newObj: bindingWithState(fleetv1beta1.BindingStateUnscheduled),
+			want:   true,
+		},
+		{
+			name:   "binding is bound",
+			oldObj: bindingWithState(fleetv1beta1.BindingStateScheduled),
+			newObj: bindingWithState(fleetv1beta1.BindingStateBound),
+			want:   false,
+		},
+		{
+			name:   "binding is being deleted",
+			oldObj: bindingWithState(fleetv1beta1.BindingStateBound),
+			newObj: deletingBinding,
+			want:   true,
+		},
+	}
+
+	p := buildCustomPredicate()
+	if !p.Create(event.CreateEvent{Object: bindingWithState(fleetv1beta1.BindingStateScheduled)}) {
+		t.Errorf("Create() = false, want true")
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.Update(event.UpdateEvent{ObjectOld: tc.oldObj, ObjectNew: tc.newObj}); got != tc.want {
+				t.Errorf("Update() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
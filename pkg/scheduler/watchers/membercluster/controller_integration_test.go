@@ -69,7 +69,21 @@ var (
 
 	allKeysEnqueuedActual = func() error {
 		errorFormat := "CRP keys %v have not been enqueued"
-		requiredKeys := []string{crpName1, crpName2, crpName3, crpName4, crpName5, crpName6}
+		requiredKeys := []string{crpName1, crpName2, crpName3, crpName4, crpName5, crpName6, crpName7}
+		if isAllPresent, absentKeys := keyCollector.IsPresent(requiredKeys...); !isAllPresent {
+			return fmt.Errorf(errorFormat, absentKeys)
+		}
+
+		if queueLen := keyCollector.Len(); queueLen != len(requiredKeys) {
+			return fmt.Errorf("work queue is not of the required length: got %d, want %d", queueLen, len(requiredKeys))
+		}
+
+		return nil
+	}
+
+	failoverKeysEnqueuedActual = func() error {
+		errorFormat := "CRP keys %v have not been enqueued"
+		requiredKeys := []string{crpName7}
 		if isAllPresent, absentKeys := keyCollector.IsPresent(requiredKeys...); !isAllPresent {
 			return fmt.Errorf(errorFormat, absentKeys)
 		}
@@ -455,9 +469,9 @@ var _ = Describe("scheduler member cluster source controller", Serial, Ordered,
 			Expect(hubClient.Status().Update(ctx, memberCluster)).Should(Succeed(), "Failed to update member cluster status")
 		})
 
-		It("should not enqueue CRPs (case 2b)", func() {
-			Eventually(noKeyEnqueuedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Workqueue is not empty")
-			Consistently(noKeyEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Workqueue is not empty")
+		It("should only enqueue CRPs with a failover policy (case 2b)", func() {
+			Eventually(failoverKeysEnqueuedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Keys are not enqueued as expected")
+			Consistently(failoverKeysEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Keys are not enqueued as expected")
 		})
 
 		AfterAll(func() {
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membercluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+// LostClusterReconciler is the member cluster controller reconciler that watches for member clusters
+// becoming lost.
+//
+// A cluster becomes lost when its member agent stops sending heartbeats; as nothing is written to the
+// member cluster object in this case, the Reconciler never receives an event for it. Instead, this
+// reconciler checks each member cluster again at the time it would be lost, and enqueues the placements
+// with a failover policy that have been scheduled on the cluster once it is lost; the placements are
+// enqueued again when their failover grace period elapses.
+type LostClusterReconciler struct {
+	// Client is a (cached) client for accessing the Kubernetes API server.
+	Client client.Client
+
+	// SchedulerWorkQueue is the work queue for the scheduler.
+	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+
+	// ClusterEligibilityChecker helps check if a cluster has been lost; it must use the same cluster
+	// unhealthy threshold as the scheduler.
+	ClusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// EnableResourcePlacement indicates whether the resource placement controller is enabled.
+	EnableResourcePlacement bool
+}
+
+// Reconcile checks if a member cluster has been lost.
+func (r *LostClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	memberClusterRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Lost cluster reconciliation starts", "memberCluster", memberClusterRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Lost cluster reconciliation ends", "memberCluster", memberClusterRef, "latency", latency)
+	}()
+
+	memberCluster := &clusterv1beta1.MemberCluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, memberCluster); err != nil {
+		if errors.IsNotFound(err) {
+			// The member cluster has left the fleet, which is handled by the Reconciler.
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get member cluster", "memberCluster", memberClusterRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	lostAt, ok := r.ClusterEligibilityChecker.LostAt(memberCluster)
+	if !ok {
+		// The cluster is leaving the fleet, or its member agent has not reported its status yet;
+		// the cluster is checked again when its member agent reports its status.
+		return ctrl.Result{}, nil
+	}
+	if waitTime := time.Until(lostAt); waitTime > 0 {
+		// Check the cluster again at the time it would be lost, if no further heartbeat is received.
+		klog.V(2).InfoS("Member cluster is not lost; checking again later", "memberCluster", memberClusterRef, "lostAt", lostAt)
+		return ctrl.Result{RequeueAfter: waitTime}, nil
+	}
+
+	placements, err := r.listPlacementsScheduledOn(ctx, memberCluster.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for idx := range placements {
+		placement := placements[idx]
+		if !hasFailoverPolicy(placement) {
+			continue
+		}
+		gracePeriodSeconds := ptr.Deref(placement.GetPlacementSpec().Policy.FailoverPolicy.GracePeriodSeconds, defaulter.DefaultFailoverGracePeriodSeconds)
+		failoverWaitTime := time.Until(lostAt.Add(time.Duration(gracePeriodSeconds) * time.Second))
+		klog.V(2).InfoS("Enqueueing placement for failover from a lost member cluster", "memberCluster", memberClusterRef, "placement", klog.KObj(placement), "waitTime", failoverWaitTime)
+		if failoverWaitTime > 0 {
+			r.SchedulerWorkQueue.AddAfter(controller.GetObjectKeyFromObj(placement), failoverWaitTime)
+		} else {
+			r.SchedulerWorkQueue.Add(controller.GetObjectKeyFromObj(placement))
+		}
+	}
+
+	// The cluster is checked again once its member agent recovers; see lostClusterPredicate.
+	return ctrl.Result{}, nil
+}
+
+// listPlacementsScheduledOn lists the placements that have been scheduled on (or bound to) a member cluster.
+func (r *LostClusterReconciler) listPlacementsScheduledOn(ctx context.Context, clusterName string) ([]placementv1beta1.PlacementObj, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, crbList); err != nil {
+		klog.ErrorS(err, "Failed to list CRBs", "memberCluster", clusterName)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	crpList := &placementv1beta1.ClusterResourcePlacementList{}
+	if err := r.Client.List(ctx, crpList); err != nil {
+		klog.ErrorS(err, "Failed to list CRPs", "memberCluster", clusterName)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindings := crbList.GetBindingObjs()
+	placements := convertCRPArrayToPlacementObjs(crpList.Items)
+	if r.EnableResourcePlacement {
+		// Empty namespace provided to list RBs and RPs across all namespaces.
+		rbList := &placementv1beta1.ResourceBindingList{}
+		if err := r.Client.List(ctx, rbList, client.InNamespace("")); err != nil {
+			klog.ErrorS(err, "Failed to list RBs", "memberCluster", clusterName)
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		rpList := &placementv1beta1.ResourcePlacementList{}
+		if err := r.Client.List(ctx, rpList, client.InNamespace("")); err != nil {
+			klog.ErrorS(err, "Failed to list RPs", "memberCluster", clusterName)
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		bindings = append(bindings, rbList.GetBindingObjs()...)
+		placements = append(placements, convertRPArrayToPlacementObjs(rpList.Items)...)
+	}
+
+	scheduledPlacementKeys := sets.New[queue.PlacementKey]()
+	for _, binding := range bindings {
+		spec := binding.GetBindingSpec()
+		if spec.TargetCluster != clusterName || (spec.State != placementv1beta1.BindingStateScheduled && spec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		placementName := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		scheduledPlacementKeys.Insert(queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), placementName)))
+	}
+
+	scheduled := make([]placementv1beta1.PlacementObj, 0, len(scheduledPlacementKeys))
+	for idx := range placements {
+		if scheduledPlacementKeys.Has(controller.GetObjectKeyFromObj(placements[idx])) {
+			scheduled = append(scheduled, placements[idx])
+		}
+	}
+	return scheduled, nil
+}
+
+// lostClusterPredicate returns the predicate that filters the member cluster events this reconciler
+// needs to process.
+func (r *LostClusterReconciler) lostClusterPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			// Start checking the cluster, including all the existing clusters when the controller starts.
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			// The member cluster has left the fleet, which is handled by the Reconciler.
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Check if the update event is valid.
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("update event is invalid"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+
+			oldCluster, oldOk := e.ObjectOld.(*clusterv1beta1.MemberCluster)
+			newCluster, newOk := e.ObjectNew.(*clusterv1beta1.MemberCluster)
+			if !oldOk || !newOk {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to cast runtime objects in update event to member cluster objects"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+
+			// Heartbeats only push the time at which the cluster would be lost further; the cluster is
+			// checked again at the previous time, and then at the new one. Other changes need attention
+			// if the cluster can be lost for the first time, if the cluster would be lost sooner (e.g.,
+			// it has become unhealthy), or if the cluster has recovered, as a lost cluster is not
+			// checked again until then.
+			newLostAt, newCanBeLost := r.ClusterEligibilityChecker.LostAt(newCluster)
+			if !newCanBeLost {
+				return false
+			}
+			oldLostAt, oldCanBeLost := r.ClusterEligibilityChecker.LostAt(oldCluster)
+			if !oldCanBeLost || newLostAt.Before(oldLostAt) {
+				return true
+			}
+			_, oldLost := r.ClusterEligibilityChecker.LostSince(oldCluster)
+			_, newLost := r.ClusterEligibilityChecker.LostSince(newCluster)
+			return oldLost && !newLost
+		},
+	}
+}
+
+// SetupWithManager builds a controller with LostClusterReconciler and sets it up with a controller manager.
+func (r *LostClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("membercluster-lost-scheduler-watcher").
+		For(&clusterv1beta1.MemberCluster{}).
+		WithEventFilter(r.lostClusterPredicate()).
+		Complete(r)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membercluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+// fakeSchedulingQueueWriter records the keys added to the scheduling queue, along with their delays.
+type fakeSchedulingQueueWriter struct {
+	delays map[queue.PlacementKey]time.Duration
+}
+
+func (q *fakeSchedulingQueueWriter) Add(placementKey queue.PlacementKey) {
+	q.delays[placementKey] = 0
+}
+
+func (q *fakeSchedulingQueueWriter) AddRateLimited(placementKey queue.PlacementKey) {
+	q.delays[placementKey] = 0
+}
+
+func (q *fakeSchedulingQueueWriter) AddAfter(placementKey queue.PlacementKey, duration time.Duration) {
+	q.delays[placementKey] = duration
+}
+
+func (q *fakeSchedulingQueueWriter) AddBatched(placementKey queue.PlacementKey) {
+	q.delays[placementKey] = 0
+}
+
+func memberClusterWithHeartbeat(name string, heartbeat time.Time) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1beta1.MemberClusterStatus{
+			AgentStatus: []clusterv1beta1.AgentStatus{
+				{
+					Type:                  clusterv1beta1.MemberAgent,
+					LastReceivedHeartbeat: metav1.NewTime(heartbeat),
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(clusterv1beta1.AgentHealthy),
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(heartbeat),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func failoverCRP(name string, gracePeriodSeconds *int32) *placementv1beta1.ClusterResourcePlacement {
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &numOfClusters,
+			},
+		},
+	}
+	if gracePeriodSeconds != nil {
+		crp.Spec.Policy.FailoverPolicy = &placementv1beta1.FailoverPolicy{GracePeriodSeconds: gracePeriodSeconds}
+	}
+	return crp
+}
+
+func crbOn(crpName, clusterName string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   crpName + "-" + clusterName,
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
+// TestLostClusterReconciler_HeartbeatsStop tests that the placements with a failover policy scheduled on a
+// member cluster are enqueued once the member agent stops sending heartbeats, without any further write to
+// the member cluster object.
+func TestLostClusterReconciler_HeartbeatsStop(t *testing.T) {
+	// The heartbeat time is truncated to seconds when the member cluster is stored.
+	unhealthyThreshold := 1500 * time.Millisecond
+	objects := []client.Object{
+		memberClusterWithHeartbeat(clusterName1, time.Now().Truncate(time.Second)),
+		// Scheduled on the cluster, failing over immediately.
+		failoverCRP(crpName1, ptr.To(int32(0))),
+		crbOn(crpName1, clusterName1, placementv1beta1.BindingStateBound),
+		// Scheduled on the cluster, failing over after the grace period.
+		failoverCRP(crpName2, ptr.To(int32(60))),
+		crbOn(crpName2, clusterName1, placementv1beta1.BindingStateScheduled),
+		// Scheduled on the cluster, without a failover policy.
+		failoverCRP(crpName3, nil),
+		crbOn(crpName3, clusterName1, placementv1beta1.BindingStateBound),
+		// Scheduled on another cluster.
+		failoverCRP(crpName4, ptr.To(int32(0))),
+		crbOn(crpName4, clusterName2, placementv1beta1.BindingStateBound),
+		// No longer scheduled on the cluster.
+		failoverCRP(crpName5, ptr.To(int32(0))),
+		crbOn(crpName5, clusterName1, placementv1beta1.BindingStateUnscheduled),
+	}
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	q := &fakeSchedulingQueueWriter{delays: map[queue.PlacementKey]time.Duration{}}
+	r := &LostClusterReconciler{
+		Client:                    fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		SchedulerWorkQueue:        q,
+		ClusterEligibilityChecker: clustereligibilitychecker.New(clustereligibilitychecker.WithClusterUnhealthyThreshold(unhealthyThreshold)),
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName1}}
+
+	// The cluster is not lost yet; it is checked again at the time it would be lost.
+	res, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Reconcile() = %v, want no error", err)
+	}
+	if res.RequeueAfter <= 0 || res.RequeueAfter > unhealthyThreshold {
+		t.Fatalf("Reconcile() requeue after = %v, want in (0, %v]", res.RequeueAfter, unhealthyThreshold)
+	}
+	if len(q.delays) != 0 {
+		t.Fatalf("Reconcile() enqueued %v, want no placement enqueued", q.delays)
+	}
+
+	// No heartbeat is received in the meantime.
+	time.Sleep(res.RequeueAfter)
+	res, err = r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Reconcile() = %v, want no error", err)
+	}
+	if !res.IsZero() {
+		t.Errorf("Reconcile() = %+v, want no requeue for a lost cluster", res)
+	}
+	if len(q.delays) != 2 {
+		t.Fatalf("Reconcile() enqueued %v, want %s and %s", q.delays, crpName1, crpName2)
+	}
+	if delay, ok := q.delays[queue.PlacementKey(crpName1)]; !ok || delay != 0 {
+		t.Errorf("Reconcile() enqueued %s after %v (enqueued: %t), want immediately", crpName1, delay, ok)
+	}
+	if delay, ok := q.delays[queue.PlacementKey(crpName2)]; !ok || delay <= 55*time.Second || delay > 60*time.Second {
+		t.Errorf("Reconcile() enqueued %s after %v (enqueued: %t), want after the grace period", crpName2, delay, ok)
+	}
+}
+
+// TestLostClusterReconciler_UpdatePredicate tests the update events that the lost cluster watcher triggers on.
+func TestLostClusterReconciler_UpdatePredicate(t *testing.T) {
+	unhealthyThreshold := time.Minute
+	now := time.Now()
+	unhealthyCluster := memberClusterWithHeartbeat(clusterName1, now)
+	unhealthyCluster.Status.AgentStatus[0].Conditions[0].Status = metav1.ConditionFalse
+	unhealthyCluster.Status.AgentStatus[0].Conditions[0].LastTransitionTime = metav1.NewTime(now.Add(-30 * time.Second))
+
+	testCases := []struct {
+		name       string
+		oldCluster *clusterv1beta1.MemberCluster
+		newCluster *clusterv1beta1.MemberCluster
+		want       bool
+	}{
+		{
+			name:       "member agent reports its status for the first time",
+			oldCluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+			newCluster: memberClusterWithHeartbeat(clusterName1, now),
+			want:       true,
+		},
+		{
+			name:       "heartbeat is received",
+			oldCluster: memberClusterWithHeartbeat(clusterName1, now.Add(-30*time.Second)),
+			newCluster: memberClusterWithHeartbeat(clusterName1, now),
+			want:       false,
+		},
+		{
+			name:       "cluster becomes unhealthy",
+			oldCluster: memberClusterWithHeartbeat(clusterName1, now),
+			newCluster: unhealthyCluster,
+			want:       true,
+		},
+		{
+			name:       "lost cluster recovers",
+			oldCluster: memberClusterWithHeartbeat(clusterName1, now.Add(-10*time.Minute)),
+			newCluster: memberClusterWithHeartbeat(clusterName1, now),
+			want:       true,
+		},
+	}
+
+	r := &LostClusterReconciler{
+		ClusterEligibilityChecker: clustereligibilitychecker.New(clustereligibilitychecker.WithClusterUnhealthyThreshold(unhealthyThreshold)),
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := r.lostClusterPredicate().Update(event.UpdateEvent{ObjectOld: tc.oldCluster, ObjectNew: tc.newCluster}); got != tc.want {
+				t.Errorf("Update() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+	if !r.lostClusterPredicate().Create(event.CreateEvent{Object: memberClusterWithHeartbeat(clusterName1, now)}) {
+		t.Errorf("Create() = false, want true")
+	}
+}
@@ -146,6 +146,22 @@ func setupResources() {
 		PlacementType:    placementv1beta1.PickNPlacementType,
 		NumberOfClusters: &numOfClusters,
 	}))).Should(Succeed(), "Failed to create CRP")
+
+	// Create a CRP that is of the PickN placement type, has a failover policy and has been fully scheduled.
+	crp = newCRP(crpName7, &placementv1beta1.PlacementPolicy{
+		PlacementType:    placementv1beta1.PickNPlacementType,
+		NumberOfClusters: &numOfClusters,
+		FailoverPolicy:   &placementv1beta1.FailoverPolicy{},
+	})
+	Expect(hubClient.Create(ctx, crp)).Should(Succeed(), "Failed to create CRP")
+	// Update the status.
+	meta.SetStatusCondition(&crp.Status.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.ClusterResourcePlacementScheduledConditionType),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: crp.Generation,
+		Reason:             dummyReason,
+	})
+	Expect(hubClient.Status().Update(ctx, crp)).Should(Succeed(), "Failed to update CRP status")
 }
 
 var _ = BeforeSuite(func() {
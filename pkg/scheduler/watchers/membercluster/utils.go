@@ -47,8 +47,8 @@ func isPlacementFullyScheduled(placement fleetv1beta1.PlacementObj) bool {
 	return condition.IsConditionStatusTrue(scheduledCondition, placement.GetGeneration())
 }
 
-// classifyPlacements returns a list of placements that are affected by cluster side changes in case 1a) and
-// 1b).
+// classifyPlacements returns a list of placements that are affected by cluster side changes in case 1a),
+// 1b), and 2a) (for placements with cluster affinity terms required during execution).
 func classifyPlacements(placements []fleetv1beta1.PlacementObj) (toProcess []fleetv1beta1.PlacementObj) {
 	// Pre-allocate array.
 	toProcess = make([]fleetv1beta1.PlacementObj, 0, len(placements))
@@ -70,6 +70,10 @@ func classifyPlacements(placements []fleetv1beta1.PlacementObj) (toProcess []fle
 			// Placements of the PickAll placement type are affected by cluster side changes in case 1a)
 			// and 1b).
 			toProcess = append(toProcess, placement)
+		case hasRequiredDuringExecutionClusterAffinityTerms(placement):
+			// Placements of the PickN placement type with cluster affinity terms required during execution
+			// are affected by cluster side changes in case 2a) as well, even if they have been fully scheduled.
+			toProcess = append(toProcess, placement)
 		case !isPlacementFullyScheduled(placement):
 			// Placements of the PickN placement type, which have not been fully scheduled, are affected
 			// by cluster side changes in case 1a) and 1b) listed in the Reconcile func.
@@ -80,6 +84,42 @@ func classifyPlacements(placements []fleetv1beta1.PlacementObj) (toProcess []fle
 	return toProcess
 }
 
+// classifyPlacementsOnIneligibleCluster returns a list of placements that are affected by a cluster
+// which is ineligible for resource placement, i.e., case 2a) (for placements with cluster affinity terms
+// required during execution) and 2b) (for placements with a failover policy).
+//
+// Other placements cannot select an ineligible cluster, and do not need to deselect it either.
+func classifyPlacementsOnIneligibleCluster(placements []fleetv1beta1.PlacementObj) (toProcess []fleetv1beta1.PlacementObj) {
+	// Pre-allocate array.
+	toProcess = make([]fleetv1beta1.PlacementObj, 0, len(placements))
+
+	for idx := range placements {
+		placement := placements[idx]
+		if hasFailoverPolicy(placement) || hasRequiredDuringExecutionClusterAffinityTerms(placement) {
+			toProcess = append(toProcess, placement)
+		}
+	}
+
+	return toProcess
+}
+
+// hasFailoverPolicy returns whether a placement of the PickN placement type has a failover policy.
+func hasFailoverPolicy(placement fleetv1beta1.PlacementObj) bool {
+	policy := placement.GetPlacementSpec().Policy
+	return policy != nil && policy.PlacementType == fleetv1beta1.PickNPlacementType && policy.FailoverPolicy != nil
+}
+
+// hasRequiredDuringExecutionClusterAffinityTerms returns whether a placement has cluster affinity terms
+// that are required during execution.
+func hasRequiredDuringExecutionClusterAffinityTerms(placement fleetv1beta1.PlacementObj) bool {
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.Affinity == nil || policy.Affinity.ClusterAffinity == nil {
+		return false
+	}
+	selector := policy.Affinity.ClusterAffinity.RequiredDuringSchedulingRequiredDuringExecution
+	return selector != nil && len(selector.ClusterSelectorTerms) > 0
+}
+
 // convertCRPArrayToPlacementObjs converts a slice of ClusterResourcePlacement items to PlacementObj array.
 func convertCRPArrayToPlacementObjs(crps []fleetv1beta1.ClusterResourcePlacement) []fleetv1beta1.PlacementObj {
 	placements := make([]fleetv1beta1.PlacementObj, len(crps))
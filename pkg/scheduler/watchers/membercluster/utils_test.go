@@ -36,6 +36,7 @@ const (
 	crpName4      = "crp-4"
 	crpName5      = "crp-5"
 	crpName6      = "crp-6"
+	crpName7      = "crp-7"
 	rpName1       = "rp-1"
 	rpName2       = "rp-2"
 	rpName3       = "rp-3"
@@ -457,6 +458,85 @@ func TestClassifyPlacements(t *testing.T) {
 			},
 			want: []placementv1beta1.PlacementObj{},
 		},
+		{
+			name: "single crp, pick N placement type, fully scheduled, with required during execution cluster affinity terms",
+			placements: []placementv1beta1.PlacementObj{
+				&placementv1beta1.ClusterResourcePlacement{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       crpName,
+						Generation: 1,
+					},
+					Spec: placementv1beta1.PlacementSpec{
+						Policy: &placementv1beta1.PlacementPolicy{
+							PlacementType:    placementv1beta1.PickNPlacementType,
+							NumberOfClusters: &numOfClusters,
+							Affinity: &placementv1beta1.Affinity{
+								ClusterAffinity: &placementv1beta1.ClusterAffinity{
+									RequiredDuringSchedulingRequiredDuringExecution: &placementv1beta1.ClusterSelector{
+										ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+											{
+												LabelSelector: &metav1.LabelSelector{
+													MatchLabels: map[string]string{
+														"region": "east",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Status: placementv1beta1.PlacementStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(placementv1beta1.ClusterResourcePlacementScheduledConditionType),
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 1,
+							},
+						},
+					},
+				},
+			},
+			want: []placementv1beta1.PlacementObj{
+				&placementv1beta1.ClusterResourcePlacement{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       crpName,
+						Generation: 1,
+					},
+					Spec: placementv1beta1.PlacementSpec{
+						Policy: &placementv1beta1.PlacementPolicy{
+							PlacementType:    placementv1beta1.PickNPlacementType,
+							NumberOfClusters: &numOfClusters,
+							Affinity: &placementv1beta1.Affinity{
+								ClusterAffinity: &placementv1beta1.ClusterAffinity{
+									RequiredDuringSchedulingRequiredDuringExecution: &placementv1beta1.ClusterSelector{
+										ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+											{
+												LabelSelector: &metav1.LabelSelector{
+													MatchLabels: map[string]string{
+														"region": "east",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Status: placementv1beta1.PlacementStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(placementv1beta1.ClusterResourcePlacementScheduledConditionType),
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 1,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "mixed crps",
 			placements: []placementv1beta1.PlacementObj{
@@ -861,6 +941,140 @@ func TestClassifyPlacements(t *testing.T) {
 	}
 }
 
+// TestClassifyPlacementsOnIneligibleCluster tests the classifyPlacementsOnIneligibleCluster function.
+func TestClassifyPlacementsOnIneligibleCluster(t *testing.T) {
+	fullyScheduledStatus := placementv1beta1.PlacementStatus{
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.ClusterResourcePlacementScheduledConditionType),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+			},
+		},
+	}
+	pickAllCRP := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName1,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+		},
+	}
+	pickNCRP := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName2,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &numOfClusters,
+			},
+		},
+		Status: fullyScheduledStatus,
+	}
+	pickNCRPWithFailoverPolicy := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName3,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &numOfClusters,
+				FailoverPolicy:   &placementv1beta1.FailoverPolicy{},
+			},
+		},
+		Status: fullyScheduledStatus,
+	}
+	pickNRPWithFailoverPolicy := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       rpName1,
+			Namespace:  testNamespace,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &numOfClusters,
+				FailoverPolicy:   &placementv1beta1.FailoverPolicy{},
+			},
+		},
+	}
+	pickNCRPWithRequiredDuringExecutionAffinity := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName4,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &numOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingRequiredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"region": "east"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: fullyScheduledStatus,
+	}
+
+	testCases := []struct {
+		name       string
+		placements []placementv1beta1.PlacementObj
+		want       []placementv1beta1.PlacementObj
+	}{
+		{
+			name:       "no placement is affected",
+			placements: []placementv1beta1.PlacementObj{pickAllCRP, pickNCRP},
+			want:       []placementv1beta1.PlacementObj{},
+		},
+		{
+			name: "fully scheduled placements with a failover policy are affected",
+			placements: []placementv1beta1.PlacementObj{
+				pickAllCRP,
+				pickNCRP,
+				pickNCRPWithFailoverPolicy,
+				pickNRPWithFailoverPolicy,
+			},
+			want: []placementv1beta1.PlacementObj{
+				pickNCRPWithFailoverPolicy,
+				pickNRPWithFailoverPolicy,
+			},
+		},
+		{
+			name: "placements with cluster affinity terms required during execution are affected",
+			placements: []placementv1beta1.PlacementObj{
+				pickNCRP,
+				pickNCRPWithRequiredDuringExecutionAffinity,
+			},
+			want: []placementv1beta1.PlacementObj{
+				pickNCRPWithRequiredDuringExecutionAffinity,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			toProcess := classifyPlacementsOnIneligibleCluster(tc.placements)
+			if diff := cmp.Diff(toProcess, tc.want); diff != "" {
+				t.Errorf("classifyPlacementsOnIneligibleCluster() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
 // TestConvertCRPArrayToPlacementObjs tests the convertCRPArrayToPlacementObjs function.
 func TestConvertCRPArrayToPlacementObjs(t *testing.T) {
 	testCases := []struct {
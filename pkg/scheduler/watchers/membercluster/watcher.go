@@ -103,6 +103,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	//   - CRPs which have not selected this cluster, regardless of its placement type, cannot
 	//     select it either, as it does not meet the requirement.
 	//
+	//   The exceptions are 2a) for CRPs with cluster affinity terms required during execution, and
+	//   2b) for CRPs of the PickN type with a failover policy; such CRPs must deselect this cluster
+	//   if it no longer meets the terms, or fail over from this cluster once it has stayed lost for
+	//   the grace period, and may further need to pick another cluster as replacement.
+	//
 	// (Note also that from this controller's perspective, we cannot reliably tell the difference
 	//  between 1a) and 2a).)
 	//
@@ -111,7 +116,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	//     must deselect it, as the binding is no longer valid (dangling). CRPs of the PickN type
 	//     may further need to pick another cluster as replacement.
 	//
-	// This controller is set to handle cases 1a), 1b), and 2c). Note that it is only guaranteed
+	// This controller is set to handle cases 1a), 1b), 2c), 2a) for CRPs with cluster affinity
+	// terms required during execution, and 2b) for CRPs with a failover policy. Note that it is only guaranteed
 	// that this controller will not emit false negatives, i.e., all the changes that require
 	// the scheduler's attention will be captured; in other words, false positives may still
 	// happen, i.e., this controller may trigger the scheduler to run a scheduling loop even though
@@ -155,9 +161,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	placements := append(convertCRPArrayToPlacementObjs(crpList.Items), convertRPArrayToPlacementObjs(rpList.Items)...)
 	if !isMemberClusterMissing && memberCluster.GetDeletionTimestamp().IsZero() {
 		// If the member cluster is set to the left state, the scheduler needs to process all
-		// placements (case 2c)); otherwise, only placements of the PickAll type + placements of the PickN type,
-		// which have not been fully scheduled, need to be processed (case 1a) and 1b)).
-		placements = classifyPlacements(placements)
+		// placements (case 2c)).
+		if eligible, _ := r.ClusterEligibilityChecker.IsEligible(memberCluster); eligible {
+			// Only placements of the PickAll type + placements of the PickN type, which have not been
+			// fully scheduled or have cluster affinity terms required during execution, need to be
+			// processed (case 1a), 1b), and 2a)).
+			placements = classifyPlacements(placements)
+		} else {
+			// An ineligible cluster cannot be picked; only placements that might need to deselect
+			// it need to be processed (case 2a) and 2b)).
+			placements = classifyPlacementsOnIneligibleCluster(placements)
+		}
 	}
 
 	// Enqueue the placements.
@@ -289,12 +303,17 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 			if !oldEligible && newEligible {
 				// The cluster becomes eligible for resource placement, i.e., match for case 1b).
-				//
-				// The reverse, i.e., eligible -> ineligible, is ignored (case 2b)).
 				klog.V(2).InfoS("A member cluster may become eligible for resource placement", "memberCluster", clusterKObj)
 				return true
 			}
 
+			if oldEligible && !newEligible {
+				// The cluster becomes ineligible for resource placement, i.e., match for case 2b);
+				// placements with a failover policy need to start tracking the cluster as lost.
+				klog.V(2).InfoS("A member cluster may become ineligible for resource placement", "memberCluster", clusterKObj)
+				return true
+			}
+
 			// All the other changes are ignored.
 			klog.V(3).InfoS("Ignoring update events that are irrelevant to the scheduler", "memberCluster", clusterKObj)
 			return false
@@ -384,6 +384,12 @@ var (
 		Resource: "jobs",
 	}
 
+	CronJobGVR = schema.GroupVersionResource{
+		Group:    batchv1.GroupName,
+		Version:  batchv1.SchemeGroupVersion.Version,
+		Resource: "cronjobs",
+	}
+
 	ConfigMapGVR = schema.GroupVersionResource{
 		Group:    corev1.GroupName,
 		Version:  corev1.SchemeGroupVersion.Version,
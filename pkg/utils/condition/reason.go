@@ -108,6 +108,41 @@ const (
 	// StatusSyncSucceededReason is the reason string of placement condition when the status sync succeeded.
 	StatusSyncSucceededReason = "StatusSyncSucceeded"
 
+	// BatchJobsCompletionUnknownReason is the reason string of the Completed condition when Fleet has not
+	// collected the status of the batch workloads from all the selected clusters yet.
+	BatchJobsCompletionUnknownReason = "BatchJobsCompletionUnknown"
+
+	// BatchJobsActiveReason is the reason string of the Completed condition when some of the batch workloads
+	// have not finished yet.
+	BatchJobsActiveReason = "BatchJobsActive"
+
+	// BatchJobsSucceededReason is the reason string of the Completed condition when all the batch workloads
+	// have finished successfully.
+	BatchJobsSucceededReason = "BatchJobsSucceeded"
+
+	// BatchJobsFailedReason is the reason string of the Completed condition when all the batch workloads
+	// have finished, and some of them have failed.
+	BatchJobsFailedReason = "BatchJobsFailed"
+
+	// RolloutRolledBackReason is the reason string of the RolledBack condition when Fleet has rolled the
+	// placement back from the latest resource snapshot, as its rollout has failed.
+	RolloutRolledBackReason = "RolloutRolledBack"
+
+	// RolloutNotRolledBackReason is the reason string of the RolledBack condition when the placement
+	// follows the latest resource snapshot.
+	RolloutNotRolledBackReason = "RolloutNotRolledBack"
+
+	// RolloutPausedReason is the reason string of the Paused condition when the rollout of the placement is paused.
+	RolloutPausedReason = "RolloutPaused"
+
+	// RolloutPinnedReason is the reason string of the Pinned condition when the placement is rolled out to
+	// the resource snapshot it is pinned to.
+	RolloutPinnedReason = "RolloutPinned"
+
+	// PinnedResourceSnapshotNotFoundReason is the reason string of the Pinned condition when the resource
+	// snapshot that the placement is pinned to is not found.
+	PinnedResourceSnapshotNotFoundReason = "PinnedResourceSnapshotNotFound"
+
 	// TODO: Add a user error reason
 )
 
@@ -218,11 +253,30 @@ const (
 	// AfterStageTaskWaitTimeElapsedReason is the reason string of condition if the wait time for after stage task has elapsed.
 	AfterStageTaskWaitTimeElapsedReason = "AfterStageTaskWaitTimeElapsed"
 
+	// AfterStageTaskHealthCheckSucceededReason is the reason string of condition if the clusters in the stage have stayed healthy for the soak time of the health check after stage task.
+	AfterStageTaskHealthCheckSucceededReason = "AfterStageTaskHealthCheckSucceeded"
+
+	// AfterStageTaskHealthCheckFailedReason is the reason string of condition if a cluster in the stage has been found unhealthy by the health check after stage task.
+	AfterStageTaskHealthCheckFailedReason = "AfterStageTaskHealthCheckFailed"
+
+	// StageTaskWebhookApprovedReason is the reason string of condition if the external webhook for before or after stage task has approved the stage.
+	StageTaskWebhookApprovedReason = "StageTaskWebhookApproved"
+
+	// StageTaskWebhookRejectedReason is the reason string of condition if the external webhook for before or after stage task has rejected the stage.
+	StageTaskWebhookRejectedReason = "StageTaskWebhookRejected"
+
+	// StageTaskWebhookFailedReason is the reason string of condition if all the calls to the external webhook for before or after stage task have failed.
+	StageTaskWebhookFailedReason = "StageTaskWebhookFailed"
+
 	// ApprovalRequestApprovalAcceptedReason is the reason string of condition if the approval of the approval request has been accepted.
 	ApprovalRequestApprovalAcceptedReason = "ApprovalRequestApprovalAccepted"
 
 	// UpdateRunWaitingMessageFmt is the message format string of condition if the staged update run is waiting for stage tasks in a stage to complete.
 	UpdateRunWaitingMessageFmt = "The updateRun is waiting for %s tasks in stage %s to complete"
+
+	// UpdateRunWaitingForMaintenanceWindowMessageFmt is the message format string of condition if the staged update run is waiting for
+	// the maintenance windows of the remaining clusters in a stage to open.
+	UpdateRunWaitingForMaintenanceWindowMessageFmt = "The updateRun is waiting for the maintenance windows of the remaining clusters in stage %s to open"
 )
 
 // A group of condition reason & message string which is used to populate the ClusterResourcePlacementEviction condition.
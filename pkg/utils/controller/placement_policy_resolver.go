@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+)
+
+// FetchPlacementPolicyFromKey resolves a NamespacedName to a concrete placement policy object that
+// implements PlacementPolicyObj.
+func FetchPlacementPolicyFromKey(ctx context.Context, c client.Reader, policyKey types.NamespacedName) (kfplacementv1alpha1.PlacementPolicyObj, error) {
+	var policy kfplacementv1alpha1.PlacementPolicyObj
+	if policyKey.Namespace != "" {
+		// This is a namespaced PlacementPolicy.
+		policy = &kfplacementv1alpha1.PlacementPolicy{}
+	} else {
+		// This is a cluster-scoped ClusterPlacementPolicy.
+		policy = &kfplacementv1alpha1.ClusterPlacementPolicy{}
+	}
+	if err := c.Get(ctx, policyKey, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// FetchPlacementBindingFromKey resolves a NamespacedName to a concrete placement binding object that
+// implements PlacementBindingObj.
+func FetchPlacementBindingFromKey(ctx context.Context, c client.Reader, bindingKey types.NamespacedName) (kfplacementv1alpha1.PlacementBindingObj, error) {
+	var binding kfplacementv1alpha1.PlacementBindingObj
+	if bindingKey.Namespace != "" {
+		// This is a namespaced PlacementBinding.
+		binding = &kfplacementv1alpha1.PlacementBinding{}
+	} else {
+		// This is a cluster-scoped ClusterPlacementBinding.
+		binding = &kfplacementv1alpha1.ClusterPlacementBinding{}
+	}
+	if err := c.Get(ctx, bindingKey, binding); err != nil {
+		return nil, err
+	}
+	return binding, nil
+}
+
+// FetchPlacementResourceSnapshotFromKey resolves a NamespacedName to a concrete placement resource snapshot
+// object that implements PlacementResourceSnapshotObj.
+func FetchPlacementResourceSnapshotFromKey(ctx context.Context, c client.Reader, snapshotKey types.NamespacedName) (kfplacementv1alpha1.PlacementResourceSnapshotObj, error) {
+	var snapshot kfplacementv1alpha1.PlacementResourceSnapshotObj
+	if snapshotKey.Namespace != "" {
+		// This is a namespaced PlacementResourceSnapshot.
+		snapshot = &kfplacementv1alpha1.PlacementResourceSnapshot{}
+	} else {
+		// This is a cluster-scoped ClusterPlacementResourceSnapshot.
+		snapshot = &kfplacementv1alpha1.ClusterPlacementResourceSnapshot{}
+	}
+	if err := c.Get(ctx, snapshotKey, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// ListPlacementBindingsFromKey returns all the (cluster) placement bindings that belong to the specified
+// placement policy, sorted by their names.
+// The fromCache parameter indicates whether the client is a cached client (true) or an uncached client (false).
+func ListPlacementBindingsFromKey(ctx context.Context, c client.Reader, policyKey types.NamespacedName, fromCache bool) ([]kfplacementv1alpha1.PlacementBindingObj, error) {
+	var bindingList kfplacementv1alpha1.PlacementBindingObjList
+	listOptions := []client.ListOption{
+		client.MatchingLabels{kfplacementv1alpha1.ParentPlacementPolicyLabel: policyKey.Name},
+	}
+	if policyKey.Namespace != "" {
+		bindingList = &kfplacementv1alpha1.PlacementBindingList{}
+		listOptions = append(listOptions, client.InNamespace(policyKey.Namespace))
+	} else {
+		bindingList = &kfplacementv1alpha1.ClusterPlacementBindingList{}
+	}
+	if err := c.List(ctx, bindingList, listOptions...); err != nil {
+		return nil, NewAPIServerError(fromCache, err)
+	}
+
+	bindings := bindingList.GetPlacementBindingObjs()
+	// Sort the bindings by their names so that callers can make deterministic decisions.
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].GetName() < bindings[j].GetName()
+	})
+	return bindings, nil
+}
+
+// ListPlacementResourceSnapshotsFromKey returns all the (cluster) placement resource snapshots that belong to
+// the specified placement policy.
+// The fromCache parameter indicates whether the client is a cached client (true) or an uncached client (false).
+func ListPlacementResourceSnapshotsFromKey(ctx context.Context, c client.Reader, policyKey types.NamespacedName, fromCache bool) ([]kfplacementv1alpha1.PlacementResourceSnapshotObj, error) {
+	var snapshotList kfplacementv1alpha1.PlacementResourceSnapshotObjList
+	listOptions := []client.ListOption{
+		client.MatchingLabels{kfplacementv1alpha1.ParentPlacementPolicyLabel: policyKey.Name},
+	}
+	if policyKey.Namespace != "" {
+		snapshotList = &kfplacementv1alpha1.PlacementResourceSnapshotList{}
+		listOptions = append(listOptions, client.InNamespace(policyKey.Namespace))
+	} else {
+		snapshotList = &kfplacementv1alpha1.ClusterPlacementResourceSnapshotList{}
+	}
+	if err := c.List(ctx, snapshotList, listOptions...); err != nil {
+		return nil, NewAPIServerError(fromCache, err)
+	}
+	return snapshotList.GetPlacementResourceSnapshotObjs(), nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
+)
+
+const (
+	placementPolicyName = "test-policy"
+	placementPolicyNS   = "test-namespace"
+)
+
+func TestListPlacementBindingsFromKey(t *testing.T) {
+	ctx := context.Background()
+
+	clusterBinding := func(name, policyName string) *kfplacementv1alpha1.ClusterPlacementBinding {
+		return &kfplacementv1alpha1.ClusterPlacementBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{kfplacementv1alpha1.ParentPlacementPolicyLabel: policyName},
+			},
+		}
+	}
+	binding := func(name, namespace, policyName string) *kfplacementv1alpha1.PlacementBinding {
+		return &kfplacementv1alpha1.PlacementBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{kfplacementv1alpha1.ParentPlacementPolicyLabel: policyName},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		policyKey     types.NamespacedName
+		objects       []client.Object
+		wantBindNames []string
+	}{
+		{
+			name:          "cluster placement policy, no bindings",
+			policyKey:     types.NamespacedName{Name: placementPolicyName},
+			wantBindNames: []string{},
+		},
+		{
+			name:      "cluster placement policy, sorted by name",
+			policyKey: types.NamespacedName{Name: placementPolicyName},
+			objects: []client.Object{
+				clusterBinding(bindingName2, placementPolicyName),
+				clusterBinding(bindingName1, placementPolicyName),
+				clusterBinding(bindingName3, "other-policy"),
+				binding(bindingName3, placementPolicyNS, placementPolicyName),
+			},
+			wantBindNames: []string{bindingName1, bindingName2},
+		},
+		{
+			name:      "placement policy, bindings in other namespaces are ignored",
+			policyKey: types.NamespacedName{Name: placementPolicyName, Namespace: placementPolicyNS},
+			objects: []client.Object{
+				binding(bindingName2, placementPolicyNS, placementPolicyName),
+				binding(bindingName1, "other-namespace", placementPolicyName),
+				clusterBinding(bindingName3, placementPolicyName),
+			},
+			wantBindNames: []string{bindingName2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = kfplacementv1alpha1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			bindings, err := ListPlacementBindingsFromKey(ctx, fakeClient, tt.policyKey, false)
+			if err != nil {
+				t.Fatalf("ListPlacementBindingsFromKey() = %v, want no error", err)
+			}
+			gotNames := make([]string, len(bindings))
+			for i := range bindings {
+				gotNames[i] = bindings[i].GetName()
+			}
+			if diff := cmp.Diff(gotNames, tt.wantBindNames); diff != "" {
+				t.Errorf("ListPlacementBindingsFromKey() names diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFetchPlacementPolicyFromKey(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = kfplacementv1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&kfplacementv1alpha1.ClusterPlacementPolicy{ObjectMeta: metav1.ObjectMeta{Name: placementPolicyName}},
+			&kfplacementv1alpha1.PlacementPolicy{ObjectMeta: metav1.ObjectMeta{Name: placementPolicyName, Namespace: placementPolicyNS}},
+		).
+		Build()
+
+	tests := []struct {
+		name      string
+		policyKey types.NamespacedName
+		wantKind  string
+	}{
+		{
+			name:      "cluster placement policy",
+			policyKey: types.NamespacedName{Name: placementPolicyName},
+			wantKind:  "*v1alpha1.ClusterPlacementPolicy",
+		},
+		{
+			name:      "placement policy",
+			policyKey: types.NamespacedName{Name: placementPolicyName, Namespace: placementPolicyNS},
+			wantKind:  "*v1alpha1.PlacementPolicy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := FetchPlacementPolicyFromKey(ctx, fakeClient, tt.policyKey)
+			if err != nil {
+				t.Fatalf("FetchPlacementPolicyFromKey() = %v, want no error", err)
+			}
+			if got := fmt.Sprintf("%T", policy); got != tt.wantKind {
+				t.Errorf("FetchPlacementPolicyFromKey() type = %s, want %s", got, tt.wantKind)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"k8s.io/kubectl/pkg/util/deployment"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kfplacementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/kubefleet.dev/placement/v1alpha1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
@@ -148,6 +149,98 @@ func (rs *ResourceSelectorResolver) SelectResourcesForPlacement(placementObj pla
 	return envelopeObjCount, resources, resourcesIDs, nil
 }
 
+// SelectResourcesForPlacementPolicy selects the resources according to the resource selectors of a
+// PlacementPolicy or ClusterPlacementPolicy object, and returns them as snapshotted resources, sorted and
+// stripped of the fields that should not be dispatched to member clusters.
+//
+// Unlike the v1beta1 placement APIs, a namespace selector selects only the namespace object itself; namespaced
+// resources are looked up in the namespace of the PlacementPolicy object, or, for ClusterPlacementPolicy
+// objects, in the namespace specified in the resource selector.
+func (rs *ResourceSelectorResolver) SelectResourcesForPlacementPolicy(placementKey types.NamespacedName, selectors []kfplacementv1alpha1.ResourceSelector) ([]kfplacementv1alpha1.SnapshottedResource, error) {
+	var selectedObjs []*unstructured.Unstructured
+	selectedResIDs := make(map[placementv1beta1.ResourceIdentifier]bool)
+	for _, selector := range selectors {
+		gvk := schema.GroupVersionKind{
+			Group:   selector.APIGroup,
+			Version: selector.APIVersion,
+			Kind:    selector.Kind,
+		}
+		if rs.ResourceConfig.IsResourceDisabled(gvk) {
+			klog.V(2).InfoS("Skip select resource", "group version kind", gvk.String())
+			continue
+		}
+
+		lookupKey := placementKey
+		switch {
+		case placementKey.Namespace != "" && selector.Namespace != "" && selector.Namespace != placementKey.Namespace:
+			err := fmt.Errorf("invalid placement policy %s: cannot select resources in namespace %s", placementKey, selector.Namespace)
+			klog.ErrorS(err, "Invalid resource selector", "selector", selector)
+			return nil, NewUserError(err)
+		case placementKey.Namespace == "":
+			lookupKey.Namespace = selector.Namespace
+		}
+
+		term := placementv1beta1.ResourceSelectorTerm{
+			Group:         selector.APIGroup,
+			Version:       selector.APIVersion,
+			Kind:          selector.Kind,
+			Name:          selector.Name,
+			LabelSelector: selector.LabelSelector,
+		}
+		objs, err := rs.fetchResources(term, lookupKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			uObj := obj.(*unstructured.Unstructured)
+			if gvk == utils.NamespaceGVK && !utils.ShouldPropagateNamespace(uObj.GetName(), rs.SkippedNamespaces) {
+				if len(selector.Name) != 0 {
+					err := fmt.Errorf("invalid placement policy %s: namespace %s is not allowed to propagate", placementKey, uObj.GetName())
+					return nil, NewUserError(err)
+				}
+				klog.V(2).InfoS("Skip the namespace that is not allowed to propagate", "namespace", uObj.GetName(), "placementPolicy", placementKey)
+				continue
+			}
+			ri := placementv1beta1.ResourceIdentifier{
+				Group:     uObj.GroupVersionKind().Group,
+				Version:   uObj.GroupVersionKind().Version,
+				Kind:      uObj.GroupVersionKind().Kind,
+				Name:      uObj.GetName(),
+				Namespace: uObj.GetNamespace(),
+			}
+			if selectedResIDs[ri] {
+				err := fmt.Errorf("found duplicate resource %+v", ri)
+				klog.ErrorS(err, "User selected one resource more than once", "resource", ri, "placementPolicy", placementKey)
+				return nil, NewUserError(err)
+			}
+			selectedResIDs[ri] = true
+			selectedObjs = append(selectedObjs, uObj)
+		}
+	}
+	// Sort the resources so that the same set of resources always yields the same snapshot.
+	sortResources(selectedObjs)
+
+	resources := make([]kfplacementv1alpha1.SnapshottedResource, len(selectedObjs))
+	for i, uObj := range selectedObjs {
+		rawContent, err := generateRawContent(uObj)
+		if err != nil {
+			return nil, NewUnexpectedBehaviorError(err)
+		}
+		resources[i] = kfplacementv1alpha1.SnapshottedResource{
+			Identifier: kfplacementv1alpha1.ObjectReference{
+				Namespace:  uObj.GetNamespace(),
+				Name:       uObj.GetName(),
+				APIGroup:   uObj.GroupVersionKind().Group,
+				APIVersion: uObj.GroupVersionKind().Version,
+				Kind:       uObj.GroupVersionKind().Kind,
+			},
+			Manifest: runtime.RawExtension{Raw: rawContent},
+		}
+	}
+	return resources, nil
+}
+
 // generateResourceContent creates a resource content from the unstructured obj.
 func generateResourceContent(object *unstructured.Unstructured) (*placementv1beta1.ResourceContent, error) {
 	rawContent, err := generateRawContent(object)
@@ -399,6 +399,14 @@ func (r *ResourceSnapshotResolver) deleteRedundantResourceSnapshots(ctx context.
 	placementKObj := klog.KObj(placementObj)
 	lastGroupIndex := -1
 	groupCounter := 0
+	// The resourceSnapshots pinned by the placement are retained regardless of the revision history limit.
+	pinnedGroupIndex := -1
+	if pinnedIndex := placementObj.GetPlacementSpec().PinnedResourceSnapshotIndex; pinnedIndex != nil {
+		if pinnedGroupIndex, err = strconv.Atoi(*pinnedIndex); err != nil {
+			klog.ErrorS(err, "Failed to parse the pinned resource snapshot index", "placement", placementKObj, "pinnedResourceSnapshotIndex", *pinnedIndex)
+			pinnedGroupIndex = -1
+		}
+	}
 
 	// delete the snapshots from the end as there are could be multiple snapshots in a group in order to keep the latest
 	// snapshots from the end.
@@ -409,6 +417,9 @@ func (r *ResourceSnapshotResolver) deleteRedundantResourceSnapshots(ctx context.
 			klog.ErrorS(err, "Failed to parse the resource index label", "placement", placementKObj, "resourceSnapshot", snapshotKObj)
 			return NewUnexpectedBehaviorError(err)
 		}
+		if ii == pinnedGroupIndex {
+			continue
+		}
 		if ii != lastGroupIndex {
 			groupCounter++
 			lastGroupIndex = ii
@@ -608,6 +619,23 @@ func ListAllResourceSnapshotWithAnIndex(ctx context.Context, k8Client client.Rea
 	return resourceSnapshotList, nil
 }
 
+// FetchMasterResourceSnapshotWithIndex fetches the master resourceSnapshot with the given index of a placement.
+// It returns nil if there is no master resourceSnapshot with the index, e.g., the resourceSnapshot group has been
+// deleted per the revision history limit.
+func FetchMasterResourceSnapshotWithIndex(ctx context.Context, k8Client client.Reader, resourceSnapshotIndex, placementName, placementNamespace string) (fleetv1beta1.ResourceSnapshotObj, error) {
+	resourceSnapshotList, err := ListAllResourceSnapshotWithAnIndex(ctx, k8Client, resourceSnapshotIndex, placementName, placementNamespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceSnapshot := range resourceSnapshotList.GetResourceSnapshotObjs() {
+		// only master has this annotation
+		if len(resourceSnapshot.GetAnnotations()[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
+			return resourceSnapshot, nil
+		}
+	}
+	return nil, nil
+}
+
 // DeleteResourceSnapshots deletes all the resource snapshots owned by the placement.
 // For cluster-scoped placements (ClusterResourcePlacement), it deletes ClusterResourceSnapshots.
 // For namespaced placements (ResourcePlacement), it deletes ResourceSnapshots.
@@ -1555,6 +1555,147 @@ func TestListAllResourceSnapshotWithAnIndex(t *testing.T) {
 	}
 }
 
+func TestFetchMasterResourceSnapshotWithIndex(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	snapshot := func(name, index string, isMaster bool) *fleetv1beta1.ClusterResourceSnapshot {
+		s := &fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+					fleetv1beta1.ResourceIndexLabel:     index,
+				},
+			},
+		}
+		if isMaster {
+			s.Annotations = map[string]string{fleetv1beta1.ResourceGroupHashAnnotation: "hash"}
+		}
+		return s
+	}
+
+	tests := []struct {
+		name                  string
+		resourceSnapshotIndex string
+		objects               []client.Object
+		wantName              string
+	}{
+		{
+			name:                  "master resource snapshot found",
+			resourceSnapshotIndex: "1",
+			objects: []client.Object{
+				snapshot("snapshot-0", "0", true),
+				snapshot("snapshot-1-0", "1", false),
+				snapshot("snapshot-1", "1", true),
+			},
+			wantName: "snapshot-1",
+		},
+		{
+			name:                  "no resource snapshot with the index",
+			resourceSnapshotIndex: "2",
+			objects: []client.Object{
+				snapshot("snapshot-1", "1", true),
+			},
+		},
+		{
+			name:                  "no master resource snapshot with the index",
+			resourceSnapshotIndex: "1",
+			objects: []client.Object{
+				snapshot("snapshot-1-0", "1", false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8Client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			got, err := FetchMasterResourceSnapshotWithIndex(context.Background(), k8Client, tt.resourceSnapshotIndex, testCRPName, "")
+			if err != nil {
+				t.Fatalf("FetchMasterResourceSnapshotWithIndex() got error %v, want no error", err)
+			}
+			gotName := ""
+			if got != nil {
+				gotName = got.GetName()
+			}
+			if gotName != tt.wantName {
+				t.Errorf("FetchMasterResourceSnapshotWithIndex() = %q, want %q", gotName, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestDeleteRedundantResourceSnapshots_PinnedIndex(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		pinnedIndex *string
+		wantIndices []string
+	}{
+		{
+			name:        "not pinned",
+			wantIndices: []string{"2", "3"},
+		},
+		{
+			name:        "pinned to a resource snapshot outside of the revision history",
+			pinnedIndex: ptr.To("0"),
+			wantIndices: []string{"0", "2", "3"},
+		},
+		{
+			name:        "pinned to a resource snapshot within the revision history",
+			pinnedIndex: ptr.To("3"),
+			wantIndices: []string{"1", "2", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: testCRPName},
+				Spec:       fleetv1beta1.PlacementSpec{PinnedResourceSnapshotIndex: tt.pinnedIndex},
+			}
+			objects := make([]client.Object, 0, 4)
+			for i := 0; i < 4; i++ {
+				objects = append(objects, &fleetv1beta1.ClusterResourceSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, i),
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: testCRPName,
+							fleetv1beta1.ResourceIndexLabel:     fmt.Sprint(i),
+						},
+					},
+				})
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			resolver := NewResourceSnapshotResolver(fakeClient, scheme)
+
+			// Reserve one slot for the new resource snapshot, as Fleet does before creating one.
+			if err := resolver.deleteRedundantResourceSnapshots(context.Background(), crp, 3); err != nil {
+				t.Fatalf("deleteRedundantResourceSnapshots() got error %v, want no error", err)
+			}
+
+			snapshotList := &fleetv1beta1.ClusterResourceSnapshotList{}
+			if err := fakeClient.List(context.Background(), snapshotList); err != nil {
+				t.Fatalf("Failed to list resource snapshots: %v", err)
+			}
+			gotIndices := make([]string, 0, len(snapshotList.Items))
+			for _, snapshot := range snapshotList.Items {
+				gotIndices = append(gotIndices, snapshot.Labels[fleetv1beta1.ResourceIndexLabel])
+			}
+			slices.Sort(gotIndices)
+			if diff := cmp.Diff(gotIndices, tt.wantIndices); diff != "" {
+				t.Errorf("deleteRedundantResourceSnapshots() remaining resource snapshot indices mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestBuildMasterResourceSnapshot(t *testing.T) {
 	tests := []struct {
 		name                        string
@@ -35,11 +35,17 @@ const (
 	// DefaultUnavailablePeriodSeconds is the default period of time we consider a newly applied workload as unavailable.
 	DefaultUnavailablePeriodSeconds = 60
 
+	// DefaultAutoRollbackFailureThreshold is the default number of failed clusters that marks a rollout as failed.
+	DefaultAutoRollbackFailureThreshold = 1
+
 	// DefaultMaxSkewValue is the default degree to which resources may be unevenly distributed.
 	DefaultMaxSkewValue = 1
 
 	// DefaultRevisionHistoryLimitValue is the default value of RevisionHistoryLimit.
 	DefaultRevisionHistoryLimitValue = 10
+
+	// DefaultFailoverGracePeriodSeconds is the default period of time we wait before failing over the placement on a lost cluster.
+	DefaultFailoverGracePeriodSeconds = 300
 )
 
 // SetPlacementDefaults sets the default values for placement.
@@ -51,24 +57,7 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 		}
 	}
 
-	if spec.Policy.TopologySpreadConstraints != nil {
-		for i := range spec.Policy.TopologySpreadConstraints {
-			if spec.Policy.TopologySpreadConstraints[i].MaxSkew == nil {
-				spec.Policy.TopologySpreadConstraints[i].MaxSkew = ptr.To(int32(DefaultMaxSkewValue))
-			}
-			if spec.Policy.TopologySpreadConstraints[i].WhenUnsatisfiable == "" {
-				spec.Policy.TopologySpreadConstraints[i].WhenUnsatisfiable = fleetv1beta1.DoNotSchedule
-			}
-		}
-	}
-
-	if spec.Policy.Tolerations != nil {
-		for i := range spec.Policy.Tolerations {
-			if spec.Policy.Tolerations[i].Operator == "" {
-				spec.Policy.Tolerations[i].Operator = corev1.TolerationOpEqual
-			}
-		}
-	}
+	SetPlacementPolicyDefaults(spec.Policy)
 
 	strategy := &spec.Strategy
 	if strategy.Type == "" {
@@ -87,6 +76,9 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 		if strategy.RollingUpdate.UnavailablePeriodSeconds == nil {
 			strategy.RollingUpdate.UnavailablePeriodSeconds = ptr.To(DefaultUnavailablePeriodSeconds)
 		}
+		if strategy.RollingUpdate.AutoRollback != nil && strategy.RollingUpdate.AutoRollback.FailureThreshold == nil {
+			strategy.RollingUpdate.AutoRollback.FailureThreshold = ptr.To(DefaultAutoRollbackFailureThreshold)
+		}
 	}
 
 	if spec.Strategy.ApplyStrategy == nil {
@@ -99,6 +91,46 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 	}
 }
 
+// SetPlacementPolicyDefaults sets the default values for a placement policy.
+func SetPlacementPolicyDefaults(policy *fleetv1beta1.PlacementPolicy) {
+	if policy.TopologySpreadConstraints != nil {
+		for i := range policy.TopologySpreadConstraints {
+			if policy.TopologySpreadConstraints[i].MaxSkew == nil {
+				policy.TopologySpreadConstraints[i].MaxSkew = ptr.To(int32(DefaultMaxSkewValue))
+			}
+			if policy.TopologySpreadConstraints[i].WhenUnsatisfiable == "" {
+				policy.TopologySpreadConstraints[i].WhenUnsatisfiable = fleetv1beta1.DoNotSchedule
+			}
+		}
+	}
+
+	if policy.Tolerations != nil {
+		for i := range policy.Tolerations {
+			if policy.Tolerations[i].Operator == "" {
+				policy.Tolerations[i].Operator = corev1.TolerationOpEqual
+			}
+		}
+	}
+
+	if policy.FailoverPolicy != nil {
+		if policy.FailoverPolicy.GracePeriodSeconds == nil {
+			policy.FailoverPolicy.GracePeriodSeconds = ptr.To(int32(DefaultFailoverGracePeriodSeconds))
+		}
+		if policy.FailoverPolicy.ResourceRetentionPolicy == "" {
+			policy.FailoverPolicy.ResourceRetentionPolicy = fleetv1beta1.FailoverResourceRetentionPolicyDelete
+		}
+	}
+
+	if policy.ReplicaScheduling != nil {
+		if policy.ReplicaScheduling.Type == "" {
+			policy.ReplicaScheduling.Type = fleetv1beta1.ReplicaSchedulingTypeDuplicated
+		}
+		if policy.ReplicaScheduling.WeightType == "" {
+			policy.ReplicaScheduling.WeightType = fleetv1beta1.ReplicaDivisionWeightTypeEven
+		}
+	}
+}
+
 // SetDefaultsApplyStrategy sets the default values for an ApplyStrategy object.
 func SetDefaultsApplyStrategy(obj *fleetv1beta1.ApplyStrategy) {
 	if obj.Type == "" {
@@ -128,6 +128,150 @@ func TestSetDefaultsClusterResourcePlacement(t *testing.T) {
 				},
 			},
 		},
+		"ClusterResourcePlacement with empty AutoRollback": {
+			obj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							ProgressDeadlineSeconds: ptr.To(600),
+							AutoRollback:            &fleetv1beta1.AutoRollbackConfig{},
+						},
+					},
+				},
+			},
+			wantObj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType: fleetv1beta1.PickAllPlacementType,
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString(DefaultMaxUnavailableValue)),
+							MaxSurge:                 ptr.To(intstr.FromString(DefaultMaxSurgeValue)),
+							UnavailablePeriodSeconds: ptr.To(DefaultUnavailablePeriodSeconds),
+							ProgressDeadlineSeconds:  ptr.To(600),
+							AutoRollback: &fleetv1beta1.AutoRollbackConfig{
+								FailureThreshold: ptr.To(DefaultAutoRollbackFailureThreshold),
+							},
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(DefaultRevisionHistoryLimitValue)),
+				},
+			},
+		},
+		"ClusterResourcePlacement with empty FailoverPolicy": {
+			obj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType:    fleetv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(2)),
+						FailoverPolicy:   &fleetv1beta1.FailoverPolicy{},
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString("%15")),
+							MaxSurge:                 ptr.To(intstr.FromString("%15")),
+							UnavailablePeriodSeconds: ptr.To(15),
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(10)),
+				},
+			},
+			wantObj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType:    fleetv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(2)),
+						FailoverPolicy: &fleetv1beta1.FailoverPolicy{
+							GracePeriodSeconds:      ptr.To(int32(DefaultFailoverGracePeriodSeconds)),
+							ResourceRetentionPolicy: fleetv1beta1.FailoverResourceRetentionPolicyDelete,
+						},
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString("%15")),
+							MaxSurge:                 ptr.To(intstr.FromString("%15")),
+							UnavailablePeriodSeconds: ptr.To(15),
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(10)),
+				},
+			},
+		},
+		"ClusterResourcePlacement with empty ReplicaScheduling": {
+			obj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType:     fleetv1beta1.PickNPlacementType,
+						NumberOfClusters:  ptr.To(int32(2)),
+						ReplicaScheduling: &fleetv1beta1.ReplicaSchedulingPolicy{},
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString("%15")),
+							MaxSurge:                 ptr.To(intstr.FromString("%15")),
+							UnavailablePeriodSeconds: ptr.To(15),
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(10)),
+				},
+			},
+			wantObj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType:    fleetv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(2)),
+						ReplicaScheduling: &fleetv1beta1.ReplicaSchedulingPolicy{
+							Type:       fleetv1beta1.ReplicaSchedulingTypeDuplicated,
+							WeightType: fleetv1beta1.ReplicaDivisionWeightTypeEven,
+						},
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString("%15")),
+							MaxSurge:                 ptr.To(intstr.FromString("%15")),
+							UnavailablePeriodSeconds: ptr.To(15),
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(10)),
+				},
+			},
+		},
 		"ClusterResourcePlacement with serverside apply config not set": {
 			obj: &fleetv1beta1.ClusterResourcePlacement{
 				Spec: fleetv1beta1.PlacementSpec{
@@ -6,6 +6,7 @@ Licensed under the MIT license.
 package eviction
 
 import (
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -40,3 +41,43 @@ func IsPlacementPresent(binding *placementv1beta1.ClusterResourceBinding) bool {
 	}
 	return false
 }
+
+// IsEvictionAllowed calculates if eviction allowed based on available bindings and spec specified in placement disruption budget.
+func IsEvictionAllowed(bindings []placementv1beta1.ClusterResourceBinding, crp placementv1beta1.ClusterResourcePlacement, db placementv1beta1.ClusterResourcePlacementDisruptionBudget) (bool, int) {
+	availableBindings := 0
+	for i := range bindings {
+		availableCondition := bindings[i].GetCondition(string(placementv1beta1.ResourceBindingAvailable))
+		if condition.IsConditionStatusTrue(availableCondition, bindings[i].GetGeneration()) {
+			availableBindings++
+		}
+	}
+
+	var desiredBindings int
+	placementType := crp.Spec.Policy.PlacementType
+	// we don't know the desired bindings for PickAll and we won't evict a binding for PickFixed CRP.
+	if placementType == placementv1beta1.PickNPlacementType {
+		desiredBindings = int(*crp.Spec.Policy.NumberOfClusters)
+	}
+
+	var disruptionsAllowed int
+	switch {
+	// For PickAll CRPs, MaxUnavailable won't be specified in DB.
+	case db.Spec.MaxUnavailable != nil:
+		maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(db.Spec.MaxUnavailable, desiredBindings, true)
+		unavailableBindings := len(bindings) - availableBindings
+		disruptionsAllowed = maxUnavailable - unavailableBindings
+	case db.Spec.MinAvailable != nil:
+		var minAvailable int
+		if placementType == placementv1beta1.PickAllPlacementType {
+			// MinAvailable will be an Integer value for PickAll CRP.
+			minAvailable = db.Spec.MinAvailable.IntValue()
+		} else {
+			minAvailable, _ = intstr.GetScaledValueFromIntOrPercent(db.Spec.MinAvailable, desiredBindings, true)
+		}
+		disruptionsAllowed = availableBindings - minAvailable
+	}
+	if disruptionsAllowed < 0 {
+		disruptionsAllowed = 0
+	}
+	return disruptionsAllowed > 0, availableBindings
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenancewindow features utilities for evaluating the maintenance windows of member
+// clusters, during which Fleet is allowed to roll out changes to the clusters.
+package maintenancewindow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Validate checks if a maintenance window is valid.
+func Validate(window *clusterv1beta1.MaintenanceWindow) error {
+	if _, _, err := parse(window); err != nil {
+		return err
+	}
+	if window.Duration.Duration <= 0 {
+		return fmt.Errorf("the duration of the maintenance window must be positive, got %s", window.Duration.Duration)
+	}
+	return nil
+}
+
+// CurrentOrNext returns the start and end time of the current maintenance window if the given time
+// falls inside a window; otherwise it returns those of the next window.
+func CurrentOrNext(window *clusterv1beta1.MaintenanceWindow, now time.Time) (start, end time.Time, err error) {
+	schedule, loc, err := parse(window)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// The earliest window that starts after (now - duration) is the current window if it has
+	// already started, or the next window otherwise.
+	start = schedule.Next(now.In(loc).Add(-window.Duration.Duration))
+	if start.IsZero() {
+		// The schedule never fires, e.g., "0 0 30 2 *" (February 30th).
+		return time.Time{}, time.Time{}, fmt.Errorf("the schedule %q of the maintenance window never fires", window.Schedule)
+	}
+	return start, start.Add(window.Duration.Duration), nil
+}
+
+// IsOpen checks if Fleet is allowed to roll out changes to a member cluster at the given time; if not,
+// it also returns the time when the next maintenance window of the cluster starts.
+//
+// A member cluster without a maintenance window is always open for changes.
+func IsOpen(cluster *clusterv1beta1.MemberCluster, now time.Time) (open bool, nextStart time.Time, err error) {
+	if cluster.Spec.MaintenanceWindow == nil {
+		return true, time.Time{}, nil
+	}
+
+	start, _, err := CurrentOrNext(cluster.Spec.MaintenanceWindow, now)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	if !start.After(now) {
+		// The cluster is inside a maintenance window.
+		return true, time.Time{}, nil
+	}
+	return false, start, nil
+}
+
+// CheckCluster checks if Fleet is allowed to roll out changes to the member cluster with the given
+// name at the given time; if not, it also returns how long Fleet should wait for the next maintenance
+// window of the cluster to open.
+//
+// A member cluster that is not found is considered open, as the scheduler will unschedule the
+// bindings to it anyway; a member cluster with an invalid maintenance window is considered closed
+// and no wait time is returned.
+func CheckCluster(ctx context.Context, c client.Reader, clusterName string, now time.Time) (open bool, waitTime time.Duration, err error) {
+	var cluster clusterv1beta1.MemberCluster
+	if err := c.Get(ctx, types.NamespacedName{Name: clusterName}, &cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, 0, nil
+		}
+		klog.ErrorS(err, "Failed to get the memberCluster", "memberCluster", clusterName)
+		return false, 0, controller.NewAPIServerError(true, err)
+	}
+
+	open, nextStart, err := IsOpen(&cluster, now)
+	if err != nil {
+		// The maintenance window should have been validated by the webhook.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Failed to evaluate the maintenance window of the memberCluster", "memberCluster", clusterName)
+		return false, 0, nil
+	}
+	if open {
+		return true, 0, nil
+	}
+	return false, nextStart.Sub(now), nil
+}
+
+// parse parses the schedule and the time zone of a maintenance window.
+func parse(window *clusterv1beta1.MaintenanceWindow) (cron.Schedule, *time.Location, error) {
+	// The time zone must be specified with the dedicated field.
+	if strings.HasPrefix(window.Schedule, "TZ=") || strings.HasPrefix(window.Schedule, "CRON_TZ=") {
+		return nil, nil, fmt.Errorf("the schedule %q of the maintenance window must not specify a time zone; use the timeZone field instead", window.Schedule)
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the schedule %q of the maintenance window: %w", window.Schedule, err)
+	}
+
+	loc := time.UTC
+	if window.TimeZone != "" {
+		if window.TimeZone == "Local" {
+			return nil, nil, fmt.Errorf("the time zone of the maintenance window must be an IANA time zone name, got %q", window.TimeZone)
+		}
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("failed to load the time zone %q of the maintenance window: %w", window.TimeZone, err)
+		}
+	}
+	return schedule, loc, nil
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+var (
+	// nightlyWindow opens at 02:00 UTC every day and lasts for 2 hours.
+	nightlyWindow = &clusterv1beta1.MaintenanceWindow{
+		Schedule: "0 2 * * *",
+		Duration: metav1.Duration{Duration: 2 * time.Hour},
+	}
+)
+
+// TestValidate tests the Validate function.
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		window  *clusterv1beta1.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid window",
+			window: nightlyWindow,
+		},
+		{
+			name: "valid window with time zone",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "30 22 * * 1-5",
+				Duration: metav1.Duration{Duration: 30 * time.Minute},
+				TimeZone: "Asia/Tokyo",
+			},
+		},
+		{
+			name: "invalid schedule",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "every night",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			wantErr: true,
+		},
+		{
+			name: "schedule with time zone",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "CRON_TZ=Asia/Tokyo 0 2 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero duration",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 2 * * *",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative duration",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 2 * * *",
+				Duration: metav1.Duration{Duration: -time.Hour},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown time zone",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 2 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "Nowhere/Nowhere",
+			},
+			wantErr: true,
+		},
+		{
+			name: "local time zone",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 2 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "Local",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := Validate(tc.window); (err != nil) != tc.wantErr {
+				t.Errorf("Validate() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestCurrentOrNext tests the CurrentOrNext function.
+func TestCurrentOrNext(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	testCases := []struct {
+		name      string
+		window    *clusterv1beta1.MaintenanceWindow
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "before the window",
+			window:    nightlyWindow,
+			now:       time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "at the start of the window",
+			window:    nightlyWindow,
+			now:       time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "inside the window",
+			window:    nightlyWindow,
+			now:       time.Date(2026, 1, 1, 3, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "at the end of the window",
+			window:    nightlyWindow,
+			now:       time.Date(2026, 1, 1, 4, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "after the window",
+			window:    nightlyWindow,
+			now:       time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window with time zone",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 2 * * *",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+				TimeZone: "Asia/Tokyo",
+			},
+			// 02:00 in Tokyo is 17:00 UTC on the previous day.
+			now:       time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 2, 2, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 1, 2, 4, 0, 0, 0, tokyo),
+		},
+		{
+			name: "window longer than the schedule interval",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 * * * *",
+				Duration: metav1.Duration{Duration: 90 * time.Minute},
+			},
+			// The window that opened at 11:00 has just closed.
+			now:       time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 13, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "schedule never fires",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 0 30 2 *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			now:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+		{
+			name: "invalid schedule",
+			window: &clusterv1beta1.MaintenanceWindow{
+				Schedule: "0 0 *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			now:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStart, gotEnd, err := CurrentOrNext(tc.window, tc.now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("CurrentOrNext() = %v, %v, nil, want error", gotStart, gotEnd)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CurrentOrNext() = %v, want no error", err)
+			}
+			if !gotStart.Equal(tc.wantStart) || !gotEnd.Equal(tc.wantEnd) {
+				t.Errorf("CurrentOrNext() = [%v, %v), want [%v, %v)", gotStart, gotEnd, tc.wantStart, tc.wantEnd)
+			}
+		})
+	}
+}
+
+// TestIsOpen tests the IsOpen function.
+func TestIsOpen(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cluster       *clusterv1beta1.MemberCluster
+		now           time.Time
+		wantOpen      bool
+		wantNextStart time.Time
+		wantErr       bool
+	}{
+		{
+			name:     "no maintenance window",
+			cluster:  &clusterv1beta1.MemberCluster{},
+			now:      time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name: "inside the window",
+			cluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: nightlyWindow,
+				},
+			},
+			now:      time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name: "outside the window",
+			cluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: nightlyWindow,
+				},
+			},
+			now:           time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantNextStart: time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "invalid window",
+			cluster: &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "invalid",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			now:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOpen, gotNextStart, err := IsOpen(tc.cluster, tc.now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("IsOpen() = %v, want error %t", err, tc.wantErr)
+			}
+			if gotOpen != tc.wantOpen || !gotNextStart.Equal(tc.wantNextStart) {
+				t.Errorf("IsOpen() = %t, %v, want %t, %v", gotOpen, gotNextStart, tc.wantOpen, tc.wantNextStart)
+			}
+		})
+	}
+}
+
+// TestCheckCluster tests the CheckCluster function.
+func TestCheckCluster(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-window"},
+		},
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "closed"},
+			Spec: clusterv1beta1.MemberClusterSpec{
+				MaintenanceWindow: nightlyWindow,
+			},
+		},
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+			Spec: clusterv1beta1.MemberClusterSpec{
+				MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+					Schedule: "invalid",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+	).Build()
+
+	testCases := []struct {
+		name         string
+		clusterName  string
+		wantOpen     bool
+		wantWaitTime time.Duration
+	}{
+		{
+			name:        "cluster without a maintenance window",
+			clusterName: "no-window",
+			wantOpen:    true,
+		},
+		{
+			name:         "cluster outside its maintenance window",
+			clusterName:  "closed",
+			wantWaitTime: 14 * time.Hour,
+		},
+		{
+			name:        "cluster with an invalid maintenance window",
+			clusterName: "invalid",
+		},
+		{
+			name:        "cluster not found",
+			clusterName: "not-found",
+			wantOpen:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOpen, gotWaitTime, err := CheckCluster(context.Background(), fakeClient, tc.clusterName, now)
+			if err != nil {
+				t.Fatalf("CheckCluster() = %v, want no error", err)
+			}
+			if gotOpen != tc.wantOpen || gotWaitTime != tc.wantWaitTime {
+				t.Errorf("CheckCluster() = %t, %v, want %t, %v", gotOpen, gotWaitTime, tc.wantOpen, tc.wantWaitTime)
+			}
+		})
+	}
+}
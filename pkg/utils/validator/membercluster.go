@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 var (
@@ -17,7 +18,11 @@ var (
 
 // ValidateMemberCluster validates member cluster fields and returns error.
 func ValidateMemberCluster(mc clusterv1beta1.MemberCluster) error {
-	return validateTaints(mc.Spec.Taints)
+	allErr := []error{validateTaints(mc.Spec.Taints)}
+	if mc.Spec.MaintenanceWindow != nil {
+		allErr = append(allErr, maintenancewindow.Validate(mc.Spec.MaintenanceWindow))
+	}
+	return apiErrors.NewAggregate(allErr)
 }
 
 func validateTaints(taints []clusterv1beta1.Taint) error {
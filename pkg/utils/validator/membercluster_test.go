@@ -3,6 +3,9 @@ package validator
 import (
 	"strings"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 )
@@ -98,3 +101,90 @@ func TestValidateTaints(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMemberCluster(t *testing.T) {
+	tests := map[string]struct {
+		mc         clusterv1beta1.MemberCluster
+		wantErr    bool
+		wantErrMsg string
+	}{
+		"valid member cluster, no maintenance window": {
+			mc: clusterv1beta1.MemberCluster{},
+		},
+		"valid member cluster, valid maintenance window": {
+			mc: clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 2 * * 6",
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+						TimeZone: "America/Los_Angeles",
+					},
+				},
+			},
+		},
+		"invalid maintenance window, invalid schedule": {
+			mc: clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 2 * *",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "failed to parse the schedule",
+		},
+		"invalid maintenance window, non-positive duration": {
+			mc: clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 2 * * *",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "duration of the maintenance window must be positive",
+		},
+		"invalid maintenance window, unknown time zone": {
+			mc: clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 2 * * *",
+						Duration: metav1.Duration{Duration: time.Hour},
+						TimeZone: "Mars/Olympus_Mons",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "failed to load the time zone",
+		},
+		"invalid taints and maintenance window": {
+			mc: clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{
+					Taints: []clusterv1beta1.Taint{
+						{
+							Key:    "",
+							Effect: "NoSchedule",
+						},
+					},
+					MaintenanceWindow: &clusterv1beta1.MaintenanceWindow{
+						Schedule: "0 2 * * *",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "name part must be non-empty",
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := ValidateMemberCluster(testCase.mc)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("ValidateMemberCluster() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("ValidateMemberCluster() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
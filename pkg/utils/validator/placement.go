@@ -35,6 +35,7 @@ import (
 	apiErrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -115,6 +116,16 @@ var supportedPropertyOperators = map[placementv1beta1.PropertySelectorOperator]o
 	},
 }
 
+// supportedStringPropertyOperators are the PropertySelector operators that compare the observed
+// values of a property as strings (or check for its presence); unlike the operators in
+// supportedPropertyOperators, they do not fold into requirementBounds. The value marks whether
+// the operator requires at least one value; if not, no value can be specified.
+var supportedStringPropertyOperators = map[placementv1beta1.PropertySelectorOperator]bool{
+	placementv1beta1.PropertySelectorIn:     true,
+	placementv1beta1.PropertySelectorNotIn:  true,
+	placementv1beta1.PropertySelectorExists: false,
+}
+
 // hasNamespaceWithResourceSelectorsMode checks if any namespace selector has NamespaceWithResourceSelectors mode.
 func hasNamespaceWithResourceSelectorsMode(resourceSelectors []placementv1beta1.ResourceSelectorTerm) bool {
 	for _, selector := range resourceSelectors {
@@ -128,7 +139,7 @@ func hasNamespaceWithResourceSelectorsMode(resourceSelectors []placementv1beta1.
 }
 
 // validatePlacement validates a placement object (either ClusterResourcePlacement or ResourcePlacement).
-func validatePlacement(name string, resourceSelectors []placementv1beta1.ResourceSelectorTerm, policy *placementv1beta1.PlacementPolicy, strategy placementv1beta1.RolloutStrategy, isClusterScoped bool) error {
+func validatePlacement(name string, resourceSelectors []placementv1beta1.ResourceSelectorTerm, policy *placementv1beta1.PlacementPolicy, strategy placementv1beta1.RolloutStrategy, pinnedResourceSnapshotIndex *string, isClusterScoped bool) error {
 	allErr := make([]error, 0)
 
 	if len(name) > validation.DNS1035LabelMaxLength {
@@ -183,10 +194,17 @@ func validatePlacement(name string, resourceSelectors []placementv1beta1.Resourc
 		}
 	}
 
-	if err := validateRolloutStrategy(strategy); err != nil {
+	if err := validateRolloutStrategy(strategy, pinnedResourceSnapshotIndex); err != nil {
 		allErr = append(allErr, fmt.Errorf("the rollout Strategy field  is invalid: %w", err))
 	}
 
+	// The replicas are divided by the rollout controller, which does not manage the placements with the External
+	// rollout strategy.
+	if policy != nil && policy.ReplicaScheduling != nil && policy.ReplicaScheduling.Type == placementv1beta1.ReplicaSchedulingTypeDivided &&
+		strategy.Type == placementv1beta1.ExternalRolloutStrategyType {
+		allErr = append(allErr, fmt.Errorf("replica scheduling type %s is not valid for ExternalRollout strategy type", placementv1beta1.ReplicaSchedulingTypeDivided))
+	}
+
 	return apiErrors.NewAggregate(allErr)
 }
 
@@ -197,6 +215,7 @@ func ValidateClusterResourcePlacement(clusterResourcePlacement *placementv1beta1
 		clusterResourcePlacement.Spec.ResourceSelectors,
 		clusterResourcePlacement.Spec.Policy,
 		clusterResourcePlacement.Spec.Strategy,
+		clusterResourcePlacement.Spec.PinnedResourceSnapshotIndex,
 		true, // isClusterScoped
 	)
 }
@@ -208,6 +227,7 @@ func ValidateResourcePlacement(resourcePlacement *placementv1beta1.ResourcePlace
 		resourcePlacement.Spec.ResourceSelectors,
 		resourcePlacement.Spec.Policy,
 		resourcePlacement.Spec.Strategy,
+		resourcePlacement.Spec.PinnedResourceSnapshotIndex,
 		false, // isClusterScoped
 	)
 }
@@ -244,6 +264,35 @@ func validatePlacementPolicy(policy *placementv1beta1.PlacementPolicy) error {
 			allErr = append(allErr, err)
 		}
 	}
+	if policy.ReplicaScheduling != nil {
+		if err := validateReplicaScheduling(policy.ReplicaScheduling); err != nil {
+			allErr = append(allErr, err)
+		}
+	}
+
+	return apiErrors.NewAggregate(allErr)
+}
+
+func validateReplicaScheduling(replicaScheduling *placementv1beta1.ReplicaSchedulingPolicy) error {
+	allErr := make([]error, 0)
+	if len(replicaScheduling.StaticWeights) > 0 && replicaScheduling.WeightType != placementv1beta1.ReplicaDivisionWeightTypeStatic {
+		allErr = append(allErr, fmt.Errorf("static weights must be empty for replica division weight type %s, only valid for %s weight type", replicaScheduling.WeightType, placementv1beta1.ReplicaDivisionWeightTypeStatic))
+	}
+	uniqueClusterNames := make(map[string]bool)
+	for _, w := range replicaScheduling.StaticWeights {
+		if _, ok := uniqueClusterNames[w.ClusterName]; ok {
+			allErr = append(allErr, fmt.Errorf("cluster name %s appears more than once in the static weights", w.ClusterName))
+			continue
+		}
+		uniqueClusterNames[w.ClusterName] = true
+	}
+	if replicaScheduling.WeightType == placementv1beta1.ReplicaDivisionWeightTypeClusterProperty {
+		if err := validateName(replicaScheduling.WeightPropertyName); err != nil {
+			allErr = append(allErr, err)
+		}
+	} else if len(replicaScheduling.WeightPropertyName) != 0 {
+		allErr = append(allErr, fmt.Errorf("weight property name must be empty for replica division weight type %s, only valid for %s weight type", replicaScheduling.WeightType, placementv1beta1.ReplicaDivisionWeightTypeClusterProperty))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -277,6 +326,12 @@ func validatePolicyForPickFixedPlacementType(policy *placementv1beta1.PlacementP
 	if policy.Tolerations != nil {
 		allErr = append(allErr, fmt.Errorf("tolerations needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
 	}
+	if policy.FailoverPolicy != nil {
+		allErr = append(allErr, fmt.Errorf("failover policy must be nil for policy type %s, only valid for PickN policy type", placementv1beta1.PickFixedPlacementType))
+	}
+	if len(policy.SchedulerName) != 0 {
+		allErr = append(allErr, fmt.Errorf("scheduler name must be empty for policy type %s, only valid for PickAll/PickN policy type", placementv1beta1.PickFixedPlacementType))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -293,9 +348,15 @@ func validatePolicyForPickAllPlacementType(policy *placementv1beta1.PlacementPol
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil {
+		allErr = append(allErr, validatePlacementAffinity(policy.Affinity, policy.PlacementType))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, fmt.Errorf("topology spread constraints needs to be empty for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
 	}
+	if policy.FailoverPolicy != nil {
+		allErr = append(allErr, fmt.Errorf("failover policy must be nil for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
+	}
 	allErr = append(allErr, validateTolerations(policy.Tolerations))
 
 	return apiErrors.NewAggregate(allErr)
@@ -317,6 +378,9 @@ func validatePolicyForPickNPolicyType(policy *placementv1beta1.PlacementPolicy)
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil {
+		allErr = append(allErr, validatePlacementAffinity(policy.Affinity, policy.PlacementType))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, validateTopologySpreadConstraints(policy.TopologySpreadConstraints))
 	}
@@ -327,18 +391,24 @@ func validatePolicyForPickNPolicyType(policy *placementv1beta1.PlacementPolicy)
 
 func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity, placementType placementv1beta1.PlacementType) error {
 	allErr := make([]error, 0)
-	// Both RequiredDuringSchedulingIgnoredDuringExecution and PreferredDuringSchedulingIgnoredDuringExecution are optional fields, so validating only if non-nil/length is greater than zero
+	// RequiredDuringSchedulingIgnoredDuringExecution, RequiredDuringSchedulingRequiredDuringExecution and PreferredDuringSchedulingIgnoredDuringExecution are optional fields, so validating only if non-nil/length is greater than zero
 	switch placementType {
 	case placementv1beta1.PickAllPlacementType:
 		if clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
-			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
+			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution, "RequiredDuringSchedulingIgnoredDuringExecution"))
+		}
+		if clusterAffinity.RequiredDuringSchedulingRequiredDuringExecution != nil {
+			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingRequiredDuringExecution, "RequiredDuringSchedulingRequiredDuringExecution"))
 		}
 		if len(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
 			allErr = append(allErr, fmt.Errorf("PreferredDuringSchedulingIgnoredDuringExecution will be ignored for placement policy type %s", placementType))
 		}
 	case placementv1beta1.PickNPlacementType:
 		if clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
-			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
+			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution, "RequiredDuringSchedulingIgnoredDuringExecution"))
+		}
+		if clusterAffinity.RequiredDuringSchedulingRequiredDuringExecution != nil {
+			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingRequiredDuringExecution, "RequiredDuringSchedulingRequiredDuringExecution"))
 		}
 		if len(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
 			allErr = append(allErr, validatePreferredClusterSelectors(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution))
@@ -347,9 +417,51 @@ func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity,
 	return apiErrors.NewAggregate(allErr)
 }
 
+func validatePlacementAffinity(affinity *placementv1beta1.Affinity, placementType placementv1beta1.PlacementType) error {
+	allErr := make([]error, 0)
+	var required []placementv1beta1.PlacementAffinityTerm
+	var preferred []placementv1beta1.WeightedPlacementAffinityTerm
+	if affinity.PlacementAffinity != nil {
+		required = append(required, affinity.PlacementAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		preferred = append(preferred, affinity.PlacementAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if affinity.PlacementAntiAffinity != nil {
+		required = append(required, affinity.PlacementAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		preferred = append(preferred, affinity.PlacementAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	for i := range required {
+		allErr = append(allErr, validateLabelSelector(&required[i].PlacementSelector, "placement affinity term"))
+	}
+	if len(preferred) > 0 && placementType == placementv1beta1.PickAllPlacementType {
+		allErr = append(allErr, fmt.Errorf("preferred placement affinity and anti-affinity terms will be ignored for placement policy type %s", placementType))
+	}
+	for i := range preferred {
+		allErr = append(allErr, validateLabelSelector(&preferred[i].PlacementAffinityTerm.PlacementSelector, "preferred placement affinity term"))
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+// tolerationKey is a comparable representation of a toleration, which compares the toleration
+// seconds by value rather than by pointer.
+type tolerationKey struct {
+	toleration           placementv1beta1.Toleration
+	tolerationSeconds    int64
+	hasTolerationSeconds bool
+}
+
+func keyOfToleration(toleration placementv1beta1.Toleration) tolerationKey {
+	key := tolerationKey{toleration: toleration}
+	if toleration.TolerationSeconds != nil {
+		key.tolerationSeconds = *toleration.TolerationSeconds
+		key.hasTolerationSeconds = true
+		key.toleration.TolerationSeconds = nil
+	}
+	return key
+}
+
 func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 	allErr := make([]error, 0)
-	tolerationMap := make(map[placementv1beta1.Toleration]bool)
+	tolerationMap := make(map[tolerationKey]bool)
 	for _, toleration := range tolerations {
 		if toleration.Key != "" {
 			for _, msg := range validation.IsQualifiedName(toleration.Key) {
@@ -369,21 +481,25 @@ func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 				allErr = append(allErr, fmt.Errorf(invalidTolerationValueErrFmt, toleration, msg))
 			}
 		}
-		if tolerationMap[toleration] {
+		if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+			allErr = append(allErr, fmt.Errorf(invalidTolerationErrFmt, toleration, "toleration effect must be NoExecute, when tolerationSeconds is set"))
+		}
+		key := keyOfToleration(toleration)
+		if tolerationMap[key] {
 			allErr = append(allErr, fmt.Errorf(uniqueTolerationErrFmt, toleration))
 		}
-		tolerationMap[toleration] = true
+		tolerationMap[key] = true
 	}
 	return apiErrors.NewAggregate(allErr)
 }
 
 func IsTolerationsUpdatedOrDeleted(oldTolerations []placementv1beta1.Toleration, newTolerations []placementv1beta1.Toleration) bool {
-	newTolerationsMap := make(map[placementv1beta1.Toleration]bool)
+	newTolerationsMap := make(map[tolerationKey]bool)
 	for _, newToleration := range newTolerations {
-		newTolerationsMap[newToleration] = true
+		newTolerationsMap[keyOfToleration(newToleration)] = true
 	}
 	for _, oldToleration := range oldTolerations {
-		if !newTolerationsMap[oldToleration] {
+		if !newTolerationsMap[keyOfToleration(oldToleration)] {
 			return true
 		}
 	}
@@ -400,18 +516,18 @@ func validateTopologySpreadConstraints(topologyConstraints []placementv1beta1.To
 	return apiErrors.NewAggregate(allErr)
 }
 
-func validateClusterSelector(clusterSelector *placementv1beta1.ClusterSelector) error {
+func validateClusterSelector(clusterSelector *placementv1beta1.ClusterSelector, affinityType string) error {
 	allErr := make([]error, 0)
 	for _, clusterSelectorTerm := range clusterSelector.ClusterSelectorTerms {
 		// Since label selector is a required field in ClusterSelectorTerm, not checking to see if it's an empty object.
 		allErr = append(allErr, validateLabelSelector(clusterSelectorTerm.LabelSelector, "cluster selector"))
 
-		// Affinity is RequiredDuringSchedulingIgnoredDuringExecution, so check that PropertySorter is nil.
+		// Affinity is required (during scheduling), so check that PropertySorter is nil.
 		if clusterSelectorTerm.PropertySorter != nil {
-			allErr = append(allErr, fmt.Errorf("PropertySorter is not allowed for RequiredDuringSchedulingIgnoredDuringExecution affinity"))
+			allErr = append(allErr, fmt.Errorf("PropertySorter is not allowed for %s affinity", affinityType))
 		}
 
-		// Affinity is RequiredDuringSchedulingIgnoredDuringExecution, so validate PropertySelector if exists
+		// Affinity is required (during scheduling), so validate PropertySelector if exists
 		if clusterSelectorTerm.PropertySelector != nil {
 			allErr = append(allErr, validatePropertySelector(clusterSelectorTerm.PropertySelector))
 		}
@@ -444,7 +560,7 @@ func validateLabelSelector(labelSelector *metav1.LabelSelector, parent string) e
 	return nil
 }
 
-func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) error {
+func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy, pinnedResourceSnapshotIndex *string) error {
 	allErr := make([]error, 0)
 
 	if rolloutStrategy.Type != "" && rolloutStrategy.Type != placementv1beta1.RollingUpdateRolloutStrategyType &&
@@ -452,6 +568,14 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 		allErr = append(allErr, fmt.Errorf("unsupported rollout strategy type `%s`", rolloutStrategy.Type))
 	}
 
+	if rolloutStrategy.Paused && rolloutStrategy.Type == placementv1beta1.ExternalRolloutStrategyType {
+		allErr = append(allErr, errors.New("paused is not valid for ExternalRollout strategy type"))
+	}
+
+	if pinnedResourceSnapshotIndex != nil && rolloutStrategy.Type == placementv1beta1.ExternalRolloutStrategyType {
+		allErr = append(allErr, errors.New("pinnedResourceSnapshotIndex is not valid for ExternalRollout strategy type"))
+	}
+
 	if rolloutStrategy.RollingUpdate != nil {
 		if rolloutStrategy.Type == placementv1beta1.ExternalRolloutStrategyType {
 			allErr = append(allErr, fmt.Errorf("rollingUpdateConifg is not valid for ExternalRollout strategy type"))
@@ -477,6 +601,18 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 				allErr = append(allErr, fmt.Errorf("maxSurge must be greater than or equal to 0, got `%+v`", rolloutStrategy.RollingUpdate.MaxSurge))
 			}
 		}
+		if rolloutStrategy.RollingUpdate.ProgressDeadlineSeconds != nil {
+			if *rolloutStrategy.RollingUpdate.ProgressDeadlineSeconds <= 0 {
+				allErr = append(allErr, fmt.Errorf("progressDeadlineSeconds must be greater than 0, got %d", *rolloutStrategy.RollingUpdate.ProgressDeadlineSeconds))
+			}
+			if rolloutStrategy.RollingUpdate.AutoRollback == nil {
+				allErr = append(allErr, errors.New("progressDeadlineSeconds can only be set when autoRollback is specified"))
+			}
+		}
+		if rolloutStrategy.RollingUpdate.AutoRollback != nil && rolloutStrategy.RollingUpdate.AutoRollback.FailureThreshold != nil &&
+			*rolloutStrategy.RollingUpdate.AutoRollback.FailureThreshold <= 0 {
+			allErr = append(allErr, fmt.Errorf("autoRollback failureThreshold must be greater than 0, got %d", *rolloutStrategy.RollingUpdate.AutoRollback.FailureThreshold))
+		}
 	}
 
 	// server-side apply strategy type is only valid for server-side apply strategy type
@@ -582,6 +718,16 @@ func validateName(name string) error {
 }
 
 func validateOperatorAndValues(op placementv1beta1.PropertySelectorOperator, values []string) error {
+	if requiresValues, ok := supportedStringPropertyOperators[op]; ok {
+		switch {
+		case requiresValues && len(values) == 0:
+			return fmt.Errorf("operator %s requires at least one value", op)
+		case !requiresValues && len(values) != 0:
+			return fmt.Errorf("operator %s requires no value, got %d", op, len(values))
+		}
+		return nil
+	}
+
 	spec, ok := supportedPropertyOperators[op]
 	if !ok {
 		return fmt.Errorf("unsupported operator %s", op)
@@ -591,7 +737,10 @@ func validateOperatorAndValues(op placementv1beta1.PropertySelectorOperator, val
 	}
 	for _, value := range values {
 		if _, err := resource.ParseQuantity(value); err != nil {
-			return fmt.Errorf("value %q is not a valid resource.Quantity: %w", value, err)
+			// Version-like values (e.g., v1.30.2) are compared as versions by the scheduler.
+			if _, verErr := version.ParseGeneric(value); verErr != nil {
+				return fmt.Errorf("value %q is neither a valid resource.Quantity nor a valid version: %w", value, err)
+			}
 		}
 	}
 	return nil
@@ -648,6 +797,10 @@ func validateRequirementsConsistency(reqs []placementv1beta1.PropertySelectorReq
 func collectRequirementBounds(reqs []placementv1beta1.PropertySelectorRequirement) (*requirementBounds, error) {
 	out := &requirementBounds{}
 	for _, req := range reqs {
+		if _, ok := supportedStringPropertyOperators[req.Operator]; ok {
+			// String operators do not fold into the bounds.
+			continue
+		}
 		spec, ok := supportedPropertyOperators[req.Operator]
 		if !ok || len(req.Values) != spec.requiredValueCount {
 			continue
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -194,6 +195,31 @@ func TestValidateClusterResourcePlacement(t *testing.T) {
 				IsClusterScopedResource: true},
 			wantErrMsg: "the name field cannot have length exceeding 63",
 		},
+		"CRP with replicas divided and the External rollout strategy": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+							Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+							WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+						},
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.ExternalRolloutStrategyType,
+					},
+				},
+			},
+			resourceInformer: &testinformer.FakeManager{
+				APIResources:            map[schema.GroupVersionKind]bool{utils.ClusterRoleGVK: true},
+				IsClusterScopedResource: true},
+			wantErr:    true,
+			wantErrMsg: "replica scheduling type Divided is not valid for ExternalRollout strategy type",
+		},
 		"invalid Resource Selector with name & label selector": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
@@ -391,9 +417,10 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 	var unavailablePeriodSeconds = -10
 
 	tests := map[string]struct {
-		strategy   placementv1beta1.RolloutStrategy
-		wantErr    bool
-		wantErrMsg string
+		strategy    placementv1beta1.RolloutStrategy
+		pinnedIndex *string
+		wantErr     bool
+		wantErrMsg  string
 	}{
 		"empty rollout strategy": {
 			wantErr: false,
@@ -431,6 +458,79 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "unavailablePeriodSeconds must be greater than or equal to 0, got -10",
 		},
+		"valid rollout strategy - auto rollback with progress deadline": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					ProgressDeadlineSeconds: ptr.To(600),
+					AutoRollback: &placementv1beta1.AutoRollbackConfig{
+						FailureThreshold: ptr.To(2),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - progress deadline without auto rollback": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					ProgressDeadlineSeconds: ptr.To(600),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "progressDeadlineSeconds can only be set when autoRollback is specified",
+		},
+		"invalid rollout strategy - non-positive progress deadline": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					ProgressDeadlineSeconds: ptr.To(0),
+					AutoRollback:            &placementv1beta1.AutoRollbackConfig{},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "progressDeadlineSeconds must be greater than 0, got 0",
+		},
+		"invalid rollout strategy - non-positive auto rollback failure threshold": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					AutoRollback: &placementv1beta1.AutoRollbackConfig{
+						FailureThreshold: ptr.To(0),
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "autoRollback failureThreshold must be greater than 0, got 0",
+		},
+		"valid rollout strategy - paused rolling update": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type:   placementv1beta1.RollingUpdateRolloutStrategyType,
+				Paused: true,
+			},
+		},
+		"invalid rollout strategy - paused external rollout": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type:   placementv1beta1.ExternalRolloutStrategyType,
+				Paused: true,
+			},
+			wantErr:    true,
+			wantErrMsg: "paused is not valid for ExternalRollout strategy type",
+		},
+		"valid rollout strategy - pinned rolling update": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+			},
+			pinnedIndex: ptr.To("1"),
+		},
+		"invalid rollout strategy - pinned external rollout": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.ExternalRolloutStrategyType,
+			},
+			pinnedIndex: ptr.To("1"),
+			wantErr:     true,
+			wantErrMsg:  "pinnedResourceSnapshotIndex is not valid for ExternalRollout strategy type",
+		},
 		"invalid rollout strategy - % error MaxUnavailable": {
 			strategy: placementv1beta1.RolloutStrategy{
 				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
@@ -512,7 +612,7 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
-			gotErr := validateRolloutStrategy(testCase.strategy)
+			gotErr := validateRolloutStrategy(testCase.strategy, testCase.pinnedIndex)
 			if (gotErr != nil) != testCase.wantErr {
 				t.Errorf("validateRolloutStrategy() error = %v, wantErr %v", gotErr, testCase.wantErr)
 			}
@@ -639,6 +739,27 @@ func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T)
 			wantErr:    true,
 			wantErrMsg: "tolerations needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
 		},
+		"invalid placement policy - PickFixed with failover policy": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"test-cluster"},
+				FailoverPolicy: &placementv1beta1.FailoverPolicy{
+					GracePeriodSeconds:      ptr.To(int32(60)),
+					ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyKeep,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "failover policy must be nil for policy type PickFixed, only valid for PickN policy type",
+		},
+		"invalid placement policy - PickFixed with scheduler name": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"test-cluster"},
+				SchedulerName: "capacity-aware",
+			},
+			wantErr:    true,
+			wantErrMsg: "scheduler name must be empty for policy type PickFixed, only valid for PickAll/PickN policy type",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -722,6 +843,44 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "PreferredDuringSchedulingIgnoredDuringExecution will be ignored for placement policy type PickAll",
 		},
+		"invalid placement policy - PickAll with preferred placement affinity terms": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+							{
+								Weight: 10,
+								PlacementAffinityTerm: placementv1beta1.PlacementAffinityTerm{
+									PlacementSelector: metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "database"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "preferred placement affinity and anti-affinity terms will be ignored for placement policy type PickAll",
+		},
+		"valid placement policy - PickAll with required placement anti-affinity terms": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								PlacementSelector: metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "stateful"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		"invalid placement policy - PickAll with non empty topology constraints": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -922,6 +1081,72 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"invalid placement policy - PickAll with failover policy": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				FailoverPolicy: &placementv1beta1.FailoverPolicy{
+					GracePeriodSeconds:      ptr.To(int32(60)),
+					ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyKeep,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "failover policy must be nil for policy type PickAll, only valid for PickN policy type",
+		},
+		"valid placement policy - PickAll with replicas divided by static weights": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "cluster-1", Weight: 2},
+						{ClusterName: "cluster-2", Weight: 1},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickAll with duplicate cluster names in static weights": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeStatic,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "cluster-1", Weight: 2},
+						{ClusterName: "cluster-1", Weight: 1},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster name cluster-1 appears more than once in the static weights",
+		},
+		"invalid placement policy - PickAll with static weights for even replica division": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:       placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType: placementv1beta1.ReplicaDivisionWeightTypeEven,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "cluster-1", Weight: 2},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "static weights must be empty for replica division weight type Even",
+		},
+		"invalid placement policy - PickAll with invalid weight property name": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaSchedulingPolicy{
+					Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+					WeightType:         placementv1beta1.ReplicaDivisionWeightTypeClusterProperty,
+					WeightPropertyName: "resources.kubernetes-fleet.io/unknown-cpu",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid capacity type in resource property name",
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -1017,6 +1242,31 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "PropertySorter is not allowed for RequiredDuringSchedulingIgnoredDuringExecution affinity",
 		},
+		"invalid placement policy - PickN with non-nil property sorter in RequiredDuringSchedulingRequiredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingRequiredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySorter: &placementv1beta1.PropertySorter{
+										Name:      "Name",
+										SortOrder: placementv1beta1.Descending,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "PropertySorter is not allowed for RequiredDuringSchedulingRequiredDuringExecution affinity",
+		},
 		"invalid placement policy - PickN with invalid property selector in RequiredDuringSchedulingIgnoredDuringExecution affinity": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType:    placementv1beta1.PickNPlacementType,
@@ -1445,6 +1695,99 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "property name segment $ is not valid",
 		},
+		"invalid placement policy - PickN with invalid placement selector in a required placement affinity term": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								PlacementSelector: metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{
+											Key:      "app",
+											Operator: metav1.LabelSelectorOpIn,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the labelSelector in placement affinity term",
+		},
+		"invalid placement policy - PickN with invalid placement selector in a preferred placement anti-affinity term": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+							{
+								Weight: 50,
+								PlacementAffinityTerm: placementv1beta1.PlacementAffinityTerm{
+									PlacementSelector: metav1.LabelSelector{
+										MatchExpressions: []metav1.LabelSelectorRequirement{
+											{
+												Key:      "app",
+												Operator: metav1.LabelSelectorOpExists,
+												Values:   []string{"stateful"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the labelSelector in preferred placement affinity term",
+		},
+		"valid placement policy - PickN with placement affinity and anti-affinity terms": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								PlacementSelector: metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "database"},
+								},
+							},
+						},
+					},
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+							{
+								Weight: 50,
+								PlacementAffinityTerm: placementv1beta1.PlacementAffinityTerm{
+									PlacementSelector: metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "stateful"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"valid placement policy - PickN with failover policy": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				FailoverPolicy: &placementv1beta1.FailoverPolicy{
+					GracePeriodSeconds:      ptr.To(int32(60)),
+					ResourceRetentionPolicy: placementv1beta1.FailoverResourceRetentionPolicyKeep,
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -1747,6 +2090,53 @@ func TestValidateTolerations(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "tolerations must be unique",
 		},
+		"valid toleration, tolerationSeconds is set, effect is NoExecute": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(600)),
+				},
+			},
+			wantErr: false,
+		},
+		"invalid toleration, tolerationSeconds is set, effect is NoSchedule": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoSchedule,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "toleration effect must be NoExecute, when tolerationSeconds is set",
+		},
+		"invalid toleration, non-unique toleration with tolerationSeconds": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "tolerations must be unique",
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -1913,6 +2303,44 @@ func TestIsTolerationsUpdatedOrDeleted(t *testing.T) {
 			},
 			want: false,
 		},
+		"old tolerations, new tolerations with the same tolerationSeconds are same": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			want: false,
+		},
+		"tolerationSeconds was updated in new tolerations": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(600)),
+				},
+			},
+			want: true,
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -2059,10 +2487,16 @@ func TestValidateOperatorAndValues(t *testing.T) {
 		{name: "Eq with one valid value", op: placementv1beta1.PropertySelectorEqualTo, values: []string{"5"}, wantErr: false},
 		{name: "Gt with one valid value", op: placementv1beta1.PropertySelectorGreaterThan, values: []string{"100Mi"}, wantErr: false},
 		{name: "Lte with one valid value", op: placementv1beta1.PropertySelectorLessThanOrEqualTo, values: []string{"2.5"}, wantErr: false},
-		{name: "unsupported operator", op: placementv1beta1.PropertySelectorOperator("In"), values: []string{"5"}, wantErr: true},
+		{name: "Ge with one version value", op: placementv1beta1.PropertySelectorGreaterThanOrEqualTo, values: []string{"v1.30.2"}, wantErr: false},
+		{name: "In with multiple values", op: placementv1beta1.PropertySelectorIn, values: []string{"eastus", "westus"}, wantErr: false},
+		{name: "NotIn with one value", op: placementv1beta1.PropertySelectorNotIn, values: []string{"v1.29"}, wantErr: false},
+		{name: "Exists with zero values", op: placementv1beta1.PropertySelectorExists, values: nil, wantErr: false},
+		{name: "unsupported operator", op: placementv1beta1.PropertySelectorOperator("DoesNotExist"), values: []string{"5"}, wantErr: true},
 		{name: "Eq with zero values", op: placementv1beta1.PropertySelectorEqualTo, values: nil, wantErr: true},
 		{name: "Eq with two values", op: placementv1beta1.PropertySelectorEqualTo, values: []string{"5", "10"}, wantErr: true},
 		{name: "Lt with malformed quantity", op: placementv1beta1.PropertySelectorLessThan, values: []string{"five"}, wantErr: true},
+		{name: "In with zero values", op: placementv1beta1.PropertySelectorIn, values: nil, wantErr: true},
+		{name: "Exists with one value", op: placementv1beta1.PropertySelectorExists, values: []string{"5"}, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2114,6 +2548,8 @@ func TestValidateRequirementsConsistency(t *testing.T) {
 
 		// Malformed inputs are skipped, not surfaced — that's validateOperatorAndValues' job.
 		{name: "malformed value is ignored for consistency check", reqs: []placementv1beta1.PropertySelectorRequirement{req(placementv1beta1.PropertySelectorEqualTo, "not-a-number"), req(placementv1beta1.PropertySelectorEqualTo, "5")}, wantErr: false},
+		{name: "string operators are ignored for consistency check", reqs: []placementv1beta1.PropertySelectorRequirement{req(placementv1beta1.PropertySelectorIn, "10"), req(placementv1beta1.PropertySelectorNotIn, "5"), req(placementv1beta1.PropertySelectorEqualTo, "5")}, wantErr: false},
+		{name: "version value is ignored for consistency check", reqs: []placementv1beta1.PropertySelectorRequirement{req(placementv1beta1.PropertySelectorGreaterThan, "v1.30.0"), req(placementv1beta1.PropertySelectorLessThan, "v1.29.0")}, wantErr: false},
 
 		// checkEqVsNe and checkEqInsideBounds must use Quantity.Cmp, not string equality, so
 		// canonically-equal cross-format inputs still produce the right conflict verdict.
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"fmt"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ValidatePlacementSimulation validates the hypothetical placement policy of a PlacementSimulation object.
+func ValidatePlacementSimulation(simulation *placementv1beta1.PlacementSimulation) error {
+	if simulation.Spec.Policy == nil {
+		return fmt.Errorf("the placement policy field is required")
+	}
+	if err := validatePlacementPolicy(simulation.Spec.Policy); err != nil {
+		return fmt.Errorf("the placement policy field is invalid: %w", err)
+	}
+	return nil
+}
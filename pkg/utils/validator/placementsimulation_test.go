@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestValidatePlacementSimulation(t *testing.T) {
+	tests := map[string]struct {
+		policy     *placementv1beta1.PlacementPolicy
+		wantErr    bool
+		wantErrMsg string
+	}{
+		"valid placement simulation - PickN": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+			},
+		},
+		"valid placement simulation - PickAll": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+		},
+		"invalid placement simulation - nil policy": {
+			wantErr:    true,
+			wantErrMsg: "the placement policy field is required",
+		},
+		"invalid placement simulation - PickN with nil number of clusters": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+			wantErr:    true,
+			wantErrMsg: "number of cluster cannot be nil for policy type PickN",
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			simulation := &placementv1beta1.PlacementSimulation{
+				Spec: placementv1beta1.PlacementSimulationSpec{
+					Policy: testCase.policy,
+				},
+			}
+			gotErr := ValidatePlacementSimulation(simulation)
+			if gotErr != nil != testCase.wantErr {
+				t.Fatalf("ValidatePlacementSimulation() = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("ValidatePlacementSimulation() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
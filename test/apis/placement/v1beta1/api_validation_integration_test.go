@@ -2690,7 +2690,7 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("in body should match.*a-z0-9"))
 		})
 
-		It("Should deny creation of ClusterStagedUpdateStrategy with invalid stage config - more than 2 AfterStageTasks", func() {
+		It("Should deny creation of ClusterStagedUpdateStrategy with invalid stage config - more than 4 AfterStageTasks", func() {
 			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
@@ -2710,6 +2710,19 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 									Type:     placementv1beta1.StageTaskTypeTimedWait,
 									WaitTime: &metav1.Duration{Duration: time.Second * 10},
 								},
+								{
+									Type: placementv1beta1.StageTaskTypeHealthCheck,
+									HealthCheck: &placementv1beta1.StageHealthCheck{
+										Expressions: []string{"true"},
+										SoakTime:    metav1.Duration{Duration: time.Minute * 10},
+									},
+								},
+								{
+									Type: placementv1beta1.StageTaskTypeWebhook,
+									Webhook: &placementv1beta1.StageWebhook{
+										URL: "https://change-management.example.com/approve",
+									},
+								},
 							},
 						},
 					},
@@ -2718,7 +2731,7 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			err := hubClient.Create(ctx, &strategy)
 			var statusErr *k8sErrors.StatusError
 			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
-			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("Too many: 3: must have at most 2 items"))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("Too many: 5: must have at most 4 items"))
 		})
 
 		It("Should deny creation of ClusterStagedUpdateStrategy with AfterStageTask of type Approval with waitTime specified", func() {
@@ -2836,6 +2849,87 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			Expect(hubClient.Delete(ctx, &strategy)).Should(Succeed())
 		})
 
+		It("Should deny creation of ClusterStagedUpdateStrategy with AfterStageTask of type HealthCheck with healthCheck not specified", func() {
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name: fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							AfterStageTasks: []placementv1beta1.StageTask{
+								{
+									Type: placementv1beta1.StageTaskTypeHealthCheck,
+								},
+							},
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("AfterStageTaskType is HealthCheck, healthCheck is required"))
+		})
+
+		It("Should deny creation of ClusterStagedUpdateStrategy with AfterStageTask of type TimedWait with healthCheck specified", func() {
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name: fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							AfterStageTasks: []placementv1beta1.StageTask{
+								{
+									Type:     placementv1beta1.StageTaskTypeTimedWait,
+									WaitTime: &metav1.Duration{Duration: time.Minute * 10},
+									HealthCheck: &placementv1beta1.StageHealthCheck{
+										Expressions: []string{"true"},
+										SoakTime:    metav1.Duration{Duration: time.Minute * 10},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("healthCheck is only allowed for AfterStageTaskType HealthCheck"))
+		})
+
+		It("Should deny creation of ClusterStagedUpdateStrategy with BeforeStageTask of type HealthCheck", func() {
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name: fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							BeforeStageTasks: []placementv1beta1.StageTask{
+								{
+									Type: placementv1beta1.StageTaskTypeHealthCheck,
+									HealthCheck: &placementv1beta1.StageHealthCheck{
+										Expressions: []string{"true"},
+										SoakTime:    metav1.Duration{Duration: time.Minute * 10},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("BeforeStageTaskType cannot be HealthCheck"))
+		})
+
 		It("Should deny creation of ClusterStagedUpdateStrategy with BeforeStageTask of type TimedWait", func() {
 			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2891,7 +2985,7 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			Expect(hubClient.Delete(ctx, &strategy)).Should(Succeed())
 		})
 
-		It("Should deny creation of ClusterStagedUpdateStrategy with more than 1 BeforeStageTask", func() {
+		It("Should deny creation of ClusterStagedUpdateStrategy with more than 2 BeforeStageTasks", func() {
 			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
@@ -2907,6 +3001,63 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 								{
 									Type: placementv1beta1.StageTaskTypeApproval,
 								},
+								{
+									Type: placementv1beta1.StageTaskTypeWebhook,
+									Webhook: &placementv1beta1.StageWebhook{
+										URL: "https://change-management.example.com/approve",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("Too many: 3: must have at most 2 items"))
+		})
+
+		It("Should deny creation of ClusterStagedUpdateStrategy with BeforeStageTask of type Webhook with webhook not specified", func() {
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name: fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							BeforeStageTasks: []placementv1beta1.StageTask{
+								{
+									Type: placementv1beta1.StageTaskTypeWebhook,
+								},
+							},
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("BeforeStageTaskType is Webhook, webhook is required"))
+		})
+
+		It("Should deny creation of ClusterStagedUpdateStrategy with AfterStageTask of type Approval with webhook specified", func() {
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name: fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							AfterStageTasks: []placementv1beta1.StageTask{
+								{
+									Type: placementv1beta1.StageTaskTypeApproval,
+									Webhook: &placementv1beta1.StageWebhook{
+										URL: "https://change-management.example.com/approve",
+									},
+								},
 							},
 						},
 					},
@@ -2915,7 +3066,7 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			err := hubClient.Create(ctx, &strategy)
 			var statusErr *k8sErrors.StatusError
 			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
-			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("Too many: 2: must have at most 1 items"))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("webhook is only allowed for AfterStageTaskType Webhook"))
 		})
 
 		It("Should deny creation of ClusterStagedUpdateStrategy with MaxConcurrency set to a negative value", func() {